@@ -4,20 +4,25 @@ import (
 	"context"
 	"log"
 	"os"
+	"time"
 
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth"
 	authMiddleware "github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/banner"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/comment"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest"
+	contestApplication "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/discord"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game"
+	gameApplication "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/middleware"
 	authProvider "github.com/FOR-GAMERS/GAMERS-BE/internal/global/security/jwt"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox"
+	outboxApplication "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/point"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/storage"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/user"
@@ -107,11 +112,15 @@ func main() {
 	// User module - uses OAuth2 repository for Discord avatar URL generation
 	userDeps := user.ProvideUserDependencies(db, appRouter, oauth2Deps.OAuth2Repository)
 
+	// Outbox module - domain events are stored with the state change and relayed to RabbitMQ
+	outboxDeps := outbox.ProvideOutboxDependencies(db, redisClient, rabbitmqConn)
+
 	// Game module - provides Game, Team, and GameTeam management
 	gameDeps := game.ProvideGameDependencies(
 		db,
 		redisClient,
 		rabbitmqConn,
+		outboxDeps.Service,
 		appRouter,
 		nil, // Contest repository will be set after contest initialization
 		oauth2Deps.OAuth2Repository,
@@ -122,7 +131,8 @@ func main() {
 	contestDeps := contest.ProvideContestDependenciesFull(
 		db,
 		redisClient,
-		rabbitmqConn,
+		outboxDeps.Service,
+		rabbitmqConn.Config().Exchange,
 		appRouter,
 		oauth2Deps.OAuth2Repository,
 		userDeps.UserQueryRepo,
@@ -130,13 +140,49 @@ func main() {
 		gameDeps.GameRepository,
 		gameDeps.TeamRepository,
 		gameDeps.GameTeamRepository,
+		gameDeps.TeamService,
+		gameDeps.ContestCleanupService,
+		gameDeps.TournamentResultService,
 	)
 
 	// Set contest repository for team service and tournament result service (to resolve circular dependency)
 	gameDeps.TeamService.SetContestRepository(contestDeps.ContestRepository)
+	gameDeps.TeamService.SetEligibilityChecker(contestDeps.EligibilityChecker)
+	gameDeps.GameService.SetPermissionChecker(contestDeps.PermissionChecker)
+	gameDeps.GameService.SetAccessChecker(contestDeps.AccessService)
+	gameDeps.GameTeamService.SetAccessChecker(contestDeps.AccessService)
+	gameDeps.CalendarService.SetContestRepository(contestDeps.ContestRepository)
+	gameDeps.CalendarService.SetAccessChecker(contestDeps.AccessService)
+	gameDeps.ResultExportService.SetPermissionChecker(contestDeps.PermissionChecker)
+	gameDeps.PlayerStatsService.SetAccessChecker(contestDeps.AccessService)
+	gameDeps.LeaderboardService.SetContestRepository(contestDeps.ContestRepository)
+	gameDeps.RatingService.SetContestRepository(contestDeps.ContestRepository)
+	gameDeps.RatingService.SetAccessChecker(contestDeps.AccessService)
+	gameDeps.PickemService.SetContestRepository(contestDeps.ContestRepository)
+	gameDeps.PickemService.SetAccessChecker(contestDeps.AccessService)
 	gameDeps.TournamentResultService.SetContestDBPort(contestDeps.ContestRepository)
+	gameDeps.RosterService.SetContestRepository(contestDeps.ContestRepository)
+	gameDeps.RosterService.SetPermissionChecker(contestDeps.PermissionChecker)
+	gameDeps.ReconcileService.SetContestRepository(contestDeps.ContestRepository)
+
+	// Show achievement badges on user profiles
+	userDeps.Service.SetBadgeQueryPort(gameDeps.AchievementService)
+
+	// Multi-step writes and their outbox events share one transaction
+	contestDeps.ApplicationService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.GameSchedulerService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.ContestCleanupService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.RosterService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.TeamService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.MatchDetectionService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.ReconcileService.SetTransactionManager(outboxDeps.TransactionManager)
+	gameDeps.RatingService.SetTransactionManager(outboxDeps.TransactionManager)
+	contestDeps.ContestService.SetTransactionManager(outboxDeps.TransactionManager)
+	if contestDeps.SeriesService != nil {
+		contestDeps.SeriesService.SetTransactionManager(outboxDeps.TransactionManager)
+	}
 
-	commentDeps := comment.ProvideCommentDependencies(db, appRouter, contestDeps.ContestRepository)
+	commentDeps := comment.ProvideCommentDependencies(db, appRouter, contestDeps.ContestRepository, contestDeps.PermissionChecker, contestDeps.AccessService)
 
 	// Point module - provides Valorant score table management
 	pointDeps := point.ProvidePointDependencies(db, appRouter)
@@ -161,10 +207,55 @@ func main() {
 	// Wire notification handler to contest and game services
 	contestDeps.ApplicationService.SetNotificationHandler(notificationDeps.Service)
 	gameDeps.TeamService.SetNotificationHandler(notificationDeps.Service)
+	contestDeps.DraftService.SetNotificationHandler(notificationDeps.Service)
+	contestDeps.ContestService.SetNotificationHandler(notificationDeps.Service)
+	gameDeps.RosterService.SetNotificationHandler(notificationDeps.Service)
+	contestDeps.OrganizerService.SetNotificationHandler(notificationDeps.Service)
+	contestDeps.AccessService.SetNotificationHandler(notificationDeps.Service)
+	gameDeps.AchievementService.SetNotificationHandler(notificationDeps.Service)
+
+	// Start outbox relay (publishes stored domain events to RabbitMQ)
+	startOutboxRelayJob(ctx, outboxDeps)
 
 	// Start Team Persistence Consumer for Write-Behind pattern
 	startTeamPersistenceConsumer(ctx, gameDeps)
 
+	// Start Team Dead-Letter Consumer (records events that failed all retries)
+	startTeamDeadLetterConsumer(ctx, gameDeps)
+
+	// Start Leaderboard Consumer (applies finished games to the leaderboards)
+	startLeaderboardConsumer(ctx, gameDeps)
+
+	// Start Rating Consumer (rates players and rosters from finished games)
+	startRatingConsumer(ctx, gameDeps)
+
+	// Start Match Award Consumer (stat awards and MVP votes of finished games)
+	startMatchAwardConsumer(ctx, gameDeps)
+
+	// Start Achievement Consumer (awards badges from game, team and contest events)
+	startAchievementConsumer(ctx, gameDeps)
+
+	// Start Pick'em Consumer (scores bracket predictions of finished games)
+	startPickemConsumer(ctx, gameDeps)
+
+	// Start captain draft pick clock (auto-pick on timeout)
+	startDraftClock(ctx, contestDeps)
+
+	// Start contest lifecycle job (auto-start at StartedAt, auto-finish)
+	startContestLifecycleJob(ctx, contestDeps)
+
+	// Start contest series job (creates upcoming recurring contests)
+	startContestSeriesJob(ctx, contestDeps)
+
+	// Start registration close job (roster lock at contest registration deadline)
+	startRegistrationCloseJob(ctx, gameDeps)
+
+	// Start team reconciliation job (Redis team cache vs MySQL drift check)
+	startTeamReconciliationJob(ctx, gameDeps)
+
+	// Start MVP voting close job (awards the MVP of matches whose voting expired)
+	startMvpVotingCloseJob(ctx, gameDeps)
+
 	setupRouter(appRouter, authDeps, userDeps, oauth2Deps, contestDeps, commentDeps, discordDeps, gameDeps, pointDeps, valorantDeps, storageDeps, bannerDeps, notificationDeps)
 
 	startServer(appRouter.Engine())
@@ -217,11 +308,30 @@ func setupRouter(
 	oauth2Deps.Controller.RegisterRoutes()
 	contestDeps.Controller.RegisterRoute()
 	contestDeps.ApplicationController.RegisterRoute()
+	contestDeps.DraftController.RegisterRoute()
+	contestDeps.FormController.RegisterRoute()
+	contestDeps.AutoAcceptController.RegisterRoute()
+	contestDeps.TemplateController.RegisterRoute()
+	contestDeps.SeriesController.RegisterRoute()
+	contestDeps.OrganizerController.RegisterRoute()
+	contestDeps.AccessController.RegisterRoute()
+	contestDeps.DiscoveryController.RegisterRoute()
 	commentDeps.Controller.RegisterRoutes()
 	// discordDeps.Controller routes are registered in the constructor
 	gameDeps.GameController.RegisterRoutes()
 	gameDeps.TeamController.RegisterRoutes()
 	gameDeps.GameTeamController.RegisterRoutes()
+	gameDeps.RosterController.RegisterRoutes()
+	gameDeps.ReconcileController.RegisterRoutes()
+	gameDeps.DeadLetterController.RegisterRoutes()
+	gameDeps.CalendarController.RegisterRoutes()
+	gameDeps.ResultExportController.RegisterRoutes()
+	gameDeps.PlayerStatsController.RegisterRoutes()
+	gameDeps.LeaderboardController.RegisterRoutes()
+	gameDeps.RatingController.RegisterRoutes()
+	gameDeps.MatchAwardController.RegisterRoutes()
+	gameDeps.AchievementController.RegisterRoutes()
+	gameDeps.PickemController.RegisterRoutes()
 	pointDeps.ValorantController.RegisterRoutes()
 	valorantDeps.Controller.RegisterRoutes()
 	if storageDeps != nil {
@@ -297,3 +407,251 @@ func startTeamPersistenceConsumer(ctx context.Context, gameDeps *game.Dependenci
 		}
 	}()
 }
+
+// startTeamDeadLetterConsumer drains the team persistence DLQ into the dead letter table
+func startTeamDeadLetterConsumer(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.DeadLetterConsumer == nil || gameDeps.DeadLetterService == nil {
+		log.Println("Team dead-letter consumer not initialized, skipping...")
+		return
+	}
+
+	go func() {
+		log.Println("Starting Team Dead-Letter Consumer...")
+		if err := gameDeps.DeadLetterConsumer.Start(ctx, gameDeps.DeadLetterService.RecordDeadLetter); err != nil {
+			log.Printf("Failed to start team dead-letter consumer: %v", err)
+		}
+	}()
+}
+
+// startLeaderboardConsumer applies game.finished events to the Redis leaderboards
+func startLeaderboardConsumer(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.LeaderboardConsumer == nil || gameDeps.LeaderboardService == nil {
+		log.Println("Leaderboard consumer not initialized, skipping...")
+		return
+	}
+
+	go func() {
+		log.Println("Starting Leaderboard Consumer...")
+		if err := gameDeps.LeaderboardConsumer.Start(ctx, gameDeps.LeaderboardService.HandleGameEvent); err != nil {
+			log.Printf("Failed to start leaderboard consumer: %v", err)
+		}
+	}()
+}
+
+// startRatingConsumer applies game.finished events to the player and roster ratings
+func startRatingConsumer(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.RatingConsumer == nil || gameDeps.RatingService == nil {
+		log.Println("Rating consumer not initialized, skipping...")
+		return
+	}
+
+	go func() {
+		log.Println("Starting Rating Consumer...")
+		if err := gameDeps.RatingConsumer.Start(ctx, gameDeps.RatingService.HandleGameEvent); err != nil {
+			log.Printf("Failed to start rating consumer: %v", err)
+		}
+	}()
+}
+
+// startMatchAwardConsumer applies game.finished events to the match awards and MVP votes
+func startMatchAwardConsumer(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.MatchAwardConsumer == nil || gameDeps.MatchAwardService == nil {
+		log.Println("Match award consumer not initialized, skipping...")
+		return
+	}
+
+	go func() {
+		log.Println("Starting Match Award Consumer...")
+		if err := gameDeps.MatchAwardConsumer.Start(ctx, gameDeps.MatchAwardService.HandleGameEvent); err != nil {
+			log.Printf("Failed to start match award consumer: %v", err)
+		}
+	}()
+}
+
+// startAchievementConsumer evaluates the achievement rules on game, team and contest events
+func startAchievementConsumer(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.AchievementConsumer == nil || gameDeps.AchievementService == nil {
+		log.Println("Achievement consumer not initialized, skipping...")
+		return
+	}
+
+	go func() {
+		log.Println("Starting Achievement Consumer...")
+		if err := gameDeps.AchievementConsumer.Start(ctx, gameDeps.AchievementService.HandleAchievementEvent); err != nil {
+			log.Printf("Failed to start achievement consumer: %v", err)
+		}
+	}()
+}
+
+// startPickemConsumer applies game.finished events to the pick'em predictions
+func startPickemConsumer(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.PickemConsumer == nil || gameDeps.PickemService == nil {
+		log.Println("Pickem consumer not initialized, skipping...")
+		return
+	}
+
+	go func() {
+		log.Println("Starting Pickem Consumer...")
+		if err := gameDeps.PickemConsumer.Start(ctx, gameDeps.PickemService.HandleGameEvent); err != nil {
+			log.Printf("Failed to start pickem consumer: %v", err)
+		}
+	}()
+}
+
+// startDraftClock runs the captain draft pick clock
+func startDraftClock(ctx context.Context, contestDeps *contest.Dependencies) {
+	if contestDeps.DraftService == nil {
+		log.Println("Draft service not initialized, skipping draft clock...")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(contestApplication.DraftClockInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				contestDeps.DraftService.RunDraftClock()
+			}
+		}
+	}()
+}
+
+// startContestLifecycleJob starts auto-start contests and finishes contests whose games are done or end time passed
+func startContestLifecycleJob(ctx context.Context, contestDeps *contest.Dependencies) {
+	if contestDeps.LifecycleService == nil {
+		log.Println("Contest lifecycle service not initialized, skipping lifecycle job...")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(contestApplication.ContestLifecycleInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				contestDeps.LifecycleService.RunLifecycle()
+			}
+		}
+	}()
+}
+
+// startContestSeriesJob creates upcoming contests of recurring series within their create-ahead window
+func startContestSeriesJob(ctx context.Context, contestDeps *contest.Dependencies) {
+	if contestDeps.SeriesService == nil {
+		log.Println("Contest series service not initialized, skipping series job...")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(contestApplication.ContestSeriesInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				contestDeps.SeriesService.RunScheduler()
+			}
+		}
+	}()
+}
+
+// startRegistrationCloseJob locks contest rosters once their registration close time passes
+func startRegistrationCloseJob(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.GameSchedulerService == nil {
+		log.Println("Game scheduler not initialized, skipping registration close job...")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(gameApplication.RegistrationCloseInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				gameDeps.GameSchedulerService.RunRegistrationClose()
+			}
+		}
+	}()
+}
+
+// startTeamReconciliationJob compares the Redis team cache with MySQL and repairs drift of pending contests
+func startTeamReconciliationJob(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.GameSchedulerService == nil {
+		log.Println("Game scheduler not initialized, skipping team reconciliation job...")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(gameApplication.TeamReconciliationInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				gameDeps.GameSchedulerService.RunTeamReconciliation()
+			}
+		}
+	}()
+}
+
+// startMvpVotingCloseJob closes expired MVP votings and awards their MVPs
+func startMvpVotingCloseJob(ctx context.Context, gameDeps *game.Dependencies) {
+	if gameDeps.MatchAwardService == nil {
+		log.Println("Match award service not initialized, skipping MVP voting close job...")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(gameApplication.MvpVotingCloseInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				gameDeps.MatchAwardService.RunMvpVotingClose()
+			}
+		}
+	}()
+}
+
+// startOutboxRelayJob publishes pending outbox events and prunes published ones
+func startOutboxRelayJob(ctx context.Context, outboxDeps *outbox.Dependencies) {
+	if outboxDeps.RelayService == nil {
+		log.Println("Outbox relay not initialized, skipping outbox relay job...")
+		return
+	}
+
+	go func() {
+		relayTicker := time.NewTicker(outboxApplication.OutboxRelayInterval)
+		defer relayTicker.Stop()
+		cleanupTicker := time.NewTicker(outboxApplication.OutboxCleanupInterval)
+		defer cleanupTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-relayTicker.C:
+				outboxDeps.RelayService.RunRelay()
+			case <-cleanupTicker.C:
+				outboxDeps.RelayService.RunCleanup()
+			}
+		}
+	}()
+}
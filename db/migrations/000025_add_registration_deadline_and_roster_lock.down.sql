@@ -0,0 +1,23 @@
+-- Drop tables first
+DROP TABLE IF EXISTS roster_change_requests;
+DROP TABLE IF EXISTS roster_lock_reports;
+
+-- Drop index conditionally
+SET @idx_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND INDEX_NAME = 'idx_contests_registration_close');
+SET @sql = IF(@idx_exists > 0, 'DROP INDEX idx_contests_registration_close ON contests', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Drop columns conditionally
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'roster_locked_at');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE contests DROP COLUMN roster_locked_at', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'registration_closes_at');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE contests DROP COLUMN registration_closes_at', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,59 @@
+-- Add registration deadline and roster lock fields to contests table
+-- Note: Using conditional approach to handle partial migrations
+
+-- Add registration_closes_at if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'registration_closes_at');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN registration_closes_at DATETIME NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add roster_locked_at if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'roster_locked_at');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN roster_locked_at DATETIME NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add idx_contests_registration_close if not exists
+SET @idx_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND INDEX_NAME = 'idx_contests_registration_close');
+SET @sql = IF(@idx_exists = 0, 'CREATE INDEX idx_contests_registration_close ON contests(registration_closes_at, roster_locked_at)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Roster lock reports table (one report per contest lock run)
+CREATE TABLE IF NOT EXISTS roster_lock_reports (
+    report_id            BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id           BIGINT NOT NULL,
+    locked_at            DATETIME NOT NULL,
+    finalized_team_count INT NOT NULL DEFAULT 0,
+    dropped_team_count   INT NOT NULL DEFAULT 0,
+    dropped_teams        JSON NULL,
+    created_at           DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_roster_lock_reports_contest (contest_id),
+    CONSTRAINT fk_roster_lock_reports_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Roster change requests table (staff approval after roster lock)
+CREATE TABLE IF NOT EXISTS roster_change_requests (
+    request_id          BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id          BIGINT NOT NULL,
+    team_id             BIGINT NOT NULL,
+    requested_by        BIGINT NOT NULL,
+    change_type         VARCHAR(16) NOT NULL,
+    target_user_id      BIGINT NULL,
+    replacement_user_id BIGINT NULL,
+    reason              VARCHAR(500) NULL,
+    status              VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    reviewed_by         BIGINT NULL,
+    review_note         VARCHAR(500) NULL,
+    reviewed_at         DATETIME NULL,
+    created_at          DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_roster_change_requests_contest (contest_id, status),
+    INDEX idx_roster_change_requests_team (team_id, status),
+    CONSTRAINT fk_roster_change_requests_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE,
+    CONSTRAINT fk_roster_change_requests_team FOREIGN KEY (team_id) REFERENCES teams(team_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS team_persistence_processed_events;
+DROP TABLE IF EXISTS team_persistence_dead_letters;
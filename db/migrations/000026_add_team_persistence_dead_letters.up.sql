@@ -0,0 +1,28 @@
+-- Dead-lettered team persistence events (failed all retries, inspected/replayed by admins)
+CREATE TABLE IF NOT EXISTS team_persistence_dead_letters (
+    dead_letter_id  BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id        VARCHAR(64) NULL,
+    idempotency_key VARCHAR(128) NULL,
+    event_type      VARCHAR(64) NOT NULL,
+    contest_id      BIGINT NULL,
+    team_id         BIGINT NULL,
+    payload         TEXT NOT NULL,
+    last_error      VARCHAR(1000) NULL,
+    retry_count     INT NOT NULL DEFAULT 0,
+    status          VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    resolved_by     BIGINT NULL,
+    resolved_at     DATETIME NULL,
+    created_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_team_dead_letters_status (status, created_at),
+    INDEX idx_team_dead_letters_contest (contest_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Idempotency keys of applied team persistence events (duplicate deliveries are skipped)
+CREATE TABLE IF NOT EXISTS team_persistence_processed_events (
+    idempotency_key VARCHAR(128) PRIMARY KEY,
+    event_type      VARCHAR(64) NOT NULL,
+    processed_at    DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_team_processed_events_processed_at (processed_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,22 @@
+-- Transactional outbox: broker messages written with the state change, published by the relay
+CREATE TABLE IF NOT EXISTS outbox_events (
+    outbox_id       BIGINT AUTO_INCREMENT PRIMARY KEY,
+    aggregate_type  VARCHAR(32) NOT NULL,
+    aggregate_id    BIGINT NOT NULL,
+    exchange        VARCHAR(128) NOT NULL,
+    routing_key     VARCHAR(128) NOT NULL,
+    message_id      VARCHAR(64) NOT NULL,
+    payload         TEXT NOT NULL,
+    headers         JSON NULL,
+    status          VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    attempts        INT NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME NOT NULL,
+    last_error      VARCHAR(1000) NULL,
+    occurred_at     DATETIME NOT NULL,
+    published_at    DATETIME NULL,
+    created_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_outbox_events_pending (status, next_attempt_at),
+    INDEX idx_outbox_events_aggregate (aggregate_type, aggregate_id, status, outbox_id),
+    INDEX idx_outbox_events_published (status, published_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
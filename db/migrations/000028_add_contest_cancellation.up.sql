@@ -0,0 +1,16 @@
+-- Add cancellation fields to contests table
+-- Note: Using conditional approach to handle partial migrations
+
+-- Add cancelled_at if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'cancelled_at');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN cancelled_at DATETIME NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add cancel_reason if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'cancel_reason');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN cancel_reason VARCHAR(500) NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
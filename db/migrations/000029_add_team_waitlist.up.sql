@@ -0,0 +1,23 @@
+-- Add waitlist fields to teams table
+-- Note: Using conditional approach to handle partial migrations
+
+-- Add status if not exists (existing teams keep their slot)
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'teams' AND COLUMN_NAME = 'status');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE teams ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT ''REGISTERED''', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add waitlisted_at if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'teams' AND COLUMN_NAME = 'waitlisted_at');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE teams ADD COLUMN waitlisted_at TIMESTAMP NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Index for waitlist ordering
+SET @idx_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'teams' AND INDEX_NAME = 'idx_teams_waitlist');
+SET @sql = IF(@idx_exists = 0, 'CREATE INDEX idx_teams_waitlist ON teams(contest_id, status, waitlisted_at)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS contest_application_answers;
+DROP TABLE IF EXISTS contest_application_forms;
@@ -0,0 +1,23 @@
+-- Contest application forms table (one form per contest)
+CREATE TABLE IF NOT EXISTS contest_application_forms (
+    form_id     BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id  BIGINT NOT NULL,
+    questions   JSON NOT NULL,
+    created_at  DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_contest_application_forms_contest (contest_id),
+    CONSTRAINT fk_contest_application_forms_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Applicant answers table (kept after applications are cleared at contest start for export)
+CREATE TABLE IF NOT EXISTS contest_application_answers (
+    answer_id    BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id   BIGINT NOT NULL,
+    user_id      BIGINT NOT NULL,
+    answers      JSON NOT NULL,
+    submitted_at DATETIME NOT NULL,
+
+    UNIQUE INDEX idx_contest_application_answers_user (contest_id, user_id),
+    CONSTRAINT fk_contest_application_answers_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
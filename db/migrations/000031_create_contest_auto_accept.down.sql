@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS contest_auto_accept_decisions;
+DROP TABLE IF EXISTS contest_auto_accept_rules;
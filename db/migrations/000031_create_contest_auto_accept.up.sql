@@ -0,0 +1,30 @@
+-- Contest auto-accept rules table (one rule per contest)
+CREATE TABLE IF NOT EXISTS contest_auto_accept_rules (
+    rule_id              BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id           BIGINT NOT NULL,
+    enabled              BOOLEAN NOT NULL DEFAULT TRUE,
+    min_tier             INT NULL,
+    max_tier             INT NULL,
+    require_riot_account BOOLEAN NOT NULL DEFAULT FALSE,
+    require_guild_member BOOLEAN NOT NULL DEFAULT FALSE,
+    accept_cap           INT NULL,
+    on_mismatch          VARCHAR(16) NOT NULL DEFAULT 'LEAVE_PENDING',
+    created_at           DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at          DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_contest_auto_accept_rules_contest (contest_id),
+    CONSTRAINT fk_contest_auto_accept_rules_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Automatic decisions audit table
+CREATE TABLE IF NOT EXISTS contest_auto_accept_decisions (
+    decision_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id  BIGINT NOT NULL,
+    user_id     BIGINT NOT NULL,
+    outcome     VARCHAR(16) NOT NULL,
+    reason      VARCHAR(255) NOT NULL,
+    decided_at  DATETIME NOT NULL,
+
+    INDEX idx_contest_auto_accept_decisions_contest (contest_id, decided_at),
+    CONSTRAINT fk_contest_auto_accept_decisions_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
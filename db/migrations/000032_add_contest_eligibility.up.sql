@@ -0,0 +1,44 @@
+-- Add eligibility constraints to contests table
+-- Note: Using conditional approach to handle partial migrations
+
+-- Add eligible_regions if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'eligible_regions');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN eligible_regions JSON NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add eligible_min_tier if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'eligible_min_tier');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN eligible_min_tier INT NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add eligible_max_tier if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'eligible_max_tier');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN eligible_max_tier INT NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add eligible_tier_basis if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'eligible_tier_basis');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN eligible_tier_basis VARCHAR(16) NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add eligible_min_account_age_days if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'eligible_min_account_age_days');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN eligible_min_account_age_days INT NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add eligible_require_guild_member if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'eligible_require_guild_member');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN eligible_require_guild_member BOOLEAN NOT NULL DEFAULT FALSE', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,29 @@
+-- Contest templates table (reusable contest settings)
+CREATE TABLE IF NOT EXISTS contest_templates (
+    template_id                   BIGINT AUTO_INCREMENT PRIMARY KEY,
+    owner_user_id                 BIGINT NOT NULL,
+    name                          VARCHAR(100) NOT NULL,
+    title                         VARCHAR(255) NOT NULL,
+    description                   TEXT NULL,
+    max_team_count                INT NULL,
+    total_point                   INT NOT NULL DEFAULT 100,
+    contest_type                  VARCHAR(16) NOT NULL,
+    auto_start                    BOOLEAN NOT NULL DEFAULT FALSE,
+    game_type                     VARCHAR(32) NULL,
+    game_point_table_id           BIGINT NULL,
+    total_team_member             INT NOT NULL DEFAULT 5,
+    discord_guild_id              VARCHAR(255) NULL,
+    discord_text_channel_id       VARCHAR(255) NULL,
+    thumbnail                     VARCHAR(512) NULL,
+    eligible_regions              JSON NULL,
+    eligible_min_tier             INT NULL,
+    eligible_max_tier             INT NULL,
+    eligible_tier_basis           VARCHAR(16) NULL,
+    eligible_min_account_age_days INT NULL,
+    eligible_require_guild_member BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at                    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at                   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX idx_contest_templates_owner (owner_user_id),
+    CONSTRAINT fk_contest_templates_owner FOREIGN KEY (owner_user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
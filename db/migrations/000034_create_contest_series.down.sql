@@ -0,0 +1,8 @@
+-- Drop series_id from contests conditionally
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'series_id');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE contests DROP FOREIGN KEY fk_contests_series, DROP INDEX idx_contests_series, DROP COLUMN series_id', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+DROP TABLE IF EXISTS contest_series;
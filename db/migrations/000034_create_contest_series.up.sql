@@ -0,0 +1,35 @@
+-- Contest series table (recurring contests grouped into a season)
+CREATE TABLE IF NOT EXISTS contest_series (
+    series_id                  BIGINT AUTO_INCREMENT PRIMARY KEY,
+    owner_user_id              BIGINT NOT NULL,
+    template_id                BIGINT NOT NULL,
+    name                       VARCHAR(100) NOT NULL,
+    description                TEXT NULL,
+    weekday                    TINYINT NOT NULL,
+    start_time                 VARCHAR(5) NOT NULL,
+    timezone                   VARCHAR(64) NOT NULL,
+    interval_weeks             INT NOT NULL DEFAULT 1,
+    duration_minutes           INT NOT NULL,
+    registration_close_minutes INT NULL,
+    create_ahead_days          INT NOT NULL DEFAULT 7,
+    starts_on                  DATETIME NOT NULL,
+    ends_on                    DATETIME NULL,
+    active                     BOOLEAN NOT NULL DEFAULT TRUE,
+    points_table               JSON NOT NULL,
+    instance_count             INT NOT NULL DEFAULT 0,
+    last_scheduled_at          DATETIME NULL,
+    created_at                 TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at                TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX idx_contest_series_owner (owner_user_id),
+    INDEX idx_contest_series_active (active),
+    CONSTRAINT fk_contest_series_owner FOREIGN KEY (owner_user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contest_series_template FOREIGN KEY (template_id) REFERENCES contest_templates(template_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Add series_id to contests if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'series_id');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN series_id BIGINT NULL, ADD INDEX idx_contests_series (series_id), ADD CONSTRAINT fk_contests_series FOREIGN KEY (series_id) REFERENCES contest_series(series_id) ON DELETE SET NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS contest_organizer_invites;
+
+-- Drop staff_role from contests_members conditionally
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests_members' AND COLUMN_NAME = 'staff_role');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE contests_members DROP COLUMN staff_role', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,20 @@
+-- Add staff_role to contests_members if not exists (NULL on legacy staff rows, treated as ADMIN)
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests_members' AND COLUMN_NAME = 'staff_role');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests_members ADD COLUMN staff_role VARCHAR(16) NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Pending co-organizer invitations
+CREATE TABLE IF NOT EXISTS contest_organizer_invites (
+    contest_id BIGINT NOT NULL,
+    user_id    BIGINT NOT NULL,
+    role       VARCHAR(16) NOT NULL,
+    invited_by BIGINT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (contest_id, user_id),
+    INDEX idx_contest_organizer_invites_user (user_id),
+    CONSTRAINT fk_contest_organizer_invites_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE,
+    CONSTRAINT fk_contest_organizer_invites_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
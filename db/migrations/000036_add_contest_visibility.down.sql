@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS contest_access_grants;
+
+-- Drop join_code from contests conditionally
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'join_code');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE contests DROP INDEX idx_contests_join_code, DROP COLUMN join_code', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Drop visibility from contests conditionally
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'visibility');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE contests DROP INDEX idx_contests_visibility, DROP COLUMN visibility', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,27 @@
+-- Add visibility to contests if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'visibility');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT ''PUBLIC'', ADD INDEX idx_contests_visibility (visibility)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add join_code to contests if not exists
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND COLUMN_NAME = 'join_code');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE contests ADD COLUMN join_code VARCHAR(16) NULL, ADD UNIQUE INDEX idx_contests_join_code (join_code)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Users allowed to read and apply to private contests (join code redeemed or invited)
+CREATE TABLE IF NOT EXISTS contest_access_grants (
+    contest_id BIGINT NOT NULL,
+    user_id    BIGINT NOT NULL,
+    source     VARCHAR(16) NOT NULL,
+    granted_by BIGINT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (contest_id, user_id),
+    INDEX idx_contest_access_grants_user (user_id),
+    CONSTRAINT fk_contest_access_grants_contest FOREIGN KEY (contest_id) REFERENCES contests(contest_id) ON DELETE CASCADE,
+    CONSTRAINT fk_contest_access_grants_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,13 @@
+-- Add ft_contests_title_description if not exists (full-text search over title and description)
+SET @idx_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND INDEX_NAME = 'ft_contests_title_description');
+SET @sql = IF(@idx_exists = 0, 'CREATE FULLTEXT INDEX ft_contests_title_description ON contests(title, description)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- Add idx_contests_discovery if not exists (listed contests filtered by status and start date)
+SET @idx_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND INDEX_NAME = 'idx_contests_discovery');
+SET @sql = IF(@idx_exists = 0, 'CREATE INDEX idx_contests_discovery ON contests(visibility, contest_status, started_at)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,10 @@
+-- Secret tokens of private per-user iCalendar feeds (one active token per user)
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    user_id    BIGINT NOT NULL,
+    token      VARCHAR(64) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id),
+    UNIQUE INDEX idx_calendar_feed_tokens_token (token),
+    CONSTRAINT fk_calendar_feed_tokens_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
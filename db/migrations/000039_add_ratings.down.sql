@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS rating_history;
+DROP TABLE IF EXISTS team_ratings;
+DROP TABLE IF EXISTS player_ratings;
@@ -0,0 +1,49 @@
+-- Glicko-2 ratings of players
+CREATE TABLE IF NOT EXISTS player_ratings (
+    user_id        BIGINT NOT NULL,
+    rating         DOUBLE NOT NULL,
+    deviation      DOUBLE NOT NULL,
+    volatility     DOUBLE NOT NULL,
+    games_played   INT NOT NULL DEFAULT 0,
+    wins           INT NOT NULL DEFAULT 0,
+    last_played_at DATETIME NULL,
+    created_at     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at    DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id),
+    CONSTRAINT fk_player_ratings_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Glicko-2 ratings of rosters, keyed by their sorted member IDs so a roster keeps its rating across contests
+CREATE TABLE IF NOT EXISTS team_ratings (
+    roster_key     VARCHAR(191) NOT NULL,
+    team_name      VARCHAR(50) NOT NULL,
+    rating         DOUBLE NOT NULL,
+    deviation      DOUBLE NOT NULL,
+    volatility     DOUBLE NOT NULL,
+    games_played   INT NOT NULL DEFAULT 0,
+    wins           INT NOT NULL DEFAULT 0,
+    last_played_at DATETIME NULL,
+    created_at     DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at    DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (roster_key)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Rating change of every player and roster per rated game; kept when the game is deleted
+CREATE TABLE IF NOT EXISTS rating_history (
+    rating_history_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    subject_type      VARCHAR(8) NOT NULL,
+    subject_key       VARCHAR(191) NOT NULL,
+    game_id           BIGINT NOT NULL,
+    won               BOOLEAN NOT NULL,
+    rating_before     DOUBLE NOT NULL,
+    rating_after      DOUBLE NOT NULL,
+    deviation_before  DOUBLE NOT NULL,
+    deviation_after   DOUBLE NOT NULL,
+    volatility_after  DOUBLE NOT NULL,
+    created_at        DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_rating_history_subject_game (subject_type, subject_key, game_id),
+    INDEX idx_rating_history_game (game_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS match_awards;
+DROP TABLE IF EXISTS mvp_votes;
+DROP TABLE IF EXISTS mvp_votings;
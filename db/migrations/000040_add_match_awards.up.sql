@@ -0,0 +1,40 @@
+-- MVP vote of each detected match, open to its lineup until closes_at
+CREATE TABLE IF NOT EXISTS mvp_votings (
+    match_result_id BIGINT NOT NULL,
+    game_id         BIGINT NOT NULL,
+    closes_at       DATETIME NOT NULL,
+    closed_at       DATETIME NULL,
+    created_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (match_result_id),
+    INDEX idx_mvp_votings_open (closed_at, closes_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- One MVP vote per player of the match
+CREATE TABLE IF NOT EXISTS mvp_votes (
+    mvp_vote_id     BIGINT AUTO_INCREMENT PRIMARY KEY,
+    match_result_id BIGINT NOT NULL,
+    voter_id        BIGINT NOT NULL,
+    candidate_id    BIGINT NOT NULL,
+    created_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_mvp_votes_voter (match_result_id, voter_id),
+    CONSTRAINT fk_mvp_votes_voting FOREIGN KEY (match_result_id) REFERENCES mvp_votings(match_result_id) ON DELETE CASCADE,
+    CONSTRAINT fk_mvp_votes_voter FOREIGN KEY (voter_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_mvp_votes_candidate FOREIGN KEY (candidate_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Awards earned in detected matches (MVP, top ACS, most headshots); tied players each receive the award
+CREATE TABLE IF NOT EXISTS match_awards (
+    match_award_id  BIGINT AUTO_INCREMENT PRIMARY KEY,
+    match_result_id BIGINT NOT NULL,
+    game_id         BIGINT NOT NULL,
+    user_id         BIGINT NOT NULL,
+    award_type      VARCHAR(32) NOT NULL,
+    value           DOUBLE NOT NULL,
+    created_at      DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_match_awards_result_type_user (match_result_id, award_type, user_id),
+    INDEX idx_match_awards_user (user_id, award_type),
+    CONSTRAINT fk_match_awards_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
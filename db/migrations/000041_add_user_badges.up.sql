@@ -0,0 +1,10 @@
+-- Achievement badges earned by users; each badge is earned once
+CREATE TABLE IF NOT EXISTS user_badges (
+    user_badge_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id       BIGINT NOT NULL,
+    badge_code    VARCHAR(64) NOT NULL,
+    awarded_at    DATETIME NOT NULL,
+
+    UNIQUE INDEX idx_user_badges_user_badge (user_id, badge_code),
+    CONSTRAINT fk_user_badges_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
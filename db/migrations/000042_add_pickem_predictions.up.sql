@@ -0,0 +1,17 @@
+-- Pick'em predictions of tournament bracket games; one per user and game, scored in virtual points when the game finishes
+CREATE TABLE IF NOT EXISTS pickem_predictions (
+    pickem_prediction_id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    contest_id           BIGINT NOT NULL,
+    game_id              BIGINT NOT NULL,
+    user_id              BIGINT NOT NULL,
+    predicted_team_id    BIGINT NOT NULL,
+    correct              BOOLEAN NULL,
+    points               INT NOT NULL DEFAULT 0,
+    scored_at            DATETIME NULL,
+    created_at           DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at          DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE INDEX idx_pickem_predictions_game_user (game_id, user_id),
+    INDEX idx_pickem_predictions_contest_user (contest_id, user_id),
+    CONSTRAINT fk_pickem_predictions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
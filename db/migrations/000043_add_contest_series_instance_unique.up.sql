@@ -0,0 +1,6 @@
+-- Add idx_contests_series_started_at if not exists (a series schedules at most one contest per occurrence)
+SET @idx_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contests' AND INDEX_NAME = 'idx_contests_series_started_at');
+SET @sql = IF(@idx_exists = 0, 'CREATE UNIQUE INDEX idx_contests_series_started_at ON contests(series_id, started_at)', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,6 @@
+-- Drop scored_winner_team_id from pickem_predictions conditionally
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'pickem_predictions' AND COLUMN_NAME = 'scored_winner_team_id');
+SET @sql = IF(@col_exists > 0, 'ALTER TABLE pickem_predictions DROP COLUMN scored_winner_team_id', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
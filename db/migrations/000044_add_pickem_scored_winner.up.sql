@@ -0,0 +1,7 @@
+-- Add scored_winner_team_id to pickem_predictions if not exists (the winner a prediction was scored against, so a
+-- corrected result rescores it; NULL on unscored and legacy rows, which are scored on their game's next result)
+SET @col_exists = (SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'pickem_predictions' AND COLUMN_NAME = 'scored_winner_team_id');
+SET @sql = IF(@col_exists = 0, 'ALTER TABLE pickem_predictions ADD COLUMN scored_winner_team_id BIGINT NULL', 'SELECT 1');
+PREPARE stmt FROM @sql;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -52,6 +52,22 @@ func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 	}
 }
 
+// OptionalAuth identifies the user when a valid access token is present but lets anonymous requests through.
+// Handlers use it to tailor public responses, e.g. to show private contests to their members.
+func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.SplitN(c.GetHeader("Authorization"), " ", 2)
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			if claims, err := m.tokenService.Validate(jwtdomain.TokenTypeAccess, parts[1]); err == nil {
+				c.Set("userId", claims.UserID)
+				c.Set("userRole", claims.Role)
+			}
+		}
+
+		c.Next()
+	}
+}
+
 // RequireAdmin is a middleware that checks if the user has admin role
 // Must be used after RequireAuth middleware
 func (m *AuthMiddleware) RequireAdmin() gin.HandlerFunc {
@@ -5,13 +5,16 @@ import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/comment/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/comment/domain"
 	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 )
 
 type CommentService struct {
-	commentRepo port.CommentDatabasePort
-	contestRepo contestPort.ContestDatabasePort
+	commentRepo       port.CommentDatabasePort
+	contestRepo       contestPort.ContestDatabasePort
+	permissionChecker contestPort.ContestPermissionPort
+	accessChecker     contestPort.ContestAccessPort
 }
 
 func NewCommentService(
@@ -24,9 +27,35 @@ func NewCommentService(
 	}
 }
 
+// SetPermissionChecker lets contest moderators delete other users' comments
+func (s *CommentService) SetPermissionChecker(checker contestPort.ContestPermissionPort) {
+	s.permissionChecker = checker
+}
+
+// canModerate reports whether the user holds the comment moderation permission on the contest
+func (s *CommentService) canModerate(contestID, userID int64) bool {
+	if s.permissionChecker == nil {
+		return false
+	}
+	return s.permissionChecker.CheckPermission(contestID, userID, contestDomain.ContestActionModerateComments) == nil
+}
+
+// SetAccessChecker hides the comments of private contests from users without access
+func (s *CommentService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// checkViewAccess applies the contest's visibility rules to its comments
+func (s *CommentService) checkViewAccess(contestID, userID int64) error {
+	if s.accessChecker == nil {
+		_, err := s.contestRepo.GetContestById(contestID)
+		return err
+	}
+	return s.accessChecker.CheckViewAccess(contestID, userID)
+}
+
 func (s *CommentService) CreateComment(contestID, userID int64, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
-	_, err := s.contestRepo.GetContestById(contestID)
-	if err != nil {
+	if err := s.checkViewAccess(contestID, userID); err != nil {
 		return nil, err
 	}
 
@@ -48,21 +77,26 @@ func (s *CommentService) CreateComment(contestID, userID int64, req *dto.CreateC
 	return dto.ToCommentResponse(savedComment, author), nil
 }
 
-func (s *CommentService) GetCommentByID(commentID int64) (*domain.Comment, error) {
+func (s *CommentService) GetCommentByID(commentID, userID int64) (*domain.Comment, error) {
 	comment, err := s.commentRepo.GetByID(commentID)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := s.checkViewAccess(comment.ContestID, userID); err != nil {
+		return nil, err
+	}
+
 	return comment, nil
 }
 
 func (s *CommentService) GetCommentsByContestID(
 	contestID int64,
+	userID int64,
 	pagination *commonDto.PaginationRequest,
 	sort *commonDto.SortRequest,
 ) (*commonDto.PaginationResponse, error) {
-	_, err := s.contestRepo.GetContestById(contestID)
-	if err != nil {
+	if err := s.checkViewAccess(contestID, userID); err != nil {
 		return nil, err
 	}
 
@@ -81,6 +115,30 @@ func (s *CommentService) GetCommentsByContestID(
 	), nil
 }
 
+func (s *CommentService) GetCommentsByContestIDCursor(
+	contestID int64,
+	userID int64,
+	cursor *commonDto.CursorRequest,
+) (*commonDto.CursorResponse, error) {
+	if err := s.checkViewAccess(contestID, userID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.GetByContestIDCursor(contestID, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, nextCursor := commonDto.TrimCursorPage(comments, cursor, func(comment *port.CommentWithUser) (interface{}, int64) {
+		if cursor.Sort.SortBy == "modified_at" {
+			return comment.ModifiedAt, comment.CommentID
+		}
+		return comment.CreatedAt, comment.CommentID
+	})
+
+	return commonDto.NewCursorResponse(dto.ToCommentResponses(comments), nextCursor, cursor.Limit), nil
+}
+
 func (s *CommentService) UpdateComment(commentID, userID int64, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error) {
 	comment, err := s.commentRepo.GetByID(commentID)
 	if err != nil {
@@ -112,7 +170,7 @@ func (s *CommentService) DeleteComment(commentID, userID int64) error {
 		return err
 	}
 
-	if !comment.IsOwner(userID) {
+	if !comment.IsOwner(userID) && !s.canModerate(comment.ContestID, userID) {
 		return exception.ErrCommentPermissionDenied
 	}
 
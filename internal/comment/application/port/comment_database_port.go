@@ -10,6 +10,7 @@ type CommentDatabasePort interface {
 	Save(comment *domain.Comment) (*domain.Comment, error)
 	GetByID(commentID int64) (*domain.Comment, error)
 	GetByContestID(contestID int64, pagination *commonDto.PaginationRequest, sort *commonDto.SortRequest) ([]*CommentWithUser, int64, error)
+	GetByContestIDCursor(contestID int64, cursor *commonDto.CursorRequest) ([]*CommentWithUser, error)
 	Update(comment *domain.Comment) error
 	Delete(commentID int64) error
 }
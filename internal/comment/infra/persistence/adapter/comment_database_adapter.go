@@ -83,6 +83,36 @@ func (a *CommentDatabaseAdapter) GetByContestID(
 	return results, totalCount, nil
 }
 
+// GetByContestIDCursor fetches one row past the page so the service can tell whether another page exists
+func (a *CommentDatabaseAdapter) GetByContestIDCursor(
+	contestID int64,
+	cursor *commonDto.CursorRequest,
+) ([]*port.CommentWithUser, error) {
+	sortColumn := "cc.created_at"
+	if cursor.Sort.SortBy == "modified_at" {
+		sortColumn = "cc.modified_at"
+	}
+
+	var results []*port.CommentWithUser
+	query := a.db.Table("contest_comments cc").
+		Select("cc.comment_id, cc.contest_id, cc.user_id, cc.content, cc.created_at, cc.modified_at, u.username, u.tag, u.avatar, da.discord_id, da.discord_avatar").
+		Joins("JOIN users u ON cc.user_id = u.id").
+		Joins("LEFT JOIN discord_accounts da ON u.id = da.user_id").
+		Where("cc.contest_id = ?", contestID)
+
+	if condition, args := cursor.KeysetCondition(sortColumn, "cc.comment_id"); condition != "" {
+		query = query.Where(condition, args...)
+	}
+
+	if err := query.Order(cursor.OrderClause(sortColumn, "cc.comment_id")).
+		Limit(cursor.FetchLimit()).
+		Scan(&results).Error; err != nil {
+		return nil, a.translateError(err)
+	}
+
+	return results, nil
+}
+
 func (a *CommentDatabaseAdapter) Update(comment *domain.Comment) error {
 	result := a.db.Model(&domain.Comment{}).
 		Where("comment_id = ?", comment.CommentID).
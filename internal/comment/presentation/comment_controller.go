@@ -32,7 +32,7 @@ func NewCommentController(
 }
 
 func (c *CommentController) RegisterRoutes() {
-	publicGroup := c.router.PublicGroup("/api/contests/:id/comments")
+	publicGroup := c.router.OptionalAuthGroup("/api/contests/:id/comments")
 	publicGroup.GET("", c.GetComments)
 	publicGroup.GET("/:commentId", c.GetCommentByID)
 
@@ -80,15 +80,17 @@ func (c *CommentController) CreateComment(ctx *gin.Context) {
 
 // GetComments godoc
 // @Summary Get comments for a contest
-// @Description Get all comments for a contest with pagination
+// @Description Get all comments for a contest with pagination. Comments of private contests are only visible to users with access.
 // @Tags contest-comments
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path int true "Contest ID"
 // @Param page query int false "Page number (default: 1)" minimum(1)
 // @Param page_size query int false "Page size (default: 10, max: 100)" minimum(1) maximum(100)
 // @Param sort_by query string false "Sort field (created_at, modified_at)" default(created_at)
 // @Param order query string false "Sort order (asc, desc)" default(desc)
+// @Param cursor query string false "Keyset cursor from next_cursor; send empty for the first page. Switches the response to CursorResponse and ignores page"
 // @Success 200 {object} response.Response{data=commonDto.PaginationResponse}
 // @Failure 400 {object} response.Response
 // @Failure 404 {object} response.Response
@@ -108,16 +110,31 @@ func (c *CommentController) GetComments(ctx *gin.Context) {
 	order := ctx.DefaultQuery("order", "desc")
 	sort := commonDto.NewSortRequest(sortBy, order, []string{"created_at", "modified_at"})
 
-	result, err := c.service.GetCommentsByContestID(contestId, pagination, sort)
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
+	if encoded, ok := ctx.GetQuery("cursor"); ok {
+		cursor, err := commonDto.NewCursorRequest(encoded, pageSize, sort)
+		if err != nil {
+			c.helper.HandleError(ctx, err)
+			return
+		}
+
+		result, err := c.service.GetCommentsByContestIDCursor(contestId, userId, cursor)
+		c.helper.RespondOK(ctx, result, err, "comments retrieved successfully")
+		return
+	}
+
+	result, err := c.service.GetCommentsByContestID(contestId, userId, pagination, sort)
 	c.helper.RespondOK(ctx, result, err, "comments retrieved successfully")
 }
 
 // GetCommentByID godoc
 // @Summary Get a comment by ID
-// @Description Get a specific comment by its ID
+// @Description Get a specific comment by its ID. Comments of private contests are only visible to users with access.
 // @Tags contest-comments
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path int true "Contest ID"
 // @Param commentId path int true "Comment ID"
 // @Success 200 {object} response.Response{data=dto.CommentResponse}
@@ -137,7 +154,9 @@ func (c *CommentController) GetCommentByID(ctx *gin.Context) {
 		return
 	}
 
-	comment, err := c.service.GetCommentByID(commentId)
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
+	comment, err := c.service.GetCommentByID(commentId, userId)
 	c.helper.RespondOK(ctx, comment, err, "comment retrieved successfully")
 }
 
@@ -187,7 +206,7 @@ func (c *CommentController) UpdateComment(ctx *gin.Context) {
 
 // DeleteComment godoc
 // @Summary Delete a comment
-// @Description Delete a comment (owner only)
+// @Description Delete a comment (owner, or contest staff with MODERATE_COMMENTS)
 // @Tags contest-comments
 // @Accept json
 // @Produce json
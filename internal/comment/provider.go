@@ -19,6 +19,8 @@ func ProvideCommentDependencies(
 	db *gorm.DB,
 	router *router.Router,
 	contestRepository contestPort.ContestDatabasePort,
+	permissionChecker contestPort.ContestPermissionPort,
+	accessChecker contestPort.ContestAccessPort,
 ) *Dependencies {
 	controllerHelper := handler.NewControllerHelper()
 
@@ -28,6 +30,8 @@ func ProvideCommentDependencies(
 		commentDatabaseAdapter,
 		contestRepository,
 	)
+	commentService.SetPermissionChecker(permissionChecker)
+	commentService.SetAccessChecker(accessChecker)
 
 	commentController := presentation.NewCommentController(
 		router,
@@ -0,0 +1,204 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
+	"errors"
+	"log"
+)
+
+// ContestAccessService decides who can read private contests and manages their join codes and invites.
+// It implements ContestAccessPort for the comment and game modules.
+type ContestAccessService struct {
+	contestRepo         port.ContestDatabasePort
+	memberRepo          port.ContestMemberDatabasePort
+	permissionChecker   port.ContestPermissionPort
+	accessRepo          port.ContestAccessDatabasePort
+	organizerRepo       port.ContestOrganizerDatabasePort
+	notificationHandler notificationPort.NotificationHandlerPort
+}
+
+func NewContestAccessService(
+	contestRepo port.ContestDatabasePort,
+	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
+	accessRepo port.ContestAccessDatabasePort,
+	organizerRepo port.ContestOrganizerDatabasePort,
+) *ContestAccessService {
+	return &ContestAccessService{
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+		accessRepo:        accessRepo,
+		organizerRepo:     organizerRepo,
+	}
+}
+
+// SetNotificationHandler sets the notification handler for private contest invite notifications
+func (s *ContestAccessService) SetNotificationHandler(handler notificationPort.NotificationHandlerPort) {
+	s.notificationHandler = handler
+}
+
+// CheckViewAccess - 대회 열람 권한 확인 (비공개 대회는 멤버, 초대받은 사용자만 가능)
+func (s *ContestAccessService) CheckViewAccess(contestId, userId int64) error {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return err
+	}
+
+	return s.CheckContestViewAccess(contest, userId)
+}
+
+// CheckContestViewAccess checks view access on an already loaded contest
+func (s *ContestAccessService) CheckContestViewAccess(contest *domain.Contest, userId int64) error {
+	if !contest.IsPrivate() {
+		return nil
+	}
+
+	// 존재 여부를 숨기기 위해 접근 불가한 비공개 대회는 Not Found로 응답
+	if userId == 0 {
+		return exception.ErrContestNotFound
+	}
+
+	if _, err := s.memberRepo.GetByContestAndUser(contest.ContestID, userId); err == nil {
+		return nil
+	} else if !errors.Is(err, exception.ErrContestMemberNotFound) {
+		return err
+	}
+
+	granted, err := s.accessRepo.HasGrant(contest.ContestID, userId)
+	if err != nil {
+		return err
+	}
+	if granted {
+		return nil
+	}
+
+	// 운영진 초대를 받은 사용자도 수락 전 대회를 볼 수 있어야 함
+	if _, err := s.organizerRepo.GetInvite(contest.ContestID, userId); err == nil {
+		return nil
+	} else if !errors.Is(err, exception.ErrOrganizerInviteNotFound) {
+		return err
+	}
+
+	return exception.ErrContestNotFound
+}
+
+// JoinByCode - 참가 코드로 비공개 대회 접근 권한 획득
+func (s *ContestAccessService) JoinByCode(userId int64, joinCode string) (*domain.Contest, error) {
+	contest, err := s.accessRepo.GetContestByJoinCode(domain.NormalizeJoinCode(joinCode))
+	if err != nil {
+		return nil, err
+	}
+
+	if contest.IsTerminalState() {
+		return nil, exception.ErrContestAlreadyClosed
+	}
+
+	if err := s.accessRepo.SaveGrant(domain.NewContestAccessGrantFromJoinCode(contest.ContestID, userId)); err != nil {
+		return nil, err
+	}
+
+	return contest, nil
+}
+
+// InviteUser - 비공개 대회에 사용자 초대 (신청 관리 권한 필요)
+func (s *ContestAccessService) InviteUser(contestId, userId int64, req *dto.InviteContestUserRequest) (*domain.ContestAccessGrant, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contest.IsPrivate() {
+		return nil, exception.ErrContestNotPrivate
+	}
+
+	if contest.IsTerminalState() {
+		return nil, exception.ErrContestAlreadyClosed
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	grant := domain.NewContestAccessGrantFromInvite(contestId, req.UserID, userId)
+	if err := s.accessRepo.SaveGrant(grant); err != nil {
+		return nil, err
+	}
+
+	s.sendContestInvitedNotification(contest, req.UserID)
+
+	return grant, nil
+}
+
+// GetAccess - 대회 공개 설정, 참가 코드, 접근 권한 목록 조회 (신청 관리 권한 필요)
+func (s *ContestAccessService) GetAccess(contestId, userId int64) (*dto.ContestAccessResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	grants := []*domain.ContestAccessGrant{}
+	if contest.IsPrivate() {
+		grants, err = s.accessRepo.GetGrantsByContest(contestId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dto.ToContestAccessResponse(contest, grants), nil
+}
+
+// RegenerateJoinCode - 참가 코드 재발급, 기존 코드와 링크는 무효화 (대회 관리 권한 필요)
+func (s *ContestAccessService) RegenerateJoinCode(contestId, userId int64) (*dto.ContestAccessResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageContest); err != nil {
+		return nil, err
+	}
+
+	if err := contest.RegenerateJoinCode(); err != nil {
+		return nil, err
+	}
+
+	if err := s.contestRepo.UpdateContest(contest); err != nil {
+		return nil, err
+	}
+
+	grants, err := s.accessRepo.GetGrantsByContest(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToContestAccessResponse(contest, grants), nil
+}
+
+// RevokeAccess - 비공개 대회 접근 권한 회수 (신청 관리 권한 필요, 이미 멤버인 경우 영향 없음)
+func (s *ContestAccessService) RevokeAccess(contestId, targetUserId, userId int64) error {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return err
+	}
+
+	return s.accessRepo.DeleteGrant(contestId, targetUserId)
+}
+
+// sendContestInvitedNotification sends SSE notification to the user invited to a private contest
+func (s *ContestAccessService) sendContestInvitedNotification(contest *domain.Contest, userId int64) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	if err := s.notificationHandler.HandleContestInviteReceived(userId, contest.ContestID, contest.Title); err != nil {
+		log.Printf("Failed to send contest invite notification: %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+)
+
+// ContestApplicationFormService manages per-contest application forms and exports applicant answers
+type ContestApplicationFormService struct {
+	formRepo          port.ContestApplicationFormDatabasePort
+	contestRepo       port.ContestDatabasePort
+	memberRepo        port.ContestMemberDatabasePort
+	permissionChecker port.ContestPermissionPort
+	accessChecker     port.ContestAccessPort
+	userQueryRepo     userQueryPort.UserQueryPort
+}
+
+func NewContestApplicationFormService(
+	formRepo port.ContestApplicationFormDatabasePort,
+	contestRepo port.ContestDatabasePort,
+	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
+	userQueryRepo userQueryPort.UserQueryPort,
+) *ContestApplicationFormService {
+	return &ContestApplicationFormService{
+		formRepo:          formRepo,
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+		userQueryRepo:     userQueryRepo,
+	}
+}
+
+// SetAccessChecker sets the checker that keeps users without access from reading forms of private contests
+func (s *ContestApplicationFormService) SetAccessChecker(checker port.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// SaveForm - 신청서 양식 생성/수정 (신청 관리 권한 필요, 대회 시작 전)
+func (s *ContestApplicationFormService) SaveForm(contestId, userId int64, req *dto.SaveApplicationFormRequest) (*dto.ApplicationFormResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contest.IsPending() {
+		return nil, exception.ErrContestNotPending
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	form := domain.NewContestApplicationForm(contestId, req.ToQuestions())
+	form.ModifiedAt = time.Now()
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.formRepo.SaveForm(form); err != nil {
+		return nil, err
+	}
+
+	return dto.ToApplicationFormResponse(form), nil
+}
+
+// GetForm - 신청서 양식 조회 (비공개 대회는 열람 권한 필요)
+func (s *ContestApplicationFormService) GetForm(contestId, userId int64) (*dto.ApplicationFormResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if contest.IsPrivate() && s.accessChecker != nil {
+		if err := s.accessChecker.CheckViewAccess(contestId, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	form, err := s.formRepo.GetFormByContestId(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToApplicationFormResponse(form), nil
+}
+
+// DeleteForm - 신청서 양식 삭제 (신청 관리 권한 필요, 대회 시작 전)
+func (s *ContestApplicationFormService) DeleteForm(contestId, userId int64) error {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return err
+	}
+
+	if !contest.IsPending() {
+		return exception.ErrContestNotPending
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return err
+	}
+
+	return s.formRepo.DeleteForm(contestId)
+}
+
+// ExportAnswers - 신청서 답변 CSV 내보내기 (신청 관리 권한 필요)
+// One row per applicant; question columns follow the current form, answers to removed questions are left out.
+func (s *ContestApplicationFormService) ExportAnswers(contestId, userId int64) ([]byte, error) {
+	if _, err := s.contestRepo.GetContestById(contestId); err != nil {
+		return nil, err
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	form, err := s.formRepo.GetFormByContestId(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := s.formRepo.GetAnswersByContestId(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	header := []string{"user_id", "username", "tag", "submitted_at"}
+	for _, question := range form.Questions {
+		header = append(header, question.Label)
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writeEscapedRow(writer, header); err != nil {
+		return nil, err
+	}
+
+	for _, answer := range answers {
+		username, tag := "", ""
+		if user, err := s.userQueryRepo.FindById(answer.UserID); err == nil {
+			username, tag = user.Username, user.Tag
+		}
+
+		row := []string{
+			strconv.FormatInt(answer.UserID, 10),
+			username,
+			tag,
+			answer.SubmittedAt.Format(time.RFC3339),
+		}
+		for _, question := range form.Questions {
+			row = append(row, answer.Answers.Get(question.QuestionID))
+		}
+
+		if err := writeEscapedRow(writer, row); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeEscapedRow writes a CSV row with formula-like cells escaped, since labels and answers are free text
+func writeEscapedRow(writer *csv.Writer, row []string) error {
+	for i, cell := range row {
+		row[i] = utils.EscapeSpreadsheetFormula(cell)
+	}
+	return writer.Write(row)
+}
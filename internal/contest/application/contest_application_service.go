@@ -5,20 +5,32 @@ import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
 	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
 	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	userDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/user/domain"
 	"context"
 	"errors"
 	"log"
 	"time"
 )
 
+// AutoDecisionProcessedBy is recorded as the processor of applications decided by an auto-accept rule
+const AutoDecisionProcessedBy int64 = 0
+
 type ContestApplicationService struct {
+	txManager           transaction.Transactor
 	applicationRepo     port.ContestApplicationRedisPort
+	formRepo            port.ContestApplicationFormDatabasePort
+	autoAcceptRepo      port.ContestAutoAcceptDatabasePort
+	discordValidator    port.DiscordValidationPort
+	eligibilityChecker  port.ContestEligibilityPort
+	accessChecker       port.ContestAccessPort
 	contestRepo         port.ContestDatabasePort
 	memberRepo          port.ContestMemberDatabasePort
+	permissionChecker   port.ContestPermissionPort
 	eventPublisher      port.EventPublisherPort
 	oauth2Repository    oauth2Port.OAuth2DatabasePort
 	userQueryRepo       userQueryPort.UserQueryPort
@@ -29,17 +41,19 @@ func NewContestApplicationService(
 	applicationRepo port.ContestApplicationRedisPort,
 	contestRepo port.ContestDatabasePort,
 	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
 	eventPublisher port.EventPublisherPort,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	userQueryRepo userQueryPort.UserQueryPort,
 ) *ContestApplicationService {
 	return &ContestApplicationService{
-		applicationRepo:  applicationRepo,
-		contestRepo:      contestRepo,
-		memberRepo:       memberRepo,
-		eventPublisher:   eventPublisher,
-		oauth2Repository: oauth2Repository,
-		userQueryRepo:    userQueryRepo,
+		applicationRepo:   applicationRepo,
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+		eventPublisher:    eventPublisher,
+		oauth2Repository:  oauth2Repository,
+		userQueryRepo:     userQueryRepo,
 	}
 }
 
@@ -48,8 +62,38 @@ func (s *ContestApplicationService) SetNotificationHandler(handler notificationP
 	s.notificationHandler = handler
 }
 
+// SetTransactionManager sets the transaction manager so member writes and their outbox events commit together
+func (s *ContestApplicationService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// SetApplicationFormRepository sets the application form repository so applications carry form answers
+func (s *ContestApplicationService) SetApplicationFormRepository(repository port.ContestApplicationFormDatabasePort) {
+	s.formRepo = repository
+}
+
+// SetAutoAcceptRepository sets the auto-accept rule repository so matching applications are accepted on submit
+func (s *ContestApplicationService) SetAutoAcceptRepository(repository port.ContestAutoAcceptDatabasePort) {
+	s.autoAcceptRepo = repository
+}
+
+// SetDiscordValidator sets the Discord validator used by the guild membership auto-accept criterion
+func (s *ContestApplicationService) SetDiscordValidator(validator port.DiscordValidationPort) {
+	s.discordValidator = validator
+}
+
+// SetEligibilityChecker sets the checker that enforces contest eligibility constraints on applicants
+func (s *ContestApplicationService) SetEligibilityChecker(checker port.ContestEligibilityPort) {
+	s.eligibilityChecker = checker
+}
+
+// SetAccessChecker sets the checker that keeps users without access from applying to private contests
+func (s *ContestApplicationService) SetAccessChecker(checker port.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
 // RequestParticipate - Contest 참가 신청
-func (s *ContestApplicationService) RequestParticipate(ctx context.Context, contestId, userId int64) (*dto.DiscordLinkRequiredResponse, error) {
+func (s *ContestApplicationService) RequestParticipate(ctx context.Context, contestId, userId int64, answers domain.ApplicationAnswers) (*dto.DiscordLinkRequiredResponse, error) {
 	// Check if user has linked Discord account
 	_, err := s.oauth2Repository.FindDiscordAccountByUserId(userId)
 	if err != nil {
@@ -64,6 +108,13 @@ func (s *ContestApplicationService) RequestParticipate(ctx context.Context, cont
 		return nil, err
 	}
 
+	// 비공개 대회는 참가 코드 또는 초대로 접근 권한을 받은 사용자만 신청 가능
+	if contest.IsPrivate() && s.accessChecker != nil {
+		if err := s.accessChecker.CheckViewAccess(contestId, userId); err != nil {
+			return nil, err
+		}
+	}
+
 	if contest.ContestStatus != "PENDING" {
 		return nil, exception.ErrCannotAcceptApplication
 	}
@@ -72,6 +123,28 @@ func (s *ContestApplicationService) RequestParticipate(ctx context.Context, cont
 		return nil, exception.ErrContestAlreadyStarted
 	}
 
+	if contest.IsRegistrationClosed(time.Now()) || contest.IsRosterLocked() {
+		return nil, exception.ErrRegistrationClosed
+	}
+
+	// 참가 자격 확인 (지역, 티어, 계정 생성일, 디스코드 서버)
+	if s.eligibilityChecker != nil {
+		if err := s.eligibilityChecker.CheckEligibility(contest, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate form answers before the application is recorded
+	form, err := s.getApplicationForm(contestId)
+	if err != nil {
+		return nil, err
+	}
+	if form != nil {
+		if err := form.ValidateAnswers(answers); err != nil {
+			return nil, err
+		}
+	}
+
 	// Fetch user info and create sender snapshot
 	user, err := s.userQueryRepo.FindById(userId)
 	if err != nil {
@@ -90,30 +163,133 @@ func (s *ContestApplicationService) RequestParticipate(ctx context.Context, cont
 		ttl = 24 * time.Hour
 	}
 
-	err = s.applicationRepo.RequestParticipate(ctx, contestId, senderSnapshot, ttl)
+	// 이벤트(outbox)를 먼저 저장하고 신청과 같은 트랜잭션으로 커밋 - 신청이 실패하면 이벤트도 롤백됨
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if err := s.publishApplicationRequestedEvent(txCtx, contest, userId); err != nil {
+			return err
+		}
+
+		if err := s.applicationRepo.RequestParticipate(ctx, contestId, senderSnapshot, ttl); err != nil {
+			return err
+		}
+
+		if form != nil {
+			answer := domain.NewContestApplicationAnswer(contestId, userId, answers, time.Now())
+			if err := s.formRepo.SaveAnswers(answer); err != nil {
+				// Roll back so the applicant can resubmit with their answers
+				if cancelErr := s.applicationRepo.CancelApplication(ctx, contestId, userId); cancelErr != nil {
+					log.Printf("Failed to roll back application of user %d in contest %d: %v", userId, contestId, cancelErr)
+				}
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// 이벤트 발행 (비동기 - 실패해도 신청은 완료됨)
-	go s.publishApplicationRequestedEvent(context.Background(), contest, userId)
+	// 자동 승인 규칙 적용 (실패해도 신청은 PENDING으로 남음)
+	s.applyAutoAcceptRule(ctx, contest, user)
 
 	return nil, nil
 }
 
-func (s *ContestApplicationService) checkLeaderPermission(contestId, userId int64) error {
-	member, err := s.memberRepo.GetByContestAndUser(contestId, userId)
+// applyAutoAcceptRule evaluates the contest's auto-accept rule for a new application and records the decision
+func (s *ContestApplicationService) applyAutoAcceptRule(ctx context.Context, contest *domain.Contest, user *userDomain.User) {
+	if s.autoAcceptRepo == nil {
+		return
+	}
+
+	rule, err := s.autoAcceptRepo.GetRuleByContestId(contest.ContestID)
 	if err != nil {
-		return exception.ErrInvalidAccess
+		if !errors.Is(err, exception.ErrAutoAcceptRuleNotFound) {
+			log.Printf("Failed to load auto-accept rule of contest %d: %v", contest.ContestID, err)
+		}
+		return
 	}
-	if !member.IsLeader() {
-		return exception.ErrPermissionDenied
+	if !rule.Enabled {
+		return
 	}
 
-	return nil
+	profile := s.buildApplicantProfile(contest, rule, user)
+
+	// The cap is counted and the application accepted while the contest row is locked,
+	// so concurrent applications cannot all see the same count and go over the cap
+	var decision domain.AutoAcceptDecision
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if rule.AcceptCap != nil {
+			if _, err := s.contestRepo.GetContestByIdForUpdate(txCtx, contest.ContestID); err != nil {
+				return err
+			}
+			accepted, err := s.applicationRepo.GetAcceptedApplications(txCtx, contest.ContestID)
+			if err != nil {
+				return err
+			}
+			profile.AcceptedCount = len(accepted)
+		}
+
+		decision = rule.Evaluate(profile)
+
+		switch decision.Outcome {
+		case domain.AutoAcceptOutcomeAccepted:
+			return s.acceptApplication(txCtx, contest, user.Id, AutoDecisionProcessedBy)
+		case domain.AutoAcceptOutcomeRejected:
+			return s.rejectApplication(txCtx, contest, user.Id, AutoDecisionProcessedBy, decision.Reason)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Failed to apply auto-accept decision for user %d in contest %d: %v", user.Id, contest.ContestID, err)
+		return
+	}
+
+	record := domain.NewContestAutoAcceptDecision(contest.ContestID, user.Id, decision, time.Now())
+	if err := s.autoAcceptRepo.SaveDecision(record); err != nil {
+		log.Printf("Failed to record auto-accept decision for user %d in contest %d: %v", user.Id, contest.ContestID, err)
+	}
+}
+
+// buildApplicantProfile gathers only the applicant data the rule's criteria need
+func (s *ContestApplicationService) buildApplicantProfile(
+	contest *domain.Contest,
+	rule *domain.ContestAutoAcceptRule,
+	user *userDomain.User,
+) domain.ApplicantProfile {
+	profile := domain.ApplicantProfile{
+		HasRiotAccount: user.HasValorantLinked(),
+	}
+	if profile.HasRiotAccount {
+		profile.Tier = user.CurrentTier
+	}
+
+	if rule.RequireGuildMember && s.discordValidator != nil && contest.DiscordGuildId != nil {
+		discordId := s.getDiscordIdByUserId(user.Id)
+		profile.InGuild = discordId != "" && s.discordValidator.ValidateUserInGuild(*contest.DiscordGuildId, discordId) == nil
+	}
+
+	return profile
+}
+
+// getApplicationForm returns the contest's application form, or nil if the contest has none
+func (s *ContestApplicationService) getApplicationForm(contestId int64) (*domain.ContestApplicationForm, error) {
+	if s.formRepo == nil {
+		return nil, nil
+	}
+
+	form, err := s.formRepo.GetFormByContestId(contestId)
+	if errors.Is(err, exception.ErrApplicationFormNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return form, nil
 }
 
-// AcceptApplication - 신청 승인 (Leader만 가능)
+// AcceptApplication - 신청 승인 (신청 관리 권한 필요)
 func (s *ContestApplicationService) AcceptApplication(ctx context.Context, contestId, userId, leaderUserId int64) error {
 	contest, err := s.contestRepo.GetContestById(contestId)
 	if err != nil {
@@ -124,31 +300,43 @@ func (s *ContestApplicationService) AcceptApplication(ctx context.Context, conte
 		return exception.ErrCannotAcceptApplication
 	}
 
-	if err := s.checkLeaderPermission(contestId, leaderUserId); err != nil {
+	if err := s.permissionChecker.CheckPermission(contestId, leaderUserId, domain.ContestActionManageApplications); err != nil {
 		return err
 	}
 
-	err = s.applicationRepo.AcceptRequest(ctx, contestId, userId, leaderUserId)
+	return s.acceptApplication(ctx, contest, userId, leaderUserId)
+}
+
+// acceptApplication accepts a pending application and saves the applicant as a contest member
+func (s *ContestApplicationService) acceptApplication(ctx context.Context, contest *domain.Contest, userId, processedBy int64) error {
+	contestId := contest.ContestID
+
+	err := s.applicationRepo.AcceptRequest(ctx, contestId, userId, processedBy)
 	if err != nil {
 		return err
 	}
 
+	// 멤버 저장과 이벤트(outbox)는 같은 트랜잭션으로 커밋
 	member := domain.NewContestMember(userId, contestId, domain.MemberTypeNormal, domain.LeaderTypeMember)
-	if err := s.memberRepo.Save(member); err != nil {
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if err := s.memberRepo.SaveWithContext(txCtx, member); err != nil {
+			return err
+		}
+		return s.publishApplicationAcceptedEvent(txCtx, contest, userId, processedBy)
+	})
+	if err != nil {
 		// DB 저장 실패 시 Redis 상태 롤백은 하지 않음 (최종적 일관성)
 		// 추후 MigrateAcceptedApplicationsToDatabase에서 재시도됨
-		_ = err
+		log.Printf("Failed to save accepted member %d of contest %d: %v", userId, contestId, err)
 	}
 
-	go s.publishApplicationAcceptedEvent(context.Background(), contest, userId, leaderUserId)
-
 	// Send SSE notification to the applicant
 	go s.sendApplicationAcceptedNotification(contest, userId)
 
 	return nil
 }
 
-// RejectApplication - 신청 거절 (Leader만 가능)
+// RejectApplication - 신청 거절 (신청 관리 권한 필요)
 func (s *ContestApplicationService) RejectApplication(ctx context.Context, contestId, userId, leaderUserId int64) error {
 	contest, err := s.contestRepo.GetContestById(contestId)
 	if err != nil {
@@ -161,21 +349,28 @@ func (s *ContestApplicationService) RejectApplication(ctx context.Context, conte
 	}
 
 	// Leader 권한 확인
-	if err := s.checkLeaderPermission(contestId, leaderUserId); err != nil {
+	if err := s.permissionChecker.CheckPermission(contestId, leaderUserId, domain.ContestActionManageApplications); err != nil {
 		return err
 	}
 
-	// 신청 거절
-	err = s.applicationRepo.RejectRequest(ctx, contestId, userId, leaderUserId)
+	return s.rejectApplication(ctx, contest, userId, leaderUserId, "")
+}
+
+// rejectApplication rejects a pending application and notifies the applicant with the reason
+func (s *ContestApplicationService) rejectApplication(ctx context.Context, contest *domain.Contest, userId, processedBy int64, reason string) error {
+	// 신청 거절 - 이벤트(outbox)를 먼저 저장하고 거절과 같은 트랜잭션으로 커밋
+	err := transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if err := s.publishApplicationRejectedEvent(txCtx, contest, userId, processedBy); err != nil {
+			return err
+		}
+		return s.applicationRepo.RejectRequest(ctx, contest.ContestID, userId, processedBy)
+	})
 	if err != nil {
 		return err
 	}
 
-	// 이벤트 발행 (비동기)
-	go s.publishApplicationRejectedEvent(context.Background(), contest, userId, leaderUserId)
-
 	// Send SSE notification to the applicant
-	go s.sendApplicationRejectedNotification(contest, userId, "")
+	go s.sendApplicationRejectedNotification(contest, userId, reason)
 
 	return nil
 }
@@ -188,7 +383,36 @@ func (s *ContestApplicationService) GetPendingApplications(ctx context.Context,
 		return nil, err
 	}
 
-	return s.applicationRepo.GetPendingApplications(ctx, contestId)
+	applications, err := s.applicationRepo.GetPendingApplications(ctx, contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.attachAnswers(contestId, applications)
+
+	return applications, nil
+}
+
+// attachAnswers adds the stored form answers to each application
+func (s *ContestApplicationService) attachAnswers(contestId int64, applications []*port.ContestApplication) {
+	if s.formRepo == nil || len(applications) == 0 {
+		return
+	}
+
+	answers, err := s.formRepo.GetAnswersByContestId(contestId)
+	if err != nil {
+		log.Printf("Failed to load application answers for contest %d: %v", contestId, err)
+		return
+	}
+
+	answersByUser := make(map[int64]domain.ApplicationAnswers, len(answers))
+	for _, answer := range answers {
+		answersByUser[answer.UserID] = answer.Answers
+	}
+
+	for _, application := range applications {
+		application.Answers = answersByUser[application.UserID]
+	}
 }
 
 // GetMyApplication - 내 신청 정보 조회
@@ -239,16 +463,28 @@ func (s *ContestApplicationService) GetMyContestStatus(ctx context.Context, cont
 	return response, nil
 }
 
+// checkContestViewAccess - 대회 존재 및 열람 권한 확인
+func (s *ContestApplicationService) checkContestViewAccess(contestId, userId int64) error {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return err
+	}
+
+	if contest.IsPrivate() && s.accessChecker != nil {
+		return s.accessChecker.CheckViewAccess(contestId, userId)
+	}
+	return nil
+}
+
 // GetContestMembers - Contest 참여 멤버 목록 조회 (Pagination)
 func (s *ContestApplicationService) GetContestMembers(
 	ctx context.Context,
-	contestId int64,
+	contestId, userId int64,
 	pagination *commonDto.PaginationRequest,
 	sort *commonDto.SortRequest,
 ) (*commonDto.PaginationResponse, error) {
-	// Contest 존재 확인
-	_, err := s.contestRepo.GetContestById(contestId)
-	if err != nil {
+	// Contest 존재 확인 (비공개 대회는 열람 권한이 없으면 Not Found)
+	if err := s.checkContestViewAccess(contestId, userId); err != nil {
 		return nil, err
 	}
 
@@ -269,6 +505,32 @@ func (s *ContestApplicationService) GetContestMembers(
 	), nil
 }
 
+// GetContestMembersByCursor - Contest 참여 멤버 목록 조회 (Cursor)
+func (s *ContestApplicationService) GetContestMembersByCursor(
+	ctx context.Context,
+	contestId, userId int64,
+	cursor *commonDto.CursorRequest,
+) (*commonDto.CursorResponse, error) {
+	// Contest 존재 확인 (비공개 대회는 열람 권한이 없으면 Not Found)
+	if err := s.checkContestViewAccess(contestId, userId); err != nil {
+		return nil, err
+	}
+
+	members, err := s.memberRepo.GetMembersWithUserByContestCursor(contestId, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	members, nextCursor := commonDto.TrimCursorPage(members, cursor, func(member *port.ContestMemberWithUser) (interface{}, int64) {
+		if cursor.Sort.SortBy == "username" {
+			return member.Username, member.UserID
+		}
+		return member.Point, member.UserID
+	})
+
+	return commonDto.NewCursorResponse(dto.ToContestMemberResponses(members), nextCursor, cursor.Limit), nil
+}
+
 // CancelApplication - Cancel a pending application (by user themselves)
 func (s *ContestApplicationService) CancelApplication(ctx context.Context, contestId, userId int64) error {
 	// Verify contest exists
@@ -282,19 +544,28 @@ func (s *ContestApplicationService) CancelApplication(ctx context.Context, conte
 		return exception.ErrCannotAcceptApplication
 	}
 
-	// Cancel the application in Redis
-	err = s.applicationRepo.CancelApplication(ctx, contestId, userId)
+	// Store the event in the outbox first and cancel the application in Redis in the same transaction,
+	// so the event is rolled back when the cancellation fails
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if err := s.publishApplicationCancelledEvent(txCtx, contest, userId); err != nil {
+			return err
+		}
+		return s.applicationRepo.CancelApplication(ctx, contestId, userId)
+	})
 	if err != nil {
 		return err
 	}
 
-	// Publish event (async)
-	go s.publishApplicationCancelledEvent(context.Background(), contest, userId)
+	if s.formRepo != nil {
+		if err := s.formRepo.DeleteAnswers(contestId, userId); err != nil {
+			log.Printf("Failed to delete application answers of user %d in contest %d: %v", userId, contestId, err)
+		}
+	}
 
 	return nil
 }
 
-// ChangeMemberRole - 멤버 역할 변경 (Leader만 가능)
+// ChangeMemberRole - 멤버 역할 변경 (Owner만 가능)
 func (s *ContestApplicationService) ChangeMemberRole(contestId, targetUserId, leaderUserId int64, newMemberType domain.MemberType) (*dto.ChangeMemberRoleResponse, error) {
 	// Contest 존재 확인
 	contest, err := s.contestRepo.GetContestById(contestId)
@@ -308,7 +579,7 @@ func (s *ContestApplicationService) ChangeMemberRole(contestId, targetUserId, le
 	}
 
 	// Leader 권한 확인
-	if err := s.checkLeaderPermission(contestId, leaderUserId); err != nil {
+	if err := s.permissionChecker.CheckPermission(contestId, leaderUserId, domain.ContestActionManageOrganizers); err != nil {
 		return nil, err
 	}
 
@@ -365,15 +636,13 @@ func (s *ContestApplicationService) WithdrawFromContest(contestId, userId int64)
 		return exception.ErrLeaderCannotWithdraw
 	}
 
-	// 멤버 삭제
-	if err := s.memberRepo.DeleteById(contestId, userId); err != nil {
-		return err
-	}
-
-	// 이벤트 발행 (비동기)
-	go s.publishMemberWithdrawnEvent(context.Background(), contest, userId)
-
-	return nil
+	// 멤버 삭제와 이벤트(outbox)는 같은 트랜잭션으로 커밋
+	return transaction.Run(context.Background(), s.txManager, func(txCtx context.Context) error {
+		if err := s.memberRepo.DeleteByIdWithContext(txCtx, contestId, userId); err != nil {
+			return err
+		}
+		return s.publishMemberWithdrawnEvent(txCtx, contest, userId)
+	})
 }
 
 func (s *ContestApplicationService) getDiscordIdByUserId(userId int64) string {
@@ -396,7 +665,7 @@ func (s *ContestApplicationService) publishApplicationRequestedEvent(
 	ctx context.Context,
 	contest *domain.Contest,
 	userId int64,
-) {
+) error {
 	discordUserId := s.getDiscordIdByUserId(userId)
 
 	event := &port.ContestApplicationEvent{
@@ -413,9 +682,7 @@ func (s *ContestApplicationService) publishApplicationRequestedEvent(
 		},
 	}
 
-	if err := s.eventPublisher.PublishContestApplicationEvent(ctx, event); err != nil {
-		_ = err
-	}
+	return s.eventPublisher.PublishContestApplicationEvent(ctx, event)
 }
 
 // publishApplicationAcceptedEvent - 신청 승인 이벤트 발행
@@ -424,7 +691,7 @@ func (s *ContestApplicationService) publishApplicationAcceptedEvent(
 	contest *domain.Contest,
 	userId int64,
 	processedBy int64,
-) {
+) error {
 	discordUserId := s.getDiscordIdByUserId(userId)
 	processedByDiscordId := s.getDiscordIdByUserId(processedBy)
 
@@ -444,10 +711,7 @@ func (s *ContestApplicationService) publishApplicationAcceptedEvent(
 		},
 	}
 
-	if err := s.eventPublisher.PublishContestApplicationEvent(ctx, event); err != nil {
-		// 로그만 남기고 에러는 무시
-		_ = err
-	}
+	return s.eventPublisher.PublishContestApplicationEvent(ctx, event)
 }
 
 // publishApplicationRejectedEvent - 신청 거절 이벤트 발행
@@ -456,7 +720,7 @@ func (s *ContestApplicationService) publishApplicationRejectedEvent(
 	contest *domain.Contest,
 	userId int64,
 	processedBy int64,
-) {
+) error {
 	discordUserId := s.getDiscordIdByUserId(userId)
 	processedByDiscordId := s.getDiscordIdByUserId(processedBy)
 
@@ -476,10 +740,7 @@ func (s *ContestApplicationService) publishApplicationRejectedEvent(
 		},
 	}
 
-	if err := s.eventPublisher.PublishContestApplicationEvent(ctx, event); err != nil {
-		// 로그만 남기고 에러는 무시
-		_ = err
-	}
+	return s.eventPublisher.PublishContestApplicationEvent(ctx, event)
 }
 
 // publishMemberWithdrawnEvent - 멤버 탈퇴 이벤트 발행
@@ -487,7 +748,7 @@ func (s *ContestApplicationService) publishMemberWithdrawnEvent(
 	ctx context.Context,
 	contest *domain.Contest,
 	userId int64,
-) {
+) error {
 	discordUserId := s.getDiscordIdByUserId(userId)
 
 	event := &port.ContestApplicationEvent{
@@ -504,10 +765,7 @@ func (s *ContestApplicationService) publishMemberWithdrawnEvent(
 		},
 	}
 
-	if err := s.eventPublisher.PublishContestApplicationEvent(ctx, event); err != nil {
-		// 로그만 남기고 에러는 무시
-		_ = err
-	}
+	return s.eventPublisher.PublishContestApplicationEvent(ctx, event)
 }
 
 // publishApplicationCancelledEvent - Publish application cancelled event
@@ -515,7 +773,7 @@ func (s *ContestApplicationService) publishApplicationCancelledEvent(
 	ctx context.Context,
 	contest *domain.Contest,
 	userId int64,
-) {
+) error {
 	discordUserId := s.getDiscordIdByUserId(userId)
 
 	event := &port.ContestApplicationEvent{
@@ -532,9 +790,7 @@ func (s *ContestApplicationService) publishApplicationCancelledEvent(
 		},
 	}
 
-	if err := s.eventPublisher.PublishContestApplicationEvent(ctx, event); err != nil {
-		_ = err
-	}
+	return s.eventPublisher.PublishContestApplicationEvent(ctx, event)
 }
 
 // sendApplicationAcceptedNotification sends SSE notification when application is accepted
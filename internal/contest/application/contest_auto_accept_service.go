@@ -0,0 +1,91 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+// ContestAutoAcceptService manages per-contest auto-accept rules and exposes their recorded decisions.
+// Rules are applied on submit by ContestApplicationService.
+type ContestAutoAcceptService struct {
+	autoAcceptRepo    port.ContestAutoAcceptDatabasePort
+	contestRepo       port.ContestDatabasePort
+	memberRepo        port.ContestMemberDatabasePort
+	permissionChecker port.ContestPermissionPort
+}
+
+func NewContestAutoAcceptService(
+	autoAcceptRepo port.ContestAutoAcceptDatabasePort,
+	contestRepo port.ContestDatabasePort,
+	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
+) *ContestAutoAcceptService {
+	return &ContestAutoAcceptService{
+		autoAcceptRepo:    autoAcceptRepo,
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+	}
+}
+
+// SaveRule - 자동 승인 규칙 생성/수정 (신청 관리 권한 필요, 대회 시작 전)
+func (s *ContestAutoAcceptService) SaveRule(contestId, userId int64, req *dto.SaveAutoAcceptRuleRequest) (*domain.ContestAutoAcceptRule, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contest.IsPending() {
+		return nil, exception.ErrContestNotPending
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	rule := req.ToRule(contestId)
+	rule.ModifiedAt = time.Now()
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if rule.RequireGuildMember && !contest.HasDiscordIntegration() {
+		return nil, exception.ErrAutoAcceptGuildRequired
+	}
+
+	if err := s.autoAcceptRepo.SaveRule(rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetRule - 자동 승인 규칙 조회 (신청 관리 권한 필요)
+func (s *ContestAutoAcceptService) GetRule(contestId, userId int64) (*domain.ContestAutoAcceptRule, error) {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	return s.autoAcceptRepo.GetRuleByContestId(contestId)
+}
+
+// DeleteRule - 자동 승인 규칙 삭제 (신청 관리 권한 필요)
+func (s *ContestAutoAcceptService) DeleteRule(contestId, userId int64) error {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return err
+	}
+
+	return s.autoAcceptRepo.DeleteRule(contestId)
+}
+
+// GetDecisions - 자동 승인/거절 기록 조회 (신청 관리 권한 필요)
+func (s *ContestAutoAcceptService) GetDecisions(contestId, userId int64) ([]*domain.ContestAutoAcceptDecision, error) {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	return s.autoAcceptRepo.GetDecisionsByContestId(contestId)
+}
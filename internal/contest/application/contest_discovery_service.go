@@ -0,0 +1,108 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"strings"
+)
+
+type ContestDiscoveryService struct {
+	discoveryRepo port.ContestDiscoveryDatabasePort
+	userQueryRepo userQueryPort.UserQueryPort
+}
+
+func NewContestDiscoveryService(
+	discoveryRepo port.ContestDiscoveryDatabasePort,
+	userQueryRepo userQueryPort.UserQueryPort,
+) *ContestDiscoveryService {
+	return &ContestDiscoveryService{
+		discoveryRepo: discoveryRepo,
+		userQueryRepo: userQueryRepo,
+	}
+}
+
+// Discover - 공개 대회 검색 (필터, 전문 검색, 패싯 집계, 추천 정렬)
+// userId is 0 for anonymous callers, who cannot use eligible_only or recommended ordering
+func (s *ContestDiscoveryService) Discover(req *dto.ContestDiscoveryRequest, userId int64) (*dto.ContestDiscoveryResponse, error) {
+	filter := req.ToFilter()
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	sort := resolveDiscoverySort(req.Sort, filter, userId)
+	if !sort.IsValid() {
+		return nil, exception.ErrInvalidDiscoveryFilter
+	}
+
+	needsUser := req.EligibleOnly || sort == domain.ContestDiscoverySortRecommended
+	if needsUser && userId == 0 {
+		return nil, exception.ErrDiscoveryLoginRequired
+	}
+
+	var recommendation *domain.RecommendationProfile
+	if needsUser {
+		user, err := s.userQueryRepo.FindById(userId)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.EligibleOnly {
+			profile := eligibilityProfileOf(user)
+			filter.Eligibility = &profile
+		}
+
+		if sort == domain.ContestDiscoverySortRecommended {
+			recommendation, err = s.discoveryRepo.GetRecommendationProfile(userId)
+			if err != nil {
+				return nil, err
+			}
+			if user.HasValorantLinked() {
+				recommendation.Tier = user.CurrentTier
+			}
+		}
+	}
+
+	pagination := commonDto.NewPaginationRequest(req.Page, req.PageSize)
+	descending := strings.ToLower(req.Order) != "asc"
+
+	contests, totalCount, err := s.discoveryRepo.SearchContests(
+		filter,
+		sort,
+		descending,
+		recommendation,
+		pagination.GetOffset(),
+		pagination.GetLimit(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := s.discoveryRepo.GetFacets(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ContestDiscoveryResponse{
+		Contests: commonDto.NewPaginationResponse(contests, pagination.Page, pagination.PageSize, totalCount),
+		Facets:   facets,
+		Sort:     sort,
+	}, nil
+}
+
+// resolveDiscoverySort defaults to relevance for searches, recommendations for signed-in users and newest first otherwise
+func resolveDiscoverySort(requested string, filter *domain.ContestDiscoveryFilter, userId int64) domain.ContestDiscoverySort {
+	if requested != "" {
+		return domain.ContestDiscoverySort(strings.ToLower(requested))
+	}
+	if filter.UsesFullText() {
+		return domain.ContestDiscoverySortRelevance
+	}
+	if userId != 0 {
+		return domain.ContestDiscoverySortRecommended
+	}
+	return domain.ContestDiscoverySortCreatedAt
+}
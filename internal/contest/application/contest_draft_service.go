@@ -0,0 +1,382 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	DefaultDraftTTL = 24 * time.Hour
+
+	// DraftClockInterval is how often the pick clock checks for expired picks
+	DraftClockInterval = 2 * time.Second
+
+	draftLockTTL = 10 * time.Second
+)
+
+// Draft SSE event names
+const (
+	DraftEventStarted   = "DRAFT_STARTED"
+	DraftEventPicked    = "DRAFT_PICKED"
+	DraftEventCompleted = "DRAFT_COMPLETED"
+	DraftEventCancelled = "DRAFT_CANCELLED"
+)
+
+// DraftTeamCreatorPort defines the interface for creating teams from a completed draft
+type DraftTeamCreatorPort interface {
+	CreateDraftedTeams(ctx context.Context, contestID int64, teams []*gameDomain.DraftedTeam) ([]*gameDomain.Team, error)
+}
+
+// ContestDraftService runs captain drafts for casual contests
+type ContestDraftService struct {
+	draftRepo           port.ContestDraftRedisPort
+	contestRepo         port.ContestDatabasePort
+	memberRepo          port.ContestMemberDatabasePort
+	permissionChecker   port.ContestPermissionPort
+	applicationRepo     port.ContestApplicationRedisPort
+	userQueryRepo       userQueryPort.UserQueryPort
+	teamCreator         DraftTeamCreatorPort
+	notificationHandler notificationPort.NotificationHandlerPort
+}
+
+func NewContestDraftService(
+	draftRepo port.ContestDraftRedisPort,
+	contestRepo port.ContestDatabasePort,
+	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
+	applicationRepo port.ContestApplicationRedisPort,
+	userQueryRepo userQueryPort.UserQueryPort,
+	teamCreator DraftTeamCreatorPort,
+) *ContestDraftService {
+	return &ContestDraftService{
+		draftRepo:         draftRepo,
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+		applicationRepo:   applicationRepo,
+		userQueryRepo:     userQueryRepo,
+		teamCreator:       teamCreator,
+	}
+}
+
+// SetNotificationHandler sets the notification handler (to avoid circular dependency)
+func (s *ContestDraftService) SetNotificationHandler(handler notificationPort.NotificationHandlerPort) {
+	s.notificationHandler = handler
+}
+
+// StartDraft - Staff가 승인된 참가자 중 캡틴을 지정하여 드래프트 시작
+func (s *ContestDraftService) StartDraft(ctx context.Context, contestId, userId int64, req *dto.StartDraftRequest) (*dto.DraftResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if contest.ContestType != domain.ContestTypeCasual {
+		return nil, exception.ErrDraftOnlyCasualContest
+	}
+
+	if !contest.IsPending() {
+		return nil, exception.ErrContestNotPending
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.draftRepo.GetDraft(ctx, contestId)
+	if err != nil && !errors.Is(err, exception.ErrDraftNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.IsInProgress() {
+		return nil, exception.ErrDraftAlreadyExists
+	}
+
+	acceptedUserIDs, err := s.applicationRepo.GetAcceptedApplications(ctx, contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make(map[int64]bool, len(acceptedUserIDs))
+	for _, id := range acceptedUserIDs {
+		accepted[id] = true
+	}
+
+	captains := make([]*domain.DraftPlayer, 0, len(req.CaptainUserIDs))
+	for _, captainID := range req.CaptainUserIDs {
+		if !accepted[captainID] {
+			return nil, exception.ErrDraftInvalidCaptain
+		}
+		captain, err := s.toDraftPlayer(captainID)
+		if err != nil {
+			return nil, err
+		}
+		captains = append(captains, captain)
+	}
+
+	pool := make([]*domain.DraftPlayer, 0, len(acceptedUserIDs))
+	for _, id := range acceptedUserIDs {
+		player, err := s.toDraftPlayer(id)
+		if err != nil {
+			log.Printf("[Draft] Skipping user %d in contest %d: %v", id, contestId, err)
+			continue
+		}
+		pool = append(pool, player)
+	}
+
+	pickTimeout := domain.DefaultDraftPickTimeout
+	if req.PickTimeoutSeconds > 0 {
+		pickTimeout = time.Duration(req.PickTimeoutSeconds) * time.Second
+	}
+
+	draft, err := domain.NewContestDraft(contestId, contest.TotalTeamMember, pickTimeout, captains, pool, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.draftRepo.SaveDraft(ctx, draft, DefaultDraftTTL); err != nil {
+		return nil, err
+	}
+
+	resp := dto.ToDraftResponse(draft)
+	go s.broadcastDraft(draft, DraftEventStarted, resp)
+
+	return resp, nil
+}
+
+// GetDraft - 드래프트 현재 상태 조회
+func (s *ContestDraftService) GetDraft(ctx context.Context, contestId int64) (*dto.DraftResponse, error) {
+	draft, err := s.draftRepo.GetDraft(ctx, contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToDraftResponse(draft), nil
+}
+
+// MakePick - 차례인 캡틴이 선수 지명
+func (s *ContestDraftService) MakePick(ctx context.Context, contestId, captainUserId, playerUserId int64) (*dto.DraftResponse, error) {
+	acquired, err := s.draftRepo.AcquireDraftLock(ctx, contestId, draftLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, exception.ErrDraftBusy
+	}
+	defer s.releaseLock(ctx, contestId)
+
+	draft, err := s.draftRepo.GetDraft(ctx, contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := draft.Pick(captainUserId, playerUserId, false, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return s.persistPick(ctx, draft)
+}
+
+// CancelDraft - Staff가 진행 중인 드래프트 취소
+func (s *ContestDraftService) CancelDraft(ctx context.Context, contestId, userId int64) error {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageApplications); err != nil {
+		return err
+	}
+
+	acquired, err := s.draftRepo.AcquireDraftLock(ctx, contestId, draftLockTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return exception.ErrDraftBusy
+	}
+	defer s.releaseLock(ctx, contestId)
+
+	draft, err := s.draftRepo.GetDraft(ctx, contestId)
+	if err != nil {
+		return err
+	}
+
+	if err := draft.Cancel(); err != nil {
+		return err
+	}
+
+	if err := s.draftRepo.DeleteDraft(ctx, contestId); err != nil {
+		return err
+	}
+
+	go s.broadcastDraft(draft, DraftEventCancelled, dto.ToDraftResponse(draft))
+
+	return nil
+}
+
+// RunDraftClock is called every DraftClockInterval.
+// It auto-picks the highest rated available player for captains whose pick clock has expired.
+func (s *ContestDraftService) RunDraftClock() {
+	ctx := context.Background()
+
+	contestIDs, err := s.draftRepo.GetActiveDraftContestIDs(ctx)
+	if err != nil {
+		log.Printf("[Draft] Failed to query active drafts: %v", err)
+		return
+	}
+
+	for _, contestID := range contestIDs {
+		s.autoPickIfExpired(ctx, contestID)
+	}
+}
+
+func (s *ContestDraftService) autoPickIfExpired(ctx context.Context, contestID int64) {
+	// Skip when a captain's pick or another instance holds the lock; the next tick retries
+	acquired, err := s.draftRepo.AcquireDraftLock(ctx, contestID, draftLockTTL)
+	if err != nil || !acquired {
+		return
+	}
+	defer s.releaseLock(ctx, contestID)
+
+	draft, err := s.draftRepo.GetDraft(ctx, contestID)
+	if errors.Is(err, exception.ErrDraftNotFound) {
+		// Draft state expired; drop the contest from the active set
+		_ = s.draftRepo.DeleteDraft(ctx, contestID)
+		return
+	}
+	if err != nil {
+		log.Printf("[Draft] Failed to load draft for contest %d: %v", contestID, err)
+		return
+	}
+
+	now := time.Now()
+	if !draft.IsPickExpired(now) {
+		return
+	}
+
+	if err := draft.AutoPick(now); err != nil {
+		log.Printf("[Draft] Auto-pick failed for contest %d: %v", contestID, err)
+		return
+	}
+
+	if _, err := s.persistPick(ctx, draft); err != nil {
+		log.Printf("[Draft] Failed to save auto-pick for contest %d: %v", contestID, err)
+	}
+}
+
+// persistPick saves the draft after a pick, broadcasts it and creates teams once the draft is complete.
+// A completed draft creates its teams before it is saved, so a failed team creation leaves the draft at its last pick.
+// If saving the completed draft fails, the draft stays at its last pick as well; team creation returns the teams
+// already created for the same rosters, so completing it again does not create them twice.
+func (s *ContestDraftService) persistPick(ctx context.Context, draft *domain.ContestDraft) (*dto.DraftResponse, error) {
+	if !draft.IsCompleted() {
+		if err := s.draftRepo.SaveDraft(ctx, draft, DefaultDraftTTL); err != nil {
+			return nil, err
+		}
+
+		resp := dto.ToDraftResponse(draft)
+		go s.broadcastDraft(draft, DraftEventPicked, resp)
+		return resp, nil
+	}
+
+	contest, err := s.contestRepo.GetContestById(draft.ContestID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamNames, err := s.createDraftedTeams(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.draftRepo.SaveDraft(ctx, draft, DefaultDraftTTL); err != nil {
+		return nil, err
+	}
+
+	resp := dto.ToDraftResponse(draft)
+	go s.broadcastDraft(draft, DraftEventCompleted, resp)
+	go s.sendDraftCompletedNotifications(contest, teamNames)
+
+	return resp, nil
+}
+
+// createDraftedTeams creates one team per captain through the team service, all or none,
+// and returns the team name of each drafted user
+func (s *ContestDraftService) createDraftedTeams(ctx context.Context, draft *domain.ContestDraft) (map[int64]string, error) {
+	teams := make([]*gameDomain.DraftedTeam, 0, len(draft.Captains))
+	teamNames := make(map[int64]string, len(draft.Captains))
+
+	for _, captain := range draft.Captains {
+		team := &gameDomain.DraftedTeam{
+			CaptainUserID: captain.UserID,
+			MemberUserIDs: draft.RosterOf(captain.UserID),
+			TeamName:      fmt.Sprintf("Team %s", captain.Username),
+		}
+		teams = append(teams, team)
+
+		teamNames[captain.UserID] = team.TeamName
+		for _, playerID := range team.MemberUserIDs {
+			teamNames[playerID] = team.TeamName
+		}
+	}
+
+	if _, err := s.teamCreator.CreateDraftedTeams(ctx, draft.ContestID, teams); err != nil {
+		return nil, err
+	}
+	return teamNames, nil
+}
+
+// toDraftPlayer builds a draft snapshot of a user, rated by Valorant elo
+func (s *ContestDraftService) toDraftPlayer(userId int64) (*domain.DraftPlayer, error) {
+	user, err := s.userQueryRepo.FindById(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	player := &domain.DraftPlayer{
+		UserID:      user.Id,
+		Username:    user.Username,
+		Tag:         user.Tag,
+		TierPatched: user.GetCurrentTierFullName(),
+	}
+	if user.Elo != nil {
+		player.Rating = *user.Elo
+	}
+
+	return player, nil
+}
+
+func (s *ContestDraftService) releaseLock(ctx context.Context, contestId int64) {
+	if err := s.draftRepo.ReleaseDraftLock(ctx, contestId); err != nil {
+		log.Printf("[Draft] Failed to release lock for contest %d: %v", contestId, err)
+	}
+}
+
+// SSE Notification helper methods
+
+func (s *ContestDraftService) broadcastDraft(draft *domain.ContestDraft, event string, resp *dto.DraftResponse) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	if err := s.notificationHandler.HandleDraftUpdated(draft.ParticipantUserIDs(), draft.ContestID, event, resp); err != nil {
+		log.Printf("Failed to broadcast draft update: %v", err)
+	}
+}
+
+func (s *ContestDraftService) sendDraftCompletedNotifications(contest *domain.Contest, teamNames map[int64]string) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	for userID, teamName := range teamNames {
+		if err := s.notificationHandler.HandleDraftCompleted(userID, contest.ContestID, contest.Title, teamName); err != nil {
+			log.Printf("Failed to send draft completed notification: %v", err)
+		}
+	}
+}
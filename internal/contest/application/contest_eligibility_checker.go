@@ -0,0 +1,75 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	userDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/user/domain"
+	"time"
+)
+
+// ContestEligibilityChecker checks users against contest eligibility constraints
+type ContestEligibilityChecker struct {
+	userQueryRepo    userQueryPort.UserQueryPort
+	oauth2Repository oauth2Port.OAuth2DatabasePort
+	discordValidator port.DiscordValidationPort
+}
+
+func NewContestEligibilityChecker(
+	userQueryRepo userQueryPort.UserQueryPort,
+	oauth2Repository oauth2Port.OAuth2DatabasePort,
+	discordValidator port.DiscordValidationPort,
+) *ContestEligibilityChecker {
+	return &ContestEligibilityChecker{
+		userQueryRepo:    userQueryRepo,
+		oauth2Repository: oauth2Repository,
+		discordValidator: discordValidator,
+	}
+}
+
+func (c *ContestEligibilityChecker) CheckEligibility(contest *domain.Contest, userID int64) error {
+	if !contest.Eligibility.IsRestricted() {
+		return nil
+	}
+
+	user, err := c.userQueryRepo.FindById(userID)
+	if err != nil {
+		return err
+	}
+
+	profile := eligibilityProfileOf(user)
+
+	if contest.Eligibility.RequireGuildMember {
+		profile.InGuild = c.isInGuild(contest, user)
+	}
+
+	return contest.Eligibility.Check(profile, time.Now())
+}
+
+// eligibilityProfileOf builds the profile from the user's account and linked Riot account (guild membership excluded)
+func eligibilityProfileOf(user *userDomain.User) domain.EligibilityProfile {
+	profile := domain.EligibilityProfile{
+		AccountCreatedAt: user.CreatedAt,
+	}
+	if user.HasValorantLinked() {
+		profile.Region = user.Region
+		profile.CurrentTier = user.CurrentTier
+		profile.PeakTier = user.PeakTier
+	}
+	return profile
+}
+
+// isInGuild checks Discord guild membership through the user's linked Discord account
+func (c *ContestEligibilityChecker) isInGuild(contest *domain.Contest, user *userDomain.User) bool {
+	if c.discordValidator == nil || !contest.HasDiscordIntegration() {
+		return false
+	}
+
+	discordAccount, err := c.oauth2Repository.FindDiscordAccountByUserId(user.Id)
+	if err != nil || discordAccount == nil {
+		return false
+	}
+
+	return c.discordValidator.ValidateUserInGuild(*contest.DiscordGuildId, discordAccount.DiscordId) == nil
+}
@@ -0,0 +1,179 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	gamePort "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// ContestLifecycleInterval is how often the lifecycle job checks for contests to start or finish
+	ContestLifecycleInterval = time.Minute
+
+	lockKeyContestLifecycle = "scheduler:lock:contest_lifecycle"
+	lockTTLContestLifecycle = 50 * time.Second
+)
+
+// ContestLifecycleService starts auto-start contests at StartedAt and finishes active contests
+// once all their games are terminal or EndedAt has passed
+type ContestLifecycleService struct {
+	contestService *ContestService
+	contestRepo    port.ContestDatabasePort
+	gameRepo       gamePort.GameDatabasePort
+	redisClient    *redis.Client
+}
+
+func NewContestLifecycleService(
+	contestService *ContestService,
+	contestRepo port.ContestDatabasePort,
+	gameRepo gamePort.GameDatabasePort,
+	redisClient *redis.Client,
+) *ContestLifecycleService {
+	return &ContestLifecycleService{
+		contestService: contestService,
+		contestRepo:    contestRepo,
+		gameRepo:       gameRepo,
+		redisClient:    redisClient,
+	}
+}
+
+// RunLifecycle is called every ContestLifecycleInterval.
+func (s *ContestLifecycleService) RunLifecycle() {
+	ctx := context.Background()
+
+	// Acquire distributed lock to prevent duplicate execution across instances
+	acquired, err := s.acquireLock(ctx)
+	if err != nil {
+		log.Printf("[ContestLifecycle] Failed to acquire lock: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer s.releaseLock(ctx)
+
+	s.TransitionDueContests(ctx, time.Now())
+}
+
+// TransitionDueContests starts the auto-start contests due at now and finishes the active contests that are done.
+// RunLifecycle calls it while holding the lifecycle lock.
+func (s *ContestLifecycleService) TransitionDueContests(ctx context.Context, now time.Time) {
+	s.startDueContests(ctx, now)
+	s.finishDoneContests(ctx, now)
+}
+
+func (s *ContestLifecycleService) startDueContests(ctx context.Context, now time.Time) {
+	contests, err := s.contestRepo.GetContestsDueForAutoStart(now)
+	if err != nil {
+		log.Printf("[ContestLifecycle] Failed to query contests due for auto-start: %v", err)
+		return
+	}
+
+	for _, contest := range contests {
+		if _, err := s.contestService.AutoStartContest(ctx, contest.ContestID); err != nil {
+			log.Printf("[ContestLifecycle] Failed to auto-start contest %d: %v", contest.ContestID, err)
+			s.disableAutoStartOnRejection(contest.ContestID, err)
+			continue
+		}
+
+		log.Printf("[ContestLifecycle] Contest %d auto-started", contest.ContestID)
+	}
+}
+
+// disableAutoStartOnRejection turns off auto-start when the contest was rejected for starting
+// (start time not reached or not enough teams), so the job does not retry every tick; the leader can
+// still start it manually. Other failures, such as a lost DB connection, are retried on the next tick.
+func (s *ContestLifecycleService) disableAutoStartOnRejection(contestID int64, cause error) {
+	if !errors.Is(cause, exception.ErrContestCannotStart) && !errors.Is(cause, exception.ErrNotEnoughTeams) {
+		return
+	}
+
+	contest, err := s.contestRepo.GetContestById(contestID)
+	if err != nil {
+		return
+	}
+
+	contest.AutoStart = false
+	if err := s.contestRepo.UpdateContest(contest); err != nil {
+		log.Printf("[ContestLifecycle] Failed to disable auto-start for contest %d: %v", contestID, err)
+	}
+}
+
+func (s *ContestLifecycleService) finishDoneContests(ctx context.Context, now time.Time) {
+	contests, err := s.contestRepo.GetContestsByStatuses([]domain.ContestStatus{domain.ContestStatusActive})
+	if err != nil {
+		log.Printf("[ContestLifecycle] Failed to query active contests: %v", err)
+		return
+	}
+
+	for _, contest := range contests {
+		done, err := s.isDone(contest, now)
+		if err != nil {
+			log.Printf("[ContestLifecycle] Failed to check games of contest %d: %v", contest.ContestID, err)
+			continue
+		}
+		if !done {
+			continue
+		}
+
+		if _, err := s.contestService.FinishContest(ctx, contest.ContestID); err != nil {
+			log.Printf("[ContestLifecycle] Failed to finish contest %d: %v", contest.ContestID, err)
+			continue
+		}
+
+		log.Printf("[ContestLifecycle] Contest %d finished", contest.ContestID)
+	}
+}
+
+// isDone reports whether the contest passed its end time or every one of its games is terminal
+func (s *ContestLifecycleService) isDone(contest *domain.Contest, now time.Time) (bool, error) {
+	if contest.IsPastEndTime(now) {
+		return true, nil
+	}
+
+	if s.gameRepo == nil {
+		return false, nil
+	}
+
+	games, err := s.gameRepo.GetByContestID(contest.ContestID)
+	if err != nil {
+		return false, err
+	}
+
+	// Contests without games only finish at EndedAt
+	if len(games) == 0 {
+		return false, nil
+	}
+
+	for _, game := range games {
+		if !game.IsTerminalState() {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// acquireLock attempts to acquire a distributed lock using Redis SETNX
+func (s *ContestLifecycleService) acquireLock(ctx context.Context) (bool, error) {
+	result, err := s.redisClient.SetNX(ctx, lockKeyContestLifecycle, fmt.Sprintf("locked:%d", time.Now().UnixMilli()), lockTTLContestLifecycle).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	return result, nil
+}
+
+// releaseLock releases the distributed lock
+func (s *ContestLifecycleService) releaseLock(ctx context.Context) {
+	if err := s.redisClient.Del(ctx, lockKeyContestLifecycle).Err(); err != nil {
+		log.Printf("[ContestLifecycle] Failed to release lock: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
+	"errors"
+	"log"
+)
+
+// ContestOrganizerService manages the organizing staff of a contest: co-organizer invites and their roles.
+// The contest leader is the OWNER; invited organizers hold ADMIN, REFEREE or MODERATOR.
+type ContestOrganizerService struct {
+	contestRepo         port.ContestDatabasePort
+	memberRepo          port.ContestMemberDatabasePort
+	permissionChecker   port.ContestPermissionPort
+	organizerRepo       port.ContestOrganizerDatabasePort
+	notificationHandler notificationPort.NotificationHandlerPort
+	accessChecker       port.ContestAccessPort
+}
+
+func NewContestOrganizerService(
+	contestRepo port.ContestDatabasePort,
+	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
+	organizerRepo port.ContestOrganizerDatabasePort,
+) *ContestOrganizerService {
+	return &ContestOrganizerService{
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+		organizerRepo:     organizerRepo,
+	}
+}
+
+// SetNotificationHandler sets the notification handler for organizer invite notifications
+func (s *ContestOrganizerService) SetNotificationHandler(handler notificationPort.NotificationHandlerPort) {
+	s.notificationHandler = handler
+}
+
+// SetAccessChecker sets the checker that hides organizers of private contests from users without access
+func (s *ContestOrganizerService) SetAccessChecker(accessChecker port.ContestAccessPort) {
+	s.accessChecker = accessChecker
+}
+
+// getOrganizer - 대상 운영진 조회 (Leader는 변경 불가)
+func (s *ContestOrganizerService) getOrganizer(contestId, targetUserId int64) (*domain.ContestMember, error) {
+	member, err := s.memberRepo.GetByContestAndUser(contestId, targetUserId)
+	if err != nil {
+		if errors.Is(err, exception.ErrContestMemberNotFound) {
+			return nil, exception.ErrNotContestOrganizer
+		}
+		return nil, err
+	}
+
+	if member.IsLeader() {
+		return nil, exception.ErrCannotChangeLeaderRole
+	}
+
+	if !member.IsStaff() {
+		return nil, exception.ErrNotContestOrganizer
+	}
+
+	return member, nil
+}
+
+// InviteOrganizer - 공동 운영진 초대 (운영진 관리 권한 필요, 종료/취소된 대회 불가)
+func (s *ContestOrganizerService) InviteOrganizer(contestId, userId int64, req *dto.InviteOrganizerRequest) (*domain.ContestOrganizerInvite, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if contest.IsTerminalState() {
+		return nil, exception.ErrContestAlreadyClosed
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageOrganizers); err != nil {
+		return nil, err
+	}
+
+	if err := domain.ValidateAssignableRole(req.Role); err != nil {
+		return nil, err
+	}
+
+	// 이미 운영진인 경우 초대 불가 (역할 변경은 ChangeOrganizerRole 사용)
+	existing, err := s.memberRepo.GetByContestAndUser(contestId, req.UserID)
+	if err != nil && !errors.Is(err, exception.ErrContestMemberNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.IsStaff() {
+		return nil, exception.ErrAlreadyContestOrganizer
+	}
+
+	invite := domain.NewContestOrganizerInvite(contestId, req.UserID, userId, req.Role)
+	if err := s.organizerRepo.SaveInvite(invite); err != nil {
+		return nil, err
+	}
+
+	s.sendOrganizerInvitedNotification(contest, invite)
+
+	return invite, nil
+}
+
+// GetOrganizers - 대회 운영진 목록 조회
+func (s *ContestOrganizerService) GetOrganizers(contestId, userId int64) ([]*dto.ContestOrganizerResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 비공개 대회는 열람 권한이 없으면 Not Found
+	if contest.IsPrivate() && s.accessChecker != nil {
+		if err := s.accessChecker.CheckViewAccess(contestId, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := s.organizerRepo.GetOrganizersByContest(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ContestOrganizerResponse, 0, len(members))
+	for _, member := range members {
+		responses = append(responses, dto.ToContestOrganizerResponse(member))
+	}
+
+	return responses, nil
+}
+
+// GetMyRole - 대회에서 내 역할과 허용된 작업 조회
+func (s *ContestOrganizerService) GetMyRole(contestId, userId int64) (*dto.ContestOrganizerResponse, error) {
+	member, err := s.memberRepo.GetByContestAndUser(contestId, userId)
+	if err != nil {
+		if !errors.Is(err, exception.ErrContestMemberNotFound) {
+			return nil, err
+		}
+		member = &domain.ContestMember{UserID: userId, ContestID: contestId}
+	}
+
+	return dto.ToContestOrganizerResponse(member), nil
+}
+
+// GetContestInvites - 대회의 대기 중인 운영진 초대 목록 (운영진 관리 권한 필요)
+func (s *ContestOrganizerService) GetContestInvites(contestId, userId int64) ([]*domain.ContestOrganizerInvite, error) {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageOrganizers); err != nil {
+		return nil, err
+	}
+
+	return s.organizerRepo.GetInvitesByContest(contestId)
+}
+
+// GetMyInvites - 내가 받은 운영진 초대 목록
+func (s *ContestOrganizerService) GetMyInvites(userId int64) ([]*domain.ContestOrganizerInvite, error) {
+	return s.organizerRepo.GetInvitesByUser(userId)
+}
+
+// AcceptInvite - 운영진 초대 수락 (초대받은 본인만 가능)
+func (s *ContestOrganizerService) AcceptInvite(contestId, userId int64) (*dto.ContestOrganizerResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if contest.IsTerminalState() {
+		return nil, exception.ErrContestAlreadyClosed
+	}
+
+	invite, err := s.organizerRepo.GetInvite(contestId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.memberRepo.GetByContestAndUser(contestId, userId)
+	if err != nil && !errors.Is(err, exception.ErrContestMemberNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.IsStaff() {
+		_ = s.organizerRepo.DeleteInvite(contestId, userId)
+		return nil, exception.ErrAlreadyContestOrganizer
+	}
+
+	if err := s.organizerRepo.AcceptInvite(invite); err != nil {
+		return nil, err
+	}
+
+	return dto.ToContestOrganizerResponse(domain.NewContestOrganizer(userId, contestId, invite.Role)), nil
+}
+
+// DeclineInvite - 운영진 초대 거절 (초대받은 본인만 가능)
+func (s *ContestOrganizerService) DeclineInvite(contestId, userId int64) error {
+	return s.organizerRepo.DeleteInvite(contestId, userId)
+}
+
+// CancelInvite - 보낸 운영진 초대 취소 (운영진 관리 권한 필요)
+func (s *ContestOrganizerService) CancelInvite(contestId, targetUserId, userId int64) error {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageOrganizers); err != nil {
+		return err
+	}
+
+	return s.organizerRepo.DeleteInvite(contestId, targetUserId)
+}
+
+// ChangeOrganizerRole - 운영진 역할 변경 (운영진 관리 권한 필요, Owner 역할은 변경 불가)
+func (s *ContestOrganizerService) ChangeOrganizerRole(contestId, targetUserId, userId int64, role domain.ContestRole) (*dto.ContestOrganizerResponse, error) {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageOrganizers); err != nil {
+		return nil, err
+	}
+
+	if err := domain.ValidateAssignableRole(role); err != nil {
+		return nil, err
+	}
+
+	member, err := s.getOrganizer(contestId, targetUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.organizerRepo.UpdateStaffRole(contestId, targetUserId, domain.MemberTypeStaff, &role); err != nil {
+		return nil, err
+	}
+
+	member.StaffRole = &role
+	return dto.ToContestOrganizerResponse(member), nil
+}
+
+// RevokeOrganizer - 운영진 권한 회수 (운영진 관리 권한 필요, NORMAL 멤버로 변경)
+func (s *ContestOrganizerService) RevokeOrganizer(contestId, targetUserId, userId int64) error {
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageOrganizers); err != nil {
+		return err
+	}
+
+	if _, err := s.getOrganizer(contestId, targetUserId); err != nil {
+		return err
+	}
+
+	return s.organizerRepo.UpdateStaffRole(contestId, targetUserId, domain.MemberTypeNormal, nil)
+}
+
+// sendOrganizerInvitedNotification sends SSE notification to the invited user
+func (s *ContestOrganizerService) sendOrganizerInvitedNotification(contest *domain.Contest, invite *domain.ContestOrganizerInvite) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	if err := s.notificationHandler.HandleOrganizerInviteReceived(invite.UserID, contest.ContestID, contest.Title, string(invite.Role)); err != nil {
+		log.Printf("Failed to send organizer invite notification: %v", err)
+	}
+}
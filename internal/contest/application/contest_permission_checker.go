@@ -0,0 +1,28 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+)
+
+// ContestPermissionChecker implements ContestPermissionPort by resolving the user's organizer role on the contest
+type ContestPermissionChecker struct {
+	memberRepo port.ContestMemberDatabasePort
+}
+
+func NewContestPermissionChecker(memberRepo port.ContestMemberDatabasePort) *ContestPermissionChecker {
+	return &ContestPermissionChecker{memberRepo: memberRepo}
+}
+
+func (c *ContestPermissionChecker) CheckPermission(contestId, userId int64, action domain.ContestAction) error {
+	member, err := c.memberRepo.GetByContestAndUser(contestId, userId)
+	if err != nil {
+		return exception.ErrInvalidAccess
+	}
+	if !member.Can(action) {
+		return exception.ErrPermissionDenied
+	}
+
+	return nil
+}
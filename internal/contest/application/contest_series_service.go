@@ -0,0 +1,324 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// ContestSeriesInterval is how often the series job creates upcoming series instances
+	ContestSeriesInterval = 10 * time.Minute
+
+	lockKeyContestSeries = "scheduler:lock:contest_series"
+	lockTTLContestSeries = 5 * time.Minute
+)
+
+// ContestSeriesService manages recurring contest series, creates their upcoming instances
+// and adds up season standings from each finished contest's placements
+type ContestSeriesService struct {
+	seriesRepo        port.ContestSeriesDatabasePort
+	templateRepo      port.ContestTemplateDatabasePort
+	contestRepo       port.ContestDatabasePort
+	contestService    *ContestService
+	userQueryRepo     userQueryPort.UserQueryPort
+	placementProvider port.ContestPlacementPort
+	redisClient       *redis.Client
+	txManager         transaction.Transactor
+	accessChecker     port.ContestAccessPort
+}
+
+func NewContestSeriesService(
+	seriesRepo port.ContestSeriesDatabasePort,
+	templateRepo port.ContestTemplateDatabasePort,
+	contestRepo port.ContestDatabasePort,
+	contestService *ContestService,
+	userQueryRepo userQueryPort.UserQueryPort,
+	placementProvider port.ContestPlacementPort,
+	redisClient *redis.Client,
+) *ContestSeriesService {
+	return &ContestSeriesService{
+		seriesRepo:        seriesRepo,
+		templateRepo:      templateRepo,
+		contestRepo:       contestRepo,
+		contestService:    contestService,
+		userQueryRepo:     userQueryRepo,
+		placementProvider: placementProvider,
+		redisClient:       redisClient,
+	}
+}
+
+// SetTransactionManager sets the transaction manager so a series instance and the series' schedule commit together
+func (s *ContestSeriesService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// SetAccessChecker sets the checker that leaves private instances the viewer cannot see out of the standings
+func (s *ContestSeriesService) SetAccessChecker(accessChecker port.ContestAccessPort) {
+	s.accessChecker = accessChecker
+}
+
+// CreateSeries - 시리즈 생성 (템플릿 소유자만 가능)
+func (s *ContestSeriesService) CreateSeries(userId int64, req *dto.CreateContestSeriesRequest) (*domain.ContestSeries, error) {
+	template, err := s.templateRepo.GetById(req.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if !template.IsOwnedBy(userId) {
+		return nil, exception.ErrNotContestTemplateOwner
+	}
+
+	series := req.ToSeries(userId)
+	if err := series.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.seriesRepo.Save(series); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// GetSeries - 시리즈 조회
+func (s *ContestSeriesService) GetSeries(seriesId int64) (*domain.ContestSeries, error) {
+	return s.seriesRepo.GetById(seriesId)
+}
+
+// GetMySeries - 내 시리즈 목록 조회
+func (s *ContestSeriesService) GetMySeries(userId int64) ([]*domain.ContestSeries, error) {
+	return s.seriesRepo.GetByOwner(userId)
+}
+
+// GetSeriesContests - 시리즈 대회 목록 조회 (비공개, 링크 공개 대회는 목록에서 제외)
+func (s *ContestSeriesService) GetSeriesContests(seriesId int64) ([]*domain.Contest, error) {
+	if _, err := s.seriesRepo.GetById(seriesId); err != nil {
+		return nil, err
+	}
+
+	contests, err := s.seriesRepo.GetContestsBySeriesId(seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make([]*domain.Contest, 0, len(contests))
+	for _, contest := range contests {
+		if contest.IsListed() {
+			listed = append(listed, contest)
+		}
+	}
+
+	return listed, nil
+}
+
+// DeleteSeries - 시리즈 삭제 (소유자만 가능, 생성된 대회는 유지)
+func (s *ContestSeriesService) DeleteSeries(seriesId, userId int64) error {
+	series, err := s.seriesRepo.GetById(seriesId)
+	if err != nil {
+		return err
+	}
+	if !series.IsOwnedBy(userId) {
+		return exception.ErrNotContestSeriesOwner
+	}
+
+	return s.seriesRepo.Delete(seriesId)
+}
+
+// GetStandings - 시즌 순위 조회 (종료된 대회의 순위를 포인트 테이블로 합산)
+func (s *ContestSeriesService) GetStandings(seriesId, userId int64) (*dto.SeriesStandingsResponse, error) {
+	series, err := s.seriesRepo.GetById(seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	contests, err := s.seriesRepo.GetContestsBySeriesId(seriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := domain.NewSeriesStandings(series.PointsTable)
+	counted := 0
+	for _, contest := range contests {
+		if contest.ContestStatus != domain.ContestStatusFinished {
+			continue
+		}
+
+		// 열람 권한이 없는 비공개 대회는 순위 합산에서 제외
+		if contest.IsPrivate() && s.accessChecker != nil {
+			if err := s.accessChecker.CheckViewAccess(contest.ContestID, userId); err != nil {
+				if errors.Is(err, exception.ErrContestNotFound) {
+					continue
+				}
+				return nil, err
+			}
+		}
+
+		placements, err := s.placementProvider.GetPlacements(contest.ContestID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, placement := range placements {
+			for _, userId := range placement.MemberUserIDs {
+				standings.AddPlacement(userId, placement.Placement)
+			}
+		}
+		counted++
+	}
+
+	ranked := standings.Ranked()
+	for _, standing := range ranked {
+		if user, err := s.userQueryRepo.FindById(standing.UserID); err == nil {
+			standing.Username, standing.Tag = user.Username, user.Tag
+		}
+	}
+
+	return &dto.SeriesStandingsResponse{
+		SeriesID:        series.SeriesID,
+		Name:            series.Name,
+		ContestsCounted: counted,
+		Standings:       ranked,
+	}, nil
+}
+
+// RunScheduler is called every ContestSeriesInterval.
+func (s *ContestSeriesService) RunScheduler() {
+	ctx := context.Background()
+
+	// Acquire distributed lock to prevent duplicate execution across instances
+	acquired, err := s.acquireLock(ctx)
+	if err != nil {
+		log.Printf("[ContestSeries] Failed to acquire lock: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer s.releaseLock(ctx)
+
+	seriesList, err := s.seriesRepo.GetActive()
+	if err != nil {
+		log.Printf("[ContestSeries] Failed to get active series: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, series := range seriesList {
+		s.scheduleInstances(series, now)
+	}
+}
+
+// scheduleInstances creates every instance of the series that falls within its create-ahead window.
+// Occurrences already in the past are skipped; a failed creation is retried on the next run.
+func (s *ContestSeriesService) scheduleInstances(series *domain.ContestSeries, now time.Time) {
+	template, err := s.templateRepo.GetById(series.TemplateID)
+	if err != nil {
+		log.Printf("[ContestSeries] Failed to get template %d of series %d: %v", series.TemplateID, series.SeriesID, err)
+		return
+	}
+
+	for {
+		occurrence, err := series.NextOccurrence()
+		if err != nil {
+			log.Printf("[ContestSeries] Invalid recurrence of series %d: %v", series.SeriesID, err)
+			return
+		}
+
+		if series.HasEnded(occurrence) {
+			series.Active = false
+			s.updateSeries(series)
+			return
+		}
+		if !series.IsDue(occurrence, now) {
+			return
+		}
+
+		if err := s.scheduleOccurrence(series, template, occurrence, now); err != nil {
+			log.Printf("[ContestSeries] Failed to schedule occurrence of series %d at %s: %v", series.SeriesID, occurrence, err)
+			return
+		}
+	}
+}
+
+// scheduleOccurrence creates the occurrence's instance, unless it is already past, and advances the series beyond it in one transaction,
+// so a failed step leaves nothing behind and the next run retries the occurrence
+func (s *ContestSeriesService) scheduleOccurrence(series *domain.ContestSeries, template *domain.ContestTemplate, occurrence, now time.Time) error {
+	scheduled := *series
+	err := transaction.Run(context.Background(), s.txManager, func(txCtx context.Context) error {
+		if occurrence.After(now) {
+			if err := s.createInstance(txCtx, series, template, occurrence); err != nil {
+				return err
+			}
+		}
+
+		scheduled.MarkScheduled(occurrence)
+		return s.seriesRepo.UpdateWithContext(txCtx, &scheduled)
+	})
+	if err != nil {
+		return err
+	}
+
+	*series = scheduled
+	return nil
+}
+
+// createInstance creates a series contest through the regular create flow, linked to the series from the start.
+// An instance already created for the occurrence is kept instead of creating it twice.
+func (s *ContestSeriesService) createInstance(ctx context.Context, series *domain.ContestSeries, template *domain.ContestTemplate, startedAt time.Time) error {
+	title := series.InstanceTitle(template.Title)
+	endedAt, registrationClosesAt := series.InstanceSchedule(startedAt)
+
+	schedule := &dto.ContestScheduleRequest{
+		Title:                &title,
+		StartedAt:            startedAt,
+		EndedAt:              endedAt,
+		RegistrationClosesAt: registrationClosesAt,
+	}
+
+	req := schedule.ToCreateContestRequest(template)
+	req.SeriesID = &series.SeriesID
+
+	if _, _, err := s.contestService.SaveContestWithContext(ctx, req, series.OwnerUserID); err != nil {
+		if errors.Is(err, exception.ErrSeriesInstanceExists) {
+			log.Printf("[ContestSeries] Instance of series %d at %s already exists", series.SeriesID, startedAt)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *ContestSeriesService) updateSeries(series *domain.ContestSeries) bool {
+	if err := s.seriesRepo.Update(series); err != nil {
+		log.Printf("[ContestSeries] Failed to update series %d: %v", series.SeriesID, err)
+		return false
+	}
+	return true
+}
+
+// acquireLock attempts to acquire a distributed lock using Redis SETNX
+func (s *ContestSeriesService) acquireLock(ctx context.Context) (bool, error) {
+	result, err := s.redisClient.SetNX(ctx, lockKeyContestSeries, fmt.Sprintf("locked:%d", time.Now().UnixMilli()), lockTTLContestSeries).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	return result, nil
+}
+
+// releaseLock releases the distributed lock
+func (s *ContestSeriesService) releaseLock(ctx context.Context) {
+	if err := s.redisClient.Del(ctx, lockKeyContestSeries).Err(); err != nil {
+		log.Printf("[ContestSeries] Failed to release lock: %v", err)
+	}
+}
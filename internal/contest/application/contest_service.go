@@ -8,7 +8,9 @@ import (
 	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
 	gamePort "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
 	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
 	"context"
 	"errors"
@@ -22,9 +24,15 @@ type TournamentGeneratorPort interface {
 	ShuffleAndAllocateTeamsWithResult(contestID int64, gameTeamRepo gamePort.GameTeamDatabasePort) (*gameApplication.TeamAllocationResult, error)
 }
 
+// ContestGameCleanupPort defines the interface for cancelling the game-side state of a cancelled contest
+type ContestGameCleanupPort interface {
+	CancelContestGames(ctx context.Context, contestID int64) (*gameApplication.ContestCleanupResult, error)
+}
+
 type ContestService struct {
 	repository            port.ContestDatabasePort
 	memberRepository      port.ContestMemberDatabasePort
+	permissionChecker     port.ContestPermissionPort
 	applicationRepository port.ContestApplicationRedisPort
 	oauth2Repository      oauth2Port.OAuth2DatabasePort
 	eventPublisher        port.EventPublisherPort
@@ -32,11 +40,16 @@ type ContestService struct {
 	tournamentGenerator   TournamentGeneratorPort
 	teamDBPort            gamePort.TeamDatabasePort
 	gameTeamDBPort        gamePort.GameTeamDatabasePort
+	notificationHandler   notificationPort.NotificationHandlerPort
+	gameCleanup           ContestGameCleanupPort
+	accessChecker         port.ContestAccessPort
+	txManager             transaction.Transactor
 }
 
 func NewContestService(
 	repository port.ContestDatabasePort,
 	memberRepository port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
 	applicationRepository port.ContestApplicationRedisPort,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	eventPublisher port.EventPublisherPort,
@@ -44,16 +57,38 @@ func NewContestService(
 	return &ContestService{
 		repository:            repository,
 		memberRepository:      memberRepository,
+		permissionChecker:     permissionChecker,
 		applicationRepository: applicationRepository,
 		oauth2Repository:      oauth2Repository,
 		eventPublisher:        eventPublisher,
 	}
 }
 
+// SetNotificationHandler sets the notification handler (to avoid circular dependency)
+func (c *ContestService) SetNotificationHandler(handler notificationPort.NotificationHandlerPort) {
+	c.notificationHandler = handler
+}
+
+// SetGameCleanup sets the game-side cleanup used when a contest is cancelled
+func (c *ContestService) SetGameCleanup(gameCleanup ContestGameCleanupPort) {
+	c.gameCleanup = gameCleanup
+}
+
+// SetAccessChecker sets the checker that hides private contests from users without access
+func (c *ContestService) SetAccessChecker(accessChecker port.ContestAccessPort) {
+	c.accessChecker = accessChecker
+}
+
+// SetTransactionManager sets the transaction manager so a new contest, its creator and its outbox event commit together
+func (c *ContestService) SetTransactionManager(txManager transaction.Transactor) {
+	c.txManager = txManager
+}
+
 // NewContestServiceWithDiscord creates a new contest service with Discord validation
 func NewContestServiceWithDiscord(
 	repository port.ContestDatabasePort,
 	memberRepository port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
 	applicationRepository port.ContestApplicationRedisPort,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	eventPublisher port.EventPublisherPort,
@@ -62,6 +97,7 @@ func NewContestServiceWithDiscord(
 	return &ContestService{
 		repository:            repository,
 		memberRepository:      memberRepository,
+		permissionChecker:     permissionChecker,
 		applicationRepository: applicationRepository,
 		oauth2Repository:      oauth2Repository,
 		eventPublisher:        eventPublisher,
@@ -73,6 +109,7 @@ func NewContestServiceWithDiscord(
 func NewContestServiceFull(
 	repository port.ContestDatabasePort,
 	memberRepository port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
 	applicationRepository port.ContestApplicationRedisPort,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	eventPublisher port.EventPublisherPort,
@@ -84,6 +121,7 @@ func NewContestServiceFull(
 	return &ContestService{
 		repository:            repository,
 		memberRepository:      memberRepository,
+		permissionChecker:     permissionChecker,
 		applicationRepository: applicationRepository,
 		oauth2Repository:      oauth2Repository,
 		eventPublisher:        eventPublisher,
@@ -95,6 +133,11 @@ func NewContestServiceFull(
 }
 
 func (c *ContestService) SaveContest(req *dto.CreateContestRequest, userId int64) (*domain.Contest, *dto.DiscordLinkRequiredResponse, error) {
+	return c.SaveContestWithContext(context.Background(), req, userId)
+}
+
+// SaveContestWithContext creates the contest inside the transaction carried by ctx, if any
+func (c *ContestService) SaveContestWithContext(ctx context.Context, req *dto.CreateContestRequest, userId int64) (*domain.Contest, *dto.DiscordLinkRequiredResponse, error) {
 	// Check if user has linked Discord account
 	discordAccount, err := c.oauth2Repository.FindDiscordAccountByUserId(userId)
 	if err != nil {
@@ -127,28 +170,45 @@ func (c *ContestService) SaveContest(req *dto.CreateContestRequest, userId int64
 		req.DiscordTextChannelId,
 		req.Thumbnail,
 	)
+	contest.RegistrationClosesAt = req.RegistrationClosesAt
+	contest.SeriesID = req.SeriesID
+	if req.Eligibility != nil {
+		contest.Eligibility = *req.Eligibility
+	}
+	if req.Visibility != nil {
+		contest.Visibility = *req.Visibility
+	}
 
 	// Validate contest (including Discord fields)
 	if err := contest.Validate(); err != nil {
 		return nil, nil, err
 	}
 
-	savedContest, err := c.repository.Save(&contest)
-	if err != nil {
+	if err := contest.EnsureJoinCode(); err != nil {
 		return nil, nil, err
 	}
 
-	// Save contest creator as leader
-	contestMember := domain.NewContestMemberAsLeader(userId, savedContest.ContestID)
-	if err := c.memberRepository.Save(contestMember); err != nil {
-		// If member save fails, we should consider rolling back the contest creation
-		// For now, we'll return the error
-		return nil, nil, err
-	}
+	// Save the contest, its creator as leader and the contest created event (outbox) in one transaction
+	var savedContest *domain.Contest
+	err = transaction.Run(ctx, c.txManager, func(txCtx context.Context) error {
+		var err error
+		savedContest, err = c.repository.SaveWithContext(txCtx, &contest)
+		if err != nil {
+			return err
+		}
 
-	// Publish contest created event (async - failure doesn't affect contest creation)
-	if savedContest.HasDiscordIntegration() {
-		go c.publishContestCreatedEvent(context.Background(), savedContest, userId, discordAccount.DiscordId)
+		contestMember := domain.NewContestMemberAsLeader(userId, savedContest.ContestID)
+		if err := c.memberRepository.SaveWithContext(txCtx, contestMember); err != nil {
+			return err
+		}
+
+		if !savedContest.HasDiscordIntegration() {
+			return nil
+		}
+		return c.publishContestCreatedEvent(txCtx, savedContest, userId, discordAccount.DiscordId)
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return savedContest, nil, nil
@@ -204,6 +264,22 @@ func (c *ContestService) GetContestById(id int64) (*domain.Contest, error) {
 	return contest, nil
 }
 
+// GetContestForViewer - 열람 권한을 확인하여 대회 조회 (비공개 대회는 접근 권한이 없으면 Not Found)
+func (c *ContestService) GetContestForViewer(id, userId int64) (*domain.Contest, error) {
+	contest, err := c.repository.GetContestById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if contest.IsPrivate() && c.accessChecker != nil {
+		if err := c.accessChecker.CheckViewAccess(id, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	return contest, nil
+}
+
 func (c *ContestService) GetAllContests(offset, limit int, sortReq *commonDto.SortRequest, title *string) ([]domain.Contest, int64, error) {
 	contests, totalCount, err := c.repository.GetContests(offset, limit, sortReq, title)
 
@@ -214,6 +290,32 @@ func (c *ContestService) GetAllContests(offset, limit int, sortReq *commonDto.So
 	return contests, totalCount, nil
 }
 
+// GetAllContestsByCursor - 커서 기반 대회 목록 조회
+func (c *ContestService) GetAllContestsByCursor(cursor *commonDto.CursorRequest, title *string) ([]domain.Contest, *string, error) {
+	contests, err := c.repository.GetContestsByCursor(cursor, title)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contests, nextCursor := commonDto.TrimCursorPage(contests, cursor, func(contest domain.Contest) (interface{}, int64) {
+		return contestSortValue(&contest, cursor.Sort.SortBy), contest.ContestID
+	})
+
+	return contests, nextCursor, nil
+}
+
+// contestSortValue returns the value of the contest list sort field for the next cursor
+func contestSortValue(contest *domain.Contest, sortBy string) time.Time {
+	switch sortBy {
+	case "started_at":
+		return contest.StartedAt
+	case "ended_at":
+		return contest.EndedAt
+	default:
+		return contest.CreatedAt
+	}
+}
+
 func (c *ContestService) GetMyContests(userId int64, pagination *commonDto.PaginationRequest, sortReq *commonDto.SortRequest, status *domain.ContestStatus) ([]*port.ContestWithMembership, int64, error) {
 	contests, totalCount, err := c.memberRepository.GetContestsByUserId(userId, pagination, sortReq, status)
 
@@ -241,6 +343,22 @@ func (c *ContestService) UpdateContest(id int64, req *dto.UpdateContestRequest)
 
 	req.ApplyTo(contest)
 
+	if err = contest.ValidateDates(); err != nil {
+		return nil, err
+	}
+
+	if err = contest.ValidateEligibility(); err != nil {
+		return nil, err
+	}
+
+	if err = contest.ValidateVisibility(); err != nil {
+		return nil, err
+	}
+
+	if err = contest.EnsureJoinCode(); err != nil {
+		return nil, err
+	}
+
 	err = c.repository.UpdateContest(contest)
 
 	if err != nil {
@@ -254,29 +372,30 @@ func (c *ContestService) DeleteContestById(id int64) error {
 	return c.repository.DeleteContestById(id)
 }
 
-// checkLeaderPermission - Leader 권한 확인
-func (c *ContestService) checkLeaderPermission(contestId, userId int64) error {
-	member, err := c.memberRepository.GetByContestAndUser(contestId, userId)
+func (c *ContestService) StartContest(ctx context.Context, contestId, userId int64) (*domain.Contest, error) {
+	contest, err := c.repository.GetContestById(contestId)
 	if err != nil {
-		return exception.ErrInvalidAccess
+		return nil, err
 	}
-	if !member.IsLeader() {
-		return exception.ErrContestAlreadyStarted
+
+	if err := c.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageContest); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return c.startContest(ctx, contest)
 }
 
-func (c *ContestService) StartContest(ctx context.Context, contestId, userId int64) (*domain.Contest, error) {
+// AutoStartContest starts a contest as the system actor (lifecycle job), skipping the leader check
+func (c *ContestService) AutoStartContest(ctx context.Context, contestId int64) (*domain.Contest, error) {
 	contest, err := c.repository.GetContestById(contestId)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := c.checkLeaderPermission(contestId, userId); err != nil {
-		return nil, err
-	}
+	return c.startContest(ctx, contest)
+}
 
+func (c *ContestService) startContest(ctx context.Context, contest *domain.Contest) (*domain.Contest, error) {
 	if contest.ContestStatus != domain.ContestStatusPending {
 		return nil, exception.ErrContestNotPending
 	}
@@ -285,17 +404,27 @@ func (c *ContestService) StartContest(ctx context.Context, contestId, userId int
 		return nil, exception.ErrContestCannotStart
 	}
 
+	var started *domain.Contest
+	var err error
 	if contest.ContestType == domain.ContestTypeTournament {
-		return c.startTournamentContest(ctx, contest)
+		started, err = c.startTournamentContest(ctx, contest)
+	} else {
+		started, err = c.startNonTournamentContest(ctx, contest)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return c.startNonTournamentContest(ctx, contest)
+
+	go c.notifyMembers(started, domain.ContestStatusActive)
+
+	return started, nil
 }
 
 // startTournamentContest handles starting a tournament-type contest
 func (c *ContestService) startTournamentContest(ctx context.Context, contest *domain.Contest) (*domain.Contest, error) {
-	// Verify finalized team count
+	// Verify finalized team count (waitlisted teams do not take part)
 	if c.teamDBPort != nil {
-		teamCount, err := c.teamDBPort.CountByContestID(contest.ContestID)
+		teamCount, err := c.teamDBPort.CountRegisteredByContestID(contest.ContestID)
 		if err != nil {
 			return nil, err
 		}
@@ -313,6 +442,9 @@ func (c *ContestService) startTournamentContest(ctx context.Context, contest *do
 
 		var members []*domain.ContestMember
 		for _, twm := range teamsWithMembers {
+			if twm.Team.IsWaitlisted() {
+				continue
+			}
 			for _, m := range twm.Members {
 				leaderType := domain.LeaderTypeMember
 				if m.MemberType == gameDomain.TeamMemberTypeLeader {
@@ -403,34 +535,176 @@ func (c *ContestService) StopContest(ctx context.Context, contestId, userId int6
 		return nil, err
 	}
 
-	if err := c.checkLeaderPermission(contestId, userId); err != nil {
+	if err := c.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageContest); err != nil {
 		return nil, err
 	}
 
+	if err := c.finishContest(contest, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return contest, nil
+}
+
+// FinishContest finishes an active contest as the system actor (lifecycle job), skipping the leader check.
+// A contest finished after its scheduled end keeps EndedAt; an early finish records the actual time.
+func (c *ContestService) FinishContest(ctx context.Context, contestId int64) (*domain.Contest, error) {
+	contest, err := c.repository.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	endedAt := now
+	if contest.IsPastEndTime(now) {
+		endedAt = contest.EndedAt
+	}
+
+	if err := c.finishContest(contest, endedAt); err != nil {
+		return nil, err
+	}
+
+	return contest, nil
+}
+
+func (c *ContestService) finishContest(contest *domain.Contest, endedAt time.Time) error {
 	if !contest.CanStop() {
-		return nil, exception.ErrContestNotActive
+		return exception.ErrContestNotActive
 	}
 
 	if err := contest.TransitionTo(domain.ContestStatusFinished); err != nil {
+		return err
+	}
+
+	contest.EndedAt = endedAt
+
+	if err := c.repository.UpdateContest(contest); err != nil {
+		return err
+	}
+
+	go c.notifyMembers(contest, domain.ContestStatusFinished)
+
+	return nil
+}
+
+// CancelContest moves a pending or active contest to CANCELLED (requires MANAGE_CONTEST).
+// Games are cancelled, application and team caches cleared, and every applicant, team member
+// and contest member is notified with the reason.
+func (c *ContestService) CancelContest(ctx context.Context, contestId, userId int64, reason string) (*domain.Contest, error) {
+	contest, err := c.repository.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageContest); err != nil {
 		return nil, err
 	}
 
-	contest.EndedAt = time.Now()
+	// Collect applicants before the application cache is cleared
+	recipients := c.collectApplicantIDs(ctx, contestId)
+
+	if err := contest.Cancel(reason, time.Now()); err != nil {
+		return nil, err
+	}
 
 	if err := c.repository.UpdateContest(contest); err != nil {
 		return nil, err
 	}
 
+	// Cleanup is best-effort: the contest is already cancelled, leftovers only cost cache space
+	if c.gameCleanup != nil {
+		result, err := c.gameCleanup.CancelContestGames(ctx, contestId)
+		if err != nil {
+			log.Printf("[CancelContest] Failed to cancel games for contest %d: %v", contestId, err)
+		} else {
+			recipients = append(recipients, result.TeamMemberUserIDs...)
+			log.Printf("[CancelContest] Contest %d cancelled, %d games cancelled", contestId, result.CancelledGameCount)
+		}
+	}
+
+	if err := c.applicationRepository.ClearApplications(ctx, contestId); err != nil {
+		log.Printf("[CancelContest] Failed to clear applications for contest %d: %v", contestId, err)
+	}
+
+	if members, err := c.memberRepository.GetMembersByContest(contestId); err == nil {
+		for _, member := range members {
+			recipients = append(recipients, member.UserID)
+		}
+	}
+
+	go c.notifyCancelled(contest, recipients, reason)
+
 	return contest, nil
 }
 
+// collectApplicantIDs returns the user IDs of pending and accepted applicants
+func (c *ContestService) collectApplicantIDs(ctx context.Context, contestId int64) []int64 {
+	var userIDs []int64
+
+	if pending, err := c.applicationRepository.GetPendingApplications(ctx, contestId); err == nil {
+		for _, app := range pending {
+			userIDs = append(userIDs, app.UserID)
+		}
+	}
+
+	if accepted, err := c.applicationRepository.GetAcceptedApplications(ctx, contestId); err == nil {
+		userIDs = append(userIDs, accepted...)
+	}
+
+	return userIDs
+}
+
+// notifyCancelled sends the cancellation notification once to every recipient
+func (c *ContestService) notifyCancelled(contest *domain.Contest, userIDs []int64, reason string) {
+	if c.notificationHandler == nil {
+		return
+	}
+
+	notified := make(map[int64]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if notified[userID] {
+			continue
+		}
+		notified[userID] = true
+
+		if err := c.notificationHandler.HandleContestCancelled(userID, contest.ContestID, contest.Title, reason); err != nil {
+			log.Printf("Failed to send contest cancelled notification to user %d: %v", userID, err)
+		}
+	}
+}
+
+// notifyMembers sends an SSE notification about a contest status transition to every member
+func (c *ContestService) notifyMembers(contest *domain.Contest, status domain.ContestStatus) {
+	if c.notificationHandler == nil {
+		return
+	}
+
+	members, err := c.memberRepository.GetMembersByContest(contest.ContestID)
+	if err != nil {
+		log.Printf("Failed to load members of contest %d for notification: %v", contest.ContestID, err)
+		return
+	}
+
+	for _, member := range members {
+		var err error
+		if status == domain.ContestStatusActive {
+			err = c.notificationHandler.HandleContestStarted(member.UserID, contest.ContestID, contest.Title)
+		} else {
+			err = c.notificationHandler.HandleContestFinished(member.UserID, contest.ContestID, contest.Title)
+		}
+		if err != nil {
+			log.Printf("Failed to send contest %s notification to user %d: %v", status, member.UserID, err)
+		}
+	}
+}
+
 // publishContestCreatedEvent publishes an event when a new contest is created
 func (c *ContestService) publishContestCreatedEvent(
 	ctx context.Context,
 	contest *domain.Contest,
 	creatorUserId int64,
 	creatorDiscordId string,
-) {
+) error {
 	event := &port.ContestCreatedEvent{
 		EventType:            port.EventTypeContestCreated,
 		ContestID:            contest.ContestID,
@@ -449,10 +723,7 @@ func (c *ContestService) publishContestCreatedEvent(
 		},
 	}
 
-	if err := c.eventPublisher.PublishContestCreatedEvent(ctx, event); err != nil {
-		// Log error but don't affect contest creation
-		_ = err
-	}
+	return c.eventPublisher.PublishContestCreatedEvent(ctx, event)
 }
 
 // GetDiscordGuilds returns all guilds the bot is in
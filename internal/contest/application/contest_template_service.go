@@ -0,0 +1,117 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+)
+
+// ContestTemplateService saves contests as reusable templates and creates contests from templates or existing contests.
+// New contests are always created through ContestService.SaveContest so they get the same validation and Discord checks.
+type ContestTemplateService struct {
+	templateRepo      port.ContestTemplateDatabasePort
+	contestRepo       port.ContestDatabasePort
+	memberRepo        port.ContestMemberDatabasePort
+	permissionChecker port.ContestPermissionPort
+	contestService    *ContestService
+}
+
+func NewContestTemplateService(
+	templateRepo port.ContestTemplateDatabasePort,
+	contestRepo port.ContestDatabasePort,
+	memberRepo port.ContestMemberDatabasePort,
+	permissionChecker port.ContestPermissionPort,
+	contestService *ContestService,
+) *ContestTemplateService {
+	return &ContestTemplateService{
+		templateRepo:      templateRepo,
+		contestRepo:       contestRepo,
+		memberRepo:        memberRepo,
+		permissionChecker: permissionChecker,
+		contestService:    contestService,
+	}
+}
+
+// getOwnedTemplate - 템플릿 조회 (소유자만 가능)
+func (s *ContestTemplateService) getOwnedTemplate(templateId, userId int64) (*domain.ContestTemplate, error) {
+	template, err := s.templateRepo.GetById(templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !template.IsOwnedBy(userId) {
+		return nil, exception.ErrNotContestTemplateOwner
+	}
+
+	return template, nil
+}
+
+// SaveAsTemplate - 대회 설정을 템플릿으로 저장 (대회 관리 권한 필요)
+func (s *ContestTemplateService) SaveAsTemplate(contestId, userId int64, req *dto.SaveContestTemplateRequest) (*domain.ContestTemplate, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageContest); err != nil {
+		return nil, err
+	}
+
+	template := domain.NewContestTemplateFromContest(userId, req.Name, contest)
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Save(template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetMyTemplates - 내 템플릿 목록 조회
+func (s *ContestTemplateService) GetMyTemplates(userId int64) ([]*domain.ContestTemplate, error) {
+	return s.templateRepo.GetByOwner(userId)
+}
+
+// GetTemplate - 템플릿 조회 (소유자만 가능)
+func (s *ContestTemplateService) GetTemplate(templateId, userId int64) (*domain.ContestTemplate, error) {
+	return s.getOwnedTemplate(templateId, userId)
+}
+
+// DeleteTemplate - 템플릿 삭제 (소유자만 가능)
+func (s *ContestTemplateService) DeleteTemplate(templateId, userId int64) error {
+	if _, err := s.getOwnedTemplate(templateId, userId); err != nil {
+		return err
+	}
+
+	return s.templateRepo.Delete(templateId)
+}
+
+// CreateFromTemplate - 템플릿으로 대회 생성 (소유자만 가능)
+func (s *ContestTemplateService) CreateFromTemplate(templateId, userId int64, req *dto.ContestScheduleRequest) (*domain.Contest, *dto.DiscordLinkRequiredResponse, error) {
+	template, err := s.getOwnedTemplate(templateId, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.contestService.SaveContest(req.ToCreateContestRequest(template), userId)
+}
+
+// CloneContest - 기존 대회 설정으로 새 대회 생성 (대회 관리 권한 필요)
+func (s *ContestTemplateService) CloneContest(contestId, userId int64, req *dto.ContestScheduleRequest) (*domain.Contest, *dto.DiscordLinkRequiredResponse, error) {
+	contest, err := s.contestRepo.GetContestById(contestId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.permissionChecker.CheckPermission(contestId, userId, domain.ContestActionManageContest); err != nil {
+		return nil, nil, err
+	}
+
+	// A clone is an unsaved template of the source contest
+	snapshot := domain.NewContestTemplateFromContest(userId, contest.Title, contest)
+
+	return s.contestService.SaveContest(req.ToCreateContestRequest(snapshot), userId)
+}
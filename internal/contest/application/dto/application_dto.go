@@ -2,6 +2,7 @@ package dto
 
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"time"
 )
 
@@ -20,6 +21,7 @@ type ApplicationResponse struct {
 	ProcessedAt *time.Time               `json:"processed_at,omitempty"`
 	ProcessedBy *int64                   `json:"processed_by,omitempty"`
 	Sender      *SenderResponse          `json:"sender,omitempty"`
+	Answers     domain.ApplicationAnswers `json:"answers,omitempty"`
 }
 
 func ToApplicationResponse(app *port.ContestApplication) *ApplicationResponse {
@@ -41,6 +43,7 @@ func ToApplicationResponse(app *port.ContestApplication) *ApplicationResponse {
 		ProcessedAt: app.ProcessedAt,
 		ProcessedBy: app.ProcessedBy,
 		Sender:      sender,
+		Answers:     app.Answers,
 	}
 }
 
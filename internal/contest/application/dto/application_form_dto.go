@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"time"
+)
+
+type FormQuestionRequest struct {
+	QuestionID string                  `json:"question_id" binding:"required,max=50"`
+	Label      string                  `json:"label" binding:"required,max=200"`
+	Type       domain.FormQuestionType `json:"type" binding:"required"`
+	Required   bool                    `json:"required"`
+	Options    []string                `json:"options"`
+}
+
+type SaveApplicationFormRequest struct {
+	Questions []*FormQuestionRequest `json:"questions" binding:"required,min=1,max=20,dive"`
+}
+
+func (req *SaveApplicationFormRequest) ToQuestions() domain.FormQuestions {
+	questions := make(domain.FormQuestions, len(req.Questions))
+	for i, q := range req.Questions {
+		questions[i] = &domain.FormQuestion{
+			QuestionID: q.QuestionID,
+			Label:      q.Label,
+			Type:       q.Type,
+			Required:   q.Required,
+			Options:    q.Options,
+		}
+	}
+	return questions
+}
+
+type ApplicationFormResponse struct {
+	ContestID  int64                `json:"contest_id"`
+	Questions  domain.FormQuestions `json:"questions"`
+	ModifiedAt time.Time            `json:"modified_at"`
+}
+
+func ToApplicationFormResponse(form *domain.ContestApplicationForm) *ApplicationFormResponse {
+	return &ApplicationFormResponse{
+		ContestID:  form.ContestID,
+		Questions:  form.Questions,
+		ModifiedAt: form.ModifiedAt,
+	}
+}
+
+type ApplicationAnswerRequest struct {
+	QuestionID string `json:"question_id" binding:"required"`
+	Value      string `json:"value"`
+}
+
+// ParticipateRequest carries the application form answers; the body is optional for contests without a form
+type ParticipateRequest struct {
+	Answers []*ApplicationAnswerRequest `json:"answers" binding:"omitempty,dive"`
+}
+
+func (req *ParticipateRequest) ToAnswers() domain.ApplicationAnswers {
+	answers := make(domain.ApplicationAnswers, len(req.Answers))
+	for i, a := range req.Answers {
+		answers[i] = &domain.ApplicationAnswer{
+			QuestionID: a.QuestionID,
+			Value:      a.Value,
+		}
+	}
+	return answers
+}
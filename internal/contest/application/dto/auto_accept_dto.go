@@ -0,0 +1,37 @@
+package dto
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+type SaveAutoAcceptRuleRequest struct {
+	// Enabled defaults to true so saving a rule turns it on
+	Enabled            *bool                           `json:"enabled"`
+	MinTier            *int                            `json:"min_tier"`
+	MaxTier            *int                            `json:"max_tier"`
+	RequireRiotAccount bool                            `json:"require_riot_account"`
+	RequireGuildMember bool                            `json:"require_guild_member"`
+	AcceptCap          *int                            `json:"accept_cap"`
+	OnMismatch         domain.AutoAcceptMismatchAction `json:"on_mismatch"`
+}
+
+func (req *SaveAutoAcceptRuleRequest) ToRule(contestId int64) *domain.ContestAutoAcceptRule {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	onMismatch := req.OnMismatch
+	if onMismatch == "" {
+		onMismatch = domain.AutoAcceptMismatchLeavePending
+	}
+
+	return &domain.ContestAutoAcceptRule{
+		ContestID:          contestId,
+		Enabled:            enabled,
+		MinTier:            req.MinTier,
+		MaxTier:            req.MaxTier,
+		RequireRiotAccount: req.RequireRiotAccount,
+		RequireGuildMember: req.RequireGuildMember,
+		AcceptCap:          req.AcceptCap,
+		OnMismatch:         onMismatch,
+	}
+}
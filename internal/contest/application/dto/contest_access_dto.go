@@ -0,0 +1,32 @@
+package dto
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+type JoinContestRequest struct {
+	JoinCode string `json:"join_code" binding:"required"`
+}
+
+type InviteContestUserRequest struct {
+	UserID int64 `json:"user_id" binding:"required"`
+}
+
+// ContestAccessResponse shows organizers how a contest is shared and who was let in
+type ContestAccessResponse struct {
+	ContestID  int64                        `json:"contest_id"`
+	Visibility domain.ContestVisibility     `json:"visibility"`
+	JoinCode   *string                      `json:"join_code,omitempty"`
+	Grants     []*domain.ContestAccessGrant `json:"grants"`
+}
+
+func ToContestAccessResponse(contest *domain.Contest, grants []*domain.ContestAccessGrant) *ContestAccessResponse {
+	if grants == nil {
+		grants = []*domain.ContestAccessGrant{}
+	}
+
+	return &ContestAccessResponse{
+		ContestID:  contest.ContestID,
+		Visibility: contest.EffectiveVisibility(),
+		JoinCode:   contest.JoinCode,
+		Grants:     grants,
+	}
+}
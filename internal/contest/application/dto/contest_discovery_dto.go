@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"time"
+)
+
+// ContestDiscoveryRequest is bound from the query string; list filters accept repeated parameters
+type ContestDiscoveryRequest struct {
+	Query        string                 `form:"q"`
+	GameTypes    []gameDomain.GameType  `form:"game_type"`
+	ContestTypes []domain.ContestType   `form:"contest_type"`
+	Statuses     []domain.ContestStatus `form:"status"`
+	StartsFrom   *time.Time             `form:"starts_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	StartsTo     *time.Time             `form:"starts_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	TeamSize     *int                   `form:"team_size"`
+	OpenSlots    bool                   `form:"open_slots"`
+	EligibleOnly bool                   `form:"eligible_only"`
+	Sort         string                 `form:"sort"`
+	Order        string                 `form:"order"`
+	Page         int                    `form:"page"`
+	PageSize     int                    `form:"page_size"`
+}
+
+func (req *ContestDiscoveryRequest) ToFilter() *domain.ContestDiscoveryFilter {
+	return &domain.ContestDiscoveryFilter{
+		Query:         req.Query,
+		GameTypes:     req.GameTypes,
+		ContestTypes:  req.ContestTypes,
+		Statuses:      req.Statuses,
+		StartsFrom:    req.StartsFrom,
+		StartsTo:      req.StartsTo,
+		TeamSize:      req.TeamSize,
+		OpenSlotsOnly: req.OpenSlots,
+	}
+}
+
+// ContestDiscoveryResponse pairs one page of contests with facet counts for the same filter
+type ContestDiscoveryResponse struct {
+	Contests *commonDto.PaginationResponse  `json:"contests"`
+	Facets   *domain.ContestDiscoveryFacets `json:"facets"`
+	Sort     domain.ContestDiscoverySort    `json:"sort"`
+}
@@ -18,12 +18,20 @@ type CreateContestRequest struct {
 	StartedAt            time.Time            `json:"started_at,omitempty"`
 	EndedAt              time.Time            `json:"ended_at,omitempty"`
 	AutoStart            bool                 `json:"auto_start,omitempty"`
+	RegistrationClosesAt *time.Time           `json:"registration_closes_at,omitempty"`
 	GameType             *gameDomain.GameType `json:"game_type,omitempty"`
 	GamePointTableId     *int64               `json:"game_point_table_id,omitempty"`
 	TotalTeamMember      int                  `json:"total_team_member,omitempty"`
 	DiscordGuildId       *string              `json:"discord_guild_id,omitempty"`
 	DiscordTextChannelId *string              `json:"discord_text_channel_id,omitempty"`
 	Thumbnail            *string              `json:"thumbnail,omitempty"`
+
+	Eligibility *domain.ContestEligibility `json:"eligibility,omitempty"`
+
+	Visibility *domain.ContestVisibility `json:"visibility,omitempty"`
+
+	// SeriesID links a series instance to its series; set by the series scheduler, never bound from requests
+	SeriesID *int64 `json:"-"`
 }
 
 type UpdateContestRequest struct {
@@ -36,12 +44,17 @@ type UpdateContestRequest struct {
 	StartedAt            *time.Time            `json:"started_at,omitempty"`
 	EndedAt              *time.Time            `json:"ended_at,omitempty"`
 	AutoStart            *bool                 `json:"auto_start,omitempty"`
+	RegistrationClosesAt *time.Time            `json:"registration_closes_at,omitempty"`
 	GameType             *gameDomain.GameType  `json:"game_type,omitempty"`
 	GamePointTableId     *int64                `json:"game_point_table_id,omitempty"`
 	TotalTeamMember      *int                  `json:"total_team_member,omitempty"`
 	DiscordGuildId       *string               `json:"discord_guild_id,omitempty"`
 	DiscordTextChannelId *string               `json:"discord_text_channel_id,omitempty"`
 	Thumbnail            *string               `json:"thumbnail,omitempty"`
+
+	Eligibility *domain.ContestEligibility `json:"eligibility,omitempty"`
+
+	Visibility *domain.ContestVisibility `json:"visibility,omitempty"`
 }
 
 type ContestResponse struct {
@@ -55,14 +68,22 @@ type ContestResponse struct {
 	StartedAt            time.Time            `json:"started_at,omitempty"`
 	EndedAt              time.Time            `json:"ended_at,omitempty"`
 	AutoStart            bool                 `json:"auto_start,omitempty"`
+	RegistrationClosesAt *time.Time           `json:"registration_closes_at,omitempty"`
+	RosterLockedAt       *time.Time           `json:"roster_locked_at,omitempty"`
+	CancelledAt          *time.Time           `json:"cancelled_at,omitempty"`
+	CancelReason         *string              `json:"cancel_reason,omitempty"`
 	GameType             *gameDomain.GameType `json:"game_type,omitempty"`
 	GamePointTableId     *int64               `json:"game_point_table_id,omitempty"`
 	TotalTeamMember      int                  `json:"total_team_member"`
 	DiscordGuildId       *string              `json:"discord_guild_id,omitempty"`
 	DiscordTextChannelId *string              `json:"discord_text_channel_id,omitempty"`
 	Thumbnail            *string              `json:"thumbnail,omitempty"`
-	CreatedAt            time.Time            `json:"created_at"`
-	ModifiedAt           time.Time            `json:"modified_at"`
+
+	Eligibility domain.ContestEligibility `json:"eligibility"`
+	Visibility  domain.ContestVisibility  `json:"visibility"`
+
+	CreatedAt  time.Time `json:"created_at"`
+	ModifiedAt time.Time `json:"modified_at"`
 }
 
 func (req *UpdateContestRequest) ApplyTo(contest *domain.Contest) {
@@ -93,6 +114,9 @@ func (req *UpdateContestRequest) ApplyTo(contest *domain.Contest) {
 	if req.AutoStart != nil {
 		contest.AutoStart = *req.AutoStart
 	}
+	if req.RegistrationClosesAt != nil {
+		contest.RegistrationClosesAt = req.RegistrationClosesAt
+	}
 	if req.GameType != nil {
 		contest.GameType = req.GameType
 	}
@@ -111,6 +135,12 @@ func (req *UpdateContestRequest) ApplyTo(contest *domain.Contest) {
 	if req.Thumbnail != nil {
 		contest.Thumbnail = req.Thumbnail
 	}
+	if req.Eligibility != nil {
+		contest.Eligibility = *req.Eligibility
+	}
+	if req.Visibility != nil {
+		contest.Visibility = *req.Visibility
+	}
 }
 
 func (req *UpdateContestRequest) HasChanges() bool {
@@ -123,12 +153,15 @@ func (req *UpdateContestRequest) HasChanges() bool {
 		req.StartedAt != nil ||
 		req.EndedAt != nil ||
 		req.AutoStart != nil ||
+		req.RegistrationClosesAt != nil ||
 		req.GameType != nil ||
 		req.GamePointTableId != nil ||
 		req.TotalTeamMember != nil ||
 		req.DiscordGuildId != nil ||
 		req.DiscordTextChannelId != nil ||
-		req.Thumbnail != nil
+		req.Thumbnail != nil ||
+		req.Eligibility != nil ||
+		req.Visibility != nil
 }
 
 func (req *UpdateContestRequest) Validate() error {
@@ -210,27 +243,27 @@ func ToContestMemberResponses(members []*port.ContestMemberWithUser) []*ContestM
 
 // MyContestResponse represents a contest the user has joined with membership info
 type MyContestResponse struct {
-	ContestID            int64                 `json:"contest_id"`
-	Title                string                `json:"title"`
-	Description          string                `json:"description,omitempty"`
-	MaxTeamCount         int                   `json:"max_team_count,omitempty"`
-	TotalPoint           int                   `json:"total_point"`
-	ContestType          domain.ContestType    `json:"contest_type"`
-	ContestStatus        domain.ContestStatus  `json:"contest_status"`
-	StartedAt            time.Time             `json:"started_at,omitempty"`
-	EndedAt              time.Time             `json:"ended_at,omitempty"`
-	AutoStart            bool                  `json:"auto_start,omitempty"`
-	GameType             *gameDomain.GameType  `json:"game_type,omitempty"`
-	GamePointTableId     *int64                `json:"game_point_table_id,omitempty"`
-	TotalTeamMember      int                   `json:"total_team_member"`
-	DiscordGuildId       *string               `json:"discord_guild_id,omitempty"`
-	DiscordTextChannelId *string               `json:"discord_text_channel_id,omitempty"`
-	Thumbnail            *string               `json:"thumbnail,omitempty"`
-	CreatedAt            time.Time             `json:"created_at"`
-	ModifiedAt           time.Time             `json:"modified_at"`
-	MemberType           domain.MemberType     `json:"member_type"`
-	LeaderType           domain.LeaderType     `json:"leader_type"`
-	Point                int                   `json:"point"`
+	ContestID            int64                `json:"contest_id"`
+	Title                string               `json:"title"`
+	Description          string               `json:"description,omitempty"`
+	MaxTeamCount         int                  `json:"max_team_count,omitempty"`
+	TotalPoint           int                  `json:"total_point"`
+	ContestType          domain.ContestType   `json:"contest_type"`
+	ContestStatus        domain.ContestStatus `json:"contest_status"`
+	StartedAt            time.Time            `json:"started_at,omitempty"`
+	EndedAt              time.Time            `json:"ended_at,omitempty"`
+	AutoStart            bool                 `json:"auto_start,omitempty"`
+	GameType             *gameDomain.GameType `json:"game_type,omitempty"`
+	GamePointTableId     *int64               `json:"game_point_table_id,omitempty"`
+	TotalTeamMember      int                  `json:"total_team_member"`
+	DiscordGuildId       *string              `json:"discord_guild_id,omitempty"`
+	DiscordTextChannelId *string              `json:"discord_text_channel_id,omitempty"`
+	Thumbnail            *string              `json:"thumbnail,omitempty"`
+	CreatedAt            time.Time            `json:"created_at"`
+	ModifiedAt           time.Time            `json:"modified_at"`
+	MemberType           domain.MemberType    `json:"member_type"`
+	LeaderType           domain.LeaderType    `json:"leader_type"`
+	Point                int                  `json:"point"`
 }
 
 // ToMyContestResponse converts port.ContestWithMembership to MyContestResponse
@@ -269,6 +302,11 @@ func ToMyContestResponses(contests []*port.ContestWithMembership) []*MyContestRe
 	return responses
 }
 
+// CancelContestRequest represents the request to cancel a contest
+type CancelContestRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
 // ChangeMemberRoleRequest represents the request to change a member's role
 type ChangeMemberRoleRequest struct {
 	MemberType domain.MemberType `json:"member_type" binding:"required"`
@@ -0,0 +1,35 @@
+package dto
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+type InviteOrganizerRequest struct {
+	UserID int64              `json:"user_id" binding:"required"`
+	Role   domain.ContestRole `json:"role" binding:"required"`
+}
+
+type ChangeOrganizerRoleRequest struct {
+	Role domain.ContestRole `json:"role" binding:"required"`
+}
+
+// ContestOrganizerResponse describes an organizer's role and the actions it grants
+type ContestOrganizerResponse struct {
+	UserID    int64                  `json:"user_id"`
+	ContestID int64                  `json:"contest_id"`
+	Role      domain.ContestRole     `json:"role"`
+	Actions   []domain.ContestAction `json:"actions"`
+}
+
+func ToContestOrganizerResponse(member *domain.ContestMember) *ContestOrganizerResponse {
+	role := member.Role()
+	actions := role.Actions()
+	if actions == nil {
+		actions = []domain.ContestAction{}
+	}
+
+	return &ContestOrganizerResponse{
+		UserID:    member.UserID,
+		ContestID: member.ContestID,
+		Role:      role,
+		Actions:   actions,
+	}
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"time"
+)
+
+const (
+	DefaultSeriesTimezone        = "Asia/Seoul"
+	DefaultSeriesCreateAheadDays = 7
+)
+
+type CreateContestSeriesRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description,omitempty"`
+	TemplateID  int64  `json:"template_id" binding:"required"`
+
+	// Weekday is 0 (Sunday) to 6 (Saturday)
+	Weekday   time.Weekday `json:"weekday"`
+	StartTime string       `json:"start_time" binding:"required" example:"20:00"`
+	// Timezone defaults to Asia/Seoul
+	Timezone                 string `json:"timezone,omitempty" example:"Asia/Seoul"`
+	IntervalWeeks            int    `json:"interval_weeks,omitempty"`
+	DurationMinutes          int    `json:"duration_minutes" binding:"required"`
+	RegistrationCloseMinutes *int   `json:"registration_close_minutes,omitempty"`
+	CreateAheadDays          int    `json:"create_ahead_days,omitempty"`
+
+	StartsOn    time.Time                `json:"starts_on" binding:"required"`
+	EndsOn      *time.Time               `json:"ends_on,omitempty"`
+	PointsTable domain.SeriesPointsTable `json:"points_table" binding:"required"`
+}
+
+func (req *CreateContestSeriesRequest) ToSeries(ownerUserId int64) *domain.ContestSeries {
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = DefaultSeriesTimezone
+	}
+
+	intervalWeeks := req.IntervalWeeks
+	if intervalWeeks == 0 {
+		intervalWeeks = 1
+	}
+
+	createAheadDays := req.CreateAheadDays
+	if createAheadDays == 0 {
+		createAheadDays = DefaultSeriesCreateAheadDays
+	}
+
+	return &domain.ContestSeries{
+		OwnerUserID:              ownerUserId,
+		TemplateID:               req.TemplateID,
+		Name:                     req.Name,
+		Description:              req.Description,
+		Weekday:                  req.Weekday,
+		StartTime:                req.StartTime,
+		Timezone:                 timezone,
+		IntervalWeeks:            intervalWeeks,
+		DurationMinutes:          req.DurationMinutes,
+		RegistrationCloseMinutes: req.RegistrationCloseMinutes,
+		CreateAheadDays:          createAheadDays,
+		StartsOn:                 req.StartsOn,
+		EndsOn:                   req.EndsOn,
+		Active:                   true,
+		PointsTable:              req.PointsTable,
+	}
+}
+
+// SeriesStandingsResponse represents the season standings of a series
+type SeriesStandingsResponse struct {
+	SeriesID int64  `json:"series_id"`
+	Name     string `json:"name"`
+	// ContestsCounted is the number of finished contests included in the standings
+	ContestsCounted int                      `json:"contests_counted"`
+	Standings       []*domain.SeriesStanding `json:"standings"`
+}
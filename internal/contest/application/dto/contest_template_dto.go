@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"time"
+)
+
+type SaveContestTemplateRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// ContestScheduleRequest gives the schedule of a contest created from a template or cloned from another contest
+type ContestScheduleRequest struct {
+	// Title overrides the copied title when set
+	Title                *string    `json:"title,omitempty"`
+	StartedAt            time.Time  `json:"started_at,omitempty"`
+	EndedAt              time.Time  `json:"ended_at,omitempty"`
+	RegistrationClosesAt *time.Time `json:"registration_closes_at,omitempty"`
+}
+
+// ToCreateContestRequest merges the template settings with the schedule into a regular create request
+func (req *ContestScheduleRequest) ToCreateContestRequest(template *domain.ContestTemplate) *CreateContestRequest {
+	title := template.Title
+	if req.Title != nil {
+		title = *req.Title
+	}
+
+	eligibility := template.Eligibility
+
+	return &CreateContestRequest{
+		Title:                title,
+		Description:          template.Description,
+		MaxTeamCount:         template.MaxTeamCount,
+		TotalPoint:           template.TotalPoint,
+		ContestType:          template.ContestType,
+		StartedAt:            req.StartedAt,
+		EndedAt:              req.EndedAt,
+		AutoStart:            template.AutoStart,
+		RegistrationClosesAt: req.RegistrationClosesAt,
+		GameType:             template.GameType,
+		GamePointTableId:     template.GamePointTableId,
+		TotalTeamMember:      template.TotalTeamMember,
+		DiscordGuildId:       template.DiscordGuildId,
+		DiscordTextChannelId: template.DiscordTextChannelId,
+		Thumbnail:            template.Thumbnail,
+		Eligibility:          &eligibility,
+	}
+}
@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"time"
+)
+
+type StartDraftRequest struct {
+	CaptainUserIDs     []int64 `json:"captain_user_ids" binding:"required,min=2"`
+	PickTimeoutSeconds int     `json:"pick_timeout_seconds"`
+}
+
+type DraftPickRequest struct {
+	PlayerUserID int64 `json:"player_user_id" binding:"required"`
+}
+
+type DraftTeamResponse struct {
+	Captain *domain.DraftPlayer   `json:"captain"`
+	Players []*domain.DraftPlayer `json:"players"`
+}
+
+type DraftResponse struct {
+	ContestID            int64                 `json:"contest_id"`
+	Status               domain.DraftStatus    `json:"status"`
+	TeamSize             int                   `json:"team_size"`
+	PickTimeoutSeconds   int                   `json:"pick_timeout_seconds"`
+	CurrentRound         int                   `json:"current_round"`
+	CurrentPickNumber    int                   `json:"current_pick_number"`
+	TotalPicks           int                   `json:"total_picks"`
+	CurrentCaptainUserID *int64                `json:"current_captain_user_id,omitempty"`
+	PickDeadline         *time.Time            `json:"pick_deadline,omitempty"`
+	RemainingSeconds     int                   `json:"remaining_seconds"`
+	Teams                []*DraftTeamResponse  `json:"teams"`
+	AvailablePlayers     []*domain.DraftPlayer `json:"available_players"`
+	Picks                []*domain.DraftPick   `json:"picks"`
+	StartedAt            time.Time             `json:"started_at"`
+	CompletedAt          *time.Time            `json:"completed_at,omitempty"`
+}
+
+func ToDraftResponse(draft *domain.ContestDraft) *DraftResponse {
+	teams := make([]*DraftTeamResponse, 0, len(draft.Captains))
+	for _, captain := range draft.Captains {
+		players := make([]*domain.DraftPlayer, 0, draft.TeamSize-1)
+		for _, pick := range draft.Picks {
+			if pick.CaptainUserID == captain.UserID {
+				players = append(players, pick.Player)
+			}
+		}
+		teams = append(teams, &DraftTeamResponse{
+			Captain: captain,
+			Players: players,
+		})
+	}
+
+	resp := &DraftResponse{
+		ContestID:          draft.ContestID,
+		Status:             draft.Status,
+		TeamSize:           draft.TeamSize,
+		PickTimeoutSeconds: draft.PickTimeoutSeconds,
+		CurrentPickNumber:  len(draft.Picks) + 1,
+		TotalPicks:         draft.TotalPicks,
+		PickDeadline:       draft.PickDeadline,
+		Teams:              teams,
+		AvailablePlayers:   draft.Pool,
+		Picks:              draft.Picks,
+		StartedAt:          draft.StartedAt,
+		CompletedAt:        draft.CompletedAt,
+	}
+
+	if draft.IsInProgress() {
+		captainID := draft.CurrentCaptainUserID()
+		resp.CurrentCaptainUserID = &captainID
+		resp.CurrentRound = draft.CurrentRound()
+		if draft.PickDeadline != nil {
+			if remaining := int(time.Until(*draft.PickDeadline).Seconds()); remaining > 0 {
+				resp.RemainingSeconds = remaining
+			}
+		}
+	} else {
+		resp.CurrentPickNumber = len(draft.Picks)
+	}
+
+	return resp
+}
@@ -0,0 +1,12 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestAccessDatabasePort defines the interface for private contest join codes and access grants
+type ContestAccessDatabasePort interface {
+	GetContestByJoinCode(joinCode string) (*domain.Contest, error)
+	SaveGrant(grant *domain.ContestAccessGrant) error
+	HasGrant(contestId, userId int64) (bool, error)
+	GetGrantsByContest(contestId int64) ([]*domain.ContestAccessGrant, error)
+	DeleteGrant(contestId, userId int64) error
+}
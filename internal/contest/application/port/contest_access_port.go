@@ -0,0 +1,7 @@
+package port
+
+// ContestAccessPort checks whether a user may read a contest and its comments and results.
+// userId is 0 for anonymous viewers. Inaccessible private contests are reported as not found.
+type ContestAccessPort interface {
+	CheckViewAccess(contestId, userId int64) error
+}
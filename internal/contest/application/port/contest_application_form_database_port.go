@@ -0,0 +1,16 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestApplicationFormDatabasePort defines the interface for application form and answer persistence
+type ContestApplicationFormDatabasePort interface {
+	// Form operations
+	SaveForm(form *domain.ContestApplicationForm) error
+	GetFormByContestId(contestId int64) (*domain.ContestApplicationForm, error)
+	DeleteForm(contestId int64) error
+
+	// Answer operations
+	SaveAnswers(answer *domain.ContestApplicationAnswer) error
+	GetAnswersByContestId(contestId int64) ([]*domain.ContestApplicationAnswer, error)
+	DeleteAnswers(contestId, userId int64) error
+}
@@ -1,6 +1,7 @@
 package port
 
 import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"context"
 	"time"
 )
@@ -29,6 +30,8 @@ type ContestApplication struct {
 	ProcessedAt *time.Time        `json:"processed_at,omitempty"`
 	ProcessedBy *int64            `json:"processed_by,omitempty"`
 	Sender      *SenderSnapshot   `json:"sender,omitempty"`
+	// Answers holds the application form answers; they are stored in DB and attached when listing
+	Answers domain.ApplicationAnswers `json:"answers,omitempty"`
 }
 
 type ContestApplicationRedisPort interface {
@@ -0,0 +1,15 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestAutoAcceptDatabasePort defines the interface for auto-accept rule and decision persistence
+type ContestAutoAcceptDatabasePort interface {
+	// Rule operations
+	SaveRule(rule *domain.ContestAutoAcceptRule) error
+	GetRuleByContestId(contestId int64) (*domain.ContestAutoAcceptRule, error)
+	DeleteRule(contestId int64) error
+
+	// Decision operations
+	SaveDecision(decision *domain.ContestAutoAcceptDecision) error
+	GetDecisionsByContestId(contestId int64) ([]*domain.ContestAutoAcceptDecision, error)
+}
@@ -3,16 +3,39 @@ package port
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"context"
+	"time"
 )
 
 type ContestDatabasePort interface {
 	Save(contest *domain.Contest) (*domain.Contest, error)
 
+	// SaveWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveWithContext(ctx context.Context, contest *domain.Contest) (*domain.Contest, error)
+
 	GetContestById(contestId int64) (*domain.Contest, error)
 
+	// GetContestByIdForUpdate locks the contest row until the transaction carried by ctx ends
+	GetContestByIdForUpdate(ctx context.Context, contestId int64) (*domain.Contest, error)
+
 	GetContests(offset, limit int, sortReq *dto.SortRequest, title *string) ([]domain.Contest, int64, error)
 
+	// GetContestsByCursor returns up to cursor.FetchLimit() listed contests after the cursor
+	GetContestsByCursor(cursor *dto.CursorRequest, title *string) ([]domain.Contest, error)
+
 	DeleteContestById(contestId int64) error
 
 	UpdateContest(contest *domain.Contest) error
+
+	// UpdateContestWithContext joins the transaction carried by ctx (see transaction.Manager)
+	UpdateContestWithContext(ctx context.Context, contest *domain.Contest) error
+
+	// GetContestsDueForRosterLock returns pending contests whose registration has closed but rosters are not locked yet
+	GetContestsDueForRosterLock(now time.Time) ([]*domain.Contest, error)
+
+	// GetContestsDueForAutoStart returns pending auto-start contests whose start time has passed
+	GetContestsDueForAutoStart(now time.Time) ([]*domain.Contest, error)
+
+	// GetContestsByStatuses returns all contests in any of the given statuses
+	GetContestsByStatuses(statuses []domain.ContestStatus) ([]*domain.Contest, error)
 }
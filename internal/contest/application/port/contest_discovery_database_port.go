@@ -0,0 +1,19 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestDiscoveryDatabasePort defines the interface for faceted search over listed contests
+type ContestDiscoveryDatabasePort interface {
+	// SearchContests returns one page of matching contests and the total match count.
+	// recommendation is only used with ContestDiscoverySortRecommended.
+	SearchContests(
+		filter *domain.ContestDiscoveryFilter,
+		sort domain.ContestDiscoverySort,
+		descending bool,
+		recommendation *domain.RecommendationProfile,
+		offset, limit int,
+	) ([]domain.Contest, int64, error)
+	GetFacets(filter *domain.ContestDiscoveryFilter) (*domain.ContestDiscoveryFacets, error)
+	// GetRecommendationProfile collects the game and contest types of the contests the user has joined
+	GetRecommendationProfile(userId int64) (*domain.RecommendationProfile, error)
+}
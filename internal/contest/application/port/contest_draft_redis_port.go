@@ -0,0 +1,21 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"context"
+	"time"
+)
+
+type ContestDraftRedisPort interface {
+	// 드래프트 상태 관리
+	SaveDraft(ctx context.Context, draft *domain.ContestDraft, ttl time.Duration) error
+	GetDraft(ctx context.Context, contestId int64) (*domain.ContestDraft, error)
+	DeleteDraft(ctx context.Context, contestId int64) error
+
+	// 진행 중인 드래프트 조회 (픽 타이머용)
+	GetActiveDraftContestIDs(ctx context.Context) ([]int64, error)
+
+	// 픽 동시성 제어
+	AcquireDraftLock(ctx context.Context, contestId int64, ttl time.Duration) (bool, error)
+	ReleaseDraftLock(ctx context.Context, contestId int64) error
+}
@@ -0,0 +1,10 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestEligibilityPort checks a user against a contest's eligibility constraints.
+// It is shared with the game module so team invites enforce the same constraints as applications.
+type ContestEligibilityPort interface {
+	// CheckEligibility returns the first constraint the user does not meet, or nil if the user is eligible
+	CheckEligibility(contest *domain.Contest, userID int64) error
+}
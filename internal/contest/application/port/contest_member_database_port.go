@@ -3,6 +3,7 @@ package port
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"context"
 )
 
 // ContestMemberWithUser represents a contest member with user information
@@ -34,10 +35,15 @@ type ContestWithMembership struct {
 type ContestMemberDatabasePort interface {
 	Save(member *domain.ContestMember) error
 	DeleteById(contestId, userId int64) error
+	// SaveWithContext and DeleteByIdWithContext join the transaction carried by ctx (see transaction.Manager)
+	SaveWithContext(ctx context.Context, member *domain.ContestMember) error
+	DeleteByIdWithContext(ctx context.Context, contestId, userId int64) error
 	GetByContestAndUser(contestId, userId int64) (*domain.ContestMember, error)
 	GetMembersByContest(contestId int64) ([]*domain.ContestMember, error)
 	SaveBatch(members []*domain.ContestMember) error
 	GetMembersWithUserByContest(contestId int64, pagination *commonDto.PaginationRequest, sort *commonDto.SortRequest) ([]*ContestMemberWithUser, int64, error)
+	// GetMembersWithUserByContestCursor returns up to cursor.FetchLimit() members after the cursor, sorted by point or username
+	GetMembersWithUserByContestCursor(contestId int64, cursor *commonDto.CursorRequest) ([]*ContestMemberWithUser, error)
 	GetContestsByUserId(userId int64, pagination *commonDto.PaginationRequest, sort *commonDto.SortRequest, status *domain.ContestStatus) ([]*ContestWithMembership, int64, error)
 	UpdateMemberType(contestId, userId int64, memberType domain.MemberType) error
 }
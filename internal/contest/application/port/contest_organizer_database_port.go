@@ -0,0 +1,16 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestOrganizerDatabasePort defines the interface for co-organizer invites and organizer roles
+type ContestOrganizerDatabasePort interface {
+	SaveInvite(invite *domain.ContestOrganizerInvite) error
+	GetInvite(contestId, userId int64) (*domain.ContestOrganizerInvite, error)
+	GetInvitesByContest(contestId int64) ([]*domain.ContestOrganizerInvite, error)
+	GetInvitesByUser(userId int64) ([]*domain.ContestOrganizerInvite, error)
+	DeleteInvite(contestId, userId int64) error
+	// AcceptInvite promotes the invitee to a STAFF member with the invited role and removes the invite atomically
+	AcceptInvite(invite *domain.ContestOrganizerInvite) error
+	GetOrganizersByContest(contestId int64) ([]*domain.ContestMember, error)
+	UpdateStaffRole(contestId, userId int64, memberType domain.MemberType, role *domain.ContestRole) error
+}
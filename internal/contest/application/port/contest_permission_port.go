@@ -0,0 +1,9 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestPermissionPort checks whether a user may perform an action on a contest.
+// Used by the game and comment modules to enforce organizer roles.
+type ContestPermissionPort interface {
+	CheckPermission(contestId, userId int64, action domain.ContestAction) error
+}
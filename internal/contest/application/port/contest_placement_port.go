@@ -0,0 +1,14 @@
+package port
+
+// ContestPlacement is a team's final placement in a contest with its players
+type ContestPlacement struct {
+	TeamID        int64
+	TeamName      string
+	Placement     int
+	MemberUserIDs []int64
+}
+
+// ContestPlacementPort provides final team placements of finished contests
+type ContestPlacementPort interface {
+	GetPlacements(contestID int64) ([]*ContestPlacement, error)
+}
@@ -0,0 +1,21 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"context"
+)
+
+// ContestSeriesDatabasePort defines the interface for contest series persistence
+type ContestSeriesDatabasePort interface {
+	Save(series *domain.ContestSeries) error
+	Update(series *domain.ContestSeries) error
+	// UpdateWithContext joins the transaction carried by ctx (see transaction.Manager)
+	UpdateWithContext(ctx context.Context, series *domain.ContestSeries) error
+	GetById(seriesId int64) (*domain.ContestSeries, error)
+	GetByOwner(ownerUserId int64) ([]*domain.ContestSeries, error)
+	GetActive() ([]*domain.ContestSeries, error)
+	Delete(seriesId int64) error
+
+	// GetContestsBySeriesId returns the contests of a series in start order
+	GetContestsBySeriesId(seriesId int64) ([]*domain.Contest, error)
+}
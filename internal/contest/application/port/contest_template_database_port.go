@@ -0,0 +1,11 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+
+// ContestTemplateDatabasePort defines the interface for contest template persistence
+type ContestTemplateDatabasePort interface {
+	Save(template *domain.ContestTemplate) error
+	GetById(templateId int64) (*domain.ContestTemplate, error)
+	GetByOwner(ownerUserId int64) ([]*domain.ContestTemplate, error)
+	Delete(templateId int64) error
+}
@@ -36,6 +36,12 @@ type Contest struct {
 
 	AutoStart bool `gorm:"column:auto_start;type:boolean;default:false" json:"auto_start"`
 
+	RegistrationClosesAt *time.Time `gorm:"column:registration_closes_at;type:datetime" json:"registration_closes_at,omitempty"`
+	RosterLockedAt       *time.Time `gorm:"column:roster_locked_at;type:datetime" json:"roster_locked_at,omitempty"`
+
+	CancelledAt  *time.Time `gorm:"column:cancelled_at;type:datetime" json:"cancelled_at,omitempty"`
+	CancelReason *string    `gorm:"column:cancel_reason;type:varchar(500)" json:"cancel_reason,omitempty"`
+
 	GameType         *gameDomain.GameType `gorm:"column:game_type;type:varchar(32)" json:"game_type,omitempty"`
 	GamePointTableId *int64               `gorm:"column:game_point_table_id;type:bigint" json:"game_point_table_id,omitempty"`
 	TotalTeamMember  int                  `gorm:"column:total_team_member;type:int;default:5" json:"total_team_member"`
@@ -46,6 +52,14 @@ type Contest struct {
 	Thumbnail *string `gorm:"column:thumbnail;type:varchar(512)" json:"thumbnail,omitempty"`
 	BannerKey *string `gorm:"column:banner_key;type:varchar(512)" json:"banner_key,omitempty"`
 
+	Eligibility ContestEligibility `gorm:"embedded" json:"eligibility"`
+
+	SeriesID *int64 `gorm:"column:series_id;type:bigint" json:"series_id,omitempty"`
+
+	Visibility ContestVisibility `gorm:"column:visibility;type:varchar(16);not null;default:PUBLIC" json:"visibility"`
+	// JoinCode is exposed only to organizers through the contest access endpoint
+	JoinCode *string `gorm:"column:join_code;type:varchar(16);uniqueIndex" json:"-"`
+
 	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;autoCreateTime" json:"created_at"`
 	ModifiedAt time.Time `gorm:"column:modified_at;type:timestamp;autoUpdateTime" json:"modified_at"`
 }
@@ -78,6 +92,7 @@ func NewContestInstance(
 		DiscordGuildId:       discordGuildId,
 		DiscordTextChannelId: discordTextChannelId,
 		Thumbnail:            thumbnail,
+		Visibility:           ContestVisibilityPublic,
 	}
 }
 
@@ -137,6 +152,16 @@ func (c *Contest) IsBeforeStartTime() bool {
 	return time.Now().Before(c.StartedAt)
 }
 
+// IsDueForAutoStart checks if an auto-start contest has reached its start time
+func (c *Contest) IsDueForAutoStart(now time.Time) bool {
+	return c.AutoStart && c.ContestStatus == ContestStatusPending && !c.StartedAt.IsZero() && !now.Before(c.StartedAt)
+}
+
+// IsPastEndTime checks if the scheduled end time has passed
+func (c *Contest) IsPastEndTime(now time.Time) bool {
+	return !c.EndedAt.IsZero() && now.After(c.EndedAt)
+}
+
 // ValidateDates checks if the contest dates are valid
 func (c *Contest) ValidateDates() error {
 	if !c.StartedAt.IsZero() && !c.EndedAt.IsZero() {
@@ -144,6 +169,46 @@ func (c *Contest) ValidateDates() error {
 			return exception.ErrInvalidContestDates
 		}
 	}
+
+	if c.RegistrationClosesAt != nil && !c.StartedAt.IsZero() {
+		if c.RegistrationClosesAt.After(c.StartedAt) {
+			return exception.ErrInvalidRegistrationDeadline
+		}
+	}
+	return nil
+}
+
+// IsRegistrationClosed checks if the registration close time has passed
+func (c *Contest) IsRegistrationClosed(now time.Time) bool {
+	return c.RegistrationClosesAt != nil && !now.Before(*c.RegistrationClosesAt)
+}
+
+// IsRosterLocked checks if team rosters have been frozen by the registration close job
+func (c *Contest) IsRosterLocked() bool {
+	return c.RosterLockedAt != nil
+}
+
+// LockRoster freezes team rosters; further roster changes require staff approval
+func (c *Contest) LockRoster(now time.Time) error {
+	if c.IsRosterLocked() {
+		return exception.ErrRosterAlreadyLocked
+	}
+
+	c.RosterLockedAt = &now
+	return nil
+}
+
+// Cancel moves a pending or active contest to CANCELLED and records the reason
+func (c *Contest) Cancel(reason string, now time.Time) error {
+	if c.IsTerminalState() {
+		return exception.ErrContestAlreadyClosed
+	}
+	if err := c.TransitionTo(ContestStatusCancelled); err != nil {
+		return err
+	}
+
+	c.CancelledAt = &now
+	c.CancelReason = &reason
 	return nil
 }
 
@@ -186,6 +251,14 @@ func (c *Contest) ValidateBusinessRules() error {
 		return err
 	}
 
+	if err := c.ValidateEligibility(); err != nil {
+		return err
+	}
+
+	if err := c.ValidateVisibility(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -209,6 +282,18 @@ func (c *Contest) ValidateDiscordFields() error {
 	return nil
 }
 
+// ValidateEligibility checks if eligibility constraints are valid
+// If guild membership is required, the contest must have a Discord server
+func (c *Contest) ValidateEligibility() error {
+	if err := c.Eligibility.Validate(); err != nil {
+		return err
+	}
+	if c.Eligibility.RequireGuildMember && !c.HasDiscordIntegration() {
+		return exception.ErrEligibilityGuildRequired
+	}
+	return nil
+}
+
 // HasDiscordIntegration checks if the contest has Discord integration configured
 func (c *Contest) HasDiscordIntegration() bool {
 	return c.DiscordGuildId != nil && *c.DiscordGuildId != ""
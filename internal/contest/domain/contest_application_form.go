@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MaxFormQuestions      = 20
+	MaxFormQuestionLabel  = 200
+	MaxFormTextAnswerSize = 500
+)
+
+// FormQuestionType represents the answer type of an application form question
+type FormQuestionType string
+
+const (
+	FormQuestionTypeText   FormQuestionType = "TEXT"
+	FormQuestionTypeChoice FormQuestionType = "CHOICE"
+	FormQuestionTypeNumber FormQuestionType = "NUMBER"
+)
+
+func (t FormQuestionType) IsValid() bool {
+	switch t {
+	case FormQuestionTypeText, FormQuestionTypeChoice, FormQuestionTypeNumber:
+		return true
+	default:
+		return false
+	}
+}
+
+// FormQuestion is a single question of a contest application form (e.g. preferred role, in-game rank)
+type FormQuestion struct {
+	QuestionID string           `json:"question_id"`
+	Label      string           `json:"label"`
+	Type       FormQuestionType `json:"type"`
+	Required   bool             `json:"required"`
+	// Options lists the allowed answers of a CHOICE question
+	Options []string `json:"options,omitempty"`
+}
+
+func (q *FormQuestion) Validate() error {
+	if strings.TrimSpace(q.QuestionID) == "" || strings.TrimSpace(q.Label) == "" {
+		return exception.ErrInvalidFormQuestion
+	}
+
+	if len(q.Label) > MaxFormQuestionLabel || !q.Type.IsValid() {
+		return exception.ErrInvalidFormQuestion
+	}
+
+	if q.Type == FormQuestionTypeChoice && len(q.Options) < 2 {
+		return exception.ErrInvalidFormQuestion
+	}
+
+	return nil
+}
+
+// validateAnswer checks a non-empty answer value against the question type
+func (q *FormQuestion) validateAnswer(value string) error {
+	switch q.Type {
+	case FormQuestionTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return exception.ErrInvalidFormAnswer
+		}
+	case FormQuestionTypeChoice:
+		for _, option := range q.Options {
+			if option == value {
+				return nil
+			}
+		}
+		return exception.ErrInvalidFormAnswer
+	default:
+		if len(value) > MaxFormTextAnswerSize {
+			return exception.ErrInvalidFormAnswer
+		}
+	}
+
+	return nil
+}
+
+// FormQuestions is stored as a JSON column
+type FormQuestions []*FormQuestion
+
+func (q FormQuestions) Value() (driver.Value, error) {
+	if q == nil {
+		return "[]", nil
+	}
+	return json.Marshal(q)
+}
+
+func (q *FormQuestions) Scan(value interface{}) error {
+	if value == nil {
+		*q = FormQuestions{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for FormQuestions")
+	}
+
+	return json.Unmarshal(data, q)
+}
+
+// ContestApplicationForm is the per-contest form applicants fill in with their application
+type ContestApplicationForm struct {
+	FormID     int64         `gorm:"column:form_id;primaryKey;autoIncrement" json:"form_id"`
+	ContestID  int64         `gorm:"column:contest_id;type:bigint;not null;uniqueIndex:idx_contest_application_forms_contest" json:"contest_id"`
+	Questions  FormQuestions `gorm:"column:questions;type:json" json:"questions"`
+	CreatedAt  time.Time     `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	ModifiedAt time.Time     `gorm:"column:modified_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"modified_at"`
+}
+
+func NewContestApplicationForm(contestID int64, questions FormQuestions) *ContestApplicationForm {
+	return &ContestApplicationForm{
+		ContestID: contestID,
+		Questions: questions,
+	}
+}
+
+func (f *ContestApplicationForm) TableName() string {
+	return "contest_application_forms"
+}
+
+func (f *ContestApplicationForm) Validate() error {
+	if len(f.Questions) == 0 || len(f.Questions) > MaxFormQuestions {
+		return exception.ErrInvalidApplicationForm
+	}
+
+	seen := make(map[string]bool, len(f.Questions))
+	for _, question := range f.Questions {
+		if err := question.Validate(); err != nil {
+			return err
+		}
+		if seen[question.QuestionID] {
+			return exception.ErrDuplicateFormQuestion
+		}
+		seen[question.QuestionID] = true
+	}
+
+	return nil
+}
+
+// ValidateAnswers checks that every required question is answered and each answer matches its question
+func (f *ContestApplicationForm) ValidateAnswers(answers ApplicationAnswers) error {
+	questions := make(map[string]*FormQuestion, len(f.Questions))
+	for _, question := range f.Questions {
+		questions[question.QuestionID] = question
+	}
+
+	answered := make(map[string]bool, len(answers))
+	for _, answer := range answers {
+		question, ok := questions[answer.QuestionID]
+		if !ok {
+			return exception.ErrUnknownFormQuestion
+		}
+		if answered[answer.QuestionID] {
+			return exception.ErrInvalidFormAnswer
+		}
+
+		value := strings.TrimSpace(answer.Value)
+		if value == "" {
+			continue
+		}
+		if err := question.validateAnswer(value); err != nil {
+			return err
+		}
+		answered[answer.QuestionID] = true
+	}
+
+	for _, question := range f.Questions {
+		if question.Required && !answered[question.QuestionID] {
+			return exception.ErrRequiredAnswerMissing
+		}
+	}
+
+	return nil
+}
+
+// ApplicationAnswer is an applicant's answer to one form question
+type ApplicationAnswer struct {
+	QuestionID string `json:"question_id"`
+	Value      string `json:"value"`
+}
+
+// ApplicationAnswers is stored as a JSON column
+type ApplicationAnswers []*ApplicationAnswer
+
+func (a ApplicationAnswers) Value() (driver.Value, error) {
+	if a == nil {
+		return "[]", nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *ApplicationAnswers) Scan(value interface{}) error {
+	if value == nil {
+		*a = ApplicationAnswers{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for ApplicationAnswers")
+	}
+
+	return json.Unmarshal(data, a)
+}
+
+// Get returns the answer value of a question, or "" if it was not answered
+func (a ApplicationAnswers) Get(questionID string) string {
+	for _, answer := range a {
+		if answer.QuestionID == questionID {
+			return answer.Value
+		}
+	}
+	return ""
+}
+
+// ContestApplicationAnswer stores an applicant's form answers.
+// Answers live in DB rather than with the Redis application so they can still be exported after the contest starts.
+type ContestApplicationAnswer struct {
+	AnswerID    int64              `gorm:"column:answer_id;primaryKey;autoIncrement" json:"answer_id"`
+	ContestID   int64              `gorm:"column:contest_id;type:bigint;not null;uniqueIndex:idx_contest_application_answers_user" json:"contest_id"`
+	UserID      int64              `gorm:"column:user_id;type:bigint;not null;uniqueIndex:idx_contest_application_answers_user" json:"user_id"`
+	Answers     ApplicationAnswers `gorm:"column:answers;type:json" json:"answers"`
+	SubmittedAt time.Time          `gorm:"column:submitted_at;type:datetime;not null" json:"submitted_at"`
+}
+
+func NewContestApplicationAnswer(contestID, userID int64, answers ApplicationAnswers, submittedAt time.Time) *ContestApplicationAnswer {
+	return &ContestApplicationAnswer{
+		ContestID:   contestID,
+		UserID:      userID,
+		Answers:     answers,
+		SubmittedAt: submittedAt,
+	}
+}
+
+func (a *ContestApplicationAnswer) TableName() string {
+	return "contest_application_answers"
+}
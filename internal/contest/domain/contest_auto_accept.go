@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"fmt"
+	"time"
+)
+
+// Valorant competitive tier range (0 = unranked, 27 = Radiant)
+const (
+	MinValorantTier = 0
+	MaxValorantTier = 27
+)
+
+// AutoAcceptMismatchAction decides what happens to applicants who do not match the rule
+type AutoAcceptMismatchAction string
+
+const (
+	AutoAcceptMismatchLeavePending AutoAcceptMismatchAction = "LEAVE_PENDING"
+	AutoAcceptMismatchReject       AutoAcceptMismatchAction = "REJECT"
+)
+
+func (a AutoAcceptMismatchAction) IsValid() bool {
+	switch a {
+	case AutoAcceptMismatchLeavePending, AutoAcceptMismatchReject:
+		return true
+	default:
+		return false
+	}
+}
+
+// AutoAcceptOutcome is the result of evaluating an applicant against the rule
+type AutoAcceptOutcome string
+
+const (
+	AutoAcceptOutcomeAccepted AutoAcceptOutcome = "ACCEPTED"
+	AutoAcceptOutcomeRejected AutoAcceptOutcome = "REJECTED"
+	AutoAcceptOutcomePending  AutoAcceptOutcome = "PENDING"
+)
+
+// ContestAutoAcceptRule accepts matching applications on submit instead of waiting for the leader.
+// Every criterion that is set must match; unset criteria are ignored.
+type ContestAutoAcceptRule struct {
+	RuleID             int64 `gorm:"column:rule_id;primaryKey;autoIncrement" json:"rule_id"`
+	ContestID          int64 `gorm:"column:contest_id;type:bigint;not null;uniqueIndex:idx_contest_auto_accept_rules_contest" json:"contest_id"`
+	Enabled            bool  `gorm:"column:enabled;type:boolean;not null;default:true" json:"enabled"`
+	MinTier            *int  `gorm:"column:min_tier;type:int" json:"min_tier,omitempty"`
+	MaxTier            *int  `gorm:"column:max_tier;type:int" json:"max_tier,omitempty"`
+	RequireRiotAccount bool  `gorm:"column:require_riot_account;type:boolean;not null;default:false" json:"require_riot_account"`
+	RequireGuildMember bool  `gorm:"column:require_guild_member;type:boolean;not null;default:false" json:"require_guild_member"`
+	// AcceptCap accepts applicants first come first served until this many applications are accepted
+	AcceptCap  *int                     `gorm:"column:accept_cap;type:int" json:"accept_cap,omitempty"`
+	OnMismatch AutoAcceptMismatchAction `gorm:"column:on_mismatch;type:varchar(16);not null;default:LEAVE_PENDING" json:"on_mismatch"`
+	CreatedAt  time.Time                `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	ModifiedAt time.Time                `gorm:"column:modified_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"modified_at"`
+}
+
+func (r *ContestAutoAcceptRule) TableName() string {
+	return "contest_auto_accept_rules"
+}
+
+func (r *ContestAutoAcceptRule) Validate() error {
+	if r.MinTier != nil && (*r.MinTier < MinValorantTier || *r.MinTier > MaxValorantTier) {
+		return exception.ErrInvalidAutoAcceptTier
+	}
+	if r.MaxTier != nil && (*r.MaxTier < MinValorantTier || *r.MaxTier > MaxValorantTier) {
+		return exception.ErrInvalidAutoAcceptTier
+	}
+	if r.MinTier != nil && r.MaxTier != nil && *r.MinTier > *r.MaxTier {
+		return exception.ErrInvalidAutoAcceptTier
+	}
+
+	if r.AcceptCap != nil && *r.AcceptCap <= 0 {
+		return exception.ErrInvalidAutoAcceptCap
+	}
+
+	if !r.OnMismatch.IsValid() {
+		return exception.ErrInvalidAutoAcceptMismatchAction
+	}
+
+	return nil
+}
+
+// ApplicantProfile is what the rule knows about an applicant at submit time
+type ApplicantProfile struct {
+	// Tier is the applicant's current Valorant tier, nil without a linked Riot account
+	Tier           *int
+	HasRiotAccount bool
+	InGuild        bool
+	// AcceptedCount is the number of applications already accepted for the contest
+	AcceptedCount int
+}
+
+// AutoAcceptDecision is the evaluated outcome with a human readable reason
+type AutoAcceptDecision struct {
+	Outcome AutoAcceptOutcome
+	Reason  string
+}
+
+// Evaluate checks the applicant against every configured criterion
+func (r *ContestAutoAcceptRule) Evaluate(profile ApplicantProfile) AutoAcceptDecision {
+	if reason := r.mismatchReason(profile); reason != "" {
+		outcome := AutoAcceptOutcomePending
+		if r.OnMismatch == AutoAcceptMismatchReject {
+			outcome = AutoAcceptOutcomeRejected
+		}
+		return AutoAcceptDecision{Outcome: outcome, Reason: reason}
+	}
+
+	return AutoAcceptDecision{Outcome: AutoAcceptOutcomeAccepted, Reason: "all auto-accept criteria matched"}
+}
+
+func (r *ContestAutoAcceptRule) mismatchReason(profile ApplicantProfile) string {
+	if r.RequireRiotAccount && !profile.HasRiotAccount {
+		return "riot account is not linked"
+	}
+
+	if r.MinTier != nil || r.MaxTier != nil {
+		if profile.Tier == nil {
+			return "valorant tier is unknown"
+		}
+		if r.MinTier != nil && *profile.Tier < *r.MinTier {
+			return fmt.Sprintf("valorant tier %d is below the minimum tier %d", *profile.Tier, *r.MinTier)
+		}
+		if r.MaxTier != nil && *profile.Tier > *r.MaxTier {
+			return fmt.Sprintf("valorant tier %d is above the maximum tier %d", *profile.Tier, *r.MaxTier)
+		}
+	}
+
+	if r.RequireGuildMember && !profile.InGuild {
+		return "not a member of the contest discord server"
+	}
+
+	if r.AcceptCap != nil && profile.AcceptedCount >= *r.AcceptCap {
+		return fmt.Sprintf("auto-accept cap of %d applicants is reached", *r.AcceptCap)
+	}
+
+	return ""
+}
+
+// ContestAutoAcceptDecision records an automatic decision on an application
+type ContestAutoAcceptDecision struct {
+	DecisionID int64             `gorm:"column:decision_id;primaryKey;autoIncrement" json:"decision_id"`
+	ContestID  int64             `gorm:"column:contest_id;type:bigint;not null;index:idx_contest_auto_accept_decisions_contest" json:"contest_id"`
+	UserID     int64             `gorm:"column:user_id;type:bigint;not null" json:"user_id"`
+	Outcome    AutoAcceptOutcome `gorm:"column:outcome;type:varchar(16);not null" json:"outcome"`
+	Reason     string            `gorm:"column:reason;type:varchar(255);not null" json:"reason"`
+	DecidedAt  time.Time         `gorm:"column:decided_at;type:datetime;not null" json:"decided_at"`
+}
+
+func NewContestAutoAcceptDecision(contestID, userID int64, decision AutoAcceptDecision, decidedAt time.Time) *ContestAutoAcceptDecision {
+	return &ContestAutoAcceptDecision{
+		ContestID: contestID,
+		UserID:    userID,
+		Outcome:   decision.Outcome,
+		Reason:    decision.Reason,
+		DecidedAt: decidedAt,
+	}
+}
+
+func (d *ContestAutoAcceptDecision) TableName() string {
+	return "contest_auto_accept_decisions"
+}
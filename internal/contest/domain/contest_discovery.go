@@ -0,0 +1,133 @@
+package domain
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"strings"
+	"time"
+)
+
+// ContestDiscoverySort is the ordering of discovery results
+type ContestDiscoverySort string
+
+const (
+	// ContestDiscoverySortRecommended ranks contests by how well they fit the caller's rank and past participation
+	ContestDiscoverySortRecommended ContestDiscoverySort = "recommended"
+	// ContestDiscoverySortRelevance ranks contests by full-text score and needs a search query
+	ContestDiscoverySortRelevance ContestDiscoverySort = "relevance"
+	ContestDiscoverySortCreatedAt ContestDiscoverySort = "created_at"
+	ContestDiscoverySortStartedAt ContestDiscoverySort = "started_at"
+	ContestDiscoverySortEndedAt   ContestDiscoverySort = "ended_at"
+)
+
+// ContestFullTextMinQueryLength is the shortest query searched with FULLTEXT; shorter queries fall back to LIKE
+// because InnoDB does not index tokens below innodb_ft_min_token_size (3 by default)
+const ContestFullTextMinQueryLength = 3
+
+// Recommendation score weights, summed per contest
+const (
+	RecommendWeightPlayedGameType    = 3
+	RecommendWeightPlayedContestType = 1
+	RecommendWeightTierInRange       = 2
+	RecommendWeightOpenForEntry      = 2
+)
+
+func (s ContestDiscoverySort) IsValid() bool {
+	switch s {
+	case ContestDiscoverySortRecommended, ContestDiscoverySortRelevance,
+		ContestDiscoverySortCreatedAt, ContestDiscoverySortStartedAt, ContestDiscoverySortEndedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContestDiscoveryFilter narrows discovery to listed contests matching every set field
+type ContestDiscoveryFilter struct {
+	Query         string
+	GameTypes     []gameDomain.GameType
+	ContestTypes  []ContestType
+	Statuses      []ContestStatus
+	StartsFrom    *time.Time
+	StartsTo      *time.Time
+	TeamSize      *int
+	OpenSlotsOnly bool
+
+	// Eligibility keeps only contests the profile meets the region, tier and account age constraints of.
+	// Discord server membership is not checked here; it is verified when the user applies.
+	Eligibility *EligibilityProfile
+}
+
+// Validate checks enum values, the date range and the team size
+func (f *ContestDiscoveryFilter) Validate() error {
+	for _, gameType := range f.GameTypes {
+		if !gameType.IsValid() {
+			return exception.ErrInvalidDiscoveryFilter
+		}
+	}
+	for _, contestType := range f.ContestTypes {
+		if !(&Contest{ContestType: contestType}).IsValidType() {
+			return exception.ErrInvalidDiscoveryFilter
+		}
+	}
+	for _, status := range f.Statuses {
+		if !(&Contest{ContestStatus: status}).IsValidStatus() {
+			return exception.ErrInvalidDiscoveryFilter
+		}
+	}
+	if f.StartsFrom != nil && f.StartsTo != nil && f.StartsTo.Before(*f.StartsFrom) {
+		return exception.ErrInvalidDiscoveryFilter
+	}
+	if f.TeamSize != nil && *f.TeamSize < 1 {
+		return exception.ErrInvalidDiscoveryFilter
+	}
+	return nil
+}
+
+// UsesFullText reports whether the query is long enough for the FULLTEXT index
+func (f *ContestDiscoveryFilter) UsesFullText() bool {
+	return len([]rune(strings.TrimSpace(f.Query))) >= ContestFullTextMinQueryLength
+}
+
+// FullTextQuery turns the search text into a BOOLEAN MODE query that requires every word as a prefix.
+// Boolean operators typed by the user are stripped so they cannot change the query.
+func (f *ContestDiscoveryFilter) FullTextQuery() string {
+	stripped := strings.Map(func(r rune) rune {
+		switch r {
+		case '+', '-', '<', '>', '(', ')', '~', '*', '"', '@':
+			return ' '
+		default:
+			return r
+		}
+	}, f.Query)
+
+	words := strings.Fields(stripped)
+	for i, word := range words {
+		words[i] = "+" + word + "*"
+	}
+	return strings.Join(words, " ")
+}
+
+// RecommendationProfile is what recommended ordering knows about the caller
+type RecommendationProfile struct {
+	// Tier is the caller's current tier, nil without a linked Riot account
+	Tier               *int
+	PlayedGameTypes    []gameDomain.GameType
+	PlayedContestTypes []ContestType
+}
+
+// ContestFacetBucket is the number of matching contests for one filter value
+type ContestFacetBucket struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// ContestDiscoveryFacets counts matches per filter value. Each facet applies every other filter
+// but not its own, so the counts show what selecting another value would return.
+type ContestDiscoveryFacets struct {
+	GameTypes     []ContestFacetBucket `json:"game_types"`
+	ContestTypes  []ContestFacetBucket `json:"contest_types"`
+	Statuses      []ContestFacetBucket `json:"statuses"`
+	TeamSizes     []ContestFacetBucket `json:"team_sizes"`
+	WithOpenSlots int64                `json:"with_open_slots"`
+}
@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+type DraftStatus string
+
+const (
+	DraftStatusInProgress DraftStatus = "IN_PROGRESS"
+	DraftStatusCompleted  DraftStatus = "COMPLETED"
+	DraftStatusCancelled  DraftStatus = "CANCELLED"
+)
+
+const (
+	DefaultDraftPickTimeout = 60 * time.Second
+	MinDraftPickTimeout     = 10 * time.Second
+	MaxDraftPickTimeout     = 5 * time.Minute
+)
+
+// DraftPlayer is a snapshot of an accepted applicant taking part in the draft
+type DraftPlayer struct {
+	UserID      int64  `json:"user_id"`
+	Username    string `json:"username"`
+	Tag         string `json:"tag"`
+	Rating      int    `json:"rating"`
+	TierPatched string `json:"tier_patched,omitempty"`
+}
+
+// DraftPick records a single pick made by a captain
+type DraftPick struct {
+	PickNumber    int          `json:"pick_number"`
+	Round         int          `json:"round"`
+	CaptainUserID int64        `json:"captain_user_id"`
+	PlayerUserID  int64        `json:"player_user_id"`
+	Player        *DraftPlayer `json:"player"`
+	AutoPicked    bool         `json:"auto_picked"`
+	PickedAt      time.Time    `json:"picked_at"`
+}
+
+// ContestDraft holds the state of a captain draft for a casual contest.
+// Captains pick in snake order (1→N, N→1, ...) until every team is full.
+type ContestDraft struct {
+	ContestID          int64          `json:"contest_id"`
+	Status             DraftStatus    `json:"status"`
+	TeamSize           int            `json:"team_size"`
+	PickTimeoutSeconds int            `json:"pick_timeout_seconds"`
+	Captains           []*DraftPlayer `json:"captains"`
+	Pool               []*DraftPlayer `json:"pool"`
+	Picks              []*DraftPick   `json:"picks"`
+	TotalPicks         int            `json:"total_picks"`
+	PickDeadline       *time.Time     `json:"pick_deadline,omitempty"`
+	StartedBy          int64          `json:"started_by"`
+	StartedAt          time.Time      `json:"started_at"`
+	CompletedAt        *time.Time     `json:"completed_at,omitempty"`
+}
+
+func NewContestDraft(
+	contestID int64,
+	teamSize int,
+	pickTimeout time.Duration,
+	captains []*DraftPlayer,
+	pool []*DraftPlayer,
+	startedBy int64,
+) (*ContestDraft, error) {
+	if len(captains) < 2 {
+		return nil, exception.ErrDraftNotEnoughCaptains
+	}
+
+	if teamSize < 2 {
+		return nil, exception.ErrDraftInvalidTeamSize
+	}
+
+	if pickTimeout < MinDraftPickTimeout || pickTimeout > MaxDraftPickTimeout {
+		return nil, exception.ErrDraftInvalidPickTimeout
+	}
+
+	captainSet := make(map[int64]bool, len(captains))
+	for _, c := range captains {
+		if captainSet[c.UserID] {
+			return nil, exception.ErrDraftInvalidCaptain
+		}
+		captainSet[c.UserID] = true
+	}
+
+	available := make([]*DraftPlayer, 0, len(pool))
+	for _, p := range pool {
+		if !captainSet[p.UserID] {
+			available = append(available, p)
+		}
+	}
+
+	totalPicks := len(captains) * (teamSize - 1)
+	if len(available) < totalPicks {
+		return nil, exception.ErrDraftNotEnoughPlayers
+	}
+
+	now := time.Now()
+	deadline := now.Add(pickTimeout)
+
+	return &ContestDraft{
+		ContestID:          contestID,
+		Status:             DraftStatusInProgress,
+		TeamSize:           teamSize,
+		PickTimeoutSeconds: int(pickTimeout / time.Second),
+		Captains:           captains,
+		Pool:               available,
+		Picks:              make([]*DraftPick, 0, totalPicks),
+		TotalPicks:         totalPicks,
+		PickDeadline:       &deadline,
+		StartedBy:          startedBy,
+		StartedAt:          now,
+	}, nil
+}
+
+func (d *ContestDraft) IsInProgress() bool {
+	return d.Status == DraftStatusInProgress
+}
+
+func (d *ContestDraft) IsCompleted() bool {
+	return d.Status == DraftStatusCompleted
+}
+
+// CurrentRound returns the 1-based round of the next pick
+func (d *ContestDraft) CurrentRound() int {
+	return len(d.Picks)/len(d.Captains) + 1
+}
+
+// CurrentCaptainUserID returns the captain on the clock following snake order
+func (d *ContestDraft) CurrentCaptainUserID() int64 {
+	if !d.IsInProgress() {
+		return 0
+	}
+
+	n := len(d.Captains)
+	idx := len(d.Picks)
+	pos := idx % n
+	if (idx/n)%2 == 1 {
+		pos = n - 1 - pos
+	}
+
+	return d.Captains[pos].UserID
+}
+
+// IsPickExpired checks whether the current captain has run out of time
+func (d *ContestDraft) IsPickExpired(now time.Time) bool {
+	return d.IsInProgress() && d.PickDeadline != nil && now.After(*d.PickDeadline)
+}
+
+// Pick assigns a player from the pool to the captain on the clock
+func (d *ContestDraft) Pick(captainUserID, playerUserID int64, autoPicked bool, now time.Time) error {
+	if !d.IsInProgress() {
+		return exception.ErrDraftNotInProgress
+	}
+
+	if d.CurrentCaptainUserID() != captainUserID {
+		return exception.ErrDraftNotYourTurn
+	}
+
+	idx := -1
+	for i, p := range d.Pool {
+		if p.UserID == playerUserID {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return exception.ErrDraftPlayerNotAvailable
+	}
+
+	d.Picks = append(d.Picks, &DraftPick{
+		PickNumber:    len(d.Picks) + 1,
+		Round:         d.CurrentRound(),
+		CaptainUserID: captainUserID,
+		PlayerUserID:  playerUserID,
+		Player:        d.Pool[idx],
+		AutoPicked:    autoPicked,
+		PickedAt:      now,
+	})
+	d.Pool = append(d.Pool[:idx], d.Pool[idx+1:]...)
+
+	if len(d.Picks) >= d.TotalPicks {
+		d.Status = DraftStatusCompleted
+		d.CompletedAt = &now
+		d.PickDeadline = nil
+		return nil
+	}
+
+	deadline := now.Add(time.Duration(d.PickTimeoutSeconds) * time.Second)
+	d.PickDeadline = &deadline
+	return nil
+}
+
+// HighestRatedAvailable returns the best rated player left in the pool
+func (d *ContestDraft) HighestRatedAvailable() *DraftPlayer {
+	var best *DraftPlayer
+	for _, p := range d.Pool {
+		if best == nil || p.Rating > best.Rating {
+			best = p
+		}
+	}
+	return best
+}
+
+// AutoPick picks the highest rated available player for the captain on the clock
+func (d *ContestDraft) AutoPick(now time.Time) error {
+	player := d.HighestRatedAvailable()
+	if player == nil {
+		return exception.ErrDraftPlayerNotAvailable
+	}
+	return d.Pick(d.CurrentCaptainUserID(), player.UserID, true, now)
+}
+
+// Cancel stops the draft without creating teams
+func (d *ContestDraft) Cancel() error {
+	if !d.IsInProgress() {
+		return exception.ErrDraftNotInProgress
+	}
+	d.Status = DraftStatusCancelled
+	d.PickDeadline = nil
+	return nil
+}
+
+// RosterOf returns the user IDs picked by a captain, in pick order
+func (d *ContestDraft) RosterOf(captainUserID int64) []int64 {
+	roster := make([]int64, 0, d.TeamSize-1)
+	for _, p := range d.Picks {
+		if p.CaptainUserID == captainUserID {
+			roster = append(roster, p.PlayerUserID)
+		}
+	}
+	return roster
+}
+
+// ParticipantUserIDs returns every captain, picked player and remaining pool player
+func (d *ContestDraft) ParticipantUserIDs() []int64 {
+	ids := make([]int64, 0, len(d.Captains)+len(d.Picks)+len(d.Pool))
+	for _, c := range d.Captains {
+		ids = append(ids, c.UserID)
+	}
+	for _, p := range d.Picks {
+		ids = append(ids, p.PlayerUserID)
+	}
+	for _, p := range d.Pool {
+		ids = append(ids, p.UserID)
+	}
+	return ids
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ValorantRegions lists the regions a linked Riot account can belong to
+var ValorantRegions = []string{"ap", "br", "eu", "kr", "latam", "na"}
+
+// EligibilityTierBasis selects which linked tier is compared against the contest tier range
+type EligibilityTierBasis string
+
+const (
+	EligibilityTierBasisCurrent EligibilityTierBasis = "CURRENT"
+	EligibilityTierBasisPeak    EligibilityTierBasis = "PEAK"
+)
+
+func (b EligibilityTierBasis) IsValid() bool {
+	switch b {
+	case EligibilityTierBasisCurrent, EligibilityTierBasisPeak:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContestRegions is stored as a JSON column
+type ContestRegions []string
+
+func (r ContestRegions) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	return json.Marshal(r)
+}
+
+func (r *ContestRegions) Scan(value interface{}) error {
+	if value == nil {
+		*r = ContestRegions{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for ContestRegions")
+	}
+
+	return json.Unmarshal(data, r)
+}
+
+// Contains checks if the region is allowed, ignoring case
+func (r ContestRegions) Contains(region string) bool {
+	for _, allowed := range r {
+		if strings.EqualFold(allowed, region) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContestEligibility restricts who can join a contest.
+// Every constraint that is set must be met; unset constraints are ignored.
+type ContestEligibility struct {
+	AllowedRegions     ContestRegions       `gorm:"column:eligible_regions;type:json" json:"allowed_regions,omitempty"`
+	MinTier            *int                 `gorm:"column:eligible_min_tier;type:int" json:"min_tier,omitempty"`
+	MaxTier            *int                 `gorm:"column:eligible_max_tier;type:int" json:"max_tier,omitempty"`
+	TierBasis          EligibilityTierBasis `gorm:"column:eligible_tier_basis;type:varchar(16)" json:"tier_basis,omitempty"`
+	MinAccountAgeDays  *int                 `gorm:"column:eligible_min_account_age_days;type:int" json:"min_account_age_days,omitempty"`
+	RequireGuildMember bool                 `gorm:"column:eligible_require_guild_member;type:boolean;default:false" json:"require_guild_member"`
+}
+
+// EligibilityProfile is what the eligibility check knows about a user
+type EligibilityProfile struct {
+	// Region, CurrentTier and PeakTier come from the linked Riot account and are nil without one
+	Region           *string
+	CurrentTier      *int
+	PeakTier         *int
+	AccountCreatedAt time.Time
+	InGuild          bool
+}
+
+// IsRestricted checks if any eligibility constraint is set
+func (e *ContestEligibility) IsRestricted() bool {
+	return len(e.AllowedRegions) > 0 ||
+		e.MinTier != nil ||
+		e.MaxTier != nil ||
+		e.MinAccountAgeDays != nil ||
+		e.RequireGuildMember
+}
+
+// HasTierRange checks if a tier constraint is set
+func (e *ContestEligibility) HasTierRange() bool {
+	return e.MinTier != nil || e.MaxTier != nil
+}
+
+func (e *ContestEligibility) Validate() error {
+	for _, region := range e.AllowedRegions {
+		if !ContestRegions(ValorantRegions).Contains(region) {
+			return exception.ErrInvalidEligibility
+		}
+	}
+
+	if e.MinTier != nil && (*e.MinTier < MinValorantTier || *e.MinTier > MaxValorantTier) {
+		return exception.ErrInvalidEligibility
+	}
+	if e.MaxTier != nil && (*e.MaxTier < MinValorantTier || *e.MaxTier > MaxValorantTier) {
+		return exception.ErrInvalidEligibility
+	}
+	if e.MinTier != nil && e.MaxTier != nil && *e.MinTier > *e.MaxTier {
+		return exception.ErrInvalidEligibility
+	}
+
+	if e.TierBasis != "" && !e.TierBasis.IsValid() {
+		return exception.ErrInvalidEligibility
+	}
+
+	if e.MinAccountAgeDays != nil && *e.MinAccountAgeDays < 0 {
+		return exception.ErrInvalidEligibility
+	}
+
+	return nil
+}
+
+// Check returns the first constraint the user does not meet, or nil if the user is eligible
+func (e *ContestEligibility) Check(profile EligibilityProfile, now time.Time) error {
+	if len(e.AllowedRegions) > 0 {
+		if profile.Region == nil || !e.AllowedRegions.Contains(*profile.Region) {
+			return exception.ErrIneligibleRegion
+		}
+	}
+
+	if e.HasTierRange() {
+		tier := profile.CurrentTier
+		if e.TierBasis == EligibilityTierBasisPeak {
+			tier = profile.PeakTier
+		}
+		if tier == nil {
+			return exception.ErrIneligibleTier
+		}
+		if e.MinTier != nil && *tier < *e.MinTier {
+			return exception.ErrIneligibleTier
+		}
+		if e.MaxTier != nil && *tier > *e.MaxTier {
+			return exception.ErrIneligibleTier
+		}
+	}
+
+	if e.MinAccountAgeDays != nil {
+		minAge := time.Duration(*e.MinAccountAgeDays) * 24 * time.Hour
+		if now.Sub(profile.AccountCreatedAt) < minAge {
+			return exception.ErrIneligibleAccountAge
+		}
+	}
+
+	if e.RequireGuildMember && !profile.InGuild {
+		return exception.ErrIneligibleGuildMembership
+	}
+
+	return nil
+}
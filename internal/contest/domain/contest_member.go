@@ -34,6 +34,9 @@ type ContestMember struct {
 	MemberType MemberType `gorm:"column:member_type;type:varchar(16);not null" json:"member_type"`
 	LeaderType LeaderType `gorm:"column:leader_type;type:varchar(8);not null" json:"leader_type"`
 	Point      int        `gorm:"column:point;type:int;default:0" json:"point"`
+
+	// StaffRole is the organizer role of a STAFF member; nil on legacy staff rows, which act as ADMIN
+	StaffRole *ContestRole `gorm:"column:staff_role;type:varchar(16)" json:"staff_role,omitempty"`
 }
 
 func NewContestMemberAsLeader(userID, contestID int64) *ContestMember {
@@ -56,6 +59,18 @@ func NewContestMember(userID, contestID int64, memberType MemberType, leaderType
 	}
 }
 
+// NewContestOrganizer creates a STAFF member holding the given organizer role
+func NewContestOrganizer(userID, contestID int64, role ContestRole) *ContestMember {
+	return &ContestMember{
+		UserID:     userID,
+		ContestID:  contestID,
+		MemberType: MemberTypeStaff,
+		LeaderType: LeaderTypeMember,
+		Point:      0,
+		StaffRole:  &role,
+	}
+}
+
 func (cm *ContestMember) TableName() string {
 	return "contests_members"
 }
@@ -68,6 +83,26 @@ func (cm *ContestMember) IsStaff() bool {
 	return cm.MemberType == MemberTypeStaff
 }
 
+// Role returns the effective organizer role of the member.
+// Only STAFF members hold a role; a NORMAL member with LEADER type is a team captain, not the owner.
+func (cm *ContestMember) Role() ContestRole {
+	if !cm.IsStaff() {
+		return ContestRoleNone
+	}
+	if cm.IsLeader() {
+		return ContestRoleOwner
+	}
+	if cm.StaffRole != nil && cm.StaffRole.IsAssignable() {
+		return *cm.StaffRole
+	}
+	return ContestRoleAdmin
+}
+
+// Can reports whether the member is allowed to perform the action on the contest
+func (cm *ContestMember) Can(action ContestAction) bool {
+	return cm.Role().Can(action)
+}
+
 func (cm *ContestMember) IsValidMemberType() bool {
 	switch cm.MemberType {
 	case MemberTypeStaff, MemberTypeNormal:
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+// ContestRole is the organizer role a contest member holds.
+// The contest leader is always OWNER; other organizers are STAFF members with one of the assignable roles.
+type ContestRole string
+
+const (
+	ContestRoleOwner     ContestRole = "OWNER"
+	ContestRoleAdmin     ContestRole = "ADMIN"
+	ContestRoleReferee   ContestRole = "REFEREE"
+	ContestRoleModerator ContestRole = "MODERATOR"
+	ContestRoleNone      ContestRole = ""
+)
+
+// ContestAction is a single operation guarded by contest permissions
+type ContestAction string
+
+const (
+	// ContestActionManageContest covers starting, stopping and cancelling the contest and reusing it as a template
+	ContestActionManageContest ContestAction = "MANAGE_CONTEST"
+	// ContestActionManageOrganizers covers inviting, re-assigning and revoking organizers
+	ContestActionManageOrganizers ContestAction = "MANAGE_ORGANIZERS"
+	// ContestActionManageApplications covers accepting/rejecting applications, forms, auto-accept rules and the draft
+	ContestActionManageApplications ContestAction = "MANAGE_APPLICATIONS"
+	// ContestActionManageGames covers creating, scheduling and progressing games and roster changes
+	ContestActionManageGames ContestAction = "MANAGE_GAMES"
+	// ContestActionSubmitResults covers manual result submission and triggering match detection
+	ContestActionSubmitResults ContestAction = "SUBMIT_RESULTS"
+	// ContestActionModerateComments covers deleting other users' comments
+	ContestActionModerateComments ContestAction = "MODERATE_COMMENTS"
+	// ContestActionExportResults covers downloading results with rosters, Riot IDs and Discord IDs
+	ContestActionExportResults ContestAction = "EXPORT_RESULTS"
+)
+
+var contestRoleActions = map[ContestRole][]ContestAction{
+	ContestRoleOwner: {
+		ContestActionManageContest,
+		ContestActionManageOrganizers,
+		ContestActionManageApplications,
+		ContestActionManageGames,
+		ContestActionSubmitResults,
+		ContestActionModerateComments,
+		ContestActionExportResults,
+	},
+	ContestRoleAdmin: {
+		ContestActionManageContest,
+		ContestActionManageApplications,
+		ContestActionManageGames,
+		ContestActionSubmitResults,
+		ContestActionModerateComments,
+		ContestActionExportResults,
+	},
+	ContestRoleReferee: {
+		ContestActionManageGames,
+		ContestActionSubmitResults,
+		ContestActionExportResults,
+	},
+	ContestRoleModerator: {
+		ContestActionManageApplications,
+		ContestActionModerateComments,
+		ContestActionExportResults,
+	},
+}
+
+// IsValid checks if the role is a known organizer role
+func (r ContestRole) IsValid() bool {
+	_, ok := contestRoleActions[r]
+	return ok
+}
+
+// IsAssignable reports whether the role can be granted to a co-organizer (OWNER is reserved for the leader)
+func (r ContestRole) IsAssignable() bool {
+	return r.IsValid() && r != ContestRoleOwner
+}
+
+// Actions returns the actions granted to the role
+func (r ContestRole) Actions() []ContestAction {
+	return contestRoleActions[r]
+}
+
+// Can reports whether the role is granted the action
+func (r ContestRole) Can(action ContestAction) bool {
+	for _, granted := range contestRoleActions[r] {
+		if granted == action {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAssignableRole ensures a role can be granted to a co-organizer
+func ValidateAssignableRole(role ContestRole) error {
+	if !role.IsAssignable() {
+		return exception.ErrInvalidContestRole
+	}
+	return nil
+}
+
+// ContestOrganizerInvite is a pending invitation for a user to join a contest's organizing staff
+type ContestOrganizerInvite struct {
+	ContestID int64       `gorm:"column:contest_id;primaryKey" json:"contest_id"`
+	UserID    int64       `gorm:"column:user_id;primaryKey" json:"user_id"`
+	Role      ContestRole `gorm:"column:role;type:varchar(16);not null" json:"role"`
+	InvitedBy int64       `gorm:"column:invited_by;not null" json:"invited_by"`
+	CreatedAt time.Time   `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+func NewContestOrganizerInvite(contestID, userID, invitedBy int64, role ContestRole) *ContestOrganizerInvite {
+	return &ContestOrganizerInvite{
+		ContestID: contestID,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: invitedBy,
+	}
+}
+
+func (i *ContestOrganizerInvite) TableName() string {
+	return "contest_organizer_invites"
+}
+
+func (i *ContestOrganizerInvite) Validate() error {
+	if i.ContestID == 0 {
+		return exception.ErrInvalidContestID
+	}
+	if i.UserID == 0 {
+		return exception.ErrInvalidUserID
+	}
+	return ValidateAssignableRole(i.Role)
+}
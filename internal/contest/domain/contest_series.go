@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	MaxContestSeriesName     = 100
+	MaxSeriesCreateAheadDays = 60
+	seriesStartTimeLayout    = "15:04"
+)
+
+// SeriesPlacementPoints awards points to every player of a team finishing at the placement
+type SeriesPlacementPoints struct {
+	Placement int `json:"placement"`
+	Points    int `json:"points"`
+}
+
+// SeriesPointsTable is stored as a JSON column
+type SeriesPointsTable []*SeriesPlacementPoints
+
+func (t SeriesPointsTable) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *SeriesPointsTable) Scan(value interface{}) error {
+	if value == nil {
+		*t = SeriesPointsTable{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for SeriesPointsTable")
+	}
+
+	return json.Unmarshal(data, t)
+}
+
+// PointsFor returns the points of a placement; placements missing from the table are worth nothing
+func (t SeriesPointsTable) PointsFor(placement int) int {
+	for _, row := range t {
+		if row.Placement == placement {
+			return row.Points
+		}
+	}
+	return 0
+}
+
+func (t SeriesPointsTable) Validate() error {
+	if len(t) == 0 {
+		return exception.ErrInvalidSeriesPointsTable
+	}
+
+	seen := make(map[int]bool, len(t))
+	for _, row := range t {
+		if row == nil || row.Placement < 1 || row.Points < 0 || seen[row.Placement] {
+			return exception.ErrInvalidSeriesPointsTable
+		}
+		seen[row.Placement] = true
+	}
+
+	return nil
+}
+
+// ContestSeries groups recurring contests (e.g. a weekly cup) into a season.
+// Instances are created from the series template every IntervalWeeks on Weekday at StartTime in Timezone.
+type ContestSeries struct {
+	SeriesID    int64  `gorm:"column:series_id;primaryKey;autoIncrement" json:"series_id"`
+	OwnerUserID int64  `gorm:"column:owner_user_id;type:bigint;not null;index:idx_contest_series_owner" json:"owner_user_id"`
+	TemplateID  int64  `gorm:"column:template_id;type:bigint;not null" json:"template_id"`
+	Name        string `gorm:"column:name;type:varchar(100);not null" json:"name"`
+	Description string `gorm:"column:description;type:text" json:"description,omitempty"`
+
+	// Recurrence rule
+	Weekday                  time.Weekday `gorm:"column:weekday;type:tinyint;not null" json:"weekday"`
+	StartTime                string       `gorm:"column:start_time;type:varchar(5);not null" json:"start_time"`
+	Timezone                 string       `gorm:"column:timezone;type:varchar(64);not null" json:"timezone"`
+	IntervalWeeks            int          `gorm:"column:interval_weeks;type:int;not null;default:1" json:"interval_weeks"`
+	DurationMinutes          int          `gorm:"column:duration_minutes;type:int;not null" json:"duration_minutes"`
+	RegistrationCloseMinutes *int         `gorm:"column:registration_close_minutes;type:int" json:"registration_close_minutes,omitempty"`
+	CreateAheadDays          int          `gorm:"column:create_ahead_days;type:int;not null;default:7" json:"create_ahead_days"`
+
+	StartsOn time.Time  `gorm:"column:starts_on;type:datetime;not null" json:"starts_on"`
+	EndsOn   *time.Time `gorm:"column:ends_on;type:datetime" json:"ends_on,omitempty"`
+	Active   bool       `gorm:"column:active;type:boolean;not null;default:true" json:"active"`
+
+	PointsTable SeriesPointsTable `gorm:"column:points_table;type:json" json:"points_table"`
+
+	// InstanceCount and LastScheduledAt track the instances created so far
+	InstanceCount   int        `gorm:"column:instance_count;type:int;not null;default:0" json:"instance_count"`
+	LastScheduledAt *time.Time `gorm:"column:last_scheduled_at;type:datetime" json:"last_scheduled_at,omitempty"`
+
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;autoCreateTime" json:"created_at"`
+	ModifiedAt time.Time `gorm:"column:modified_at;type:timestamp;autoUpdateTime" json:"modified_at"`
+}
+
+func (s *ContestSeries) TableName() string {
+	return "contest_series"
+}
+
+func (s *ContestSeries) Validate() error {
+	s.Name = strings.TrimSpace(s.Name)
+	if s.Name == "" || len(s.Name) > MaxContestSeriesName {
+		return exception.ErrInvalidContestSeries
+	}
+
+	if s.Weekday < time.Sunday || s.Weekday > time.Saturday {
+		return exception.ErrInvalidSeriesRecurrence
+	}
+	if _, err := time.Parse(seriesStartTimeLayout, s.StartTime); err != nil {
+		return exception.ErrInvalidSeriesRecurrence
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil || s.Timezone == "" {
+		return exception.ErrInvalidSeriesRecurrence
+	}
+	if s.IntervalWeeks < 1 || s.DurationMinutes < 1 {
+		return exception.ErrInvalidSeriesRecurrence
+	}
+	if s.RegistrationCloseMinutes != nil && *s.RegistrationCloseMinutes < 0 {
+		return exception.ErrInvalidSeriesRecurrence
+	}
+	if s.CreateAheadDays < 1 || s.CreateAheadDays > MaxSeriesCreateAheadDays {
+		return exception.ErrInvalidSeriesRecurrence
+	}
+
+	if s.StartsOn.IsZero() || (s.EndsOn != nil && !s.EndsOn.After(s.StartsOn)) {
+		return exception.ErrInvalidContestSeries
+	}
+
+	return s.PointsTable.Validate()
+}
+
+// IsOwnedBy checks if the user created this series
+func (s *ContestSeries) IsOwnedBy(userID int64) bool {
+	return s.OwnerUserID == userID
+}
+
+// NextOccurrence returns the start time of the next instance to create.
+// The first instance is the first matching weekday at or after StartsOn; later ones follow every IntervalWeeks,
+// keeping the local wall clock time across daylight saving changes.
+func (s *ContestSeries) NextOccurrence() (time.Time, error) {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if s.LastScheduledAt != nil {
+		return s.LastScheduledAt.In(location).AddDate(0, 0, 7*s.IntervalWeeks), nil
+	}
+
+	clock, err := time.Parse(seriesStartTimeLayout, s.StartTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	startsOn := s.StartsOn.In(location)
+	candidate := time.Date(startsOn.Year(), startsOn.Month(), startsOn.Day(), clock.Hour(), clock.Minute(), 0, 0, location)
+	for candidate.Weekday() != s.Weekday || candidate.Before(startsOn) {
+		candidate = candidate.AddDate(0, 0, 1)
+	}
+
+	return candidate, nil
+}
+
+// IsDue checks if an occurrence falls within the creation window and before the series ends
+func (s *ContestSeries) IsDue(occurrence, now time.Time) bool {
+	if s.EndsOn != nil && occurrence.After(*s.EndsOn) {
+		return false
+	}
+	return !occurrence.After(now.AddDate(0, 0, s.CreateAheadDays))
+}
+
+// HasEnded checks if the next occurrence is past the end of the series
+func (s *ContestSeries) HasEnded(occurrence time.Time) bool {
+	return s.EndsOn != nil && occurrence.After(*s.EndsOn)
+}
+
+// MarkScheduled records an occurrence as handled
+func (s *ContestSeries) MarkScheduled(occurrence time.Time) {
+	s.LastScheduledAt = &occurrence
+	s.InstanceCount++
+}
+
+// InstanceTitle numbers the contests of the series (e.g. "Weekly Cup #3")
+func (s *ContestSeries) InstanceTitle(baseTitle string) string {
+	return fmt.Sprintf("%s #%d", baseTitle, s.InstanceCount+1)
+}
+
+// InstanceSchedule returns the end time and registration close time of an instance starting at startedAt
+func (s *ContestSeries) InstanceSchedule(startedAt time.Time) (endedAt time.Time, registrationClosesAt *time.Time) {
+	endedAt = startedAt.Add(time.Duration(s.DurationMinutes) * time.Minute)
+	if s.RegistrationCloseMinutes != nil {
+		closesAt := startedAt.Add(-time.Duration(*s.RegistrationCloseMinutes) * time.Minute)
+		registrationClosesAt = &closesAt
+	}
+	return endedAt, registrationClosesAt
+}
+
+// SeriesStanding is a player's season total across the contests of a series
+type SeriesStanding struct {
+	Rank           int    `json:"rank"`
+	UserID         int64  `json:"user_id"`
+	Username       string `json:"username,omitempty"`
+	Tag            string `json:"tag,omitempty"`
+	Points         int    `json:"points"`
+	ContestsPlayed int    `json:"contests_played"`
+	BestPlacement  int    `json:"best_placement"`
+}
+
+// SeriesStandings adds up placements of every player across contests
+type SeriesStandings struct {
+	pointsTable SeriesPointsTable
+	byUser      map[int64]*SeriesStanding
+}
+
+func NewSeriesStandings(pointsTable SeriesPointsTable) *SeriesStandings {
+	return &SeriesStandings{
+		pointsTable: pointsTable,
+		byUser:      make(map[int64]*SeriesStanding),
+	}
+}
+
+// AddPlacement awards the placement points to a player of a team
+func (s *SeriesStandings) AddPlacement(userID int64, placement int) {
+	standing, ok := s.byUser[userID]
+	if !ok {
+		standing = &SeriesStanding{UserID: userID, BestPlacement: placement}
+		s.byUser[userID] = standing
+	}
+
+	standing.Points += s.pointsTable.PointsFor(placement)
+	standing.ContestsPlayed++
+	if placement < standing.BestPlacement {
+		standing.BestPlacement = placement
+	}
+}
+
+// Ranked returns standings by points, then best placement, then contests played.
+// Players with identical totals share a rank.
+func (s *SeriesStandings) Ranked() []*SeriesStanding {
+	standings := make([]*SeriesStanding, 0, len(s.byUser))
+	for _, standing := range s.byUser {
+		standings = append(standings, standing)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.BestPlacement != b.BestPlacement {
+			return a.BestPlacement < b.BestPlacement
+		}
+		if a.ContestsPlayed != b.ContestsPlayed {
+			return a.ContestsPlayed > b.ContestsPlayed
+		}
+		return a.UserID < b.UserID
+	})
+
+	for i, standing := range standings {
+		standing.Rank = i + 1
+		if i > 0 {
+			prev := standings[i-1]
+			if prev.Points == standing.Points && prev.BestPlacement == standing.BestPlacement && prev.ContestsPlayed == standing.ContestsPlayed {
+				standing.Rank = prev.Rank
+			}
+		}
+	}
+
+	return standings
+}
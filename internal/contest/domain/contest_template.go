@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"strings"
+	"time"
+)
+
+const MaxContestTemplateName = 100
+
+// ContestTemplate stores the reusable settings of a contest so recurring contests can be created from it.
+// Schedule fields (dates, status, roster lock) are not part of a template and are given when a contest is created.
+type ContestTemplate struct {
+	TemplateID  int64  `gorm:"column:template_id;primaryKey;autoIncrement" json:"template_id"`
+	OwnerUserID int64  `gorm:"column:owner_user_id;type:bigint;not null;index:idx_contest_templates_owner" json:"owner_user_id"`
+	Name        string `gorm:"column:name;type:varchar(100);not null" json:"name"`
+
+	Title            string               `gorm:"column:title;type:varchar(255);not null" json:"title"`
+	Description      string               `gorm:"column:description;type:text" json:"description,omitempty"`
+	MaxTeamCount     int                  `gorm:"column:max_team_count;type:int" json:"max_team_count,omitempty"`
+	TotalPoint       int                  `gorm:"column:total_point;type:int;default:100" json:"total_point"`
+	ContestType      ContestType          `gorm:"column:contest_type;type:varchar(16);not null" json:"contest_type"`
+	AutoStart        bool                 `gorm:"column:auto_start;type:boolean;default:false" json:"auto_start"`
+	GameType         *gameDomain.GameType `gorm:"column:game_type;type:varchar(32)" json:"game_type,omitempty"`
+	GamePointTableId *int64               `gorm:"column:game_point_table_id;type:bigint" json:"game_point_table_id,omitempty"`
+	TotalTeamMember  int                  `gorm:"column:total_team_member;type:int;default:5" json:"total_team_member"`
+
+	DiscordGuildId       *string `gorm:"column:discord_guild_id;type:varchar(255)" json:"discord_guild_id,omitempty"`
+	DiscordTextChannelId *string `gorm:"column:discord_text_channel_id;type:varchar(255)" json:"discord_text_channel_id,omitempty"`
+
+	Thumbnail *string `gorm:"column:thumbnail;type:varchar(512)" json:"thumbnail,omitempty"`
+
+	Eligibility ContestEligibility `gorm:"embedded" json:"eligibility"`
+
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;autoCreateTime" json:"created_at"`
+	ModifiedAt time.Time `gorm:"column:modified_at;type:timestamp;autoUpdateTime" json:"modified_at"`
+}
+
+// NewContestTemplateFromContest copies the reusable settings of a contest
+func NewContestTemplateFromContest(ownerUserID int64, name string, contest *Contest) *ContestTemplate {
+	return &ContestTemplate{
+		OwnerUserID:          ownerUserID,
+		Name:                 strings.TrimSpace(name),
+		Title:                contest.Title,
+		Description:          contest.Description,
+		MaxTeamCount:         contest.MaxTeamCount,
+		TotalPoint:           contest.TotalPoint,
+		ContestType:          contest.ContestType,
+		AutoStart:            contest.AutoStart,
+		GameType:             contest.GameType,
+		GamePointTableId:     contest.GamePointTableId,
+		TotalTeamMember:      contest.TotalTeamMember,
+		DiscordGuildId:       contest.DiscordGuildId,
+		DiscordTextChannelId: contest.DiscordTextChannelId,
+		Thumbnail:            contest.Thumbnail,
+		Eligibility:          contest.Eligibility,
+	}
+}
+
+func (t *ContestTemplate) TableName() string {
+	return "contest_templates"
+}
+
+func (t *ContestTemplate) Validate() error {
+	if t.Name == "" || len(t.Name) > MaxContestTemplateName {
+		return exception.ErrInvalidContestTemplateName
+	}
+	return nil
+}
+
+// IsOwnedBy checks if the user saved this template
+func (t *ContestTemplate) IsOwnedBy(userID int64) bool {
+	return t.OwnerUserID == userID
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ContestVisibility controls who can find and read a contest
+type ContestVisibility string
+
+const (
+	// ContestVisibilityPublic contests are listed and readable by anyone
+	ContestVisibilityPublic ContestVisibility = "PUBLIC"
+	// ContestVisibilityUnlisted contests are readable by anyone with the link but hidden from listings
+	ContestVisibilityUnlisted ContestVisibility = "UNLISTED"
+	// ContestVisibilityPrivate contests are hidden and readable only by members and invitees
+	ContestVisibilityPrivate ContestVisibility = "PRIVATE"
+)
+
+const (
+	ContestJoinCodeLength = 10
+	// contestJoinCodeAlphabet leaves out characters that are easy to misread (0/O, 1/I/L)
+	contestJoinCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+)
+
+// IsValid checks if the visibility is a known value
+func (v ContestVisibility) IsValid() bool {
+	switch v {
+	case ContestVisibilityPublic, ContestVisibilityUnlisted, ContestVisibilityPrivate:
+		return true
+	default:
+		return false
+	}
+}
+
+// GenerateContestJoinCode returns a random join code for a private contest
+func GenerateContestJoinCode() (string, error) {
+	var sb strings.Builder
+	max := big.NewInt(int64(len(contestJoinCodeAlphabet)))
+	for i := 0; i < ContestJoinCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(contestJoinCodeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// NormalizeJoinCode makes join code lookups case and whitespace insensitive
+func NormalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// EffectiveVisibility treats contests created before visibility existed as public
+func (c *Contest) EffectiveVisibility() ContestVisibility {
+	if c.Visibility == "" {
+		return ContestVisibilityPublic
+	}
+	return c.Visibility
+}
+
+// IsPrivate checks if the contest is restricted to members and invitees
+func (c *Contest) IsPrivate() bool {
+	return c.EffectiveVisibility() == ContestVisibilityPrivate
+}
+
+// IsListed checks if the contest appears in public listings and search
+func (c *Contest) IsListed() bool {
+	return c.EffectiveVisibility() == ContestVisibilityPublic
+}
+
+// ValidateVisibility checks if the visibility setting is valid
+func (c *Contest) ValidateVisibility() error {
+	if c.Visibility != "" && !c.Visibility.IsValid() {
+		return exception.ErrInvalidContestVisibility
+	}
+	return nil
+}
+
+// EnsureJoinCode gives a private contest a join code if it has none yet
+func (c *Contest) EnsureJoinCode() error {
+	if !c.IsPrivate() || c.JoinCode != nil {
+		return nil
+	}
+	return c.RegenerateJoinCode()
+}
+
+// RegenerateJoinCode replaces the join code, invalidating links shared with the old one
+func (c *Contest) RegenerateJoinCode() error {
+	if !c.IsPrivate() {
+		return exception.ErrContestNotPrivate
+	}
+
+	code, err := GenerateContestJoinCode()
+	if err != nil {
+		return err
+	}
+
+	c.JoinCode = &code
+	return nil
+}
+
+type ContestAccessSource string
+
+const (
+	ContestAccessSourceJoinCode ContestAccessSource = "JOIN_CODE"
+	ContestAccessSourceInvite   ContestAccessSource = "INVITE"
+)
+
+// ContestAccessGrant lets a non-member read and apply to a private contest
+type ContestAccessGrant struct {
+	ContestID int64               `gorm:"column:contest_id;primaryKey" json:"contest_id"`
+	UserID    int64               `gorm:"column:user_id;primaryKey" json:"user_id"`
+	Source    ContestAccessSource `gorm:"column:source;type:varchar(16);not null" json:"source"`
+	GrantedBy *int64              `gorm:"column:granted_by" json:"granted_by,omitempty"`
+	CreatedAt time.Time           `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+func NewContestAccessGrantFromJoinCode(contestID, userID int64) *ContestAccessGrant {
+	return &ContestAccessGrant{
+		ContestID: contestID,
+		UserID:    userID,
+		Source:    ContestAccessSourceJoinCode,
+	}
+}
+
+func NewContestAccessGrantFromInvite(contestID, userID, grantedBy int64) *ContestAccessGrant {
+	return &ContestAccessGrant{
+		ContestID: contestID,
+		UserID:    userID,
+		Source:    ContestAccessSourceInvite,
+		GrantedBy: &grantedBy,
+	}
+}
+
+func (g *ContestAccessGrant) TableName() string {
+	return "contest_access_grants"
+}
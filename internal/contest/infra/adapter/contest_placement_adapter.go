@@ -0,0 +1,52 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	gameApplication "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gamePort "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+)
+
+// ContestPlacementAdapter adapts the tournament result service to the contest placement port
+type ContestPlacementAdapter struct {
+	resultService *gameApplication.TournamentResultService
+	teamRepo      gamePort.TeamDatabasePort
+}
+
+// NewContestPlacementAdapter creates a new contest placement adapter
+func NewContestPlacementAdapter(resultService *gameApplication.TournamentResultService, teamRepo gamePort.TeamDatabasePort) *ContestPlacementAdapter {
+	return &ContestPlacementAdapter{
+		resultService: resultService,
+		teamRepo:      teamRepo,
+	}
+}
+
+// GetPlacements returns the bracket placements of a contest with each team's players
+func (a *ContestPlacementAdapter) GetPlacements(contestID int64) ([]*port.ContestPlacement, error) {
+	result, err := a.resultService.GetContestResult(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := a.teamRepo.GetTeamsByContestWithMembers(contestID)
+	if err != nil {
+		return nil, err
+	}
+	membersByTeam := make(map[int64][]int64, len(teams))
+	for _, team := range teams {
+		for _, member := range team.Members {
+			membersByTeam[team.Team.TeamID] = append(membersByTeam[team.Team.TeamID], member.UserID)
+		}
+	}
+
+	placements := make([]*port.ContestPlacement, 0, len(result.Placements))
+	for _, placement := range result.Placements {
+		placements = append(placements, &port.ContestPlacement{
+			TeamID:        placement.TeamID,
+			TeamName:      placement.TeamName,
+			Placement:     placement.Placement,
+			MemberUserIDs: membersByTeam[placement.TeamID],
+		})
+	}
+
+	return placements, nil
+}
@@ -0,0 +1,67 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContestAccessDatabaseAdapter implements ContestAccessDatabasePort using GORM
+type ContestAccessDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestAccessDatabaseAdapter(db *gorm.DB) *ContestAccessDatabaseAdapter {
+	return &ContestAccessDatabaseAdapter{db: db}
+}
+
+func (a *ContestAccessDatabaseAdapter) GetContestByJoinCode(joinCode string) (*domain.Contest, error) {
+	var contest domain.Contest
+	err := a.db.Where("join_code = ? AND visibility = ?", joinCode, domain.ContestVisibilityPrivate).First(&contest).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrInvalidJoinCode
+		}
+		return nil, err
+	}
+	return &contest, nil
+}
+
+// SaveGrant stores a grant; redeeming a code after an invite keeps the original grant
+func (a *ContestAccessDatabaseAdapter) SaveGrant(grant *domain.ContestAccessGrant) error {
+	return a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(grant).Error
+}
+
+func (a *ContestAccessDatabaseAdapter) HasGrant(contestId, userId int64) (bool, error) {
+	var count int64
+	if err := a.db.Model(&domain.ContestAccessGrant{}).
+		Where("contest_id = ? AND user_id = ?", contestId, userId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (a *ContestAccessDatabaseAdapter) GetGrantsByContest(contestId int64) ([]*domain.ContestAccessGrant, error) {
+	var grants []*domain.ContestAccessGrant
+	if err := a.db.Where("contest_id = ?", contestId).Order("created_at DESC").Find(&grants).Error; err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+func (a *ContestAccessDatabaseAdapter) DeleteGrant(contestId, userId int64) error {
+	result := a.db.Where("contest_id = ? AND user_id = ?", contestId, userId).Delete(&domain.ContestAccessGrant{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrContestMemberNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContestApplicationFormDatabaseAdapter implements ContestApplicationFormDatabasePort using GORM
+type ContestApplicationFormDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestApplicationFormDatabaseAdapter(db *gorm.DB) *ContestApplicationFormDatabaseAdapter {
+	return &ContestApplicationFormDatabaseAdapter{db: db}
+}
+
+// SaveForm creates the contest form or replaces its questions
+func (a *ContestApplicationFormDatabaseAdapter) SaveForm(form *domain.ContestApplicationForm) error {
+	if err := form.Validate(); err != nil {
+		return err
+	}
+
+	return a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "contest_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"questions", "modified_at"}),
+	}).Create(form).Error
+}
+
+func (a *ContestApplicationFormDatabaseAdapter) GetFormByContestId(contestId int64) (*domain.ContestApplicationForm, error) {
+	var form domain.ContestApplicationForm
+	if err := a.db.Where("contest_id = ?", contestId).First(&form).Error; err != nil {
+		return nil, a.translateError(err, exception.ErrApplicationFormNotFound)
+	}
+	return &form, nil
+}
+
+func (a *ContestApplicationFormDatabaseAdapter) DeleteForm(contestId int64) error {
+	result := a.db.Where("contest_id = ?", contestId).Delete(&domain.ContestApplicationForm{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrApplicationFormNotFound
+	}
+
+	return nil
+}
+
+// SaveAnswers stores an applicant's answers, replacing answers of an earlier application
+func (a *ContestApplicationFormDatabaseAdapter) SaveAnswers(answer *domain.ContestApplicationAnswer) error {
+	return a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "contest_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"answers", "submitted_at"}),
+	}).Create(answer).Error
+}
+
+func (a *ContestApplicationFormDatabaseAdapter) GetAnswersByContestId(contestId int64) ([]*domain.ContestApplicationAnswer, error) {
+	var answers []*domain.ContestApplicationAnswer
+	if err := a.db.Where("contest_id = ?", contestId).Order("submitted_at ASC").Find(&answers).Error; err != nil {
+		return nil, err
+	}
+	return answers, nil
+}
+
+func (a *ContestApplicationFormDatabaseAdapter) DeleteAnswers(contestId, userId int64) error {
+	return a.db.Where("contest_id = ? AND user_id = ?", contestId, userId).Delete(&domain.ContestApplicationAnswer{}).Error
+}
+
+func (a *ContestApplicationFormDatabaseAdapter) translateError(err error, notFound error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContestAutoAcceptDatabaseAdapter implements ContestAutoAcceptDatabasePort using GORM
+type ContestAutoAcceptDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestAutoAcceptDatabaseAdapter(db *gorm.DB) *ContestAutoAcceptDatabaseAdapter {
+	return &ContestAutoAcceptDatabaseAdapter{db: db}
+}
+
+// SaveRule creates the contest rule or replaces its criteria
+func (a *ContestAutoAcceptDatabaseAdapter) SaveRule(rule *domain.ContestAutoAcceptRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	return a.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "contest_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"enabled", "min_tier", "max_tier", "require_riot_account",
+			"require_guild_member", "accept_cap", "on_mismatch", "modified_at",
+		}),
+	}).Create(rule).Error
+}
+
+func (a *ContestAutoAcceptDatabaseAdapter) GetRuleByContestId(contestId int64) (*domain.ContestAutoAcceptRule, error) {
+	var rule domain.ContestAutoAcceptRule
+	if err := a.db.Where("contest_id = ?", contestId).First(&rule).Error; err != nil {
+		return nil, a.translateError(err, exception.ErrAutoAcceptRuleNotFound)
+	}
+	return &rule, nil
+}
+
+func (a *ContestAutoAcceptDatabaseAdapter) DeleteRule(contestId int64) error {
+	result := a.db.Where("contest_id = ?", contestId).Delete(&domain.ContestAutoAcceptRule{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrAutoAcceptRuleNotFound
+	}
+
+	return nil
+}
+
+func (a *ContestAutoAcceptDatabaseAdapter) SaveDecision(decision *domain.ContestAutoAcceptDecision) error {
+	return a.db.Create(decision).Error
+}
+
+func (a *ContestAutoAcceptDatabaseAdapter) GetDecisionsByContestId(contestId int64) ([]*domain.ContestAutoAcceptDecision, error) {
+	var decisions []*domain.ContestAutoAcceptDecision
+	if err := a.db.Where("contest_id = ?", contestId).Order("decided_at ASC").Find(&decisions).Error; err != nil {
+		return nil, err
+	}
+	return decisions, nil
+}
+
+func (a *ContestAutoAcceptDatabaseAdapter) translateError(err error, notFound error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
@@ -3,11 +3,15 @@ package adapter
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func NewContestDatabaseAdapter(db *gorm.DB) *ContestDatabaseAdapter {
@@ -20,8 +24,16 @@ type ContestDatabaseAdapter struct {
 	db *gorm.DB
 }
 
+// seriesInstanceIndex is the unique (series_id, started_at) index that keeps a series from scheduling an occurrence twice
+const seriesInstanceIndex = "idx_contests_series_started_at"
+
 func (c ContestDatabaseAdapter) Save(contest *domain.Contest) (*domain.Contest, error) {
-	err := c.db.Save(contest).Error
+	return c.SaveWithContext(context.Background(), contest)
+}
+
+// SaveWithContext saves the contest, joining the transaction carried by ctx if any
+func (c ContestDatabaseAdapter) SaveWithContext(ctx context.Context, contest *domain.Contest) (*domain.Contest, error) {
+	err := transaction.DB(ctx, c.db).Save(contest).Error
 
 	if err != nil {
 		return nil, c.translateError(err)
@@ -46,11 +58,28 @@ func (c ContestDatabaseAdapter) GetContestById(contestId int64) (*domain.Contest
 	return &contest, nil
 }
 
+// GetContestByIdForUpdate reads the contest with SELECT ... FOR UPDATE inside the transaction carried by ctx
+func (c ContestDatabaseAdapter) GetContestByIdForUpdate(ctx context.Context, contestId int64) (*domain.Contest, error) {
+	var contest domain.Contest
+
+	result := transaction.DB(ctx, c.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("contest_id = ?", contestId).
+		First(&contest)
+
+	if result.Error != nil {
+		return nil, c.translateError(result.Error)
+	}
+
+	return &contest, nil
+}
+
 func (c ContestDatabaseAdapter) GetContests(offset, limit int, sortReq *dto.SortRequest, title *string) ([]domain.Contest, int64, error) {
 	var contests []domain.Contest
 	var totalCount int64
 
-	query := c.db.Model(&domain.Contest{})
+	// Unlisted and private contests never appear in listings or search
+	query := c.db.Model(&domain.Contest{}).Where("visibility = ?", domain.ContestVisibilityPublic)
 
 	// Apply title search filter if provided
 	if title != nil && *title != "" {
@@ -78,6 +107,29 @@ func (c ContestDatabaseAdapter) GetContests(offset, limit int, sortReq *dto.Sort
 	return contests, totalCount, nil
 }
 
+// GetContestsByCursor returns one page after the cursor, fetching an extra row so the caller can tell if more exist
+func (c ContestDatabaseAdapter) GetContestsByCursor(cursor *dto.CursorRequest, title *string) ([]domain.Contest, error) {
+	var contests []domain.Contest
+
+	query := c.db.Model(&domain.Contest{}).Where("visibility = ?", domain.ContestVisibilityPublic)
+
+	if title != nil && *title != "" {
+		query = query.Where("title LIKE ?", "%"+*title+"%")
+	}
+
+	if condition, args := cursor.KeysetCondition(cursor.Sort.SortBy, "contest_id"); condition != "" {
+		query = query.Where(condition, args...)
+	}
+
+	if err := query.Order(cursor.OrderClause(cursor.Sort.SortBy, "contest_id")).
+		Limit(cursor.FetchLimit()).
+		Find(&contests).Error; err != nil {
+		return nil, c.translateError(err)
+	}
+
+	return contests, nil
+}
+
 func (c ContestDatabaseAdapter) DeleteContestById(contestId int64) error {
 	result := c.db.Where("contest_id = ?", contestId).Delete(&domain.Contest{})
 	if result.Error != nil {
@@ -88,7 +140,52 @@ func (c ContestDatabaseAdapter) DeleteContestById(contestId int64) error {
 }
 
 func (c ContestDatabaseAdapter) UpdateContest(contest *domain.Contest) error {
-	return c.db.Save(contest).Error
+	return c.UpdateContestWithContext(context.Background(), contest)
+}
+
+// UpdateContestWithContext saves the contest, joining the transaction carried by ctx if any
+func (c ContestDatabaseAdapter) UpdateContestWithContext(ctx context.Context, contest *domain.Contest) error {
+	return transaction.DB(ctx, c.db).Save(contest).Error
+}
+
+func (c ContestDatabaseAdapter) GetContestsDueForRosterLock(now time.Time) ([]*domain.Contest, error) {
+	var contests []*domain.Contest
+
+	err := c.db.Where("contest_status IN ?", []domain.ContestStatus{domain.ContestStatusPending, domain.ContestStatusActive}).
+		Where("registration_closes_at IS NOT NULL AND registration_closes_at <= ?", now).
+		Where("roster_locked_at IS NULL").
+		Order("registration_closes_at ASC").
+		Find(&contests).Error
+	if err != nil {
+		return nil, c.translateError(err)
+	}
+
+	return contests, nil
+}
+
+func (c ContestDatabaseAdapter) GetContestsDueForAutoStart(now time.Time) ([]*domain.Contest, error) {
+	var contests []*domain.Contest
+
+	err := c.db.Where("contest_status = ?", domain.ContestStatusPending).
+		Where("auto_start = ?", true).
+		Where("started_at IS NOT NULL AND started_at <= ?", now).
+		Order("started_at ASC").
+		Find(&contests).Error
+	if err != nil {
+		return nil, c.translateError(err)
+	}
+
+	return contests, nil
+}
+
+func (c ContestDatabaseAdapter) GetContestsByStatuses(statuses []domain.ContestStatus) ([]*domain.Contest, error) {
+	var contests []*domain.Contest
+
+	if err := c.db.Where("contest_status IN ?", statuses).Find(&contests).Error; err != nil {
+		return nil, c.translateError(err)
+	}
+
+	return contests, nil
 }
 
 func (c ContestDatabaseAdapter) translateError(err error) error {
@@ -101,6 +198,9 @@ func (c ContestDatabaseAdapter) translateError(err error) error {
 	}
 
 	if isDuplicateKeyError(err) {
+		if strings.Contains(err.Error(), seriesInstanceIndex) {
+			return exception.ErrSeriesInstanceExists
+		}
 		return exception.ErrContestAlreadyExists
 	}
 
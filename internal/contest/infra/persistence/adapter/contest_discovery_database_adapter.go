@@ -0,0 +1,248 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// discoveryFacet names a filter dimension so facet queries can leave their own filter out
+type discoveryFacet string
+
+const (
+	facetNone        discoveryFacet = ""
+	facetGameType    discoveryFacet = "game_type"
+	facetContestType discoveryFacet = "contest_type"
+	facetStatus      discoveryFacet = "contest_status"
+	facetTeamSize    discoveryFacet = "total_team_member"
+	facetOpenSlots   discoveryFacet = "open_slots"
+)
+
+const fullTextMatch = "MATCH(contests.title, contests.description) AGAINST (? IN BOOLEAN MODE)"
+
+// openSlotsCondition matches contests without a team cap or with fewer registered teams than the cap
+const openSlotsCondition = "(contests.max_team_count = 0 OR contests.max_team_count > " +
+	"(SELECT COUNT(*) FROM teams WHERE teams.contest_id = contests.contest_id AND teams.status = ?))"
+
+// tierForBasis picks the caller's current or peak tier depending on the contest's tier basis
+const tierForBasis = "(CASE WHEN contests.eligible_tier_basis = 'PEAK' THEN ? ELSE ? END)"
+
+// ContestDiscoveryDatabaseAdapter implements ContestDiscoveryDatabasePort using GORM on MySQL
+type ContestDiscoveryDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestDiscoveryDatabaseAdapter(db *gorm.DB) *ContestDiscoveryDatabaseAdapter {
+	return &ContestDiscoveryDatabaseAdapter{db: db}
+}
+
+func (a *ContestDiscoveryDatabaseAdapter) SearchContests(
+	filter *domain.ContestDiscoveryFilter,
+	sort domain.ContestDiscoverySort,
+	descending bool,
+	recommendation *domain.RecommendationProfile,
+	offset, limit int,
+) ([]domain.Contest, int64, error) {
+	var contests []domain.Contest
+	var totalCount int64
+
+	if err := a.filtered(filter, facetNone).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Expression orders cannot be merged with column orders in GORM, so tie-breakers are part of the expression
+	query := a.filtered(filter, facetNone)
+	switch sort {
+	case domain.ContestDiscoverySortRecommended:
+		query = query.Order(recommendationOrder(recommendation))
+	case domain.ContestDiscoverySortRelevance:
+		if filter.UsesFullText() {
+			query = query.Order(clause.OrderBy{Expression: clause.Expr{
+				SQL:  fullTextMatch + " DESC, contests.created_at DESC",
+				Vars: []interface{}{filter.FullTextQuery()},
+			}})
+		} else {
+			query = query.Order("contests.created_at DESC")
+		}
+	default:
+		direction := "ASC"
+		if descending {
+			direction = "DESC"
+		}
+		query = query.Order(fmt.Sprintf("contests.%s %s", sort, direction))
+	}
+
+	if err := query.Offset(offset).Limit(limit).Find(&contests).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return contests, totalCount, nil
+}
+
+func (a *ContestDiscoveryDatabaseAdapter) GetFacets(filter *domain.ContestDiscoveryFilter) (*domain.ContestDiscoveryFacets, error) {
+	facets := &domain.ContestDiscoveryFacets{}
+
+	var err error
+	if facets.GameTypes, err = a.countBy(filter, facetGameType); err != nil {
+		return nil, err
+	}
+	if facets.ContestTypes, err = a.countBy(filter, facetContestType); err != nil {
+		return nil, err
+	}
+	if facets.Statuses, err = a.countBy(filter, facetStatus); err != nil {
+		return nil, err
+	}
+	if facets.TeamSizes, err = a.countBy(filter, facetTeamSize); err != nil {
+		return nil, err
+	}
+
+	if err := a.filtered(filter, facetOpenSlots).
+		Where(openSlotsCondition, gameDomain.TeamStatusRegistered).
+		Count(&facets.WithOpenSlots).Error; err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+func (a *ContestDiscoveryDatabaseAdapter) GetRecommendationProfile(userId int64) (*domain.RecommendationProfile, error) {
+	profile := &domain.RecommendationProfile{}
+
+	joined := a.db.Table("contests_members").
+		Joins("JOIN contests ON contests.contest_id = contests_members.contest_id").
+		Where("contests_members.user_id = ?", userId)
+
+	if err := joined.Session(&gorm.Session{}).
+		Where("contests.game_type IS NOT NULL").
+		Distinct().
+		Pluck("contests.game_type", &profile.PlayedGameTypes).Error; err != nil {
+		return nil, err
+	}
+
+	if err := joined.Session(&gorm.Session{}).
+		Distinct().
+		Pluck("contests.contest_type", &profile.PlayedContestTypes).Error; err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// countBy groups the filtered contests by a facet column, ignoring the filter on that column
+func (a *ContestDiscoveryDatabaseAdapter) countBy(filter *domain.ContestDiscoveryFilter, facet discoveryFacet) ([]domain.ContestFacetBucket, error) {
+	column := "contests." + string(facet)
+	buckets := []domain.ContestFacetBucket{}
+
+	err := a.filtered(filter, facet).
+		Select(fmt.Sprintf("CAST(%s AS CHAR) AS value, COUNT(*) AS count", column)).
+		Where(column + " IS NOT NULL").
+		Group(column).
+		Order("count DESC").
+		Scan(&buckets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// filtered builds the listed-contest query with every filter except the skipped facet
+func (a *ContestDiscoveryDatabaseAdapter) filtered(filter *domain.ContestDiscoveryFilter, skip discoveryFacet) *gorm.DB {
+	// Unlisted and private contests never appear in discovery
+	query := a.db.Model(&domain.Contest{}).Where("contests.visibility = ?", domain.ContestVisibilityPublic)
+
+	if text := strings.TrimSpace(filter.Query); text != "" {
+		if filter.UsesFullText() {
+			query = query.Where(fullTextMatch, filter.FullTextQuery())
+		} else {
+			pattern := "%" + text + "%"
+			query = query.Where("(contests.title LIKE ? OR contests.description LIKE ?)", pattern, pattern)
+		}
+	}
+
+	if skip != facetGameType && len(filter.GameTypes) > 0 {
+		query = query.Where("contests.game_type IN ?", filter.GameTypes)
+	}
+	if skip != facetContestType && len(filter.ContestTypes) > 0 {
+		query = query.Where("contests.contest_type IN ?", filter.ContestTypes)
+	}
+	if skip != facetStatus && len(filter.Statuses) > 0 {
+		query = query.Where("contests.contest_status IN ?", filter.Statuses)
+	}
+	if filter.StartsFrom != nil {
+		query = query.Where("contests.started_at >= ?", *filter.StartsFrom)
+	}
+	if filter.StartsTo != nil {
+		query = query.Where("contests.started_at <= ?", *filter.StartsTo)
+	}
+	if skip != facetTeamSize && filter.TeamSize != nil {
+		query = query.Where("contests.total_team_member = ?", *filter.TeamSize)
+	}
+	if skip != facetOpenSlots && filter.OpenSlotsOnly {
+		query = query.Where(openSlotsCondition, gameDomain.TeamStatusRegistered)
+	}
+	if filter.Eligibility != nil {
+		query = applyEligibility(query, filter.Eligibility)
+	}
+
+	return query
+}
+
+// applyEligibility mirrors ContestEligibility.Check for the region, tier and account age constraints
+func applyEligibility(query *gorm.DB, profile *domain.EligibilityProfile) *gorm.DB {
+	noRegionLimit := "(contests.eligible_regions IS NULL OR JSON_LENGTH(contests.eligible_regions) = 0)"
+	if profile.Region != nil {
+		query = query.Where("("+noRegionLimit+" OR JSON_CONTAINS(LOWER(contests.eligible_regions), JSON_QUOTE(?)))",
+			strings.ToLower(*profile.Region))
+	} else {
+		query = query.Where(noRegionLimit)
+	}
+
+	// Comparisons against a NULL tier are never true, so users without a linked account only see contests without a tier range
+	query = query.Where(
+		"((contests.eligible_min_tier IS NULL AND contests.eligible_max_tier IS NULL) OR "+
+			"((contests.eligible_min_tier IS NULL OR contests.eligible_min_tier <= "+tierForBasis+") AND "+
+			"(contests.eligible_max_tier IS NULL OR contests.eligible_max_tier >= "+tierForBasis+")))",
+		profile.PeakTier, profile.CurrentTier, profile.PeakTier, profile.CurrentTier,
+	)
+
+	accountAgeDays := int(time.Since(profile.AccountCreatedAt).Hours() / 24)
+	query = query.Where("(contests.eligible_min_account_age_days IS NULL OR contests.eligible_min_account_age_days <= ?)", accountAgeDays)
+
+	return query
+}
+
+// recommendationOrder scores each contest against the caller's profile, highest first
+func recommendationOrder(profile *domain.RecommendationProfile) clause.OrderBy {
+	terms := []string{fmt.Sprintf("(CASE WHEN contests.contest_status = '%s' THEN %d ELSE 0 END)",
+		domain.ContestStatusPending, domain.RecommendWeightOpenForEntry)}
+	var vars []interface{}
+
+	if profile != nil {
+		if len(profile.PlayedGameTypes) > 0 {
+			terms = append(terms, fmt.Sprintf("(CASE WHEN contests.game_type IN ? THEN %d ELSE 0 END)", domain.RecommendWeightPlayedGameType))
+			vars = append(vars, profile.PlayedGameTypes)
+		}
+		if len(profile.PlayedContestTypes) > 0 {
+			terms = append(terms, fmt.Sprintf("(CASE WHEN contests.contest_type IN ? THEN %d ELSE 0 END)", domain.RecommendWeightPlayedContestType))
+			vars = append(vars, profile.PlayedContestTypes)
+		}
+		// Only contests with a tier range are rewarded, so open-to-all contests do not outrank tailored ones
+		if profile.Tier != nil {
+			terms = append(terms, fmt.Sprintf("(CASE WHEN (contests.eligible_min_tier IS NOT NULL OR contests.eligible_max_tier IS NOT NULL) "+
+				"AND COALESCE(contests.eligible_min_tier, ?) <= ? AND COALESCE(contests.eligible_max_tier, ?) >= ? THEN %d ELSE 0 END)",
+				domain.RecommendWeightTierInRange))
+			vars = append(vars, *profile.Tier, *profile.Tier, *profile.Tier, *profile.Tier)
+		}
+	}
+
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL:  "(" + strings.Join(terms, " + ") + ") DESC, contests.started_at ASC",
+		Vars: vars,
+	}}
+}
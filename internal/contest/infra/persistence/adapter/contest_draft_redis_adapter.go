@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type ContestDraftRedisAdapter struct {
+	client *redis.Client
+}
+
+func NewContestDraftRedisAdapter(client *redis.Client) *ContestDraftRedisAdapter {
+	return &ContestDraftRedisAdapter{
+		client: client,
+	}
+}
+
+// SaveDraft - 드래프트 상태 저장 및 진행 중 목록 갱신
+func (c *ContestDraftRedisAdapter) SaveDraft(ctx context.Context, draft *domain.ContestDraft, ttl time.Duration) error {
+	data, err := json.Marshal(draft)
+	if err != nil {
+		return err
+	}
+
+	pipe := c.client.Pipeline()
+	pipe.Set(ctx, utils.GetDraftKey(draft.ContestID), data, ttl)
+
+	activeKey := utils.GetActiveDraftsKey()
+	if draft.IsInProgress() {
+		pipe.SAdd(ctx, activeKey, draft.ContestID)
+	} else {
+		pipe.SRem(ctx, activeKey, draft.ContestID)
+	}
+
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetDraft - 드래프트 상태 조회
+func (c *ContestDraftRedisAdapter) GetDraft(ctx context.Context, contestId int64) (*domain.ContestDraft, error) {
+	data, err := c.client.Get(ctx, utils.GetDraftKey(contestId)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, exception.ErrDraftNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var draft domain.ContestDraft
+	if err := json.Unmarshal([]byte(data), &draft); err != nil {
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+// DeleteDraft - 드래프트 상태 삭제
+func (c *ContestDraftRedisAdapter) DeleteDraft(ctx context.Context, contestId int64) error {
+	pipe := c.client.Pipeline()
+	pipe.Del(ctx, utils.GetDraftKey(contestId))
+	pipe.SRem(ctx, utils.GetActiveDraftsKey(), contestId)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetActiveDraftContestIDs - 진행 중인 드래프트의 contest ID 목록 조회
+func (c *ContestDraftRedisAdapter) GetActiveDraftContestIDs(ctx context.Context) ([]int64, error) {
+	members, err := c.client.SMembers(ctx, utils.GetActiveDraftsKey()).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	contestIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		contestID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		contestIDs = append(contestIDs, contestID)
+	}
+
+	return contestIDs, nil
+}
+
+// AcquireDraftLock - SETNX 기반 드래프트 픽 락 획득
+func (c *ContestDraftRedisAdapter) AcquireDraftLock(ctx context.Context, contestId int64, ttl time.Duration) (bool, error) {
+	result, err := c.client.SetNX(ctx, utils.GetDraftLockKey(contestId), fmt.Sprintf("locked:%d", time.Now().UnixMilli()), ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	return result, nil
+}
+
+// ReleaseDraftLock - 드래프트 픽 락 해제
+func (c *ContestDraftRedisAdapter) ReleaseDraftLock(ctx context.Context, contestId int64) error {
+	return c.client.Del(ctx, utils.GetDraftLockKey(contestId)).Err()
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
 	"errors"
 	"strings"
 
@@ -16,11 +18,16 @@ type ContestMemberDatabaseAdapter struct {
 }
 
 func (c ContestMemberDatabaseAdapter) Save(member *domain.ContestMember) error {
+	return c.SaveWithContext(context.Background(), member)
+}
+
+// SaveWithContext saves the member, joining the transaction carried by ctx if any
+func (c ContestMemberDatabaseAdapter) SaveWithContext(ctx context.Context, member *domain.ContestMember) error {
 	if err := member.Validate(); err != nil {
 		return err
 	}
 
-	err := c.db.Save(member).Error
+	err := transaction.DB(ctx, c.db).Save(member).Error
 	if err != nil {
 		return c.translateError(err)
 	}
@@ -29,7 +36,12 @@ func (c ContestMemberDatabaseAdapter) Save(member *domain.ContestMember) error {
 }
 
 func (c ContestMemberDatabaseAdapter) DeleteById(contestId, userId int64) error {
-	result := c.db.Where("contest_id = ? AND user_id = ?", contestId, userId).Delete(&domain.ContestMember{})
+	return c.DeleteByIdWithContext(context.Background(), contestId, userId)
+}
+
+// DeleteByIdWithContext deletes the member, joining the transaction carried by ctx if any
+func (c ContestMemberDatabaseAdapter) DeleteByIdWithContext(ctx context.Context, contestId, userId int64) error {
+	result := transaction.DB(ctx, c.db).Where("contest_id = ? AND user_id = ?", contestId, userId).Delete(&domain.ContestMember{})
 	if result.Error != nil {
 		return c.translateError(result.Error)
 	}
@@ -167,6 +179,35 @@ func (c ContestMemberDatabaseAdapter) GetMembersWithUserByContest(
 	return results, totalCount, nil
 }
 
+func (c ContestMemberDatabaseAdapter) GetMembersWithUserByContestCursor(
+	contestId int64,
+	cursor *commonDto.CursorRequest,
+) ([]*port.ContestMemberWithUser, error) {
+	sortColumn := "cm.point"
+	if cursor.Sort.SortBy == "username" {
+		sortColumn = "u.username"
+	}
+
+	var results []*port.ContestMemberWithUser
+	query := c.db.Table("contests_members cm").
+		Select("cm.user_id, cm.contest_id, cm.member_type, cm.leader_type, cm.point, u.username, u.tag, u.avatar, da.discord_id, da.discord_avatar, u.current_tier, u.current_tier_patched, u.peak_tier, u.peak_tier_patched").
+		Joins("JOIN users u ON cm.user_id = u.id").
+		Joins("LEFT JOIN discord_accounts da ON u.id = da.user_id").
+		Where("cm.contest_id = ?", contestId)
+
+	if condition, args := cursor.KeysetCondition(sortColumn, "cm.user_id"); condition != "" {
+		query = query.Where(condition, args...)
+	}
+
+	if err := query.Order(cursor.OrderClause(sortColumn, "cm.user_id")).
+		Limit(cursor.FetchLimit()).
+		Scan(&results).Error; err != nil {
+		return nil, c.translateError(err)
+	}
+
+	return results, nil
+}
+
 func (c ContestMemberDatabaseAdapter) UpdateMemberType(contestId, userId int64, memberType domain.MemberType) error {
 	result := c.db.Model(&domain.ContestMember{}).
 		Where("contest_id = ? AND user_id = ?", contestId, userId).
@@ -0,0 +1,119 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContestOrganizerDatabaseAdapter implements ContestOrganizerDatabasePort using GORM
+type ContestOrganizerDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestOrganizerDatabaseAdapter(db *gorm.DB) *ContestOrganizerDatabaseAdapter {
+	return &ContestOrganizerDatabaseAdapter{db: db}
+}
+
+// SaveInvite stores an invite, replacing the role of an earlier invite to the same user
+func (a *ContestOrganizerDatabaseAdapter) SaveInvite(invite *domain.ContestOrganizerInvite) error {
+	if err := invite.Validate(); err != nil {
+		return err
+	}
+
+	return a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "contest_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "invited_by", "created_at"}),
+	}).Create(invite).Error
+}
+
+func (a *ContestOrganizerDatabaseAdapter) GetInvite(contestId, userId int64) (*domain.ContestOrganizerInvite, error) {
+	var invite domain.ContestOrganizerInvite
+	if err := a.db.Where("contest_id = ? AND user_id = ?", contestId, userId).First(&invite).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrOrganizerInviteNotFound
+		}
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (a *ContestOrganizerDatabaseAdapter) GetInvitesByContest(contestId int64) ([]*domain.ContestOrganizerInvite, error) {
+	var invites []*domain.ContestOrganizerInvite
+	if err := a.db.Where("contest_id = ?", contestId).Order("created_at DESC").Find(&invites).Error; err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (a *ContestOrganizerDatabaseAdapter) GetInvitesByUser(userId int64) ([]*domain.ContestOrganizerInvite, error) {
+	var invites []*domain.ContestOrganizerInvite
+	if err := a.db.Where("user_id = ?", userId).Order("created_at DESC").Find(&invites).Error; err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+func (a *ContestOrganizerDatabaseAdapter) DeleteInvite(contestId, userId int64) error {
+	result := a.db.Where("contest_id = ? AND user_id = ?", contestId, userId).Delete(&domain.ContestOrganizerInvite{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrOrganizerInviteNotFound
+	}
+
+	return nil
+}
+
+func (a *ContestOrganizerDatabaseAdapter) AcceptInvite(invite *domain.ContestOrganizerInvite) error {
+	member := domain.NewContestOrganizer(invite.UserID, invite.ContestID, invite.Role)
+	if err := member.Validate(); err != nil {
+		return err
+	}
+
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		// An existing participant keeps their points and is promoted in place
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "contest_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"member_type", "staff_role"}),
+		}).Create(member).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("contest_id = ? AND user_id = ?", invite.ContestID, invite.UserID).
+			Delete(&domain.ContestOrganizerInvite{}).Error
+	})
+}
+
+func (a *ContestOrganizerDatabaseAdapter) GetOrganizersByContest(contestId int64) ([]*domain.ContestMember, error) {
+	var members []*domain.ContestMember
+	if err := a.db.Where("contest_id = ? AND member_type = ?", contestId, domain.MemberTypeStaff).
+		Order("leader_type = 'LEADER' DESC, user_id").
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (a *ContestOrganizerDatabaseAdapter) UpdateStaffRole(contestId, userId int64, memberType domain.MemberType, role *domain.ContestRole) error {
+	result := a.db.Model(&domain.ContestMember{}).
+		Where("contest_id = ? AND user_id = ?", contestId, userId).
+		Updates(map[string]interface{}{
+			"member_type": memberType,
+			"staff_role":  role,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrContestMemberNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ContestSeriesDatabaseAdapter implements ContestSeriesDatabasePort using GORM
+type ContestSeriesDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestSeriesDatabaseAdapter(db *gorm.DB) *ContestSeriesDatabaseAdapter {
+	return &ContestSeriesDatabaseAdapter{db: db}
+}
+
+func (a *ContestSeriesDatabaseAdapter) Save(series *domain.ContestSeries) error {
+	return a.db.Create(series).Error
+}
+
+func (a *ContestSeriesDatabaseAdapter) Update(series *domain.ContestSeries) error {
+	return a.UpdateWithContext(context.Background(), series)
+}
+
+// UpdateWithContext saves the series, joining the transaction carried by ctx if any
+func (a *ContestSeriesDatabaseAdapter) UpdateWithContext(ctx context.Context, series *domain.ContestSeries) error {
+	return transaction.DB(ctx, a.db).Save(series).Error
+}
+
+func (a *ContestSeriesDatabaseAdapter) GetById(seriesId int64) (*domain.ContestSeries, error) {
+	var series domain.ContestSeries
+	if err := a.db.First(&series, "series_id = ?", seriesId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrContestSeriesNotFound
+		}
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (a *ContestSeriesDatabaseAdapter) GetByOwner(ownerUserId int64) ([]*domain.ContestSeries, error) {
+	var series []*domain.ContestSeries
+	if err := a.db.Where("owner_user_id = ?", ownerUserId).Order("created_at DESC").Find(&series).Error; err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func (a *ContestSeriesDatabaseAdapter) GetActive() ([]*domain.ContestSeries, error) {
+	var series []*domain.ContestSeries
+	if err := a.db.Where("active = ?", true).Find(&series).Error; err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func (a *ContestSeriesDatabaseAdapter) Delete(seriesId int64) error {
+	result := a.db.Where("series_id = ?", seriesId).Delete(&domain.ContestSeries{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrContestSeriesNotFound
+	}
+
+	return nil
+}
+
+func (a *ContestSeriesDatabaseAdapter) GetContestsBySeriesId(seriesId int64) ([]*domain.Contest, error) {
+	var contests []*domain.Contest
+	if err := a.db.Where("series_id = ?", seriesId).Order("started_at ASC").Find(&contests).Error; err != nil {
+		return nil, err
+	}
+	return contests, nil
+}
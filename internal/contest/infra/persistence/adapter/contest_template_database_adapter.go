@@ -0,0 +1,54 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ContestTemplateDatabaseAdapter implements ContestTemplateDatabasePort using GORM
+type ContestTemplateDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestTemplateDatabaseAdapter(db *gorm.DB) *ContestTemplateDatabaseAdapter {
+	return &ContestTemplateDatabaseAdapter{db: db}
+}
+
+func (a *ContestTemplateDatabaseAdapter) Save(template *domain.ContestTemplate) error {
+	return a.db.Create(template).Error
+}
+
+func (a *ContestTemplateDatabaseAdapter) GetById(templateId int64) (*domain.ContestTemplate, error) {
+	var template domain.ContestTemplate
+	if err := a.db.First(&template, "template_id = ?", templateId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrContestTemplateNotFound
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (a *ContestTemplateDatabaseAdapter) GetByOwner(ownerUserId int64) ([]*domain.ContestTemplate, error) {
+	var templates []*domain.ContestTemplate
+	if err := a.db.Where("owner_user_id = ?", ownerUserId).Order("created_at DESC").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (a *ContestTemplateDatabaseAdapter) Delete(templateId int64) error {
+	result := a.db.Where("template_id = ?", templateId).Delete(&domain.ContestTemplate{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrContestTemplateNotFound
+	}
+
+	return nil
+}
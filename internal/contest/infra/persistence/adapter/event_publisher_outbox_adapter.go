@@ -0,0 +1,108 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	outboxPort "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventPublisherOutboxAdapter writes contest events to the transactional outbox; the outbox relay publishes them to RabbitMQ
+type EventPublisherOutboxAdapter struct {
+	outbox   outboxPort.OutboxPort
+	exchange string
+}
+
+func NewEventPublisherOutboxAdapter(outbox outboxPort.OutboxPort, exchange string) *EventPublisherOutboxAdapter {
+	return &EventPublisherOutboxAdapter{
+		outbox:   outbox,
+		exchange: exchange,
+	}
+}
+
+func (a *EventPublisherOutboxAdapter) PublishContestApplicationEvent(
+	ctx context.Context,
+	event *port.ContestApplicationEvent,
+) error {
+	// Ensure event has ID and timestamp
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.enqueue(ctx, event.ContestID, event.EventID, event.EventType, event.Timestamp, event, map[string]interface{}{
+		"event_type":              string(event.EventType),
+		"contest_id":              event.ContestID,
+		"user_id":                 event.UserID,
+		"discord_user_id":         event.DiscordUserID,
+		"discord_guild_id":        event.DiscordGuildID,
+		"discord_text_channel_id": event.DiscordTextChannelID,
+	})
+}
+
+func (a *EventPublisherOutboxAdapter) PublishContestCreatedEvent(
+	ctx context.Context,
+	event *port.ContestCreatedEvent,
+) error {
+	// Ensure event has ID and timestamp
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.enqueue(ctx, event.ContestID, event.EventID, event.EventType, event.Timestamp, event, map[string]interface{}{
+		"event_type":       string(event.EventType),
+		"contest_id":       event.ContestID,
+		"creator_user_id":  event.CreatorUserID,
+		"discord_guild_id": event.DiscordGuildID,
+	})
+}
+
+// enqueue stores the event in the outbox, ordered with the other events of its contest
+func (a *EventPublisherOutboxAdapter) enqueue(
+	ctx context.Context,
+	contestID int64,
+	eventID string,
+	eventType port.EventType,
+	timestamp time.Time,
+	event interface{},
+	headers map[string]interface{},
+) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return a.outbox.Enqueue(ctx, &outboxPort.OutboxMessage{
+		AggregateType: outboxPort.AggregateContest,
+		AggregateID:   contestID,
+		Exchange:      a.exchange,
+		RoutingKey:    a.buildRoutingKey(eventType),
+		MessageID:     eventID,
+		Body:          body,
+		Headers:       headers,
+		OccurredAt:    timestamp,
+	})
+}
+
+func (a *EventPublisherOutboxAdapter) buildRoutingKey(eventType port.EventType) string {
+	return fmt.Sprintf("contest.%s", eventType)
+}
+
+// Close is a no-op; the RabbitMQ connection is owned by the outbox relay
+func (a *EventPublisherOutboxAdapter) Close() error {
+	return nil
+}
+
+// HealthCheck always succeeds; events are buffered in the outbox while the broker is down
+func (a *EventPublisherOutboxAdapter) HealthCheck(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,205 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestAccessController struct {
+	router  *router.Router
+	service *application.ContestAccessService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestAccessController(
+	router *router.Router,
+	service *application.ContestAccessService,
+	helper *handler.ControllerHelper,
+) *ContestAccessController {
+	return &ContestAccessController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestAccessController) RegisterRoute() {
+	joinGroup := c.router.ProtectedGroup("/api/contests/join")
+	joinGroup.POST("", c.JoinByCode)
+
+	privateGroup := c.router.ProtectedGroup("/api/contests/:id/access")
+	privateGroup.GET("", c.GetAccess)
+	privateGroup.POST("/invites", c.InviteUser)
+	privateGroup.DELETE("/invites/:userId", c.RevokeAccess)
+	privateGroup.POST("/join-code/regenerate", c.RegenerateJoinCode)
+}
+
+// JoinByCode godoc
+// @Summary Join a private contest by code
+// @Description Redeem a private contest's join code or invite link code to gain access to the contest
+// @Tags contest-access
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.JoinContestRequest true "Join request"
+// @Success 200 {object} response.Response{data=dto.ContestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/join [post]
+func (c *ContestAccessController) JoinByCode(ctx *gin.Context) {
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.JoinContestRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	contest, err := c.service.JoinByCode(userId, req.JoinCode)
+	c.helper.RespondOK(ctx, contest, err, "contest joined successfully")
+}
+
+// GetAccess godoc
+// @Summary Get contest access settings
+// @Description Get the contest's visibility, join code and the users granted access (requires MANAGE_APPLICATIONS)
+// @Tags contest-access
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=dto.ContestAccessResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/access [get]
+func (c *ContestAccessController) GetAccess(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	access, err := c.service.GetAccess(contestId, userId)
+	c.helper.RespondOK(ctx, access, err, "contest access retrieved successfully")
+}
+
+// InviteUser godoc
+// @Summary Invite a user to a private contest
+// @Description Grant a user access to a private contest and notify them (requires MANAGE_APPLICATIONS)
+// @Tags contest-access
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.InviteContestUserRequest true "Invite request"
+// @Success 201 {object} response.Response{data=domain.ContestAccessGrant}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/access/invites [post]
+func (c *ContestAccessController) InviteUser(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.InviteContestUserRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	grant, err := c.service.InviteUser(contestId, userId, &req)
+	c.helper.RespondCreated(ctx, grant, err, "user invited successfully")
+}
+
+// RevokeAccess godoc
+// @Summary Revoke a user's access to a private contest
+// @Description Remove an invite or redeemed join code; existing contest members are not removed (requires MANAGE_APPLICATIONS)
+// @Tags contest-access
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param userId path int true "User ID"
+// @Success 204
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/access/invites/{userId} [delete]
+func (c *ContestAccessController) RevokeAccess(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	targetUserId, err := strconv.ParseInt(ctx.Param("userId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.RevokeAccess(contestId, targetUserId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// RegenerateJoinCode godoc
+// @Summary Regenerate a private contest's join code
+// @Description Issue a new join code, invalidating the old code and invite links (requires MANAGE_CONTEST)
+// @Tags contest-access
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=dto.ContestAccessResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/access/join-code/regenerate [post]
+func (c *ContestAccessController) RegenerateJoinCode(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	access, err := c.service.RegenerateJoinCode(contestId, userId)
+	c.helper.RespondOK(ctx, access, err, "join code regenerated successfully")
+}
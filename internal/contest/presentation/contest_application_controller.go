@@ -61,6 +61,7 @@ func (c *ContestApplicationController) RegisterRoute() {
 // @Produce json
 // @Security BearerAuth
 // @Param contestId path int true "Contest ID"
+// @Param request body dto.ParticipateRequest false "Application form answers"
 // @Success 201 {object} response.Response
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
@@ -80,7 +81,13 @@ func (c *ContestApplicationController) RequestParticipate(ctx *gin.Context) {
 		return
 	}
 
-	discordLinkRequired, err := c.service.RequestParticipate(ctx.Request.Context(), contestId, userId)
+	// The body is optional: contests without an application form take no answers
+	var req dto.ParticipateRequest
+	if ctx.Request.ContentLength > 0 && !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	discordLinkRequired, err := c.service.RequestParticipate(ctx.Request.Context(), contestId, userId, req.ToAnswers())
 
 	// Handle Discord link required error
 	if errors.Is(err, exception.ErrDiscordLinkRequired) {
@@ -93,7 +100,7 @@ func (c *ContestApplicationController) RequestParticipate(ctx *gin.Context) {
 
 // GetPendingApplications godoc
 // @Summary Get pending applications for a contest
-// @Description Get all pending applications for a contest (Leader only)
+// @Description Get all pending applications for a contest with their application form answers (Leader only)
 // @Tags contest-applications
 // @Accept json
 // @Produce json
@@ -146,7 +153,7 @@ func (c *ContestApplicationController) GetMyApplication(ctx *gin.Context) {
 
 // AcceptApplication godoc
 // @Summary Accept a contest application
-// @Description Accept a user's application to join the contest (Leader only)
+// @Description Accept a user's application to join the contest (requires MANAGE_APPLICATIONS)
 // @Tags contest-applications
 // @Accept json
 // @Produce json
@@ -182,7 +189,7 @@ func (c *ContestApplicationController) AcceptApplication(ctx *gin.Context) {
 
 // RejectApplication godoc
 // @Summary Reject a contest application
-// @Description Reject a user's application to join the contest (Leader only)
+// @Description Reject a user's application to join the contest (requires MANAGE_APPLICATIONS)
 // @Tags contest-applications
 // @Accept json
 // @Produce json
@@ -278,8 +285,8 @@ func (c *ContestApplicationController) WithdrawFromContest(ctx *gin.Context) {
 }
 
 // ChangeMemberRole godoc
-// @Summary Change a member's role (Leader only)
-// @Description Promote a member to STAFF or demote STAFF to NORMAL (Leader only, cannot change leader's role)
+// @Summary Change a member's role (Owner only)
+// @Description Promote a member to STAFF or demote STAFF to NORMAL (Owner only, cannot change leader's role)
 // @Tags contest-members
 // @Accept json
 // @Produce json
@@ -369,6 +376,7 @@ func (c *ContestApplicationController) GetMyContestStatus(ctx *gin.Context) {
 // @Param page_size query int false "Page size (default: 10, max: 100)" minimum(1) maximum(100)
 // @Param sort_by query string false "Sort field (point, username)" default(point)
 // @Param order query string false "Sort order (asc, desc)" default(desc)
+// @Param cursor query string false "Keyset cursor from next_cursor; send empty for the first page. Switches the response to CursorResponse and ignores page"
 // @Success 200 {object} response.Response{data=commonDto.PaginationResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
@@ -381,6 +389,8 @@ func (c *ContestApplicationController) GetContestMembers(ctx *gin.Context) {
 		return
 	}
 
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
 	// Parse pagination parameters
 	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
@@ -391,7 +401,19 @@ func (c *ContestApplicationController) GetContestMembers(ctx *gin.Context) {
 	order := ctx.DefaultQuery("order", "desc")
 	sort := commonDto.NewSortRequest(sortBy, order, []string{"point", "username"})
 
-	result, err := c.service.GetContestMembers(ctx.Request.Context(), contestId, pagination, sort)
+	if encoded, ok := ctx.GetQuery("cursor"); ok {
+		cursor, err := commonDto.NewCursorRequest(encoded, pageSize, sort)
+		if err != nil {
+			c.helper.HandleError(ctx, err)
+			return
+		}
+
+		result, err := c.service.GetContestMembersByCursor(ctx.Request.Context(), contestId, userId, cursor)
+		c.helper.RespondOK(ctx, result, err, "members retrieved successfully")
+		return
+	}
+
+	result, err := c.service.GetContestMembers(ctx.Request.Context(), contestId, userId, pagination, sort)
 	c.helper.RespondOK(ctx, result, err, "members retrieved successfully")
 }
 
@@ -513,6 +535,8 @@ func HandleGetContestMembers(ctx *gin.Context, service *application.ContestAppli
 		return
 	}
 
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
 	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
 	pagination := commonDto.NewPaginationRequest(page, pageSize)
@@ -521,6 +545,6 @@ func HandleGetContestMembers(ctx *gin.Context, service *application.ContestAppli
 	order := ctx.DefaultQuery("order", "desc")
 	sort := commonDto.NewSortRequest(sortBy, order, []string{"point", "username"})
 
-	result, err := service.GetContestMembers(ctx.Request.Context(), contestId, pagination, sort)
+	result, err := service.GetContestMembers(ctx.Request.Context(), contestId, userId, pagination, sort)
 	helper.RespondOK(ctx, result, err, "members retrieved successfully")
 }
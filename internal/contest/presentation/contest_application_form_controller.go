@@ -0,0 +1,172 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestApplicationFormController struct {
+	router  *router.Router
+	service *application.ContestApplicationFormService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestApplicationFormController(
+	router *router.Router,
+	service *application.ContestApplicationFormService,
+	helper *handler.ControllerHelper,
+) *ContestApplicationFormController {
+	return &ContestApplicationFormController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestApplicationFormController) RegisterRoute() {
+	formGroup := c.router.ProtectedGroup("/api/contests/:id/application-form")
+	formGroup.PUT("", c.SaveForm)
+	formGroup.GET("", c.GetForm)
+	formGroup.DELETE("", c.DeleteForm)
+	formGroup.GET("/answers/export", c.ExportAnswers)
+}
+
+// SaveForm godoc
+// @Summary Create or replace the application form
+// @Description Define typed questions (text, choice, number) applicants answer with their application (requires MANAGE_APPLICATIONS, before start)
+// @Tags contest-application-forms
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.SaveApplicationFormRequest true "Form questions"
+// @Success 200 {object} response.Response{data=dto.ApplicationFormResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/application-form [put]
+func (c *ContestApplicationFormController) SaveForm(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.SaveApplicationFormRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	form, err := c.service.SaveForm(contestId, userId, &req)
+	c.helper.RespondOK(ctx, form, err, "application form saved successfully")
+}
+
+// GetForm godoc
+// @Summary Get the application form
+// @Description Get the questions applicants answer when applying to the contest (private contests require view access)
+// @Tags contest-application-forms
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=dto.ApplicationFormResponse}
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/application-form [get]
+func (c *ContestApplicationFormController) GetForm(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	form, err := c.service.GetForm(contestId, userId)
+	c.helper.RespondOK(ctx, form, err, "application form retrieved successfully")
+}
+
+// DeleteForm godoc
+// @Summary Delete the application form
+// @Description Remove the application form so applications no longer need answers (requires MANAGE_APPLICATIONS, before start)
+// @Tags contest-application-forms
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/application-form [delete]
+func (c *ContestApplicationFormController) DeleteForm(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.DeleteForm(contestId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// ExportAnswers godoc
+// @Summary Export application answers
+// @Description Download every applicant's form answers as CSV (requires MANAGE_APPLICATIONS)
+// @Tags contest-application-forms
+// @Produce text/csv
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/application-form/answers/export [get]
+func (c *ContestApplicationFormController) ExportAnswers(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	data, err := c.service.ExportAnswers(contestId, userId)
+	if err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=contest_%d_applications.csv", contestId))
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", data)
+}
@@ -0,0 +1,164 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestAutoAcceptController struct {
+	router  *router.Router
+	service *application.ContestAutoAcceptService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestAutoAcceptController(
+	router *router.Router,
+	service *application.ContestAutoAcceptService,
+	helper *handler.ControllerHelper,
+) *ContestAutoAcceptController {
+	return &ContestAutoAcceptController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestAutoAcceptController) RegisterRoute() {
+	autoAcceptGroup := c.router.ProtectedGroup("/api/contests/:id/auto-accept")
+	autoAcceptGroup.PUT("", c.SaveRule)
+	autoAcceptGroup.GET("", c.GetRule)
+	autoAcceptGroup.DELETE("", c.DeleteRule)
+	autoAcceptGroup.GET("/decisions", c.GetDecisions)
+}
+
+// SaveRule godoc
+// @Summary Create or replace the auto-accept rule
+// @Description Configure tier range, Riot account, Discord membership and cap criteria that accept applications on submit (requires MANAGE_APPLICATIONS, before start)
+// @Tags contest-auto-accept
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.SaveAutoAcceptRuleRequest true "Auto-accept rule"
+// @Success 200 {object} response.Response{data=domain.ContestAutoAcceptRule}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/auto-accept [put]
+func (c *ContestAutoAcceptController) SaveRule(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.SaveAutoAcceptRuleRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	rule, err := c.service.SaveRule(contestId, userId, &req)
+	c.helper.RespondOK(ctx, rule, err, "auto-accept rule saved successfully")
+}
+
+// GetRule godoc
+// @Summary Get the auto-accept rule
+// @Description Get the auto-accept rule of the contest (requires MANAGE_APPLICATIONS)
+// @Tags contest-auto-accept
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=domain.ContestAutoAcceptRule}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/auto-accept [get]
+func (c *ContestAutoAcceptController) GetRule(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	rule, err := c.service.GetRule(contestId, userId)
+	c.helper.RespondOK(ctx, rule, err, "auto-accept rule retrieved successfully")
+}
+
+// DeleteRule godoc
+// @Summary Delete the auto-accept rule
+// @Description Remove the auto-accept rule so every application waits for the leader (requires MANAGE_APPLICATIONS)
+// @Tags contest-auto-accept
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/auto-accept [delete]
+func (c *ContestAutoAcceptController) DeleteRule(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.DeleteRule(contestId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// GetDecisions godoc
+// @Summary List automatic decisions
+// @Description List every decision the auto-accept rule made with its reason (requires MANAGE_APPLICATIONS)
+// @Tags contest-auto-accept
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=[]domain.ContestAutoAcceptDecision}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/auto-accept/decisions [get]
+func (c *ContestAutoAcceptController) GetDecisions(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	decisions, err := c.service.GetDecisions(contestId, userId)
+	c.helper.RespondOK(ctx, decisions, err, "auto-accept decisions retrieved successfully")
+}
@@ -39,10 +39,13 @@ func (c *ContestController) RegisterRoute() {
 	privateGroup.DELETE("/:id", c.DeleteContest)
 	privateGroup.POST("/:id/start", c.StartContest)
 	privateGroup.POST("/:id/stop", c.StopContest)
+	privateGroup.POST("/:id/cancel", c.CancelContest)
 
 	publicGroup := c.router.PublicGroup("/api/contests")
 	publicGroup.GET("", c.GetAllContests)
-	publicGroup.GET("/:id", c.GetContestById)
+
+	viewerGroup := c.router.OptionalAuthGroup("/api/contests")
+	viewerGroup.GET("/:id", c.GetContestById)
 }
 
 // SaveContest godoc
@@ -93,6 +96,7 @@ func (c *ContestController) SaveContest(ctx *gin.Context) {
 // @Param sort_by query string false "Sort field: created_at, started_at, ended_at (default: created_at)"
 // @Param order query string false "Sort order: asc, desc (default: desc)"
 // @Param title query string false "Search by contest title (partial match)"
+// @Param cursor query string false "Keyset cursor from next_cursor; send empty for the first page. Switches the response to CursorResponse and ignores page"
 // @Success 200 {object} response.Response{data=commonDto.PaginationResponse}
 // @Failure 400 {object} response.Response
 // @Router /api/contests [get]
@@ -112,6 +116,19 @@ func (c *ContestController) GetAllContests(ctx *gin.Context) {
 	allowedSortFields := []string{"created_at", "started_at", "ended_at"}
 	sortReq := commonDto.NewSortRequest(sortBy, order, allowedSortFields)
 
+	// Cursor mode is opt-in so existing page-based clients keep their response shape
+	if encoded, ok := ctx.GetQuery("cursor"); ok {
+		cursorReq, err := commonDto.NewCursorRequest(encoded, pageSize, sortReq)
+		if err != nil {
+			c.helper.HandleError(ctx, err)
+			return
+		}
+
+		contests, nextCursor, err := c.service.GetAllContestsByCursor(cursorReq, title)
+		c.helper.RespondOK(ctx, commonDto.NewCursorResponse(contests, nextCursor, cursorReq.Limit), err, "contests retrieved successfully")
+		return
+	}
+
 	contests, totalCount, err := c.service.GetAllContests(paginationReq.GetOffset(), paginationReq.GetLimit(), sortReq, title)
 	if err != nil {
 		response.JSON(ctx, response.Error(400, err.Error()))
@@ -124,10 +141,11 @@ func (c *ContestController) GetAllContests(ctx *gin.Context) {
 
 // GetContestById godoc
 // @Summary Get a contest by ID
-// @Description Get contest details by contest ID
+// @Description Get contest details by contest ID. Private contests are only visible to members and invited users; others get 404.
 // @Tags contests
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path int true "Contest ID"
 // @Success 200 {object} response.Response{data=dto.ContestResponse}
 // @Failure 400 {object} response.Response
@@ -140,7 +158,10 @@ func (c *ContestController) GetContestById(ctx *gin.Context) {
 		return
 	}
 
-	contest, err := c.service.GetContestById(contestId)
+	// Anonymous viewers resolve to 0 and can only read non-private contests
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
+	contest, err := c.service.GetContestForViewer(contestId, userId)
 	c.helper.RespondOK(ctx, contest, err, "contest retrieved successfully")
 }
 
@@ -203,7 +224,7 @@ func (c *ContestController) DeleteContest(ctx *gin.Context) {
 
 // StartContest godoc
 // @Summary Start a contest
-// @Description Start a contest and migrate accepted applications to config (Leader only)
+// @Description Start a contest and migrate accepted applications to config (requires MANAGE_CONTEST)
 // @Tags contests
 // @Accept json
 // @Produce json
@@ -234,7 +255,7 @@ func (c *ContestController) StartContest(ctx *gin.Context) {
 
 // StopContest godoc
 // @Summary Stop a contest
-// @Description Stop an active contest and transition it to finished status (Leader only)
+// @Description Stop an active contest and transition it to finished status (requires MANAGE_CONTEST)
 // @Tags contests
 // @Accept json
 // @Produce json
@@ -263,6 +284,44 @@ func (c *ContestController) StopContest(ctx *gin.Context) {
 	c.helper.RespondOK(ctx, contest, err, "contest stopped successfully")
 }
 
+// CancelContest godoc
+// @Summary Cancel a contest
+// @Description Cancel a pending or active contest with a reason. Cancels its games, clears applications and team caches and notifies participants (requires MANAGE_CONTEST)
+// @Tags contests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param request body dto.CancelContestRequest true "Cancel reason"
+// @Success 200 {object} response.Response{data=dto.ContestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{id}/cancel [post]
+func (c *ContestController) CancelContest(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.CancelContestRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	contest, err := c.service.CancelContest(ctx.Request.Context(), id, userId, req.Reason)
+	c.helper.RespondOK(ctx, contest, err, "contest cancelled successfully")
+}
+
 // GetMyContests godoc
 // @Summary Get contests I have joined
 // @Description Get all contests that the authenticated user has joined with pagination, sorting, and filtering support
@@ -0,0 +1,73 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestDiscoveryController struct {
+	router  *router.Router
+	service *application.ContestDiscoveryService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestDiscoveryController(
+	router *router.Router,
+	service *application.ContestDiscoveryService,
+	helper *handler.ControllerHelper,
+) *ContestDiscoveryController {
+	return &ContestDiscoveryController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestDiscoveryController) RegisterRoute() {
+	viewerGroup := c.router.OptionalAuthGroup("/api/contests/discover")
+	viewerGroup.GET("", c.Discover)
+}
+
+// Discover godoc
+// @Summary Discover contests
+// @Description Search listed contests with filters, full-text search over title and description, and facet counts per filter.
+// @Description Facet counts apply every filter except their own. eligible_only and sort=recommended require login.
+// @Tags contests
+// @Produce json
+// @Security BearerAuth
+// @Param q query string false "Search text (full-text from 3 characters, partial match below)"
+// @Param game_type query []string false "Game types (VALORANT, LOL)" collectionFormat(multi)
+// @Param contest_type query []string false "Contest types (TOURNAMENT, LEAGUE, CASUAL)" collectionFormat(multi)
+// @Param status query []string false "Contest statuses (PENDING, ACTIVE, FINISHED, CANCELLED)" collectionFormat(multi)
+// @Param starts_from query string false "Earliest start time (RFC3339)"
+// @Param starts_to query string false "Latest start time (RFC3339)"
+// @Param team_size query int false "Players per team"
+// @Param open_slots query bool false "Only contests with free team slots"
+// @Param eligible_only query bool false "Only contests whose region, tier and account age constraints the caller meets"
+// @Param sort query string false "recommended, relevance, created_at, started_at, ended_at (default: relevance when searching, recommended when signed in, created_at otherwise)"
+// @Param order query string false "Sort order for date sorts: asc, desc (default: desc)"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.Response{data=dto.ContestDiscoveryResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/contests/discover [get]
+func (c *ContestDiscoveryController) Discover(ctx *gin.Context) {
+	var req dto.ContestDiscoveryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	// Anonymous callers resolve to 0
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
+	result, err := c.service.Discover(&req, userId)
+	c.helper.RespondOK(ctx, result, err, "contests discovered successfully")
+}
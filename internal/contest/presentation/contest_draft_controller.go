@@ -0,0 +1,164 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestDraftController struct {
+	router  *router.Router
+	service *application.ContestDraftService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestDraftController(
+	router *router.Router,
+	service *application.ContestDraftService,
+	helper *handler.ControllerHelper,
+) *ContestDraftController {
+	return &ContestDraftController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestDraftController) RegisterRoute() {
+	draftGroup := c.router.ProtectedGroup("/api/contests/:id/draft")
+	draftGroup.POST("", c.StartDraft)
+	draftGroup.GET("", c.GetDraft)
+	draftGroup.POST("/picks", c.MakePick)
+	draftGroup.DELETE("", c.CancelDraft)
+}
+
+// StartDraft godoc
+// @Summary Start a captain draft
+// @Description Staff selects captains from accepted applicants and starts a snake-order draft (casual contests only)
+// @Tags contest-drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.StartDraftRequest true "Captains and pick clock"
+// @Success 201 {object} response.Response{data=dto.DraftResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{contestId}/draft [post]
+func (c *ContestDraftController) StartDraft(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.StartDraftRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	draft, err := c.service.StartDraft(ctx.Request.Context(), contestId, userId, &req)
+	c.helper.RespondCreated(ctx, draft, err, "draft started successfully")
+}
+
+// GetDraft godoc
+// @Summary Get captain draft state
+// @Description Get the current draft board, pick clock and available players
+// @Tags contest-drafts
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=dto.DraftResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/draft [get]
+func (c *ContestDraftController) GetDraft(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	draft, err := c.service.GetDraft(ctx.Request.Context(), contestId)
+	c.helper.RespondOK(ctx, draft, err, "draft retrieved successfully")
+}
+
+// MakePick godoc
+// @Summary Pick a player
+// @Description The captain on the clock picks an available player
+// @Tags contest-drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.DraftPickRequest true "Player to pick"
+// @Success 200 {object} response.Response{data=dto.DraftResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{contestId}/draft/picks [post]
+func (c *ContestDraftController) MakePick(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.DraftPickRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	draft, err := c.service.MakePick(ctx.Request.Context(), contestId, userId, req.PlayerUserID)
+	c.helper.RespondOK(ctx, draft, err, "player picked successfully")
+}
+
+// CancelDraft godoc
+// @Summary Cancel a captain draft
+// @Description Staff cancels the draft in progress without creating teams
+// @Tags contest-drafts
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/draft [delete]
+func (c *ContestDraftController) CancelDraft(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.CancelDraft(ctx.Request.Context(), contestId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
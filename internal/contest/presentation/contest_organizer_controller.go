@@ -0,0 +1,361 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestOrganizerController struct {
+	router  *router.Router
+	service *application.ContestOrganizerService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestOrganizerController(
+	router *router.Router,
+	service *application.ContestOrganizerService,
+	helper *handler.ControllerHelper,
+) *ContestOrganizerController {
+	return &ContestOrganizerController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestOrganizerController) RegisterRoute() {
+	privateGroup := c.router.ProtectedGroup("/api/contests/:id/organizers")
+	privateGroup.GET("/me", c.GetMyRole)
+	privateGroup.PATCH("/:userId", c.ChangeOrganizerRole)
+	privateGroup.DELETE("/:userId", c.RevokeOrganizer)
+	privateGroup.POST("/invites", c.InviteOrganizer)
+	privateGroup.GET("/invites", c.GetContestInvites)
+	privateGroup.DELETE("/invites/:userId", c.CancelInvite)
+	privateGroup.POST("/invites/accept", c.AcceptInvite)
+	privateGroup.POST("/invites/decline", c.DeclineInvite)
+
+	viewerGroup := c.router.OptionalAuthGroup("/api/contests/:id/organizers")
+	viewerGroup.GET("", c.GetOrganizers)
+
+	myInvitesGroup := c.router.ProtectedGroup("/api/contest-organizer-invites")
+	myInvitesGroup.GET("", c.GetMyInvites)
+}
+
+// InviteOrganizer godoc
+// @Summary Invite a co-organizer
+// @Description Invite a user to organize the contest as ADMIN, REFEREE or MODERATOR (requires MANAGE_ORGANIZERS)
+// @Tags contest-organizers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.InviteOrganizerRequest true "Invite request"
+// @Success 201 {object} response.Response{data=domain.ContestOrganizerInvite}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/invites [post]
+func (c *ContestOrganizerController) InviteOrganizer(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.InviteOrganizerRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	invite, err := c.service.InviteOrganizer(contestId, userId, &req)
+	c.helper.RespondCreated(ctx, invite, err, "organizer invited successfully")
+}
+
+// GetOrganizers godoc
+// @Summary List contest organizers
+// @Description List the owner and staff of the contest with their roles and granted actions
+// @Tags contest-organizers
+// @Produce json
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=[]dto.ContestOrganizerResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/organizers [get]
+func (c *ContestOrganizerController) GetOrganizers(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
+	organizers, err := c.service.GetOrganizers(contestId, userId)
+	c.helper.RespondOK(ctx, organizers, err, "organizers retrieved successfully")
+}
+
+// GetMyRole godoc
+// @Summary Get my contest role
+// @Description Get the caller's organizer role on the contest and the actions it grants
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=dto.ContestOrganizerResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/me [get]
+func (c *ContestOrganizerController) GetMyRole(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	role, err := c.service.GetMyRole(contestId, userId)
+	c.helper.RespondOK(ctx, role, err, "contest role retrieved successfully")
+}
+
+// GetContestInvites godoc
+// @Summary List pending organizer invites
+// @Description List the pending co-organizer invites of the contest (requires MANAGE_ORGANIZERS)
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=[]domain.ContestOrganizerInvite}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/invites [get]
+func (c *ContestOrganizerController) GetContestInvites(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	invites, err := c.service.GetContestInvites(contestId, userId)
+	c.helper.RespondOK(ctx, invites, err, "organizer invites retrieved successfully")
+}
+
+// GetMyInvites godoc
+// @Summary List my organizer invites
+// @Description List the co-organizer invites the caller has received
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=[]domain.ContestOrganizerInvite}
+// @Failure 401 {object} response.Response
+// @Router /api/contest-organizer-invites [get]
+func (c *ContestOrganizerController) GetMyInvites(ctx *gin.Context) {
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	invites, err := c.service.GetMyInvites(userId)
+	c.helper.RespondOK(ctx, invites, err, "organizer invites retrieved successfully")
+}
+
+// AcceptInvite godoc
+// @Summary Accept an organizer invite
+// @Description Join the contest staff with the invited role
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 200 {object} response.Response{data=dto.ContestOrganizerResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/invites/accept [post]
+func (c *ContestOrganizerController) AcceptInvite(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	organizer, err := c.service.AcceptInvite(contestId, userId)
+	c.helper.RespondOK(ctx, organizer, err, "organizer invite accepted successfully")
+}
+
+// DeclineInvite godoc
+// @Summary Decline an organizer invite
+// @Description Decline a co-organizer invite received for the contest
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/invites/decline [post]
+func (c *ContestOrganizerController) DeclineInvite(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.DeclineInvite(contestId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// CancelInvite godoc
+// @Summary Cancel an organizer invite
+// @Description Withdraw a pending co-organizer invite (requires MANAGE_ORGANIZERS)
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param userId path int true "Invited user ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/invites/{userId} [delete]
+func (c *ContestOrganizerController) CancelInvite(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	targetUserId, err := strconv.ParseInt(ctx.Param("userId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.CancelInvite(contestId, targetUserId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// ChangeOrganizerRole godoc
+// @Summary Change an organizer's role
+// @Description Re-assign an organizer to ADMIN, REFEREE or MODERATOR (requires MANAGE_ORGANIZERS, the owner cannot be changed)
+// @Tags contest-organizers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param userId path int true "Organizer user ID"
+// @Param request body dto.ChangeOrganizerRoleRequest true "New role"
+// @Success 200 {object} response.Response{data=dto.ContestOrganizerResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/{userId} [patch]
+func (c *ContestOrganizerController) ChangeOrganizerRole(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	targetUserId, err := strconv.ParseInt(ctx.Param("userId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.ChangeOrganizerRoleRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	organizer, err := c.service.ChangeOrganizerRole(contestId, targetUserId, userId, req.Role)
+	c.helper.RespondOK(ctx, organizer, err, "organizer role changed successfully")
+}
+
+// RevokeOrganizer godoc
+// @Summary Revoke an organizer
+// @Description Remove an organizer from the contest staff, leaving them a normal member (requires MANAGE_ORGANIZERS)
+// @Tags contest-organizers
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param userId path int true "Organizer user ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{contestId}/organizers/{userId} [delete]
+func (c *ContestOrganizerController) RevokeOrganizer(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	targetUserId, err := strconv.ParseInt(ctx.Param("userId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.RevokeOrganizer(contestId, targetUserId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
@@ -0,0 +1,190 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestSeriesController struct {
+	router  *router.Router
+	service *application.ContestSeriesService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestSeriesController(
+	router *router.Router,
+	service *application.ContestSeriesService,
+	helper *handler.ControllerHelper,
+) *ContestSeriesController {
+	return &ContestSeriesController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestSeriesController) RegisterRoute() {
+	privateGroup := c.router.ProtectedGroup("/api/contest-series")
+	privateGroup.POST("", c.CreateSeries)
+	privateGroup.GET("/me", c.GetMySeries)
+	privateGroup.DELETE("/:seriesId", c.DeleteSeries)
+
+	publicGroup := c.router.PublicGroup("/api/contest-series")
+	publicGroup.GET("/:seriesId", c.GetSeries)
+	publicGroup.GET("/:seriesId/contests", c.GetSeriesContests)
+
+	viewerGroup := c.router.OptionalAuthGroup("/api/contest-series")
+	viewerGroup.GET("/:seriesId/standings", c.GetStandings)
+}
+
+// CreateSeries godoc
+// @Summary Create a contest series
+// @Description Create a recurring series from a template; upcoming contests are created automatically (Template owner only)
+// @Tags contest-series
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateContestSeriesRequest true "Series configuration"
+// @Success 201 {object} response.Response{data=domain.ContestSeries}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-series [post]
+func (c *ContestSeriesController) CreateSeries(ctx *gin.Context) {
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.CreateContestSeriesRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	series, err := c.service.CreateSeries(userId, &req)
+	c.helper.RespondCreated(ctx, series, err, "contest series created successfully")
+}
+
+// GetMySeries godoc
+// @Summary Get my contest series
+// @Description Get the contest series created by the current user
+// @Tags contest-series
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=[]domain.ContestSeries}
+// @Failure 401 {object} response.Response
+// @Router /api/contest-series/me [get]
+func (c *ContestSeriesController) GetMySeries(ctx *gin.Context) {
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	series, err := c.service.GetMySeries(userId)
+	c.helper.RespondOK(ctx, series, err, "contest series retrieved successfully")
+}
+
+// DeleteSeries godoc
+// @Summary Delete a contest series
+// @Description Stop a series and remove it; contests already created are kept (Owner only)
+// @Tags contest-series
+// @Produce json
+// @Security BearerAuth
+// @Param seriesId path int true "Series ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-series/{seriesId} [delete]
+func (c *ContestSeriesController) DeleteSeries(ctx *gin.Context) {
+	seriesId, err := strconv.ParseInt(ctx.Param("seriesId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid series id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.DeleteSeries(seriesId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// GetSeries godoc
+// @Summary Get a contest series
+// @Description Get a contest series with its recurrence rule and points table
+// @Tags contest-series
+// @Produce json
+// @Param seriesId path int true "Series ID"
+// @Success 200 {object} response.Response{data=domain.ContestSeries}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-series/{seriesId} [get]
+func (c *ContestSeriesController) GetSeries(ctx *gin.Context) {
+	seriesId, err := strconv.ParseInt(ctx.Param("seriesId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid series id"))
+		return
+	}
+
+	series, err := c.service.GetSeries(seriesId)
+	c.helper.RespondOK(ctx, series, err, "contest series retrieved successfully")
+}
+
+// GetSeriesContests godoc
+// @Summary Get the contests of a series
+// @Description Get every contest created for the series in start order
+// @Tags contest-series
+// @Produce json
+// @Param seriesId path int true "Series ID"
+// @Success 200 {object} response.Response{data=[]dto.ContestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-series/{seriesId}/contests [get]
+func (c *ContestSeriesController) GetSeriesContests(ctx *gin.Context) {
+	seriesId, err := strconv.ParseInt(ctx.Param("seriesId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid series id"))
+		return
+	}
+
+	contests, err := c.service.GetSeriesContests(seriesId)
+	c.helper.RespondOK(ctx, contests, err, "series contests retrieved successfully")
+}
+
+// GetStandings godoc
+// @Summary Get season standings
+// @Description Add up player placements of every finished contest in the series using the series points table
+// @Tags contest-series
+// @Produce json
+// @Param seriesId path int true "Series ID"
+// @Success 200 {object} response.Response{data=dto.SeriesStandingsResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-series/{seriesId}/standings [get]
+func (c *ContestSeriesController) GetStandings(ctx *gin.Context) {
+	seriesId, err := strconv.ParseInt(ctx.Param("seriesId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid series id"))
+		return
+	}
+
+	userId, _ := middleware.GetUserIdFromContext(ctx)
+
+	standings, err := c.service.GetStandings(seriesId, userId)
+	c.helper.RespondOK(ctx, standings, err, "series standings retrieved successfully")
+}
@@ -0,0 +1,246 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestTemplateController struct {
+	router  *router.Router
+	service *application.ContestTemplateService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestTemplateController(
+	router *router.Router,
+	service *application.ContestTemplateService,
+	helper *handler.ControllerHelper,
+) *ContestTemplateController {
+	return &ContestTemplateController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestTemplateController) RegisterRoute() {
+	contestGroup := c.router.ProtectedGroup("/api/contests/:id")
+	contestGroup.POST("/templates", c.SaveAsTemplate)
+	contestGroup.POST("/clone", c.CloneContest)
+
+	templateGroup := c.router.ProtectedGroup("/api/contest-templates")
+	templateGroup.GET("", c.GetMyTemplates)
+	templateGroup.GET("/:templateId", c.GetTemplate)
+	templateGroup.DELETE("/:templateId", c.DeleteTemplate)
+	templateGroup.POST("/:templateId/contests", c.CreateFromTemplate)
+}
+
+// SaveAsTemplate godoc
+// @Summary Save a contest as a template
+// @Description Save the reusable settings of a contest (type, point table, Discord channel, thumbnail, description, eligibility) as a template (requires MANAGE_CONTEST)
+// @Tags contest-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.SaveContestTemplateRequest true "Template name"
+// @Success 201 {object} response.Response{data=domain.ContestTemplate}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/templates [post]
+func (c *ContestTemplateController) SaveAsTemplate(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.SaveContestTemplateRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	template, err := c.service.SaveAsTemplate(contestId, userId, &req)
+	c.helper.RespondCreated(ctx, template, err, "contest template saved successfully")
+}
+
+// CloneContest godoc
+// @Summary Clone a contest
+// @Description Create a new contest with the settings of an existing contest and a new schedule (requires MANAGE_CONTEST)
+// @Tags contest-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param request body dto.ContestScheduleRequest true "Schedule of the new contest"
+// @Success 201 {object} response.Response{data=dto.ContestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response{data=dto.DiscordLinkRequiredResponse}
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/clone [post]
+func (c *ContestTemplateController) CloneContest(ctx *gin.Context) {
+	contestId, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.ContestScheduleRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	contest, discordLinkRequired, err := c.service.CloneContest(contestId, userId, &req)
+	if errors.Is(err, exception.ErrDiscordLinkRequired) {
+		response.JSON(ctx, response.Forbidden(discordLinkRequired, "discord linking required"))
+		return
+	}
+
+	c.helper.RespondCreated(ctx, contest, err, "contest cloned successfully")
+}
+
+// GetMyTemplates godoc
+// @Summary Get my contest templates
+// @Description Get the contest templates saved by the current user
+// @Tags contest-templates
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=[]domain.ContestTemplate}
+// @Failure 401 {object} response.Response
+// @Router /api/contest-templates [get]
+func (c *ContestTemplateController) GetMyTemplates(ctx *gin.Context) {
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	templates, err := c.service.GetMyTemplates(userId)
+	c.helper.RespondOK(ctx, templates, err, "contest templates retrieved successfully")
+}
+
+// GetTemplate godoc
+// @Summary Get a contest template
+// @Description Get a contest template (Owner only)
+// @Tags contest-templates
+// @Produce json
+// @Security BearerAuth
+// @Param templateId path int true "Template ID"
+// @Success 200 {object} response.Response{data=domain.ContestTemplate}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-templates/{templateId} [get]
+func (c *ContestTemplateController) GetTemplate(ctx *gin.Context) {
+	templateId, err := strconv.ParseInt(ctx.Param("templateId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid template id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	template, err := c.service.GetTemplate(templateId, userId)
+	c.helper.RespondOK(ctx, template, err, "contest template retrieved successfully")
+}
+
+// DeleteTemplate godoc
+// @Summary Delete a contest template
+// @Description Delete a contest template (Owner only)
+// @Tags contest-templates
+// @Produce json
+// @Security BearerAuth
+// @Param templateId path int true "Template ID"
+// @Success 204 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contest-templates/{templateId} [delete]
+func (c *ContestTemplateController) DeleteTemplate(ctx *gin.Context) {
+	templateId, err := strconv.ParseInt(ctx.Param("templateId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid template id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	err = c.service.DeleteTemplate(templateId, userId)
+	c.helper.RespondNoContent(ctx, err)
+}
+
+// CreateFromTemplate godoc
+// @Summary Create a contest from a template
+// @Description Create a new contest with the template settings and the given schedule (Owner only)
+// @Tags contest-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param templateId path int true "Template ID"
+// @Param request body dto.ContestScheduleRequest true "Schedule of the new contest"
+// @Success 201 {object} response.Response{data=dto.ContestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response{data=dto.DiscordLinkRequiredResponse}
+// @Failure 404 {object} response.Response
+// @Router /api/contest-templates/{templateId}/contests [post]
+func (c *ContestTemplateController) CreateFromTemplate(ctx *gin.Context) {
+	templateId, err := strconv.ParseInt(ctx.Param("templateId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid template id"))
+		return
+	}
+
+	userId, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req dto.ContestScheduleRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	contest, discordLinkRequired, err := c.service.CreateFromTemplate(templateId, userId, &req)
+	if errors.Is(err, exception.ErrDiscordLinkRequired) {
+		response.JSON(ctx, response.Forbidden(discordLinkRequired, "discord linking required"))
+		return
+	}
+
+	c.helper.RespondCreated(ctx, contest, err, "contest created successfully")
+}
@@ -11,8 +11,8 @@ import (
 	gamePort "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
-	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
 	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
+	outboxPort "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
 	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
 
 	"github.com/redis/go-redis/v9"
@@ -23,14 +23,31 @@ type Dependencies struct {
 	Controller            *presentation.ContestController
 	ApplicationController *presentation.ContestApplicationController
 	ContestRepository     port.ContestDatabasePort
+	MemberRepository      port.ContestMemberDatabasePort
 	ContestService        *application.ContestService
 	ApplicationService    *application.ContestApplicationService
+	DraftController       *presentation.ContestDraftController
+	DraftService          *application.ContestDraftService
+	FormController        *presentation.ContestApplicationFormController
+	LifecycleService      *application.ContestLifecycleService
+	AutoAcceptController  *presentation.ContestAutoAcceptController
+	EligibilityChecker    port.ContestEligibilityPort
+	TemplateController    *presentation.ContestTemplateController
+	SeriesController      *presentation.ContestSeriesController
+	SeriesService         *application.ContestSeriesService
+	OrganizerController   *presentation.ContestOrganizerController
+	OrganizerService      *application.ContestOrganizerService
+	PermissionChecker     port.ContestPermissionPort
+	AccessController      *presentation.ContestAccessController
+	AccessService         *application.ContestAccessService
+	DiscoveryController   *presentation.ContestDiscoveryController
 }
 
 func ProvideContestDependencies(
 	db *gorm.DB,
 	redisClient *redis.Client,
-	rabbitmqConn *config.RabbitMQConnection,
+	outbox outboxPort.OutboxPort,
+	exchange string,
 	router *router.Router,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	userQueryRepo userQueryPort.UserQueryPort,
@@ -40,17 +57,16 @@ func ProvideContestDependencies(
 	// Contest 관련
 	contestDatabaseAdapter := adapter.NewContestDatabaseAdapter(db)
 	contestMemberDatabaseAdapter := adapter.NewContestMemberDatabaseAdapter(db)
+	contestPermissionChecker := application.NewContestPermissionChecker(contestMemberDatabaseAdapter)
 	contestApplicationRedisAdapter := adapter.NewContestApplicationRedisAdapter(redisClient)
 
-	// RabbitMQ Event Publisher
-	eventPublisher := adapter.NewEventPublisherRabbitMQAdapter(
-		rabbitmqConn,
-		rabbitmqConn.Config().Exchange,
-	)
+	// Event Publisher (relayed to RabbitMQ through the transactional outbox)
+	eventPublisher := adapter.NewEventPublisherOutboxAdapter(outbox, exchange)
 
 	contestService := application.NewContestService(
 		contestDatabaseAdapter,
 		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
 		contestApplicationRedisAdapter,
 		oauth2Repository,
 		eventPublisher,
@@ -62,6 +78,7 @@ func ProvideContestDependencies(
 		contestApplicationRedisAdapter,
 		contestDatabaseAdapter,
 		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
 		eventPublisher,
 		oauth2Repository,
 		userQueryRepo,
@@ -76,6 +93,7 @@ func ProvideContestDependencies(
 		Controller:            contestController,
 		ApplicationController: contestApplicationController,
 		ContestRepository:     contestDatabaseAdapter,
+		MemberRepository:      contestMemberDatabaseAdapter,
 		ContestService:        contestService,
 		ApplicationService:    contestApplicationService,
 	}
@@ -85,7 +103,8 @@ func ProvideContestDependencies(
 func ProvideContestDependenciesWithDiscord(
 	db *gorm.DB,
 	redisClient *redis.Client,
-	rabbitmqConn *config.RabbitMQConnection,
+	outbox outboxPort.OutboxPort,
+	exchange string,
 	router *router.Router,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	userQueryRepo userQueryPort.UserQueryPort,
@@ -96,13 +115,11 @@ func ProvideContestDependenciesWithDiscord(
 	// Contest 관련
 	contestDatabaseAdapter := adapter.NewContestDatabaseAdapter(db)
 	contestMemberDatabaseAdapter := adapter.NewContestMemberDatabaseAdapter(db)
+	contestPermissionChecker := application.NewContestPermissionChecker(contestMemberDatabaseAdapter)
 	contestApplicationRedisAdapter := adapter.NewContestApplicationRedisAdapter(redisClient)
 
-	// RabbitMQ Event Publisher
-	eventPublisher := adapter.NewEventPublisherRabbitMQAdapter(
-		rabbitmqConn,
-		rabbitmqConn.Config().Exchange,
-	)
+	// Event Publisher (relayed to RabbitMQ through the transactional outbox)
+	eventPublisher := adapter.NewEventPublisherOutboxAdapter(outbox, exchange)
 
 	// Discord Validation Adapter
 	discordValidationAdapter := contestAdapter.NewDiscordValidationAdapter(discordValidationService)
@@ -110,6 +127,7 @@ func ProvideContestDependenciesWithDiscord(
 	contestService := application.NewContestServiceWithDiscord(
 		contestDatabaseAdapter,
 		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
 		contestApplicationRedisAdapter,
 		oauth2Repository,
 		eventPublisher,
@@ -122,6 +140,7 @@ func ProvideContestDependenciesWithDiscord(
 		contestApplicationRedisAdapter,
 		contestDatabaseAdapter,
 		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
 		eventPublisher,
 		oauth2Repository,
 		userQueryRepo,
@@ -136,6 +155,7 @@ func ProvideContestDependenciesWithDiscord(
 		Controller:            contestController,
 		ApplicationController: contestApplicationController,
 		ContestRepository:     contestDatabaseAdapter,
+		MemberRepository:      contestMemberDatabaseAdapter,
 		ContestService:        contestService,
 		ApplicationService:    contestApplicationService,
 	}
@@ -145,7 +165,8 @@ func ProvideContestDependenciesWithDiscord(
 func ProvideContestDependenciesFull(
 	db *gorm.DB,
 	redisClient *redis.Client,
-	rabbitmqConn *config.RabbitMQConnection,
+	outbox outboxPort.OutboxPort,
+	exchange string,
 	router *router.Router,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
 	userQueryRepo userQueryPort.UserQueryPort,
@@ -153,19 +174,20 @@ func ProvideContestDependenciesFull(
 	gameRepository gamePort.GameDatabasePort,
 	teamRepository gamePort.TeamDatabasePort,
 	gameTeamRepository gamePort.GameTeamDatabasePort,
+	teamService *gameApplication.TeamService,
+	contestCleanupService *gameApplication.ContestCleanupService,
+	tournamentResultService *gameApplication.TournamentResultService,
 ) *Dependencies {
 	controllerHelper := handler.NewControllerHelper()
 
 	// Contest 관련
 	contestDatabaseAdapter := adapter.NewContestDatabaseAdapter(db)
 	contestMemberDatabaseAdapter := adapter.NewContestMemberDatabaseAdapter(db)
+	contestPermissionChecker := application.NewContestPermissionChecker(contestMemberDatabaseAdapter)
 	contestApplicationRedisAdapter := adapter.NewContestApplicationRedisAdapter(redisClient)
 
-	// RabbitMQ Event Publisher
-	eventPublisher := adapter.NewEventPublisherRabbitMQAdapter(
-		rabbitmqConn,
-		rabbitmqConn.Config().Exchange,
-	)
+	// Event Publisher (relayed to RabbitMQ through the transactional outbox)
+	eventPublisher := adapter.NewEventPublisherOutboxAdapter(outbox, exchange)
 
 	// Discord Validation Adapter
 	discordValidationAdapter := contestAdapter.NewDiscordValidationAdapter(discordValidationService)
@@ -176,6 +198,7 @@ func ProvideContestDependenciesFull(
 	contestService := application.NewContestServiceFull(
 		contestDatabaseAdapter,
 		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
 		contestApplicationRedisAdapter,
 		oauth2Repository,
 		eventPublisher,
@@ -184,6 +207,7 @@ func ProvideContestDependenciesFull(
 		teamRepository,
 		gameTeamRepository,
 	)
+	contestService.SetGameCleanup(contestCleanupService)
 	contestController := presentation.NewContestController(router, contestService, controllerHelper)
 
 	// Contest Application 관련
@@ -191,6 +215,7 @@ func ProvideContestDependenciesFull(
 		contestApplicationRedisAdapter,
 		contestDatabaseAdapter,
 		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
 		eventPublisher,
 		oauth2Repository,
 		userQueryRepo,
@@ -201,11 +226,167 @@ func ProvideContestDependenciesFull(
 		controllerHelper,
 	)
 
+	// Application Form 관련
+	contestApplicationFormDatabaseAdapter := adapter.NewContestApplicationFormDatabaseAdapter(db)
+	contestApplicationService.SetApplicationFormRepository(contestApplicationFormDatabaseAdapter)
+	contestApplicationFormService := application.NewContestApplicationFormService(
+		contestApplicationFormDatabaseAdapter,
+		contestDatabaseAdapter,
+		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
+		userQueryRepo,
+	)
+	contestApplicationFormController := presentation.NewContestApplicationFormController(
+		router,
+		contestApplicationFormService,
+		controllerHelper,
+	)
+
+	// Eligibility 관련
+	contestEligibilityChecker := application.NewContestEligibilityChecker(userQueryRepo, oauth2Repository, discordValidationAdapter)
+	contestApplicationService.SetEligibilityChecker(contestEligibilityChecker)
+
+	// Auto-accept 관련
+	contestAutoAcceptDatabaseAdapter := adapter.NewContestAutoAcceptDatabaseAdapter(db)
+	contestApplicationService.SetAutoAcceptRepository(contestAutoAcceptDatabaseAdapter)
+	contestApplicationService.SetDiscordValidator(discordValidationAdapter)
+	contestAutoAcceptService := application.NewContestAutoAcceptService(
+		contestAutoAcceptDatabaseAdapter,
+		contestDatabaseAdapter,
+		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
+	)
+	contestAutoAcceptController := presentation.NewContestAutoAcceptController(
+		router,
+		contestAutoAcceptService,
+		controllerHelper,
+	)
+
+	// Template 관련
+	contestTemplateDatabaseAdapter := adapter.NewContestTemplateDatabaseAdapter(db)
+	contestTemplateService := application.NewContestTemplateService(
+		contestTemplateDatabaseAdapter,
+		contestDatabaseAdapter,
+		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
+		contestService,
+	)
+	contestTemplateController := presentation.NewContestTemplateController(
+		router,
+		contestTemplateService,
+		controllerHelper,
+	)
+
+	// Series 관련
+	contestSeriesDatabaseAdapter := adapter.NewContestSeriesDatabaseAdapter(db)
+	contestPlacementAdapter := contestAdapter.NewContestPlacementAdapter(tournamentResultService, teamRepository)
+	contestSeriesService := application.NewContestSeriesService(
+		contestSeriesDatabaseAdapter,
+		contestTemplateDatabaseAdapter,
+		contestDatabaseAdapter,
+		contestService,
+		userQueryRepo,
+		contestPlacementAdapter,
+		redisClient,
+	)
+	contestSeriesController := presentation.NewContestSeriesController(
+		router,
+		contestSeriesService,
+		controllerHelper,
+	)
+
+	// Organizer 관련
+	contestOrganizerDatabaseAdapter := adapter.NewContestOrganizerDatabaseAdapter(db)
+	contestOrganizerService := application.NewContestOrganizerService(
+		contestDatabaseAdapter,
+		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
+		contestOrganizerDatabaseAdapter,
+	)
+	contestOrganizerController := presentation.NewContestOrganizerController(
+		router,
+		contestOrganizerService,
+		controllerHelper,
+	)
+
+	// Private contest access 관련
+	contestAccessDatabaseAdapter := adapter.NewContestAccessDatabaseAdapter(db)
+	contestAccessService := application.NewContestAccessService(
+		contestDatabaseAdapter,
+		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
+		contestAccessDatabaseAdapter,
+		contestOrganizerDatabaseAdapter,
+	)
+	contestAccessController := presentation.NewContestAccessController(
+		router,
+		contestAccessService,
+		controllerHelper,
+	)
+	contestService.SetAccessChecker(contestAccessService)
+	contestApplicationService.SetAccessChecker(contestAccessService)
+	contestApplicationFormService.SetAccessChecker(contestAccessService)
+	contestOrganizerService.SetAccessChecker(contestAccessService)
+	contestSeriesService.SetAccessChecker(contestAccessService)
+
+	// Discovery 관련
+	contestDiscoveryDatabaseAdapter := adapter.NewContestDiscoveryDatabaseAdapter(db)
+	contestDiscoveryService := application.NewContestDiscoveryService(
+		contestDiscoveryDatabaseAdapter,
+		userQueryRepo,
+	)
+	contestDiscoveryController := presentation.NewContestDiscoveryController(
+		router,
+		contestDiscoveryService,
+		controllerHelper,
+	)
+
+	// Captain Draft 관련
+	contestDraftRedisAdapter := adapter.NewContestDraftRedisAdapter(redisClient)
+	contestDraftService := application.NewContestDraftService(
+		contestDraftRedisAdapter,
+		contestDatabaseAdapter,
+		contestMemberDatabaseAdapter,
+		contestPermissionChecker,
+		contestApplicationRedisAdapter,
+		userQueryRepo,
+		teamService,
+	)
+	contestDraftController := presentation.NewContestDraftController(
+		router,
+		contestDraftService,
+		controllerHelper,
+	)
+
+	// Lifecycle job (auto-start at StartedAt, auto-finish)
+	contestLifecycleService := application.NewContestLifecycleService(
+		contestService,
+		contestDatabaseAdapter,
+		gameRepository,
+		redisClient,
+	)
+
 	return &Dependencies{
 		Controller:            contestController,
 		ApplicationController: contestApplicationController,
 		ContestRepository:     contestDatabaseAdapter,
+		MemberRepository:      contestMemberDatabaseAdapter,
 		ContestService:        contestService,
 		ApplicationService:    contestApplicationService,
+		DraftController:       contestDraftController,
+		DraftService:          contestDraftService,
+		LifecycleService:      contestLifecycleService,
+		FormController:        contestApplicationFormController,
+		AutoAcceptController:  contestAutoAcceptController,
+		EligibilityChecker:    contestEligibilityChecker,
+		TemplateController:    contestTemplateController,
+		SeriesController:      contestSeriesController,
+		SeriesService:         contestSeriesService,
+		OrganizerController:   contestOrganizerController,
+		OrganizerService:      contestOrganizerService,
+		PermissionChecker:     contestPermissionChecker,
+		AccessController:      contestAccessController,
+		AccessService:         contestAccessService,
+		DiscoveryController:   contestDiscoveryController,
 	}
 }
@@ -0,0 +1,221 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// AchievementService evaluates the achievement rules on game, team and contest events and awards their badges.
+// A badge is earned once; the user is notified when it is first earned.
+type AchievementService struct {
+	achievementRepo     port.AchievementDatabasePort
+	gameDBPort          port.GameDatabasePort
+	matchResultDBPort   port.MatchResultDatabasePort
+	leaderboardRepo     port.LeaderboardDatabasePort
+	userQueryPort       userQueryPort.UserQueryPort
+	notificationHandler notificationPort.NotificationHandlerPort
+}
+
+func NewAchievementService(
+	achievementRepo port.AchievementDatabasePort,
+	gameDBPort port.GameDatabasePort,
+	matchResultDBPort port.MatchResultDatabasePort,
+	leaderboardRepo port.LeaderboardDatabasePort,
+	userQueryPort userQueryPort.UserQueryPort,
+) *AchievementService {
+	return &AchievementService{
+		achievementRepo:   achievementRepo,
+		gameDBPort:        gameDBPort,
+		matchResultDBPort: matchResultDBPort,
+		leaderboardRepo:   leaderboardRepo,
+		userQueryPort:     userQueryPort,
+	}
+}
+
+// SetNotificationHandler sets the notification handler (to avoid circular dependency)
+func (s *AchievementService) SetNotificationHandler(handler notificationPort.NotificationHandlerPort) {
+	s.notificationHandler = handler
+}
+
+// HandleAchievementEvent evaluates the rules of the event's trigger for every user the event is about
+func (s *AchievementService) HandleAchievementEvent(ctx context.Context, event *port.AchievementEvent) error {
+	if event.Trigger == domain.AchievementTriggerGameFinished {
+		return s.evaluateGame(event.GameID)
+	}
+
+	facts := make([]*domain.AchievementFacts, 0, len(event.UserIDs))
+	for _, userID := range event.UserIDs {
+		fact := &domain.AchievementFacts{UserID: userID}
+		if event.Trigger == domain.AchievementTriggerTeamFinalized {
+			fact.TeamSize = len(event.UserIDs)
+		}
+		facts = append(facts, fact)
+	}
+	return s.award(event.Trigger, facts)
+}
+
+// evaluateGame gathers the facts of every player of a finished game.
+// Games finished without a match result only count towards the contests played.
+func (s *AchievementService) evaluateGame(gameID int64) error {
+	game, err := s.gameDBPort.GetByID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrGameNotFound) {
+			log.Printf("[Achievement] Skipping deleted game %d", gameID)
+			return nil
+		}
+		return err
+	}
+	if game.GameStatus != domain.GameStatusFinished {
+		return nil
+	}
+
+	members, err := s.leaderboardRepo.GetGameTeamMembers(gameID)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	result, err := s.matchResultDBPort.GetByGameID(gameID)
+	if err != nil {
+		if !errors.Is(err, exception.ErrMatchResultNotFound) {
+			return err
+		}
+		result = nil
+	}
+
+	kills := make(map[int64]int)
+	if result != nil {
+		stats, err := s.matchResultDBPort.GetPlayerStatsByMatchResult(result.MatchResultID)
+		if err != nil {
+			return err
+		}
+		for _, stat := range stats {
+			kills[stat.UserID] = stat.Kills
+		}
+	}
+
+	userIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+	contestsPlayed, err := s.achievementRepo.CountContestsPlayed(userIDs)
+	if err != nil {
+		return err
+	}
+
+	isFinal := game.IsTournamentGame() && game.NextGameID == nil
+
+	facts := make([]*domain.AchievementFacts, 0, len(members))
+	for _, member := range members {
+		fact := &domain.AchievementFacts{
+			UserID:         member.UserID,
+			ContestsPlayed: contestsPlayed[member.UserID],
+			Kills:          kills[member.UserID],
+		}
+		if result != nil {
+			fact.WonMatch = member.TeamID == result.WinnerTeamID
+			fact.WinnerScore = result.WinnerScore
+			fact.LoserScore = result.LoserScore
+			fact.WonTournamentFinal = isFinal && fact.WonMatch
+		}
+		facts = append(facts, fact)
+	}
+	return s.award(domain.AchievementTriggerGameFinished, facts)
+}
+
+// award saves the badges the facts earn and notifies the users of the ones they did not have yet
+func (s *AchievementService) award(trigger domain.AchievementTrigger, facts []*domain.AchievementFacts) error {
+	now := time.Now()
+
+	var badges []*domain.UserBadge
+	for _, fact := range facts {
+		for _, code := range domain.EvaluateAchievements(trigger, fact) {
+			badges = append(badges, domain.NewUserBadge(fact.UserID, code, now))
+		}
+	}
+	if len(badges) == 0 {
+		return nil
+	}
+
+	awarded, err := s.achievementRepo.AwardBadges(badges)
+	if err != nil {
+		return err
+	}
+
+	for _, userBadge := range awarded {
+		log.Printf("[Achievement] User %d earned badge %s", userBadge.UserID, userBadge.BadgeCode)
+		s.notifyBadgeAwarded(userBadge)
+	}
+	return nil
+}
+
+func (s *AchievementService) notifyBadgeAwarded(userBadge *domain.UserBadge) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	badge, ok := domain.FindBadge(userBadge.BadgeCode)
+	if !ok {
+		return
+	}
+
+	if err := s.notificationHandler.HandleBadgeAwarded(userBadge.UserID, string(badge.Code), badge.Name, badge.Description); err != nil {
+		log.Printf("[Achievement] Failed to notify user %d of badge %s: %v", userBadge.UserID, badge.Code, err)
+	}
+}
+
+// GetBadges returns the badges a user earned, oldest first
+func (s *AchievementService) GetBadges(userID int64) (*dto.UserBadgesResponse, error) {
+	if _, err := s.userQueryPort.FindById(userID); err != nil {
+		return nil, err
+	}
+
+	badges, err := s.achievementRepo.GetUserBadges(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.UserBadgesResponse{
+		UserID: userID,
+		Badges: make([]*dto.BadgeResponse, 0, len(badges)),
+	}
+	for _, userBadge := range badges {
+		if badge, ok := domain.FindBadge(userBadge.BadgeCode); ok {
+			resp.Badges = append(resp.Badges, dto.NewBadgeResponse(badge, userBadge.AwardedAt))
+		}
+	}
+	return resp, nil
+}
+
+// GetUserBadges implements UserBadgeQueryPort, for the badges shown on user profiles
+func (s *AchievementService) GetUserBadges(userID int64) ([]*userQueryPort.UserBadge, error) {
+	badges, err := s.achievementRepo.GetUserBadges(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*userQueryPort.UserBadge, 0, len(badges))
+	for _, userBadge := range badges {
+		badge, ok := domain.FindBadge(userBadge.BadgeCode)
+		if !ok {
+			continue
+		}
+		result = append(result, &userQueryPort.UserBadge{
+			Code:        string(badge.Code),
+			Name:        badge.Name,
+			Description: badge.Description,
+			AwardedAt:   userBadge.AwardedAt,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"context"
+	"log"
+	"time"
+)
+
+// ContestCleanupResult summarizes the game-side cleanup of a cancelled contest
+type ContestCleanupResult struct {
+	CancelledGameCount int
+	// TeamMemberUserIDs holds every user on a cached or persisted team of the contest
+	TeamMemberUserIDs []int64
+}
+
+// ContestCleanupService cancels the games and clears the team cache of a cancelled contest
+type ContestCleanupService struct {
+	gameDBPort     port.GameDatabasePort
+	teamDBPort     port.TeamDatabasePort
+	teamRedisPort  port.TeamRedisPort
+	eventPublisher port.GameEventPublisherPort
+	txManager      transaction.Transactor
+}
+
+func NewContestCleanupService(
+	gameDBPort port.GameDatabasePort,
+	teamDBPort port.TeamDatabasePort,
+	teamRedisPort port.TeamRedisPort,
+	eventPublisher port.GameEventPublisherPort,
+) *ContestCleanupService {
+	return &ContestCleanupService{
+		gameDBPort:     gameDBPort,
+		teamDBPort:     teamDBPort,
+		teamRedisPort:  teamRedisPort,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// SetTransactionManager sets the transaction manager so game updates and their outbox events commit together
+func (s *ContestCleanupService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// CancelContestGames cancels every pending or active game (which stops match detection)
+// and clears the Redis team cache of the contest.
+// Team members are collected before the cache is cleared so the caller can notify them.
+func (s *ContestCleanupService) CancelContestGames(ctx context.Context, contestID int64) (*ContestCleanupResult, error) {
+	result := &ContestCleanupResult{}
+
+	games, err := s.gameDBPort.GetByContestID(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, game := range games {
+		if game.IsTerminalState() {
+			continue
+		}
+
+		if err := game.CancelGame(); err != nil {
+			log.Printf("[ContestCleanup] Failed to cancel game %d: %v", game.GameID, err)
+			continue
+		}
+
+		event := &port.GameEvent{
+			EventType:   port.GameEventCancelled,
+			Timestamp:   time.Now(),
+			ContestID:   game.ContestID,
+			GameID:      game.GameID,
+			Round:       game.GetRound(),
+			MatchNumber: game.GetMatchNumber(),
+		}
+		err := transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+			if err := s.gameDBPort.UpdateWithContext(txCtx, game); err != nil {
+				return err
+			}
+			return s.eventPublisher.PublishGameEvent(txCtx, event)
+		})
+		if err != nil {
+			log.Printf("[ContestCleanup] Failed to save cancelled game %d: %v", game.GameID, err)
+			continue
+		}
+
+		result.CancelledGameCount++
+	}
+
+	result.TeamMemberUserIDs = s.collectTeamMembers(ctx, contestID)
+
+	if err := s.teamRedisPort.ClearTeam(ctx, contestID); err != nil {
+		log.Printf("[ContestCleanup] Failed to clear team cache for contest %d: %v", contestID, err)
+	}
+	if err := s.teamRedisPort.SetFinalizedTeamCount(ctx, contestID, 0); err != nil {
+		log.Printf("[ContestCleanup] Failed to reset finalized team count for contest %d: %v", contestID, err)
+	}
+
+	return result, nil
+}
+
+// collectTeamMembers returns the distinct user IDs of cached and persisted team members
+func (s *ContestCleanupService) collectTeamMembers(ctx context.Context, contestID int64) []int64 {
+	seen := make(map[int64]bool)
+	var userIDs []int64
+	add := func(userID int64) {
+		if !seen[userID] {
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	if cachedMembers, err := s.teamRedisPort.GetAllMembers(ctx, contestID); err == nil {
+		for _, m := range cachedMembers {
+			add(m.UserID)
+		}
+	}
+
+	teams, err := s.teamDBPort.GetTeamsByContestWithMembers(contestID)
+	if err != nil {
+		log.Printf("[ContestCleanup] Failed to load teams for contest %d: %v", contestID, err)
+		return userIDs
+	}
+	for _, twm := range teams {
+		for _, m := range twm.Members {
+			add(m.UserID)
+		}
+	}
+
+	return userIDs
+}
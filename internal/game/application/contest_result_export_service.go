@@ -0,0 +1,369 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// utf8BOM lets spreadsheet apps detect UTF-8, so Korean team and player names are not garbled
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// ContestResultExportService builds downloadable contest results for organizers
+type ContestResultExportService struct {
+	resultService     *TournamentResultService
+	exportRepo        port.ContestResultExportDatabasePort
+	teamRepo          port.TeamDatabasePort
+	permissionChecker contestPort.ContestPermissionPort
+}
+
+func NewContestResultExportService(
+	resultService *TournamentResultService,
+	exportRepo port.ContestResultExportDatabasePort,
+	teamRepo port.TeamDatabasePort,
+) *ContestResultExportService {
+	return &ContestResultExportService{
+		resultService: resultService,
+		exportRepo:    exportRepo,
+		teamRepo:      teamRepo,
+	}
+}
+
+// SetPermissionChecker sets the contest permission checker (to resolve circular dependency)
+func (s *ContestResultExportService) SetPermissionChecker(checker contestPort.ContestPermissionPort) {
+	s.permissionChecker = checker
+}
+
+// Export - 대회 결과 내보내기 (EXPORT_RESULTS 권한 필요), 파일 내용과 Content-Type 반환
+func (s *ContestResultExportService) Export(contestID, userID int64, req *dto.ContestResultExportRequest) ([]byte, string, error) {
+	format := req.Format
+	if format == "" {
+		format = dto.ResultExportFormatJSON
+	}
+	if !format.IsValid() {
+		return nil, "", exception.ErrInvalidExportFormat
+	}
+
+	section := req.Section
+	if section == "" {
+		section = dto.ResultExportSectionPlacements
+	}
+	if format == dto.ResultExportFormatCSV && !section.IsValid() {
+		return nil, "", exception.ErrInvalidExportSection
+	}
+
+	if s.permissionChecker == nil {
+		return nil, "", exception.ErrPermissionDenied
+	}
+	if err := s.permissionChecker.CheckPermission(contestID, userID, contestDomain.ContestActionExportResults); err != nil {
+		return nil, "", err
+	}
+
+	export, err := s.BuildExport(contestID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if format == dto.ResultExportFormatCSV {
+		body, err := renderResultExportCSV(export, section)
+		return body, "text/csv; charset=utf-8", err
+	}
+
+	body, err := json.MarshalIndent(export, "", "  ")
+	return body, "application/json; charset=utf-8", err
+}
+
+// BuildExport collects placements, rosters, games and player stats of a contest
+func (s *ContestResultExportService) BuildExport(contestID int64) (*dto.ContestResultExport, error) {
+	result, err := s.resultService.GetContestResult(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByContestID(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.exportRepo.GetRosterMembers(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.exportRepo.GetGamesWithResults(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	gameTeams, err := s.exportRepo.GetGameTeams(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	playerTotals, err := s.exportRepo.GetPlayerStatTotals(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamNames := make(map[int64]string, len(teams))
+	for _, team := range teams {
+		teamNames[team.TeamID] = team.TeamName
+	}
+
+	rosters := make(map[int64][]dto.ResultExportRosterMember)
+	for _, member := range members {
+		rosters[member.TeamID] = append(rosters[member.TeamID], dto.ResultExportRosterMember{
+			UserID:     member.UserID,
+			Username:   member.Username,
+			Tag:        member.Tag,
+			MemberType: string(member.MemberType),
+			RiotID:     riotID(member.RiotName, member.RiotTag),
+			DiscordID:  member.DiscordID,
+		})
+	}
+
+	export := &dto.ContestResultExport{
+		ContestID:     result.ContestID,
+		Title:         result.Title,
+		ContestStatus: result.ContestStatus,
+		ExportedAt:    time.Now().UTC(),
+		Placements:    make([]dto.ResultExportPlacement, 0, len(result.Placements)),
+		Teams:         make([]dto.ResultExportTeam, 0, len(teams)),
+		Games:         make([]dto.ResultExportGameRow, 0, len(games)),
+		Players:       make([]dto.ResultExportPlayerStats, 0, len(playerTotals)),
+	}
+
+	placements := make(map[int64]int, len(result.Placements))
+	for _, placement := range result.Placements {
+		placements[placement.TeamID] = placement.Placement
+		export.Placements = append(export.Placements, dto.ResultExportPlacement{
+			Placement: placement.Placement,
+			TeamID:    placement.TeamID,
+			TeamName:  placement.TeamName,
+			Roster:    rosterOrEmpty(rosters[placement.TeamID]),
+		})
+	}
+
+	for _, team := range teams {
+		exportTeam := dto.ResultExportTeam{
+			TeamID:   team.TeamID,
+			TeamName: team.TeamName,
+			Status:   string(team.Status),
+			Roster:   rosterOrEmpty(rosters[team.TeamID]),
+		}
+		if placement, ok := placements[team.TeamID]; ok {
+			exportTeam.Placement = &placement
+		}
+		export.Teams = append(export.Teams, exportTeam)
+	}
+
+	teamsByGame := make(map[int64][]string)
+	for _, gameTeam := range gameTeams {
+		teamsByGame[gameTeam.GameID] = append(teamsByGame[gameTeam.GameID], teamNames[gameTeam.TeamID])
+	}
+
+	for _, game := range games {
+		row := dto.ResultExportGameRow{
+			GameID:          game.GameID,
+			Round:           game.Round,
+			MatchNumber:     game.MatchNumber,
+			GameStatus:      string(game.GameStatus),
+			Teams:           teamsByGame[game.GameID],
+			WinnerTeamID:    game.WinnerTeamID,
+			LoserTeamID:     game.LoserTeamID,
+			WinnerScore:     game.WinnerScore,
+			LoserScore:      game.LoserScore,
+			MapName:         game.MapName,
+			RoundsPlayed:    game.RoundsPlayed,
+			StartedAt:       game.StartedAt,
+			DurationSeconds: game.GameDuration,
+		}
+		if row.Teams == nil {
+			row.Teams = []string{}
+		}
+		if game.Round != nil && result.TotalRounds > 0 {
+			row.RoundName = GetRoundName(*game.Round, result.TotalRounds)
+		}
+		if game.GameStartedAt != nil {
+			row.StartedAt = game.GameStartedAt
+		}
+		if game.WinnerTeamID != nil {
+			row.WinnerTeamName = teamNames[*game.WinnerTeamID]
+		}
+		if game.LoserTeamID != nil {
+			row.LoserTeamName = teamNames[*game.LoserTeamID]
+		}
+		export.Games = append(export.Games, row)
+	}
+
+	for _, totals := range playerTotals {
+		export.Players = append(export.Players, toResultExportPlayerStats(totals, teamNames[totals.TeamID]))
+	}
+
+	return export, nil
+}
+
+func toResultExportPlayerStats(totals *port.ResultExportPlayerTotals, teamName string) dto.ResultExportPlayerStats {
+	stats := dto.ResultExportPlayerStats{
+		UserID:       totals.UserID,
+		Username:     totals.Username,
+		Tag:          totals.Tag,
+		RiotID:       riotID(totals.RiotName, totals.RiotTag),
+		TeamID:       totals.TeamID,
+		TeamName:     teamName,
+		GamesPlayed:  totals.GamesPlayed,
+		RoundsPlayed: totals.RoundsPlayed,
+		Kills:        totals.Kills,
+		Deaths:       totals.Deaths,
+		Assists:      totals.Assists,
+	}
+
+	// A deathless player's K/D is their kill count, as in the Valorant scoreboard
+	stats.KDRatio = float64(totals.Kills)
+	if totals.Deaths > 0 {
+		stats.KDRatio = roundTo2(float64(totals.Kills) / float64(totals.Deaths))
+	}
+	if totals.RoundsPlayed > 0 {
+		stats.AverageCombatScore = roundTo2(float64(totals.Score) / float64(totals.RoundsPlayed))
+	}
+	if shots := totals.Headshots + totals.Bodyshots + totals.Legshots; shots > 0 {
+		stats.HeadshotPercent = roundTo2(float64(totals.Headshots) * 100 / float64(shots))
+	}
+
+	return stats
+}
+
+// renderResultExportCSV writes one section as a CSV table
+func renderResultExportCSV(export *dto.ContestResultExport, section dto.ResultExportSection) ([]byte, error) {
+	var rows [][]string
+
+	switch section {
+	case dto.ResultExportSectionPlacements:
+		rows = append(rows, []string{"placement", "team_id", "team_name", "players"})
+		for _, p := range export.Placements {
+			rows = append(rows, []string{itoa(p.Placement), i64toa(p.TeamID), p.TeamName, rosterSummary(p.Roster)})
+		}
+	case dto.ResultExportSectionRosters:
+		rows = append(rows, []string{"team_id", "team_name", "team_status", "placement", "user_id", "username", "member_type", "riot_id", "discord_id"})
+		for _, t := range export.Teams {
+			for _, m := range t.Roster {
+				rows = append(rows, []string{
+					i64toa(t.TeamID), t.TeamName, t.Status, optionalInt(t.Placement),
+					i64toa(m.UserID), m.Username + "#" + m.Tag, m.MemberType, optionalString(m.RiotID), optionalString(m.DiscordID),
+				})
+			}
+		}
+	case dto.ResultExportSectionGames:
+		rows = append(rows, []string{"game_id", "round", "round_name", "match_number", "game_status", "teams",
+			"winner_team", "loser_team", "winner_score", "loser_score", "map", "rounds_played", "started_at", "duration_seconds"})
+		for _, g := range export.Games {
+			startedAt := ""
+			if g.StartedAt != nil {
+				startedAt = g.StartedAt.UTC().Format(time.RFC3339)
+			}
+			rows = append(rows, []string{
+				i64toa(g.GameID), optionalInt(g.Round), g.RoundName, optionalInt(g.MatchNumber), g.GameStatus,
+				strings.Join(g.Teams, " vs "), g.WinnerTeamName, g.LoserTeamName, optionalInt(g.WinnerScore), optionalInt(g.LoserScore),
+				optionalString(g.MapName), optionalInt(g.RoundsPlayed), startedAt, optionalInt(g.DurationSeconds),
+			})
+		}
+	case dto.ResultExportSectionPlayers:
+		rows = append(rows, []string{"user_id", "username", "riot_id", "team_id", "team_name", "games_played", "rounds_played",
+			"kills", "deaths", "assists", "kd_ratio", "average_combat_score", "headshot_percent"})
+		for _, p := range export.Players {
+			rows = append(rows, []string{
+				i64toa(p.UserID), p.Username + "#" + p.Tag, optionalString(p.RiotID), i64toa(p.TeamID), p.TeamName,
+				itoa(p.GamesPlayed), itoa(p.RoundsPlayed), itoa(p.Kills), itoa(p.Deaths), itoa(p.Assists),
+				ftoa(p.KDRatio), ftoa(p.AverageCombatScore), ftoa(p.HeadshotPercent),
+			})
+		}
+	default:
+		return nil, exception.ErrInvalidExportSection
+	}
+
+	var buf bytes.Buffer
+	buf.Write(utf8BOM)
+	writer := csv.NewWriter(&buf)
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = utils.EscapeSpreadsheetFormula(cell)
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func riotID(name, tag *string) *string {
+	if name == nil || tag == nil || *name == "" {
+		return nil
+	}
+	id := *name + "#" + *tag
+	return &id
+}
+
+func rosterOrEmpty(roster []dto.ResultExportRosterMember) []dto.ResultExportRosterMember {
+	if roster == nil {
+		return []dto.ResultExportRosterMember{}
+	}
+	return roster
+}
+
+// rosterSummary lists players as Riot IDs, falling back to the GAMERS username
+func rosterSummary(roster []dto.ResultExportRosterMember) string {
+	names := make([]string, 0, len(roster))
+	for _, m := range roster {
+		if m.RiotID != nil {
+			names = append(names, *m.RiotID)
+		} else {
+			names = append(names, m.Username+"#"+m.Tag)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+func itoa(v int) string {
+	return strconv.Itoa(v)
+}
+
+func i64toa(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func ftoa(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func optionalInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func optionalString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+// BadgeResponse is a badge a user earned from an achievement
+type BadgeResponse struct {
+	Code        gameDomain.BadgeCode `json:"code"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	AwardedAt   time.Time            `json:"awarded_at"`
+}
+
+func NewBadgeResponse(badge *gameDomain.Badge, awardedAt time.Time) *BadgeResponse {
+	return &BadgeResponse{
+		Code:        badge.Code,
+		Name:        badge.Name,
+		Description: badge.Description,
+		AwardedAt:   awardedAt,
+	}
+}
+
+// UserBadgesResponse lists the badges a user earned, oldest first
+type UserBadgesResponse struct {
+	UserID int64            `json:"user_id"`
+	Badges []*BadgeResponse `json:"badges"`
+}
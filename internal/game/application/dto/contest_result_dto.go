@@ -8,6 +8,16 @@ type ContestResultResponse struct {
 	TotalRounds   int           `json:"total_rounds"`
 	Champion      *TeamSummary  `json:"champion,omitempty"`
 	Rounds        []RoundResult `json:"rounds"`
+	// Placements lists the final placement of every team decided so far
+	Placements []TeamPlacement `json:"placements"`
+}
+
+// TeamPlacement represents a team's final placement in the bracket.
+// Teams knocked out in the same round share a placement (both semi-final losers are 3rd).
+type TeamPlacement struct {
+	TeamID    int64  `json:"team_id"`
+	TeamName  string `json:"team_name"`
+	Placement int    `json:"placement"`
 }
 
 // RoundResult represents a single round in the tournament
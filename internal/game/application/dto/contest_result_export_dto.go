@@ -0,0 +1,118 @@
+package dto
+
+import "time"
+
+// ResultExportFormat is the file format of a result export
+type ResultExportFormat string
+
+const (
+	ResultExportFormatJSON ResultExportFormat = "json"
+	ResultExportFormatCSV  ResultExportFormat = "csv"
+)
+
+func (f ResultExportFormat) IsValid() bool {
+	return f == ResultExportFormatJSON || f == ResultExportFormatCSV
+}
+
+// ResultExportSection selects the table written to a CSV export; a JSON export always holds every section
+type ResultExportSection string
+
+const (
+	ResultExportSectionPlacements ResultExportSection = "placements"
+	ResultExportSectionRosters    ResultExportSection = "rosters"
+	ResultExportSectionGames      ResultExportSection = "games"
+	ResultExportSectionPlayers    ResultExportSection = "players"
+)
+
+func (s ResultExportSection) IsValid() bool {
+	switch s {
+	case ResultExportSectionPlacements, ResultExportSectionRosters, ResultExportSectionGames, ResultExportSectionPlayers:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContestResultExportRequest holds the query parameters of a result export
+type ContestResultExportRequest struct {
+	Format  ResultExportFormat  `form:"format"`
+	Section ResultExportSection `form:"section"`
+}
+
+// ContestResultExport is everything organizers need to hand out prizes
+type ContestResultExport struct {
+	ContestID     int64                     `json:"contest_id"`
+	Title         string                    `json:"title"`
+	ContestStatus string                    `json:"contest_status"`
+	ExportedAt    time.Time                 `json:"exported_at"`
+	Placements    []ResultExportPlacement   `json:"placements"`
+	Teams         []ResultExportTeam        `json:"teams"`
+	Games         []ResultExportGameRow     `json:"games"`
+	Players       []ResultExportPlayerStats `json:"players"`
+}
+
+// ResultExportPlacement is a team's final placement with its roster
+type ResultExportPlacement struct {
+	Placement int                        `json:"placement"`
+	TeamID    int64                      `json:"team_id"`
+	TeamName  string                     `json:"team_name"`
+	Roster    []ResultExportRosterMember `json:"roster"`
+}
+
+// ResultExportTeam is a team registered for the contest, with its placement once decided
+type ResultExportTeam struct {
+	TeamID    int64                      `json:"team_id"`
+	TeamName  string                     `json:"team_name"`
+	Status    string                     `json:"status"`
+	Placement *int                       `json:"placement,omitempty"`
+	Roster    []ResultExportRosterMember `json:"roster"`
+}
+
+// ResultExportRosterMember identifies a player; RiotID is "name#tag" when a Valorant account is linked
+type ResultExportRosterMember struct {
+	UserID     int64   `json:"user_id"`
+	Username   string  `json:"username"`
+	Tag        string  `json:"tag"`
+	MemberType string  `json:"member_type"`
+	RiotID     *string `json:"riot_id,omitempty"`
+	DiscordID  *string `json:"discord_id,omitempty"`
+}
+
+// ResultExportGameRow is a game with its teams, score and map
+type ResultExportGameRow struct {
+	GameID          int64      `json:"game_id"`
+	Round           *int       `json:"round,omitempty"`
+	RoundName       string     `json:"round_name,omitempty"`
+	MatchNumber     *int       `json:"match_number,omitempty"`
+	GameStatus      string     `json:"game_status"`
+	Teams           []string   `json:"teams"`
+	WinnerTeamID    *int64     `json:"winner_team_id,omitempty"`
+	WinnerTeamName  string     `json:"winner_team_name,omitempty"`
+	LoserTeamID     *int64     `json:"loser_team_id,omitempty"`
+	LoserTeamName   string     `json:"loser_team_name,omitempty"`
+	WinnerScore     *int       `json:"winner_score,omitempty"`
+	LoserScore      *int       `json:"loser_score,omitempty"`
+	MapName         *string    `json:"map_name,omitempty"`
+	RoundsPlayed    *int       `json:"rounds_played,omitempty"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	DurationSeconds *int       `json:"duration_seconds,omitempty"`
+}
+
+// ResultExportPlayerStats aggregates a player's match stats over the contest
+type ResultExportPlayerStats struct {
+	UserID       int64   `json:"user_id"`
+	Username     string  `json:"username"`
+	Tag          string  `json:"tag"`
+	RiotID       *string `json:"riot_id,omitempty"`
+	TeamID       int64   `json:"team_id"`
+	TeamName     string  `json:"team_name"`
+	GamesPlayed  int     `json:"games_played"`
+	RoundsPlayed int     `json:"rounds_played"`
+	Kills        int     `json:"kills"`
+	Deaths       int     `json:"deaths"`
+	Assists      int     `json:"assists"`
+	KDRatio      float64 `json:"kd_ratio"`
+	// AverageCombatScore is the total score divided by rounds played
+	AverageCombatScore float64 `json:"average_combat_score"`
+	HeadshotPercent    float64 `json:"headshot_percent"`
+}
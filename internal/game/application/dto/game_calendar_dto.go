@@ -0,0 +1,22 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+// CalendarFeedResponse describes the user's private calendar feed.
+// FeedURL is a secret: anyone holding it can read the user's match schedule until it is regenerated.
+type CalendarFeedResponse struct {
+	FeedURL   string    `json:"feed_url"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToCalendarFeedResponse(token *gameDomain.CalendarFeedToken, feedURL string) *CalendarFeedResponse {
+	return &CalendarFeedResponse{
+		FeedURL:   feedURL,
+		Token:     token.Token,
+		CreatedAt: token.CreatedAt,
+	}
+}
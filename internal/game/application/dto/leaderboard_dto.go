@@ -0,0 +1,39 @@
+package dto
+
+// LeaderboardRequest holds the query parameters of a leaderboard page
+type LeaderboardRequest struct {
+	SeasonID *int64 `form:"season_id"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"page_size"`
+}
+
+// LeaderboardEntryResponse is a ranked player or roster.
+// Player fields are set on player leaderboards and roster fields on team leaderboards.
+type LeaderboardEntryResponse struct {
+	Rank  int64   `json:"rank"`
+	Score float64 `json:"score"`
+
+	UserID   *int64 `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Avatar   string `json:"avatar,omitempty"`
+
+	RosterKey string  `json:"roster_key,omitempty"`
+	TeamName  string  `json:"team_name,omitempty"`
+	MemberIDs []int64 `json:"member_ids,omitempty"`
+}
+
+// LeaderboardPositionResponse holds the requesting user's entries on a leaderboard:
+// at most one on player leaderboards, one per roster played in on team leaderboards
+type LeaderboardPositionResponse struct {
+	Subject    string                      `json:"subject"`
+	Metric     string                      `json:"metric"`
+	Scope      string                      `json:"scope"`
+	TotalCount int64                       `json:"total_count"`
+	Entries    []*LeaderboardEntryResponse `json:"entries"`
+}
+
+// LeaderboardRebuildResponse reports how many finished games were replayed into the rebuilt leaderboards
+type LeaderboardRebuildResponse struct {
+	GamesApplied int `json:"games_applied"`
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+// MatchAwardResponse is an award a player earned in a match
+type MatchAwardResponse struct {
+	UserID    int64                     `json:"userId"`
+	AwardType gameDomain.MatchAwardType `json:"awardType"`
+	Value     float64                   `json:"value"`
+}
+
+func ToMatchAwardResponse(award *gameDomain.MatchAward) *MatchAwardResponse {
+	return &MatchAwardResponse{
+		UserID:    award.UserID,
+		AwardType: award.AwardType,
+		Value:     award.Value,
+	}
+}
+
+// MvpVotingResponse is the state of a match's MVP vote; vote counts stay hidden until it closes
+type MvpVotingResponse struct {
+	ClosesAt time.Time `json:"closesAt"`
+	Open     bool      `json:"open"`
+}
+
+func ToMvpVotingResponse(voting *gameDomain.MvpVoting, now time.Time) *MvpVotingResponse {
+	return &MvpVotingResponse{
+		ClosesAt: voting.ClosesAt,
+		Open:     voting.IsOpen(now),
+	}
+}
+
+// MvpVoteRequest is the request body for voting for a match's MVP
+type MvpVoteRequest struct {
+	CandidateUserID int64 `json:"candidateUserId" binding:"required"`
+}
+
+// MyMvpVoteResponse is the requesting player's vote in a match, with the voting state
+type MyMvpVoteResponse struct {
+	CandidateUserID *int64     `json:"candidateUserId,omitempty"`
+	VotedAt         *time.Time `json:"votedAt,omitempty"`
+	ClosesAt        time.Time  `json:"closesAt"`
+	Open            bool       `json:"open"`
+}
+
+// TrophyCount is how many times a user received an award type
+type TrophyCount struct {
+	AwardType gameDomain.MatchAwardType `json:"award_type"`
+	Count     int                       `json:"count"`
+}
+
+// UserTrophiesResponse adds up the match awards a user received, one line per award type
+type UserTrophiesResponse struct {
+	UserID   int64          `json:"user_id"`
+	Total    int            `json:"total"`
+	Trophies []*TrophyCount `json:"trophies"`
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+// PickemPredictionRequest is the request body for predicting the winner of a bracket game
+type PickemPredictionRequest struct {
+	TeamID int64 `json:"teamId" binding:"required"`
+}
+
+// PickemPredictionResponse is a user's prediction of a game; correct and scoredAt are set once the result is scored
+type PickemPredictionResponse struct {
+	GameID     int64      `json:"gameId"`
+	TeamID     int64      `json:"teamId"`
+	Correct    *bool      `json:"correct,omitempty"`
+	Points     int        `json:"points"`
+	ScoredAt   *time.Time `json:"scoredAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ModifiedAt time.Time  `json:"modifiedAt"`
+}
+
+func NewPickemPredictionResponse(prediction *gameDomain.PickemPrediction) *PickemPredictionResponse {
+	return &PickemPredictionResponse{
+		GameID:     prediction.GameID,
+		TeamID:     prediction.PredictedTeamID,
+		Correct:    prediction.Correct,
+		Points:     prediction.Points,
+		ScoredAt:   prediction.ScoredAt,
+		CreatedAt:  prediction.CreatedAt,
+		ModifiedAt: prediction.ModifiedAt,
+	}
+}
+
+// MyPickemResponse is the requesting user's predictions in a contest with their scored totals
+type MyPickemResponse struct {
+	ContestID    int64                       `json:"contestId"`
+	Points       int                         `json:"points"`
+	CorrectPicks int                         `json:"correctPicks"`
+	ScoredPicks  int                         `json:"scoredPicks"`
+	Predictions  []*PickemPredictionResponse `json:"predictions"`
+}
+
+// PickemLeaderboardRequest holds the query parameters of a pick'em leaderboard page
+type PickemLeaderboardRequest struct {
+	Page     int `form:"page"`
+	PageSize int `form:"page_size"`
+}
+
+// PickemStandingResponse is a ranked user of a contest's pick'em leaderboard
+type PickemStandingResponse struct {
+	Rank         int    `json:"rank"`
+	UserID       int64  `json:"userId"`
+	Username     string `json:"username,omitempty"`
+	Tag          string `json:"tag,omitempty"`
+	Avatar       string `json:"avatar,omitempty"`
+	Points       int    `json:"points"`
+	CorrectPicks int    `json:"correctPicks"`
+	ScoredPicks  int    `json:"scoredPicks"`
+}
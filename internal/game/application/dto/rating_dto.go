@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"math"
+	"time"
+)
+
+// RatingResponse is a player's or roster's Glicko-2 rating with its deviation decayed for inactivity
+type RatingResponse struct {
+	Rating             float64 `json:"rating"`
+	Deviation          float64 `json:"deviation"`
+	Volatility         float64 `json:"volatility"`
+	ConservativeRating float64 `json:"conservative_rating"`
+	// Provisional ratings have too few recent games to be trusted
+	Provisional  bool       `json:"provisional"`
+	GamesPlayed  int        `json:"games_played"`
+	Wins         int        `json:"wins"`
+	LastPlayedAt *time.Time `json:"last_played_at,omitempty"`
+}
+
+func NewRatingResponse(g domain.Glicko2, gamesPlayed, wins int, lastPlayedAt *time.Time) RatingResponse {
+	return RatingResponse{
+		Rating:             roundRating(g.Rating),
+		Deviation:          roundRating(g.Deviation),
+		Volatility:         g.Volatility,
+		ConservativeRating: roundRating(g.ConservativeRating()),
+		Provisional:        g.IsProvisional(),
+		GamesPlayed:        gamesPlayed,
+		Wins:               wins,
+		LastPlayedAt:       lastPlayedAt,
+	}
+}
+
+// PlayerRatingResponse is a user's rating
+type PlayerRatingResponse struct {
+	UserID int64 `json:"user_id"`
+	RatingResponse
+}
+
+// TeamRatingResponse is a roster's rating
+type TeamRatingResponse struct {
+	RosterKey string  `json:"roster_key"`
+	TeamName  string  `json:"team_name"`
+	MemberIDs []int64 `json:"member_ids"`
+	RatingResponse
+}
+
+// RatingHistoryResponse is the rating change caused by one game
+type RatingHistoryResponse struct {
+	GameID          int64     `json:"game_id"`
+	Won             bool      `json:"won"`
+	RatingBefore    float64   `json:"rating_before"`
+	RatingAfter     float64   `json:"rating_after"`
+	RatingChange    float64   `json:"rating_change"`
+	DeviationBefore float64   `json:"deviation_before"`
+	DeviationAfter  float64   `json:"deviation_after"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func NewRatingHistoryResponse(h *domain.RatingHistory) *RatingHistoryResponse {
+	return &RatingHistoryResponse{
+		GameID:          h.GameID,
+		Won:             h.Won,
+		RatingBefore:    roundRating(h.RatingBefore),
+		RatingAfter:     roundRating(h.RatingAfter),
+		RatingChange:    roundRating(h.RatingAfter - h.RatingBefore),
+		DeviationBefore: roundRating(h.DeviationBefore),
+		DeviationAfter:  roundRating(h.DeviationAfter),
+		CreatedAt:       h.CreatedAt,
+	}
+}
+
+// RatingHistoryRequest holds the query parameters of a rating history page
+type RatingHistoryRequest struct {
+	Page     int `form:"page"`
+	PageSize int `form:"page_size"`
+}
+
+// SeededTeamResponse is a contest team with its seed; unrated rosters are rated by the composite of their members
+type SeededTeamResponse struct {
+	Seed               int     `json:"seed"`
+	TeamID             int64   `json:"team_id"`
+	TeamName           string  `json:"team_name"`
+	RosterKey          string  `json:"roster_key"`
+	MemberIDs          []int64 `json:"member_ids"`
+	Rating             float64 `json:"rating"`
+	Deviation          float64 `json:"deviation"`
+	ConservativeRating float64 `json:"conservative_rating"`
+	// RosterRated tells whether the roster itself has played rated games together
+	RosterRated bool `json:"roster_rated"`
+}
+
+// ContestSeedingResponse lists a contest's teams from the first seed down
+type ContestSeedingResponse struct {
+	ContestID int64                 `json:"contest_id"`
+	Teams     []*SeededTeamResponse `json:"teams"`
+}
+
+func roundRating(v float64) float64 {
+	return math.Round(v*100) / 100
+}
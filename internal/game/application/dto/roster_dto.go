@@ -0,0 +1,82 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+type CreateRosterChangeRequest struct {
+	ChangeType        gameDomain.RosterChangeType `json:"change_type" binding:"required"`
+	TargetUserID      *int64                      `json:"target_user_id,omitempty"`
+	ReplacementUserID *int64                      `json:"replacement_user_id,omitempty"`
+	Reason            string                      `json:"reason" binding:"max=500"`
+}
+
+type ReviewRosterChangeRequest struct {
+	Note string `json:"note" binding:"max=500"`
+}
+
+type RosterChangeRequestResponse struct {
+	RequestID         int64                         `json:"request_id"`
+	ContestID         int64                         `json:"contest_id"`
+	TeamID            int64                         `json:"team_id"`
+	RequestedBy       int64                         `json:"requested_by"`
+	ChangeType        gameDomain.RosterChangeType   `json:"change_type"`
+	TargetUserID      *int64                        `json:"target_user_id,omitempty"`
+	ReplacementUserID *int64                        `json:"replacement_user_id,omitempty"`
+	Reason            string                        `json:"reason,omitempty"`
+	Status            gameDomain.RosterChangeStatus `json:"status"`
+	ReviewedBy        *int64                        `json:"reviewed_by,omitempty"`
+	ReviewNote        string                        `json:"review_note,omitempty"`
+	ReviewedAt        *time.Time                    `json:"reviewed_at,omitempty"`
+	CreatedAt         time.Time                     `json:"created_at"`
+}
+
+type RosterLockReportResponse struct {
+	ContestID          int64                     `json:"contest_id"`
+	LockedAt           time.Time                 `json:"locked_at"`
+	FinalizedTeamCount int                       `json:"finalized_team_count"`
+	DroppedTeamCount   int                       `json:"dropped_team_count"`
+	DroppedTeams       []*gameDomain.DroppedTeam `json:"dropped_teams"`
+}
+
+func ToRosterChangeRequestResponse(request *gameDomain.RosterChangeRequest) *RosterChangeRequestResponse {
+	return &RosterChangeRequestResponse{
+		RequestID:         request.RequestID,
+		ContestID:         request.ContestID,
+		TeamID:            request.TeamID,
+		RequestedBy:       request.RequestedBy,
+		ChangeType:        request.ChangeType,
+		TargetUserID:      request.TargetUserID,
+		ReplacementUserID: request.ReplacementUserID,
+		Reason:            request.Reason,
+		Status:            request.Status,
+		ReviewedBy:        request.ReviewedBy,
+		ReviewNote:        request.ReviewNote,
+		ReviewedAt:        request.ReviewedAt,
+		CreatedAt:         request.CreatedAt,
+	}
+}
+
+func ToRosterChangeRequestResponses(requests []*gameDomain.RosterChangeRequest) []*RosterChangeRequestResponse {
+	responses := make([]*RosterChangeRequestResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = ToRosterChangeRequestResponse(request)
+	}
+	return responses
+}
+
+func ToRosterLockReportResponse(report *gameDomain.RosterLockReport) *RosterLockReportResponse {
+	droppedTeams := []*gameDomain.DroppedTeam(report.DroppedTeams)
+	if droppedTeams == nil {
+		droppedTeams = []*gameDomain.DroppedTeam{}
+	}
+
+	return &RosterLockReportResponse{
+		ContestID:          report.ContestID,
+		LockedAt:           report.LockedAt,
+		FinalizedTeamCount: report.FinalizedTeamCount,
+		DroppedTeamCount:   report.DroppedTeamCount,
+		DroppedTeams:       droppedTeams,
+	}
+}
@@ -64,6 +64,10 @@ type MatchResultResponse struct {
 	GameDuration    int                        `json:"gameDuration,omitempty"`
 	DetectionStatus gameDomain.DetectionStatus `json:"detectionStatus"`
 	PlayerStats     []*PlayerStatResponse      `json:"playerStats,omitempty"`
+
+	// Awards holds the stat awards of detected matches, and the MVP once voting closed
+	Awards    []*MatchAwardResponse `json:"awards,omitempty"`
+	MvpVoting *MvpVotingResponse    `json:"mvpVoting,omitempty"`
 }
 
 // PlayerStatResponse represents individual player stats
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+type TeamDeadLetterResponse struct {
+	DeadLetterID   int64                       `json:"dead_letter_id"`
+	EventID        string                      `json:"event_id"`
+	IdempotencyKey string                      `json:"idempotency_key"`
+	EventType      string                      `json:"event_type"`
+	ContestID      int64                       `json:"contest_id"`
+	TeamID         int64                       `json:"team_id"`
+	Payload        string                      `json:"payload"`
+	LastError      string                      `json:"last_error"`
+	RetryCount     int                         `json:"retry_count"`
+	Status         gameDomain.DeadLetterStatus `json:"status"`
+	ResolvedBy     *int64                      `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time                  `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time                   `json:"created_at"`
+}
+
+func ToTeamDeadLetterResponse(deadLetter *gameDomain.TeamDeadLetter) *TeamDeadLetterResponse {
+	return &TeamDeadLetterResponse{
+		DeadLetterID:   deadLetter.DeadLetterID,
+		EventID:        deadLetter.EventID,
+		IdempotencyKey: deadLetter.IdempotencyKey,
+		EventType:      deadLetter.EventType,
+		ContestID:      deadLetter.ContestID,
+		TeamID:         deadLetter.TeamID,
+		Payload:        deadLetter.Payload,
+		LastError:      deadLetter.LastError,
+		RetryCount:     deadLetter.RetryCount,
+		Status:         deadLetter.Status,
+		ResolvedBy:     deadLetter.ResolvedBy,
+		ResolvedAt:     deadLetter.ResolvedAt,
+		CreatedAt:      deadLetter.CreatedAt,
+	}
+}
+
+func ToTeamDeadLetterResponses(deadLetters []*gameDomain.TeamDeadLetter) []*TeamDeadLetterResponse {
+	responses := make([]*TeamDeadLetterResponse, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		responses[i] = ToTeamDeadLetterResponse(deadLetter)
+	}
+	return responses
+}
@@ -4,6 +4,7 @@ import (
 	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
 )
 
 type CreateTeamRequest struct {
@@ -158,3 +159,13 @@ func ToCachedMemberResponses(members []*port.CachedTeamMember) []*CachedMemberRe
 	}
 	return responses
 }
+
+// WaitlistPositionResponse represents a team's place on the contest waitlist
+type WaitlistPositionResponse struct {
+	ContestID    int64     `json:"contest_id"`
+	TeamID       int64     `json:"team_id"`
+	TeamName     string    `json:"team_name"`
+	Position     int       `json:"position"`
+	WaitlistSize int       `json:"waitlist_size"`
+	WaitlistedAt time.Time `json:"waitlisted_at"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+import (
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+)
+
+type ReconcileTeamsRequest struct {
+	Direction gameDomain.ReconcileDirection `json:"direction" binding:"required"`
+}
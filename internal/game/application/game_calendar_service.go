@@ -0,0 +1,158 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GameCalendarService builds iCalendar feeds of scheduled games and manages private feed tokens
+type GameCalendarService struct {
+	calendarRepo  port.GameCalendarDatabasePort
+	contestRepo   contestPort.ContestDatabasePort
+	accessChecker contestPort.ContestAccessPort
+}
+
+func NewGameCalendarService(
+	calendarRepo port.GameCalendarDatabasePort,
+	contestRepo contestPort.ContestDatabasePort,
+) *GameCalendarService {
+	return &GameCalendarService{
+		calendarRepo: calendarRepo,
+		contestRepo:  contestRepo,
+	}
+}
+
+// SetContestRepository sets the contest repository (to avoid circular dependency)
+func (s *GameCalendarService) SetContestRepository(repository contestPort.ContestDatabasePort) {
+	s.contestRepo = repository
+}
+
+// SetAccessChecker sets the contest access checker that hides the feeds of private contests
+func (s *GameCalendarService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// GetContestCalendar renders the feed of every scheduled game in a contest (userID is 0 for anonymous)
+func (s *GameCalendarService) GetContestCalendar(contestID, userID int64) ([]byte, error) {
+	contest, err := s.contestRepo.GetContestById(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.accessChecker != nil {
+		if err := s.accessChecker.CheckViewAccess(contestID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	games, err := s.calendarRepo.GetCalendarGamesByContest(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.RenderCalendar(contest.Title, toCalendarEvents(games), time.Now()), nil
+}
+
+// GetUserCalendar renders the private feed behind a token: every game the user's teams play across contests
+func (s *GameCalendarService) GetUserCalendar(token string) ([]byte, error) {
+	feedToken, err := s.calendarRepo.GetFeedTokenByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.calendarRepo.GetCalendarGamesByUser(feedToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.RenderCalendar("GAMERS - My matches", toCalendarEvents(games), time.Now()), nil
+}
+
+// GetFeedToken returns the user's feed token, issuing one on first use
+func (s *GameCalendarService) GetFeedToken(userID int64) (*domain.CalendarFeedToken, error) {
+	token, err := s.calendarRepo.GetFeedTokenByUserID(userID)
+	if err == nil {
+		return token, nil
+	}
+	if !errors.Is(err, exception.ErrCalendarFeedNotFound) {
+		return nil, err
+	}
+
+	return s.RegenerateFeedToken(userID)
+}
+
+// RegenerateFeedToken issues a new feed token; the previous feed URL stops working
+func (s *GameCalendarService) RegenerateFeedToken(userID int64) (*domain.CalendarFeedToken, error) {
+	token, err := domain.NewCalendarFeedToken(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.calendarRepo.SaveFeedToken(token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func toCalendarEvents(games []*port.CalendarGame) []domain.CalendarEvent {
+	events := make([]domain.CalendarEvent, 0, len(games))
+	for _, g := range games {
+		if !g.Game.IsOnCalendar() {
+			continue
+		}
+
+		events = append(events, domain.CalendarEvent{
+			UID:          g.Game.CalendarUID(),
+			Summary:      calendarSummary(g),
+			Description:  calendarDescription(g),
+			Start:        *g.Game.CalendarStart(),
+			End:          g.Game.CalendarEnd(),
+			LastModified: g.Game.ModifiedAt,
+			Sequence:     g.Game.CalendarSequence(),
+		})
+	}
+	return events
+}
+
+// calendarSummary reads like "Spring Cup - Semi-finals: Alpha vs Bravo"
+func calendarSummary(g *port.CalendarGame) string {
+	matchup := "TBD vs TBD"
+	switch len(g.TeamNames) {
+	case 0:
+	case 1:
+		matchup = g.TeamNames[0] + " vs TBD"
+	default:
+		matchup = strings.Join(g.TeamNames, " vs ")
+	}
+
+	if roundName := calendarRoundName(g); roundName != "" {
+		return fmt.Sprintf("%s - %s: %s", g.ContestTitle, roundName, matchup)
+	}
+	return fmt.Sprintf("%s: %s", g.ContestTitle, matchup)
+}
+
+func calendarDescription(g *port.CalendarGame) string {
+	lines := []string{"Contest: " + g.ContestTitle}
+	if roundName := calendarRoundName(g); roundName != "" {
+		lines = append(lines, fmt.Sprintf("Round: %s (match %d)", roundName, g.Game.GetMatchNumber()))
+	}
+	if len(g.TeamNames) > 0 {
+		lines = append(lines, "Teams: "+strings.Join(g.TeamNames, ", "))
+	}
+	lines = append(lines, "Status: "+string(g.Game.GameStatus))
+	return strings.Join(lines, "\n")
+}
+
+func calendarRoundName(g *port.CalendarGame) string {
+	if !g.Game.IsTournamentGame() || g.TotalRounds == 0 {
+		return ""
+	}
+	return GetRoundName(g.Game.GetRound(), g.TotalRounds)
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"context"
 	"fmt"
 	"log"
@@ -12,36 +13,52 @@ import (
 
 const (
 	// Redis distributed lock keys
-	lockKeyActivation = "scheduler:lock:activation"
-	lockKeyDetection  = "scheduler:lock:detection"
+	lockKeyActivation        = "scheduler:lock:activation"
+	lockKeyDetection         = "scheduler:lock:detection"
+	lockKeyRegistrationClose = "scheduler:lock:registration_close"
+	lockKeyTeamReconcile     = "scheduler:lock:team_reconciliation"
 
 	// Lock TTL — should be longer than max expected execution time
-	lockTTLActivation = 50 * time.Second
-	lockTTLDetection  = 2 * time.Minute
+	lockTTLActivation        = 50 * time.Second
+	lockTTLDetection         = 2 * time.Minute
+	lockTTLRegistrationClose = 2 * time.Minute
+	lockTTLTeamReconcile     = 5 * time.Minute
 )
 
 // GameSchedulerService handles cron-triggered game activation and match detection
 type GameSchedulerService struct {
 	gameDBPort        port.GameDatabasePort
 	matchDetectionSvc *MatchDetectionService
+	rosterSvc         *RosterService
+	reconciliationSvc *TeamReconciliationService
 	eventPublisher    port.GameEventPublisherPort
 	redisClient       *redis.Client
+	txManager         transaction.Transactor
 }
 
 func NewGameSchedulerService(
 	gameDBPort port.GameDatabasePort,
 	matchDetectionSvc *MatchDetectionService,
+	rosterSvc *RosterService,
+	reconciliationSvc *TeamReconciliationService,
 	eventPublisher port.GameEventPublisherPort,
 	redisClient *redis.Client,
 ) *GameSchedulerService {
 	return &GameSchedulerService{
 		gameDBPort:        gameDBPort,
 		matchDetectionSvc: matchDetectionSvc,
+		rosterSvc:         rosterSvc,
+		reconciliationSvc: reconciliationSvc,
 		eventPublisher:    eventPublisher,
 		redisClient:       redisClient,
 	}
 }
 
+// SetTransactionManager sets the transaction manager so game updates and their outbox events commit together
+func (s *GameSchedulerService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
 // RunScheduledActivation is called every 1 minute by cron.
 // It activates games whose scheduled start time has arrived.
 func (s *GameSchedulerService) RunScheduledActivation() {
@@ -77,12 +94,7 @@ func (s *GameSchedulerService) RunScheduledActivation() {
 			continue
 		}
 
-		if err := s.gameDBPort.Update(game); err != nil {
-			log.Printf("[Scheduler] Failed to save activated game %d: %v", game.GameID, err)
-			continue
-		}
-
-		// Publish activation event
+		// Save the activation and its event (outbox) in one transaction
 		event := &port.GameEvent{
 			EventType:   port.GameEventActivated,
 			Timestamp:   time.Now(),
@@ -91,8 +103,15 @@ func (s *GameSchedulerService) RunScheduledActivation() {
 			Round:       game.GetRound(),
 			MatchNumber: game.GetMatchNumber(),
 		}
-		if err := s.eventPublisher.PublishGameEvent(ctx, event); err != nil {
-			log.Printf("[Scheduler] Failed to publish activation event for game %d: %v", game.GameID, err)
+		err := transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+			if err := s.gameDBPort.UpdateWithContext(txCtx, game); err != nil {
+				return err
+			}
+			return s.eventPublisher.PublishGameEvent(txCtx, event)
+		})
+		if err != nil {
+			log.Printf("[Scheduler] Failed to save activated game %d: %v", game.GameID, err)
+			continue
 		}
 
 		log.Printf("[Scheduler] Game %d activated (contest %d, round %d, match %d)",
@@ -146,6 +165,44 @@ func (s *GameSchedulerService) RunMatchDetection() {
 	}
 }
 
+// RunRegistrationClose is called every 1 minute.
+// It locks rosters of contests whose registration close time has arrived.
+func (s *GameSchedulerService) RunRegistrationClose() {
+	ctx := context.Background()
+
+	acquired, err := s.acquireLock(ctx, lockKeyRegistrationClose, lockTTLRegistrationClose)
+	if err != nil {
+		log.Printf("[Scheduler] Failed to acquire registration close lock: %v", err)
+		return
+	}
+	if !acquired {
+		log.Printf("[Scheduler] Registration close job already running on another instance, skipping")
+		return
+	}
+	defer s.releaseLock(ctx, lockKeyRegistrationClose)
+
+	s.rosterSvc.LockDueContests(ctx)
+}
+
+// RunTeamReconciliation is called every 10 minutes.
+// It compares the Redis team cache with MySQL and repairs drift of pending contests.
+func (s *GameSchedulerService) RunTeamReconciliation() {
+	ctx := context.Background()
+
+	acquired, err := s.acquireLock(ctx, lockKeyTeamReconcile, lockTTLTeamReconcile)
+	if err != nil {
+		log.Printf("[Scheduler] Failed to acquire team reconciliation lock: %v", err)
+		return
+	}
+	if !acquired {
+		log.Printf("[Scheduler] Team reconciliation job already running on another instance, skipping")
+		return
+	}
+	defer s.releaseLock(ctx, lockKeyTeamReconcile)
+
+	s.reconciliationSvc.RunScheduledReconciliation(ctx)
+}
+
 // acquireLock attempts to acquire a distributed lock using Redis SETNX
 func (s *GameSchedulerService) acquireLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
 	result, err := s.redisClient.SetNX(ctx, key, fmt.Sprintf("locked:%d", time.Now().UnixMilli()), ttl).Result()
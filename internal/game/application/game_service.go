@@ -1,6 +1,8 @@
 package application
 
 import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
@@ -8,8 +10,10 @@ import (
 )
 
 type GameService struct {
-	gameRepository port.GameDatabasePort
-	teamRepository port.TeamDatabasePort
+	gameRepository    port.GameDatabasePort
+	teamRepository    port.TeamDatabasePort
+	permissionChecker contestPort.ContestPermissionPort
+	accessChecker     contestPort.ContestAccessPort
 }
 
 func NewGameService(
@@ -22,6 +26,68 @@ func NewGameService(
 	}
 }
 
+// SetPermissionChecker sets the contest permission checker (to resolve circular dependency)
+func (s *GameService) SetPermissionChecker(checker contestPort.ContestPermissionPort) {
+	s.permissionChecker = checker
+}
+
+// CheckContestPermission checks that the user holds a contest organizer role granting the action
+func (s *GameService) CheckContestPermission(contestID, userID int64, action contestDomain.ContestAction) error {
+	if s.permissionChecker == nil {
+		return exception.ErrPermissionDenied
+	}
+	return s.permissionChecker.CheckPermission(contestID, userID, action)
+}
+
+// CheckGamePermission checks the action against the organizer role the user holds on the game's contest
+func (s *GameService) CheckGamePermission(gameID, userID int64, action contestDomain.ContestAction) error {
+	game, err := s.gameRepository.GetByID(gameID)
+	if err != nil {
+		return err
+	}
+	return s.CheckContestPermission(game.ContestID, userID, action)
+}
+
+// SetAccessChecker sets the contest access checker that hides games and results of private contests
+func (s *GameService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// CheckContestViewAccess checks that the viewer may read the contest's games and results (userID is 0 for anonymous)
+func (s *GameService) CheckContestViewAccess(contestID, userID int64) error {
+	if s.accessChecker == nil {
+		return nil
+	}
+	return s.accessChecker.CheckViewAccess(contestID, userID)
+}
+
+// CheckGameViewAccess applies the visibility of the game's contest to the game
+func (s *GameService) CheckGameViewAccess(gameID, userID int64) error {
+	game, err := s.gameRepository.GetByID(gameID)
+	if err != nil {
+		return err
+	}
+	return s.CheckContestViewAccess(game.ContestID, userID)
+}
+
+// checkGameManager allows contest staff with MANAGE_GAMES or the leader of a team in the game
+func (s *GameService) checkGameManager(game *domain.Game, userID int64) error {
+	if s.CheckContestPermission(game.ContestID, userID, contestDomain.ContestActionManageGames) == nil {
+		return nil
+	}
+
+	member, err := s.teamRepository.GetByGameAndUser(game.GameID, userID)
+	if err != nil {
+		return exception.ErrNotTeamMember
+	}
+
+	if !member.IsLeader() {
+		return exception.ErrNoPermissionToDelete
+	}
+
+	return nil
+}
+
 // CreateGame creates a new game
 func (s *GameService) CreateGame(req *dto.CreateGameRequest) (*domain.Game, error) {
 	game := domain.NewGame(
@@ -124,14 +190,8 @@ func (s *GameService) CancelGame(gameID, userID int64) (*domain.Game, error) {
 		return nil, err
 	}
 
-	// Check if user is the leader
-	member, err := s.teamRepository.GetByGameAndUser(gameID, userID)
-	if err != nil {
-		return nil, exception.ErrNotTeamMember
-	}
-
-	if !member.IsLeader() {
-		return nil, exception.ErrNoPermissionToDelete
+	if err := s.checkGameManager(game, userID); err != nil {
+		return nil, err
 	}
 
 	if err := game.TransitionTo(domain.GameStatusCancelled); err != nil {
@@ -173,7 +233,7 @@ func (s *GameService) GetDetectionStatus(gameID int64) (*domain.Game, error) {
 	return s.gameRepository.GetByID(gameID)
 }
 
-// DeleteGame deletes a game (Leader only, only in PENDING status)
+// DeleteGame deletes a game (contest staff or team leader, only in PENDING status)
 func (s *GameService) DeleteGame(gameID, userID int64) error {
 	game, err := s.gameRepository.GetByID(gameID)
 	if err != nil {
@@ -184,14 +244,8 @@ func (s *GameService) DeleteGame(gameID, userID int64) error {
 		return exception.ErrGameNotPending
 	}
 
-	// Check if user is the leader
-	member, err := s.teamRepository.GetByGameAndUser(gameID, userID)
-	if err != nil {
-		return exception.ErrNotTeamMember
-	}
-
-	if !member.IsLeader() {
-		return exception.ErrNoPermissionToDelete
+	if err := s.checkGameManager(game, userID); err != nil {
+		return err
 	}
 
 	// Delete all team members first
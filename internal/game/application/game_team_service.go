@@ -13,6 +13,7 @@ type GameTeamService struct {
 	gameRepository     port.GameDatabasePort
 	teamRepository     port.TeamDatabasePort
 	contestRepository  contestPort.ContestDatabasePort
+	accessChecker      contestPort.ContestAccessPort
 }
 
 func NewGameTeamService(
@@ -29,6 +30,24 @@ func NewGameTeamService(
 	}
 }
 
+// SetAccessChecker sets the contest access checker that hides game teams of private contests
+func (s *GameTeamService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// checkGameViewAccess applies the visibility of the game's contest to its game teams (userID is 0 for anonymous)
+func (s *GameTeamService) checkGameViewAccess(gameID, userID int64) error {
+	if s.accessChecker == nil {
+		return nil
+	}
+
+	game, err := s.gameRepository.GetByID(gameID)
+	if err != nil {
+		return err
+	}
+	return s.accessChecker.CheckViewAccess(game.ContestID, userID)
+}
+
 // CreateGameTeam creates a new game-team relationship with grade validation
 func (s *GameTeamService) CreateGameTeam(req *dto.CreateGameTeamRequest) (*domain.GameTeam, error) {
 	// Validate game exists
@@ -84,12 +103,23 @@ func (s *GameTeamService) CreateGameTeam(req *dto.CreateGameTeamRequest) (*domai
 }
 
 // GetGameTeam returns a game team by ID
-func (s *GameTeamService) GetGameTeam(gameTeamID int64) (*domain.GameTeam, error) {
-	return s.gameTeamRepository.GetByID(gameTeamID)
+func (s *GameTeamService) GetGameTeam(gameTeamID, userID int64) (*domain.GameTeam, error) {
+	gameTeam, err := s.gameTeamRepository.GetByID(gameTeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGameViewAccess(gameTeam.GameID, userID); err != nil {
+		return nil, err
+	}
+	return gameTeam, nil
 }
 
 // GetGameTeamsByGame returns all game teams for a game
-func (s *GameTeamService) GetGameTeamsByGame(gameID int64) ([]*domain.GameTeam, error) {
+func (s *GameTeamService) GetGameTeamsByGame(gameID, userID int64) ([]*domain.GameTeam, error) {
+	if err := s.checkGameViewAccess(gameID, userID); err != nil {
+		return nil, err
+	}
 	return s.gameTeamRepository.GetByGameID(gameID)
 }
 
@@ -0,0 +1,560 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LeaderboardService maintains the player and team leaderboards in Redis.
+// Every finished game is applied once, incrementally, to the global leaderboards and those of its season.
+// Rating leaderboards are pushed by RatingService instead, since ratings live in the database.
+type LeaderboardService struct {
+	cache             port.LeaderboardCachePort
+	leaderboardRepo   port.LeaderboardDatabasePort
+	ratingRepo        port.RatingDatabasePort
+	gameDBPort        port.GameDatabasePort
+	gameTeamDBPort    port.GameTeamDatabasePort
+	matchResultDBPort port.MatchResultDatabasePort
+	contestRepo       contestPort.ContestDatabasePort
+
+	// rebuildMu keeps event handling from interleaving with a rebuild replaying the same games
+	rebuildMu sync.Mutex
+}
+
+func NewLeaderboardService(
+	cache port.LeaderboardCachePort,
+	leaderboardRepo port.LeaderboardDatabasePort,
+	ratingRepo port.RatingDatabasePort,
+	gameDBPort port.GameDatabasePort,
+	gameTeamDBPort port.GameTeamDatabasePort,
+	matchResultDBPort port.MatchResultDatabasePort,
+) *LeaderboardService {
+	return &LeaderboardService{
+		cache:             cache,
+		leaderboardRepo:   leaderboardRepo,
+		ratingRepo:        ratingRepo,
+		gameDBPort:        gameDBPort,
+		gameTeamDBPort:    gameTeamDBPort,
+		matchResultDBPort: matchResultDBPort,
+	}
+}
+
+// SetContestRepository sets the contest repository (to avoid circular dependency)
+func (s *LeaderboardService) SetContestRepository(repository contestPort.ContestDatabasePort) {
+	s.contestRepo = repository
+}
+
+// leaderboardSide is a team seated in a finished game
+type leaderboardSide struct {
+	teamName  string
+	grade     *int
+	rosterKey string
+	members   []string
+	userIDs   []int64
+}
+
+// HandleGameEvent applies game.finished events; other game events are ignored
+func (s *LeaderboardService) HandleGameEvent(ctx context.Context, event *port.GameEvent) error {
+	if event.EventType != port.GameEventFinished {
+		return nil
+	}
+
+	s.rebuildMu.Lock()
+	defer s.rebuildMu.Unlock()
+
+	return s.applyGameOnce(ctx, event.GameID)
+}
+
+// applyGameOnce applies a game unless it was already applied, so redelivered events do not count twice.
+// The processed marker and every scope are written in one atomic step, so a failed update leaves nothing behind to retry over.
+func (s *LeaderboardService) applyGameOnce(ctx context.Context, gameID int64) error {
+	update, err := s.buildGameUpdate(gameID)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+
+	_, err = s.cache.ApplyGame(ctx, gameID, update)
+	return err
+}
+
+// buildGameUpdate returns what the game adds to the leaderboards, or nil if it adds nothing
+func (s *LeaderboardService) buildGameUpdate(gameID int64) (*port.LeaderboardGameUpdate, error) {
+	game, err := s.gameDBPort.GetByID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrGameNotFound) {
+			log.Printf("[Leaderboard] Skipping deleted game %d", gameID)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if game.GameStatus != domain.GameStatusFinished {
+		return nil, nil
+	}
+
+	scopes, err := s.scopesOf(game)
+	if err != nil {
+		return nil, err
+	}
+
+	sides, err := s.loadSides(game.GameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(sides) == 0 {
+		return nil, nil
+	}
+
+	stats, roundsPlayed, err := s.loadPlayerStats(game.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := port.NewLeaderboardGameUpdate()
+	addRosters(update, sides)
+	for _, scope := range scopes {
+		addToScope(update, scope, game, sides, stats, roundsPlayed)
+	}
+	return update, nil
+}
+
+// scopesOf returns the global scope, plus the season of the game's contest when it belongs to a series
+func (s *LeaderboardService) scopesOf(game *domain.Game) ([]domain.LeaderboardScope, error) {
+	scopes := []domain.LeaderboardScope{domain.LeaderboardScopeGlobal}
+	if s.contestRepo == nil {
+		return scopes, nil
+	}
+
+	contest, err := s.contestRepo.GetContestById(game.ContestID)
+	if err != nil {
+		if errors.Is(err, exception.ErrContestNotFound) {
+			return scopes, nil
+		}
+		return nil, err
+	}
+	if contest.SeriesID != nil {
+		scopes = append(scopes, domain.SeasonLeaderboardScope(*contest.SeriesID))
+	}
+	return scopes, nil
+}
+
+// loadSides groups the members of the game's teams, keyed by team ID
+func (s *LeaderboardService) loadSides(gameID int64) (map[int64]*leaderboardSide, error) {
+	gameTeams, err := s.gameTeamDBPort.GetByGameID(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.leaderboardRepo.GetGameTeamMembers(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	sides := make(map[int64]*leaderboardSide, len(gameTeams))
+	for _, gameTeam := range gameTeams {
+		sides[gameTeam.TeamID] = &leaderboardSide{grade: gameTeam.Grade}
+	}
+
+	for _, member := range members {
+		side, ok := sides[member.TeamID]
+		if !ok {
+			continue
+		}
+		side.teamName = member.TeamName
+		side.userIDs = append(side.userIDs, member.UserID)
+		side.members = append(side.members, domain.PlayerLeaderboardMember(member.UserID))
+	}
+
+	for teamID, side := range sides {
+		if len(side.userIDs) == 0 {
+			delete(sides, teamID)
+			continue
+		}
+		side.rosterKey = domain.RosterKey(side.userIDs)
+	}
+	return sides, nil
+}
+
+// loadPlayerStats returns the detected player stats of the game and its rounds played.
+// Games without a match result, or with a manual one, have no stats.
+func (s *LeaderboardService) loadPlayerStats(gameID int64) ([]*domain.MatchPlayerStat, int, error) {
+	result, err := s.matchResultDBPort.GetByGameID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrMatchResultNotFound) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+
+	stats, err := s.matchResultDBPort.GetPlayerStatsByMatchResult(result.MatchResultID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return stats, result.RoundsPlayed, nil
+}
+
+// addRosters records the latest name of each roster and the rosters each user played in
+func addRosters(update *port.LeaderboardGameUpdate, sides map[int64]*leaderboardSide) {
+	for _, side := range sides {
+		update.TeamNames[side.rosterKey] = side.teamName
+		for _, userID := range side.userIDs {
+			update.UserRosters[userID] = append(update.UserRosters[userID], side.rosterKey)
+		}
+	}
+}
+
+// addToScope adds placement points, tournament wins, kills and ACS totals to the leaderboards of a scope
+func addToScope(
+	update *port.LeaderboardGameUpdate,
+	scope domain.LeaderboardScope,
+	game *domain.Game,
+	sides map[int64]*leaderboardSide,
+	stats []*domain.MatchPlayerStat,
+	roundsPlayed int,
+) {
+	players := string(domain.LeaderboardSubjectPlayers)
+	teams := string(domain.LeaderboardSubjectTeams)
+	key := func(subject string, metric domain.LeaderboardMetric) string {
+		return leaderboardKey(scope, subject, metric)
+	}
+
+	// Placement points and tournament wins come from the grades of the seated teams
+	isFinal := game.IsTournamentGame() && game.NextGameID == nil
+	playerPlacement := make(map[string]float64)
+	teamPlacement := make(map[string]float64)
+	playerWins := make(map[string]float64)
+	teamWins := make(map[string]float64)
+	for _, side := range sides {
+		if side.grade == nil {
+			continue
+		}
+		points := float64(domain.PlacementPoints(*side.grade, len(sides)))
+		teamPlacement[side.rosterKey] += points
+		for _, member := range side.members {
+			playerPlacement[member] += points
+		}
+
+		if isFinal && *side.grade == 1 {
+			teamWins[side.rosterKey]++
+			for _, member := range side.members {
+				playerWins[member]++
+			}
+		}
+	}
+
+	// Combat stats come from the detected match, if any
+	playerKills := make(map[string]float64)
+	teamKills := make(map[string]float64)
+	acsTotals := make(map[string]int64)
+	var acsMembers []string
+	for _, stat := range stats {
+		member := domain.PlayerLeaderboardMember(stat.UserID)
+		playerKills[member] += float64(stat.Kills)
+		if side, ok := sides[stat.TeamID]; ok {
+			teamKills[side.rosterKey] += float64(stat.Kills)
+		}
+		if roundsPlayed > 0 {
+			acsTotals[member+":score"] += int64(stat.Score)
+			acsTotals[member+":rounds"] += int64(roundsPlayed)
+			acsMembers = append(acsMembers, member)
+		}
+	}
+
+	update.AddScores(key(players, domain.LeaderboardMetricPlacement), playerPlacement)
+	update.AddScores(key(teams, domain.LeaderboardMetricPlacement), teamPlacement)
+	update.AddScores(key(players, domain.LeaderboardMetricWins), playerWins)
+	update.AddScores(key(teams, domain.LeaderboardMetricWins), teamWins)
+	update.AddScores(key(players, domain.LeaderboardMetricKills), playerKills)
+	update.AddScores(key(teams, domain.LeaderboardMetricKills), teamKills)
+
+	// ACS is ranked from the running totals, once the player reached MinACSRounds
+	if len(acsTotals) > 0 {
+		totalsKey := leaderboardTotalsKey(scope, players)
+		update.AddTotals(totalsKey, acsTotals)
+		update.Averages = append(update.Averages, &port.LeaderboardAverage{
+			Key:       key(players, domain.LeaderboardMetricACS),
+			TotalsKey: totalsKey,
+			Members:   acsMembers,
+			MinRounds: domain.MinACSRounds,
+		})
+	}
+}
+
+// GetLeaderboard returns a page of a leaderboard, ranked from the top
+func (s *LeaderboardService) GetLeaderboard(
+	subject domain.LeaderboardSubject,
+	metric domain.LeaderboardMetric,
+	req *dto.LeaderboardRequest,
+) (*commonDto.PaginationResponse, error) {
+	if err := validateLeaderboard(subject, metric, req.SeasonID); err != nil {
+		return nil, err
+	}
+
+	pagination := commonDto.NewPaginationRequest(req.Page, req.PageSize)
+	key := leaderboardKey(leaderboardScopeOf(req.SeasonID), string(subject), metric)
+	ctx := context.Background()
+
+	total, err := s.cache.Count(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.cache.GetRange(ctx, key, pagination.GetOffset(), pagination.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.toEntryResponses(ctx, subject, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return commonDto.NewPaginationResponse(responses, pagination.Page, pagination.PageSize, total), nil
+}
+
+// GetMyPosition returns the user's rank on a player leaderboard, or the ranks of their rosters on a team leaderboard
+func (s *LeaderboardService) GetMyPosition(
+	subject domain.LeaderboardSubject,
+	metric domain.LeaderboardMetric,
+	seasonID *int64,
+	userID int64,
+) (*dto.LeaderboardPositionResponse, error) {
+	if err := validateLeaderboard(subject, metric, seasonID); err != nil {
+		return nil, err
+	}
+
+	scope := leaderboardScopeOf(seasonID)
+	key := leaderboardKey(scope, string(subject), metric)
+	ctx := context.Background()
+
+	members := []string{domain.PlayerLeaderboardMember(userID)}
+	if subject == domain.LeaderboardSubjectTeams {
+		rosters, err := s.cache.GetUserRosters(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		members = rosters
+	}
+
+	var entries []*domain.LeaderboardEntry
+	for _, member := range members {
+		entry, err := s.cache.GetEntry(ctx, key, member)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Rank < entries[j].Rank })
+
+	responses, err := s.toEntryResponses(ctx, subject, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.cache.Count(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LeaderboardPositionResponse{
+		Subject:    string(subject),
+		Metric:     string(metric),
+		Scope:      string(scope),
+		TotalCount: total,
+		Entries:    responses,
+	}, nil
+}
+
+// Rebuild clears every leaderboard and replays all finished games in the order they ended.
+// Use it after changing how leaderboards are scored or when events were lost.
+func (s *LeaderboardService) Rebuild(ctx context.Context) (*dto.LeaderboardRebuildResponse, error) {
+	s.rebuildMu.Lock()
+	defer s.rebuildMu.Unlock()
+
+	gameIDs, err := s.leaderboardRepo.GetFinishedGameIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cache.Clear(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.reseedRatings(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, gameID := range gameIDs {
+		if err := s.applyGameOnce(ctx, gameID); err != nil {
+			return nil, fmt.Errorf("failed to apply game %d: %w", gameID, err)
+		}
+	}
+
+	log.Printf("[Leaderboard] Rebuilt leaderboards from %d finished games", len(gameIDs))
+	return &dto.LeaderboardRebuildResponse{GamesApplied: len(gameIDs)}, nil
+}
+
+// SetRatings updates the all-time rating leaderboards with conservative ratings,
+// keyed by leaderboard member for players and by roster key for teams
+func (s *LeaderboardService) SetRatings(ctx context.Context, players, teams map[string]float64, teamNames map[string]string) error {
+	playerKey := leaderboardKey(domain.LeaderboardScopeGlobal, string(domain.LeaderboardSubjectPlayers), domain.LeaderboardMetricRating)
+	teamKey := leaderboardKey(domain.LeaderboardScopeGlobal, string(domain.LeaderboardSubjectTeams), domain.LeaderboardMetricRating)
+	if err := s.cache.SetScores(ctx, playerKey, players); err != nil {
+		return err
+	}
+	if err := s.cache.SetTeamNames(ctx, teamNames); err != nil {
+		return err
+	}
+	return s.cache.SetScores(ctx, teamKey, teams)
+}
+
+// reseedRatings copies the stored ratings, decayed up to now, back into the cleared rating leaderboards
+func (s *LeaderboardService) reseedRatings(ctx context.Context) error {
+	playerRatings, err := s.ratingRepo.GetAllPlayerRatings()
+	if err != nil {
+		return err
+	}
+	teamRatings, err := s.ratingRepo.GetAllTeamRatings()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	players := make(map[string]float64, len(playerRatings))
+	for _, rating := range playerRatings {
+		players[domain.PlayerLeaderboardMember(rating.UserID)] = rating.Current(now).ConservativeRating()
+	}
+	teams := make(map[string]float64, len(teamRatings))
+	teamNames := make(map[string]string, len(teamRatings))
+	for _, rating := range teamRatings {
+		teams[rating.RosterKey] = rating.Current(now).ConservativeRating()
+		teamNames[rating.RosterKey] = rating.TeamName
+	}
+	return s.SetRatings(ctx, players, teams, teamNames)
+}
+
+// toEntryResponses attaches the user profiles or roster names of the ranked members
+func (s *LeaderboardService) toEntryResponses(
+	ctx context.Context,
+	subject domain.LeaderboardSubject,
+	entries []*domain.LeaderboardEntry,
+) ([]*dto.LeaderboardEntryResponse, error) {
+	responses := make([]*dto.LeaderboardEntryResponse, 0, len(entries))
+	if len(entries) == 0 {
+		return responses, nil
+	}
+
+	if subject == domain.LeaderboardSubjectTeams {
+		rosterKeys := make([]string, len(entries))
+		for i, entry := range entries {
+			rosterKeys[i] = entry.Member
+		}
+		names, err := s.cache.GetTeamNames(ctx, rosterKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			responses = append(responses, &dto.LeaderboardEntryResponse{
+				Rank:      entry.Rank,
+				Score:     entry.Score,
+				RosterKey: entry.Member,
+				TeamName:  names[entry.Member],
+				MemberIDs: rosterMemberIDs(entry.Member),
+			})
+		}
+		return responses, nil
+	}
+
+	userIDs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		if userID, err := strconv.ParseInt(entry.Member, 10, 64); err == nil {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	users, err := s.leaderboardRepo.GetLeaderboardUsers(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[int64]*port.LeaderboardUser, len(users))
+	for _, user := range users {
+		usersByID[user.UserID] = user
+	}
+
+	for _, entry := range entries {
+		userID, err := strconv.ParseInt(entry.Member, 10, 64)
+		if err != nil {
+			continue
+		}
+		response := &dto.LeaderboardEntryResponse{
+			Rank:   entry.Rank,
+			Score:  entry.Score,
+			UserID: &userID,
+		}
+		if user, ok := usersByID[userID]; ok {
+			response.Username = user.Username
+			response.Tag = user.Tag
+			response.Avatar = user.Avatar
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+func validateLeaderboard(subject domain.LeaderboardSubject, metric domain.LeaderboardMetric, seasonID *int64) error {
+	if !subject.IsValid() {
+		return exception.ErrInvalidLeaderboardSubject
+	}
+	if !metric.Supports(subject) {
+		return exception.ErrInvalidLeaderboardMetric
+	}
+	if seasonID != nil && !metric.IsSeasonal() {
+		return exception.ErrLeaderboardNotSeasonal
+	}
+	return nil
+}
+
+func leaderboardScopeOf(seasonID *int64) domain.LeaderboardScope {
+	if seasonID == nil {
+		return domain.LeaderboardScopeGlobal
+	}
+	return domain.SeasonLeaderboardScope(*seasonID)
+}
+
+func leaderboardKey(scope domain.LeaderboardScope, subject string, metric domain.LeaderboardMetric) string {
+	return utils.GetLeaderboardKey(string(scope), subject, string(metric))
+}
+
+func leaderboardTotalsKey(scope domain.LeaderboardScope, subject string) string {
+	return utils.GetLeaderboardTotalsKey(string(scope), subject)
+}
+
+// rosterMemberIDs splits a roster key back into its member IDs
+func rosterMemberIDs(rosterKey string) []int64 {
+	parts := strings.Split(rosterKey, "-")
+	ids := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		if id, err := strconv.ParseInt(part, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
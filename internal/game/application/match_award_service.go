@@ -0,0 +1,191 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// MvpVotingCloseInterval is how often the MVP voting job closes expired votings
+const MvpVotingCloseInterval = time.Minute
+
+// MatchAwardService runs the MVP votes of detected matches and hands out their awards.
+// A finished game with player stats gets its stat awards at once and an MVP vote that closes after MvpVotingWindow.
+type MatchAwardService struct {
+	awardRepo         port.MatchAwardDatabasePort
+	matchResultDBPort port.MatchResultDatabasePort
+	userQueryPort     userQueryPort.UserQueryPort
+}
+
+func NewMatchAwardService(
+	awardRepo port.MatchAwardDatabasePort,
+	matchResultDBPort port.MatchResultDatabasePort,
+	userQueryPort userQueryPort.UserQueryPort,
+) *MatchAwardService {
+	return &MatchAwardService{
+		awardRepo:         awardRepo,
+		matchResultDBPort: matchResultDBPort,
+		userQueryPort:     userQueryPort,
+	}
+}
+
+// HandleGameEvent opens the MVP vote of game.finished events; other game events are ignored
+func (s *MatchAwardService) HandleGameEvent(ctx context.Context, event *port.GameEvent) error {
+	if event.EventType != port.GameEventFinished {
+		return nil
+	}
+	return s.openVoting(event.GameID)
+}
+
+// openVoting computes the stat awards and opens the MVP vote, once per match.
+// Manual results have no lineup, so they get neither.
+func (s *MatchAwardService) openVoting(gameID int64) error {
+	result, err := s.matchResultDBPort.GetByGameID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrMatchResultNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	stats, err := s.matchResultDBPort.GetPlayerStatsByMatchResult(result.MatchResultID)
+	if err != nil {
+		return err
+	}
+	if len(stats) == 0 {
+		return nil
+	}
+
+	opened, err := s.awardRepo.OpenVoting(domain.NewMvpVoting(result), domain.ComputeStatAwards(result, stats))
+	if err != nil {
+		return err
+	}
+	if opened {
+		log.Printf("[MatchAward] Opened MVP voting of game %d", gameID)
+	}
+	return nil
+}
+
+// Vote records the voter's MVP vote in the game's match
+func (s *MatchAwardService) Vote(gameID, voterID int64, req *dto.MvpVoteRequest) (*dto.MyMvpVoteResponse, error) {
+	result, voting, err := s.getVoting(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	lineup, err := s.matchResultDBPort.GetPlayerStatsByMatchResult(result.MatchResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := voting.CanVote(lineup, voterID, req.CandidateUserID, now); err != nil {
+		return nil, err
+	}
+
+	vote := domain.NewMvpVote(result.MatchResultID, voterID, req.CandidateUserID)
+	if err := s.awardRepo.SaveVote(vote); err != nil {
+		return nil, err
+	}
+
+	return toMyMvpVoteResponse(voting, vote, now), nil
+}
+
+// GetMyVote returns the voter's MVP vote in the game's match, if any
+func (s *MatchAwardService) GetMyVote(gameID, voterID int64) (*dto.MyMvpVoteResponse, error) {
+	result, voting, err := s.getVoting(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := s.awardRepo.GetVote(result.MatchResultID, voterID)
+	if err != nil {
+		return nil, err
+	}
+	return toMyMvpVoteResponse(voting, vote, time.Now()), nil
+}
+
+func (s *MatchAwardService) getVoting(gameID int64) (*domain.MatchResult, *domain.MvpVoting, error) {
+	result, err := s.matchResultDBPort.GetByGameID(gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	voting, err := s.awardRepo.GetVoting(result.MatchResultID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, voting, nil
+}
+
+// RunMvpVotingClose is called every MvpVotingCloseInterval.
+// It tallies the votes of expired votings and awards the MVP; a voting is closed by one instance only.
+func (s *MatchAwardService) RunMvpVotingClose() {
+	now := time.Now()
+	votings, err := s.awardRepo.GetExpiredVotings(now)
+	if err != nil {
+		log.Printf("[MatchAward] Failed to get expired MVP votings: %v", err)
+		return
+	}
+
+	for _, voting := range votings {
+		counts, err := s.awardRepo.CountVotes(voting.MatchResultID)
+		if err != nil {
+			log.Printf("[MatchAward] Failed to count MVP votes of game %d: %v", voting.GameID, err)
+			continue
+		}
+
+		closed, err := s.awardRepo.CloseVoting(voting, now, voting.MvpAwards(counts))
+		if err != nil {
+			log.Printf("[MatchAward] Failed to close MVP voting of game %d: %v", voting.GameID, err)
+			continue
+		}
+		if closed {
+			log.Printf("[MatchAward] Closed MVP voting of game %d", voting.GameID)
+		}
+	}
+}
+
+// GetUserTrophies adds up the match awards a user received
+func (s *MatchAwardService) GetUserTrophies(userID int64) (*dto.UserTrophiesResponse, error) {
+	if _, err := s.userQueryPort.FindById(userID); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.awardRepo.GetAwardCountsByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[domain.MatchAwardType]int, len(counts))
+	for _, count := range counts {
+		byType[count.AwardType] = count.Count
+	}
+
+	resp := &dto.UserTrophiesResponse{
+		UserID:   userID,
+		Trophies: make([]*dto.TrophyCount, 0, len(domain.MatchAwardTypes)),
+	}
+	for _, awardType := range domain.MatchAwardTypes {
+		resp.Trophies = append(resp.Trophies, &dto.TrophyCount{AwardType: awardType, Count: byType[awardType]})
+		resp.Total += byType[awardType]
+	}
+	return resp, nil
+}
+
+func toMyMvpVoteResponse(voting *domain.MvpVoting, vote *domain.MvpVote, now time.Time) *dto.MyMvpVoteResponse {
+	resp := &dto.MyMvpVoteResponse{
+		ClosesAt: voting.ClosesAt,
+		Open:     voting.IsOpen(now),
+	}
+	if vote != nil {
+		resp.CandidateUserID = &vote.CandidateID
+		resp.VotedAt = &vote.CreatedAt
+	}
+	return resp
+}
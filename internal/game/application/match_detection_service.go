@@ -4,9 +4,11 @@ import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -22,6 +24,10 @@ type MatchDetectionService struct {
 	matchResultDBPort  port.MatchResultDatabasePort
 	eventPublisher     port.GameEventPublisherPort
 	userQueryPort      userQueryPort.UserQueryPort
+
+	statsCache port.PlayerStatsCachePort
+	awardRepo  port.MatchAwardDatabasePort
+	txManager  transaction.Transactor
 }
 
 func NewMatchDetectionService(
@@ -44,6 +50,21 @@ func NewMatchDetectionService(
 	}
 }
 
+// SetPlayerStatsCache sets the cache of player career stats, invalidated whenever new stats are saved
+func (s *MatchDetectionService) SetPlayerStatsCache(cache port.PlayerStatsCachePort) {
+	s.statsCache = cache
+}
+
+// SetMatchAwardRepository sets the repository of match awards shown with match results
+func (s *MatchDetectionService) SetMatchAwardRepository(repository port.MatchAwardDatabasePort) {
+	s.awardRepo = repository
+}
+
+// SetTransactionManager sets the transaction manager so game updates and their outbox events commit together
+func (s *MatchDetectionService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
 // DetectMatchForGame runs match detection for a single game
 func (s *MatchDetectionService) DetectMatchForGame(gameID int64) error {
 	game, err := s.gameDBPort.GetByID(gameID)
@@ -60,10 +81,15 @@ func (s *MatchDetectionService) DetectMatchForGame(gameID int64) error {
 		if err := game.MarkDetectionFailed(); err != nil {
 			return err
 		}
-		if err := s.gameDBPort.Update(game); err != nil {
+		err := transaction.Run(context.Background(), s.txManager, func(txCtx context.Context) error {
+			if err := s.gameDBPort.UpdateWithContext(txCtx, game); err != nil {
+				return err
+			}
+			return s.publishGameEvent(txCtx, game, port.GameEventMatchFailed)
+		})
+		if err != nil {
 			return err
 		}
-		s.publishGameEvent(game, port.GameEventMatchFailed)
 		log.Printf("[MatchDetection] Detection window expired for game %d", gameID)
 		return nil
 	}
@@ -266,39 +292,46 @@ func (s *MatchDetectionService) ProcessDetectedMatch(
 		match.GameLength,
 	)
 
-	savedResult, err := s.matchResultDBPort.Save(matchResult)
+	// Finish the game and save it with its match result, player stats and events (outbox) in one transaction
+	if err := game.FinishGame(); err != nil {
+		return err
+	}
+	var playerStats []*domain.MatchPlayerStat
+	err := transaction.Run(context.Background(), s.txManager, func(txCtx context.Context) error {
+		savedResult, err := s.matchResultDBPort.SaveWithContext(txCtx, matchResult)
+		if err != nil {
+			return fmt.Errorf("failed to save match result: %w", err)
+		}
+
+		playerStats = s.buildPlayerStats(savedResult.MatchResultID, match, teamAAccounts, teamBAccounts)
+		if err := s.matchResultDBPort.SavePlayerStatsWithContext(txCtx, playerStats); err != nil {
+			return fmt.Errorf("failed to save player stats: %w", err)
+		}
+
+		if err := s.gameDBPort.UpdateWithContext(txCtx, game); err != nil {
+			return err
+		}
+		if err := s.publishMatchDetectedEvent(txCtx, game, match, winnerTeamID, loserTeamID, winnerScore, loserScore); err != nil {
+			return err
+		}
+		return s.publishGameEvent(txCtx, game, port.GameEventFinished)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to save match result: %w", err)
+		return err
 	}
 
-	// Save player stats
-	playerStats := s.buildPlayerStats(savedResult.MatchResultID, match, teamAAccounts, teamBAccounts)
 	if len(playerStats) > 0 {
-		if err := s.matchResultDBPort.SavePlayerStats(playerStats); err != nil {
-			log.Printf("[MatchDetection] Failed to save player stats for game %d: %v", game.GameID, err)
-		}
+		s.invalidatePlayerStats(playerStats)
 	}
 
 	// Update GameTeam grades: winner=1, loser=2
 	s.updateGameTeamGrades(teamA, teamB, winnerTeamID)
 
-	// Finish the game
-	if err := game.FinishGame(); err != nil {
-		return err
-	}
-	if err := s.gameDBPort.Update(game); err != nil {
-		return err
-	}
-
 	// Advance winner to next round if applicable
 	if game.NextGameID != nil {
 		s.advanceWinnerToNextGame(*game.NextGameID, winnerTeamID)
 	}
 
-	// Publish events
-	s.publishMatchDetectedEvent(game, match, winnerTeamID, loserTeamID, winnerScore, loserScore)
-	s.publishGameEvent(game, port.GameEventFinished)
-
 	log.Printf("[MatchDetection] Game %d finished. Winner: team %d, Score: %d-%d",
 		game.GameID, winnerTeamID, winnerScore, loserScore)
 
@@ -409,7 +442,7 @@ func (s *MatchDetectionService) advanceWinnerToNextGame(nextGameID, winnerTeamID
 	}
 }
 
-func (s *MatchDetectionService) publishGameEvent(game *domain.Game, eventType port.GameEventType) {
+func (s *MatchDetectionService) publishGameEvent(ctx context.Context, game *domain.Game, eventType port.GameEventType) error {
 	event := &port.GameEvent{
 		EventType: eventType,
 		Timestamp: time.Now(),
@@ -418,18 +451,19 @@ func (s *MatchDetectionService) publishGameEvent(game *domain.Game, eventType po
 		Round:     game.GetRound(),
 		MatchNumber: game.GetMatchNumber(),
 	}
-	if err := s.eventPublisher.PublishGameEvent(context.Background(), event); err != nil {
-		log.Printf("[MatchDetection] Failed to publish %s event for game %d: %v",
-			eventType, game.GameID, err)
+	if err := s.eventPublisher.PublishGameEvent(ctx, event); err != nil {
+		return fmt.Errorf("failed to publish %s event for game %d: %w", eventType, game.GameID, err)
 	}
+	return nil
 }
 
 func (s *MatchDetectionService) publishMatchDetectedEvent(
+	ctx context.Context,
 	game *domain.Game,
 	match *port.ValorantMatchDetail,
 	winnerTeamID, loserTeamID int64,
 	winnerScore, loserScore int,
-) {
+) error {
 	event := &port.MatchDetectedEvent{
 		GameEvent: port.GameEvent{
 			EventType:   port.GameEventMatchDetected,
@@ -445,10 +479,10 @@ func (s *MatchDetectionService) publishMatchDetectedEvent(
 		Score:           fmt.Sprintf("%d-%d", winnerScore, loserScore),
 		MapName:         match.MapName,
 	}
-	if err := s.eventPublisher.PublishMatchDetectedEvent(context.Background(), event); err != nil {
-		log.Printf("[MatchDetection] Failed to publish match detected event for game %d: %v",
-			game.GameID, err)
+	if err := s.eventPublisher.PublishMatchDetectedEvent(ctx, event); err != nil {
+		return fmt.Errorf("failed to publish match detected event for game %d: %w", game.GameID, err)
 	}
+	return nil
 }
 
 // SubmitManualResult allows staff to manually input a game result
@@ -504,11 +538,6 @@ func (s *MatchDetectionService) SubmitManualResult(gameID int64, req *dto.Manual
 		0,
 	)
 
-	savedResult, err := s.matchResultDBPort.Save(matchResult)
-	if err != nil {
-		return nil, fmt.Errorf("failed to save manual result: %w", err)
-	}
-
 	// Update grades
 	winnerGT.SetGrade(1)
 	loserGT.SetGrade(2)
@@ -527,7 +556,24 @@ func (s *MatchDetectionService) SubmitManualResult(gameID int64, req *dto.Manual
 		game.ModifiedAt = now
 	}
 
-	if err := s.gameDBPort.Update(game); err != nil {
+	// Save the finished game, its match result and its events (outbox) in one transaction
+	var savedResult *domain.MatchResult
+	err = transaction.Run(context.Background(), s.txManager, func(txCtx context.Context) error {
+		result, err := s.matchResultDBPort.SaveWithContext(txCtx, matchResult)
+		if err != nil {
+			return fmt.Errorf("failed to save manual result: %w", err)
+		}
+		savedResult = result
+
+		if err := s.gameDBPort.UpdateWithContext(txCtx, game); err != nil {
+			return err
+		}
+		if err := s.publishGameEvent(txCtx, game, port.GameEventManualResult); err != nil {
+			return err
+		}
+		return s.publishGameEvent(txCtx, game, port.GameEventFinished)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -536,9 +582,6 @@ func (s *MatchDetectionService) SubmitManualResult(gameID int64, req *dto.Manual
 		s.advanceWinnerToNextGame(*game.NextGameID, req.WinnerTeamID)
 	}
 
-	s.publishGameEvent(game, port.GameEventManualResult)
-	s.publishGameEvent(game, port.GameEventFinished)
-
 	return savedResult, nil
 }
 
@@ -555,9 +598,38 @@ func (s *MatchDetectionService) GetMatchResult(gameID int64) (*dto.MatchResultRe
 	}
 
 	resp := dto.ToMatchResultResponse(result, game.DetectionStatus)
+	if err := s.attachAwards(resp); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
+// attachAwards adds the match's awards and MVP voting state; manual results have neither
+func (s *MatchDetectionService) attachAwards(resp *dto.MatchResultResponse) error {
+	if s.awardRepo == nil {
+		return nil
+	}
+
+	awards, err := s.awardRepo.GetAwardsByMatchResult(resp.MatchResultID)
+	if err != nil {
+		return err
+	}
+	resp.Awards = make([]*dto.MatchAwardResponse, len(awards))
+	for i, award := range awards {
+		resp.Awards[i] = dto.ToMatchAwardResponse(award)
+	}
+
+	voting, err := s.awardRepo.GetVoting(resp.MatchResultID)
+	if err != nil {
+		if errors.Is(err, exception.ErrMvpVotingNotFound) {
+			return nil
+		}
+		return err
+	}
+	resp.MvpVoting = dto.ToMvpVotingResponse(voting, time.Now())
+	return nil
+}
+
 // GetMatchResultWithStats returns the match result with player stats
 func (s *MatchDetectionService) GetMatchResultWithStats(gameID int64) (*dto.MatchResultResponse, error) {
 	resp, err := s.GetMatchResult(gameID)
@@ -578,3 +650,18 @@ func (s *MatchDetectionService) GetMatchResultWithStats(gameID int64) (*dto.Matc
 
 	return resp, nil
 }
+
+// invalidatePlayerStats drops the cached career stats of the players of a newly recorded game
+func (s *MatchDetectionService) invalidatePlayerStats(stats []*domain.MatchPlayerStat) {
+	if s.statsCache == nil {
+		return
+	}
+
+	userIDs := make([]int64, 0, len(stats))
+	for _, stat := range stats {
+		userIDs = append(userIDs, stat.UserID)
+	}
+	if err := s.statsCache.Invalidate(context.Background(), userIDs...); err != nil {
+		log.Printf("[MatchDetection] Failed to invalidate player stats cache: %v", err)
+	}
+}
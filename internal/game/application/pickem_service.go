@@ -0,0 +1,236 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// PickemService runs the pick'em predictions of tournament brackets.
+// Users predict the winner of each bracket game until it locks; predictions are scored in virtual points when the game finishes.
+type PickemService struct {
+	pickemRepo        port.PickemDatabasePort
+	gameDBPort        port.GameDatabasePort
+	gameTeamDBPort    port.GameTeamDatabasePort
+	matchResultDBPort port.MatchResultDatabasePort
+	leaderboardRepo   port.LeaderboardDatabasePort
+	contestRepo       contestPort.ContestDatabasePort
+	accessChecker     contestPort.ContestAccessPort
+}
+
+func NewPickemService(
+	pickemRepo port.PickemDatabasePort,
+	gameDBPort port.GameDatabasePort,
+	gameTeamDBPort port.GameTeamDatabasePort,
+	matchResultDBPort port.MatchResultDatabasePort,
+	leaderboardRepo port.LeaderboardDatabasePort,
+) *PickemService {
+	return &PickemService{
+		pickemRepo:        pickemRepo,
+		gameDBPort:        gameDBPort,
+		gameTeamDBPort:    gameTeamDBPort,
+		matchResultDBPort: matchResultDBPort,
+		leaderboardRepo:   leaderboardRepo,
+	}
+}
+
+// SetContestRepository sets the contest repository (to avoid circular dependency)
+func (s *PickemService) SetContestRepository(repository contestPort.ContestDatabasePort) {
+	s.contestRepo = repository
+}
+
+// SetAccessChecker sets the contest access checker that hides the pick'em of private contests
+func (s *PickemService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// HandleGameEvent scores the predictions of game.finished events; other game events are ignored
+func (s *PickemService) HandleGameEvent(ctx context.Context, event *port.GameEvent) error {
+	if event.EventType != port.GameEventFinished {
+		return nil
+	}
+	return s.scoreGame(event.GameID)
+}
+
+// scoreGame scores the game's predictions against its match result, detected or manual.
+// Predictions already scored against the same winner are left alone, so redelivered events score nothing twice,
+// while a manual result that corrects the winner rescores them.
+func (s *PickemService) scoreGame(gameID int64) error {
+	game, err := s.gameDBPort.GetByID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrGameNotFound) {
+			log.Printf("[Pickem] Skipping deleted game %d", gameID)
+			return nil
+		}
+		return err
+	}
+	if !game.IsTournamentGame() {
+		return nil
+	}
+
+	result, err := s.matchResultDBPort.GetByGameID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrMatchResultNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	scored, err := s.pickemRepo.ScoreGame(gameID, result.WinnerTeamID, domain.PickemPoints(game.GetRound()), time.Now())
+	if err != nil {
+		return err
+	}
+	if scored > 0 {
+		log.Printf("[Pickem] Scored %d predictions of game %d", scored, gameID)
+	}
+	return nil
+}
+
+// Predict records the user's predicted winner of a bracket game, replacing their earlier pick until the game locks
+func (s *PickemService) Predict(contestID, gameID, userID int64, req *dto.PickemPredictionRequest) (*dto.PickemPredictionResponse, error) {
+	if err := s.checkContest(contestID, userID); err != nil {
+		return nil, err
+	}
+
+	game, err := s.gameDBPort.GetByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if game.ContestID != contestID {
+		return nil, exception.ErrGameNotFound
+	}
+
+	now := time.Now()
+	if err := game.CanPredict(now); err != nil {
+		return nil, err
+	}
+
+	gameTeams, err := s.gameTeamDBPort.GetByGameID(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(gameTeams) < 2 {
+		return nil, exception.ErrPickemTeamsNotSet
+	}
+
+	inGame := false
+	for _, gameTeam := range gameTeams {
+		inGame = inGame || gameTeam.TeamID == req.TeamID
+	}
+	if !inGame {
+		return nil, exception.ErrPickemTeamNotInGame
+	}
+
+	// The lock is checked again on save, in case the game activated while the teams were read
+	if err := s.pickemRepo.SavePrediction(domain.NewPickemPrediction(game, userID, req.TeamID), now); err != nil {
+		return nil, err
+	}
+
+	// Re-read for the stored timestamps; the row is gone if the game was deleted in between
+	prediction, err := s.pickemRepo.GetPrediction(gameID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if prediction == nil {
+		return nil, exception.ErrPickemPredictionNotFound
+	}
+	return dto.NewPickemPredictionResponse(prediction), nil
+}
+
+// GetMyPicks returns the user's predictions in a contest with their scored totals
+func (s *PickemService) GetMyPicks(contestID, userID int64) (*dto.MyPickemResponse, error) {
+	if err := s.checkContest(contestID, userID); err != nil {
+		return nil, err
+	}
+
+	predictions, err := s.pickemRepo.GetUserPredictions(contestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.MyPickemResponse{
+		ContestID:   contestID,
+		Predictions: make([]*dto.PickemPredictionResponse, 0, len(predictions)),
+	}
+	for _, prediction := range predictions {
+		resp.Predictions = append(resp.Predictions, dto.NewPickemPredictionResponse(prediction))
+		if prediction.ScoredAt == nil {
+			continue
+		}
+		resp.ScoredPicks++
+		resp.Points += prediction.Points
+		if prediction.Correct != nil && *prediction.Correct {
+			resp.CorrectPicks++
+		}
+	}
+	return resp, nil
+}
+
+// GetLeaderboard ranks the users of a contest by their pick'em points, then correct picks
+func (s *PickemService) GetLeaderboard(contestID, userID int64, req *dto.PickemLeaderboardRequest) (*commonDto.PaginationResponse, error) {
+	if err := s.checkContest(contestID, userID); err != nil {
+		return nil, err
+	}
+
+	pagination := commonDto.NewPaginationRequest(req.Page, req.PageSize)
+	standings, total, err := s.pickemRepo.GetStandings(contestID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, len(standings))
+	for i, standing := range standings {
+		userIDs[i] = standing.UserID
+	}
+	users, err := s.leaderboardRepo.GetLeaderboardUsers(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[int64]*port.LeaderboardUser, len(users))
+	for _, user := range users {
+		usersByID[user.UserID] = user
+	}
+
+	responses := make([]*dto.PickemStandingResponse, len(standings))
+	for i, standing := range standings {
+		entry := &dto.PickemStandingResponse{
+			Rank:         pagination.GetOffset() + i + 1,
+			UserID:       standing.UserID,
+			Points:       standing.Points,
+			CorrectPicks: standing.CorrectPicks,
+			ScoredPicks:  standing.ScoredPicks,
+		}
+		if user, ok := usersByID[standing.UserID]; ok {
+			entry.Username = user.Username
+			entry.Tag = user.Tag
+			entry.Avatar = user.Avatar
+		}
+		responses[i] = entry
+	}
+
+	return commonDto.NewPaginationResponse(responses, pagination.Page, pagination.PageSize, total), nil
+}
+
+// checkContest checks that the contest runs a bracket and that the user can view it
+func (s *PickemService) checkContest(contestID, userID int64) error {
+	contest, err := s.contestRepo.GetContestById(contestID)
+	if err != nil {
+		return err
+	}
+	if contest.ContestType != contestDomain.ContestTypeTournament {
+		return exception.ErrPickemNotAvailable
+	}
+
+	if s.accessChecker != nil {
+		return s.accessChecker.CheckViewAccess(contestID, userID)
+	}
+	return nil
+}
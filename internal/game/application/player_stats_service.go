@@ -0,0 +1,113 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"log"
+	"time"
+)
+
+// playerStatsCacheTTL bounds staleness for changes that do not invalidate the cache, such as a renamed contest
+const playerStatsCacheTTL = 30 * time.Minute
+
+// PlayerStatsService serves player career statistics aggregated from match_player_stats
+type PlayerStatsService struct {
+	statsRepo     port.PlayerStatsDatabasePort
+	statsCache    port.PlayerStatsCachePort
+	userQueryPort userQueryPort.UserQueryPort
+	accessChecker contestPort.ContestAccessPort
+}
+
+func NewPlayerStatsService(
+	statsRepo port.PlayerStatsDatabasePort,
+	statsCache port.PlayerStatsCachePort,
+	userQueryPort userQueryPort.UserQueryPort,
+) *PlayerStatsService {
+	return &PlayerStatsService{
+		statsRepo:     statsRepo,
+		statsCache:    statsCache,
+		userQueryPort: userQueryPort,
+	}
+}
+
+// SetAccessChecker sets the contest access checker that hides private contests from other viewers
+func (s *PlayerStatsService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// GetPlayerStats returns a user's career stats as seen by the viewer (viewerID is 0 for anonymous).
+// Games of contests the viewer cannot see are left out of every line, lifetime totals included.
+func (s *PlayerStatsService) GetPlayerStats(userID, viewerID int64) (*domain.PlayerCareerStats, error) {
+	if _, err := s.userQueryPort.FindById(userID); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.loadPlayerStats(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.accessChecker == nil || userID == viewerID {
+		return stats, nil
+	}
+
+	hidden := make(map[int64]bool)
+	checked := make(map[int64]bool)
+	isHidden := func(contestID int64) bool {
+		if !checked[contestID] {
+			checked[contestID] = true
+			hidden[contestID] = s.accessChecker.CheckViewAccess(contestID, viewerID) != nil
+		}
+		return hidden[contestID]
+	}
+
+	anyHidden := false
+	for _, contest := range stats.Contests {
+		anyHidden = isHidden(contest.ContestID) || anyHidden
+	}
+	if !anyHidden {
+		return stats, nil
+	}
+
+	// The cached totals include the hidden games, so the viewer's copy is rebuilt from the visible games only
+	games, err := s.statsRepo.GetPlayerGameStats(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]*domain.PlayerGameStat, 0, len(games))
+	for _, game := range games {
+		if !isHidden(game.ContestID) {
+			visible = append(visible, game)
+		}
+	}
+	return domain.BuildPlayerCareerStats(userID, visible, stats.ComputedAt), nil
+}
+
+// loadPlayerStats reads the stats from the cache, computing and caching them on a miss.
+// Cache failures fall back to the database so the profile stays available without Redis.
+func (s *PlayerStatsService) loadPlayerStats(userID int64) (*domain.PlayerCareerStats, error) {
+	ctx := context.Background()
+
+	cached, err := s.statsCache.Get(ctx, userID)
+	if err != nil {
+		log.Printf("[PlayerStats] Failed to read cached stats of user %d: %v", userID, err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	games, err := s.statsRepo.GetPlayerGameStats(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := domain.BuildPlayerCareerStats(userID, games, time.Now())
+	if err := s.statsCache.Set(ctx, stats, playerStatsCacheTTL); err != nil {
+		log.Printf("[PlayerStats] Failed to cache stats of user %d: %v", userID, err)
+	}
+	return stats, nil
+}
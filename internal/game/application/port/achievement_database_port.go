@@ -0,0 +1,13 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+// AchievementDatabasePort defines the storage of earned badges and the facts achievement rules read
+type AchievementDatabasePort interface {
+	// AwardBadges saves the badges the user has not earned yet and returns the newly earned ones
+	AwardBadges(badges []*domain.UserBadge) ([]*domain.UserBadge, error)
+	// GetUserBadges returns the user's badges, oldest first
+	GetUserBadges(userID int64) ([]*domain.UserBadge, error)
+	// CountContestsPlayed returns, per user, the number of contests with a finished game the user's team played
+	CountContestsPlayed(userIDs []int64) (map[int64]int, error)
+}
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+)
+
+// AchievementEvent is a game, team or contest event the achievement rules are evaluated on
+type AchievementEvent struct {
+	Trigger   domain.AchievementTrigger
+	ContestID int64
+	// GameID is set on AchievementTriggerGameFinished
+	GameID int64
+	// UserIDs are the users the event is about; empty on AchievementTriggerGameFinished, whose players are looked up
+	UserIDs []int64
+}
+
+// AchievementEventHandler is the function type for handling achievement events consumed from RabbitMQ
+type AchievementEventHandler func(ctx context.Context, event *AchievementEvent) error
+
+// AchievementEventConsumerPort defines the interface for consuming the events of the achievement queue
+type AchievementEventConsumerPort interface {
+	// Start begins consuming messages from the queue
+	Start(ctx context.Context, handler AchievementEventHandler) error
+
+	// Stop gracefully stops the consumer
+	Stop() error
+
+	// IsRunning returns whether the consumer is currently running
+	IsRunning() bool
+}
@@ -0,0 +1,69 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+// ResultExportMember is a team member with the account identifiers organizers need to hand out prizes
+type ResultExportMember struct {
+	TeamID     int64
+	UserID     int64
+	MemberType domain.TeamMemberType
+	Username   string
+	Tag        string
+	RiotName   *string
+	RiotTag    *string
+	DiscordID  *string
+}
+
+// ResultExportGame is a game of the contest with its match result, if one was recorded
+type ResultExportGame struct {
+	GameID        int64
+	GameStatus    domain.GameStatus
+	Round         *int
+	MatchNumber   *int
+	StartedAt     *time.Time
+	WinnerTeamID  *int64
+	LoserTeamID   *int64
+	WinnerScore   *int
+	LoserScore    *int
+	MapName       *string
+	RoundsPlayed  *int
+	GameStartedAt *time.Time
+	GameDuration  *int
+}
+
+// ResultExportGameTeam is a team's seat in a game
+type ResultExportGameTeam struct {
+	GameID int64
+	TeamID int64
+	Grade  *int
+}
+
+// ResultExportPlayerTotals sums a player's match_player_stats over the contest, per team played for
+type ResultExportPlayerTotals struct {
+	UserID       int64
+	TeamID       int64
+	Username     string
+	Tag          string
+	RiotName     *string
+	RiotTag      *string
+	GamesPlayed  int
+	RoundsPlayed int
+	Kills        int
+	Deaths       int
+	Assists      int
+	Score        int
+	Headshots    int
+	Bodyshots    int
+	Legshots     int
+}
+
+// ContestResultExportDatabasePort defines the read queries behind contest result exports
+type ContestResultExportDatabasePort interface {
+	GetRosterMembers(contestID int64) ([]*ResultExportMember, error)
+	GetGamesWithResults(contestID int64) ([]*ResultExportGame, error)
+	GetGameTeams(contestID int64) ([]*ResultExportGameTeam, error)
+	GetPlayerStatTotals(contestID int64) ([]*ResultExportPlayerTotals, error)
+}
@@ -0,0 +1,26 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+// CalendarGame is a game with what its calendar event shows
+type CalendarGame struct {
+	Game         *domain.Game
+	ContestTitle string
+	// TotalRounds is the number of bracket rounds in the game's contest, used to name the round
+	TotalRounds int
+	TeamNames   []string
+}
+
+// GameCalendarDatabasePort defines the queries behind iCalendar feeds and feed token persistence
+type GameCalendarDatabasePort interface {
+	// GetCalendarGamesByContest returns the contest's games that have a start time and were not cancelled
+	GetCalendarGamesByContest(contestID int64) ([]*CalendarGame, error)
+	// GetCalendarGamesByUser returns the games, across contests, of every team the user belongs to
+	GetCalendarGamesByUser(userID int64) ([]*CalendarGame, error)
+
+	// Feed token operations
+	GetFeedTokenByUserID(userID int64) (*domain.CalendarFeedToken, error)
+	GetFeedTokenByToken(token string) (*domain.CalendarFeedToken, error)
+	// SaveFeedToken stores the token, replacing the user's previous one
+	SaveFeedToken(token *domain.CalendarFeedToken) error
+}
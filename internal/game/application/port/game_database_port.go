@@ -1,6 +1,9 @@
 package port
 
-import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+)
 
 type GameDatabasePort interface {
 	Save(game *domain.Game) (*domain.Game, error)
@@ -9,6 +12,8 @@ type GameDatabasePort interface {
 	GetByContestID(contestID int64) ([]*domain.Game, error)
 	GetByContestAndRound(contestID int64, round int) ([]*domain.Game, error)
 	Update(game *domain.Game) error
+	// UpdateWithContext joins the transaction carried by ctx (see transaction.Manager)
+	UpdateWithContext(ctx context.Context, game *domain.Game) error
 	Delete(gameID int64) error
 	DeleteByContestID(contestID int64) error
 
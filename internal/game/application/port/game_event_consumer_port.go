@@ -0,0 +1,18 @@
+package port
+
+import "context"
+
+// GameEventHandler is the function type for handling game events consumed from RabbitMQ
+type GameEventHandler func(ctx context.Context, event *GameEvent) error
+
+// GameEventConsumerPort defines the interface for consuming game events from a queue
+type GameEventConsumerPort interface {
+	// Start begins consuming messages from the queue
+	Start(ctx context.Context, handler GameEventHandler) error
+
+	// Stop gracefully stops the consumer
+	Stop() error
+
+	// IsRunning returns whether the consumer is currently running
+	IsRunning() bool
+}
@@ -16,6 +16,7 @@ const (
 	GameEventMatchFailed        GameEventType = "game.match.failed"
 	GameEventFinished           GameEventType = "game.finished"
 	GameEventManualResult       GameEventType = "game.result.manual"
+	GameEventCancelled          GameEventType = "game.cancelled"
 )
 
 // GameEvent is the base event structure for game-related events
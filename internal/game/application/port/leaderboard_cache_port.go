@@ -0,0 +1,88 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+)
+
+// LeaderboardCachePort stores leaderboards as sorted sets, ranked by descending score
+type LeaderboardCachePort interface {
+	// ApplyGame marks the game processed and applies its update in one atomic step.
+	// It returns false without changing anything if the game was already applied, so redelivered events are skipped.
+	ApplyGame(ctx context.Context, gameID int64, update *LeaderboardGameUpdate) (bool, error)
+
+	// GetScores returns the scores of the given members; members missing from the leaderboard are left out
+	GetScores(ctx context.Context, key string, members []string) (map[string]float64, error)
+	SetScores(ctx context.Context, key string, scores map[string]float64) error
+	RemoveMembers(ctx context.Context, key string, members []string) error
+
+	GetRange(ctx context.Context, key string, offset, limit int) ([]*domain.LeaderboardEntry, error)
+	Count(ctx context.Context, key string) (int64, error)
+	// GetEntry returns nil without an error when the member is not ranked
+	GetEntry(ctx context.Context, key, member string) (*domain.LeaderboardEntry, error)
+
+	SetTeamNames(ctx context.Context, names map[string]string) error
+	GetTeamNames(ctx context.Context, rosterKeys []string) (map[string]string, error)
+	GetUserRosters(ctx context.Context, userID int64) ([]string, error)
+
+	// Clear deletes every leaderboard and the processed game set
+	Clear(ctx context.Context) error
+}
+
+// LeaderboardGameUpdate is everything a finished game adds to the leaderboards of all its scopes
+type LeaderboardGameUpdate struct {
+	// Increments adds to leaderboard scores, by leaderboard key then member
+	Increments map[string]map[string]float64
+	// Totals adds to running total hashes, by hash key then field
+	Totals map[string]map[string]int64
+	// Averages are ranked from their totals once the new totals are in
+	Averages []*LeaderboardAverage
+	// TeamNames is the latest name of each roster
+	TeamNames map[string]string
+	// UserRosters adds the rosters each user played in
+	UserRosters map[int64][]string
+}
+
+// LeaderboardAverage ranks members by score per round from the "<member>:score" and "<member>:rounds" fields of a totals hash.
+// Members below MinRounds are not ranked.
+type LeaderboardAverage struct {
+	Key       string
+	TotalsKey string
+	Members   []string
+	MinRounds int64
+}
+
+func NewLeaderboardGameUpdate() *LeaderboardGameUpdate {
+	return &LeaderboardGameUpdate{
+		Increments:  make(map[string]map[string]float64),
+		Totals:      make(map[string]map[string]int64),
+		TeamNames:   make(map[string]string),
+		UserRosters: make(map[int64][]string),
+	}
+}
+
+// AddScores adds deltas to a leaderboard
+func (u *LeaderboardGameUpdate) AddScores(key string, deltas map[string]float64) {
+	if len(deltas) == 0 {
+		return
+	}
+	if u.Increments[key] == nil {
+		u.Increments[key] = make(map[string]float64, len(deltas))
+	}
+	for member, delta := range deltas {
+		u.Increments[key][member] += delta
+	}
+}
+
+// AddTotals adds increments to a totals hash
+func (u *LeaderboardGameUpdate) AddTotals(key string, increments map[string]int64) {
+	if len(increments) == 0 {
+		return
+	}
+	if u.Totals[key] == nil {
+		u.Totals[key] = make(map[string]int64, len(increments))
+	}
+	for field, increment := range increments {
+		u.Totals[key][field] += increment
+	}
+}
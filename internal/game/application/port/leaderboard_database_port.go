@@ -0,0 +1,24 @@
+package port
+
+// LeaderboardTeamMember is a member of a team seated in a game
+type LeaderboardTeamMember struct {
+	TeamID   int64
+	TeamName string
+	UserID   int64
+}
+
+// LeaderboardUser is the public identity shown next to a player's rank
+type LeaderboardUser struct {
+	UserID   int64
+	Username string
+	Tag      string
+	Avatar   string
+}
+
+// LeaderboardDatabasePort defines the read queries behind leaderboard updates
+type LeaderboardDatabasePort interface {
+	GetGameTeamMembers(gameID int64) ([]*LeaderboardTeamMember, error)
+	// GetFinishedGameIDs returns every finished game in the order it ended, for rebuilding leaderboards
+	GetFinishedGameIDs() ([]int64, error)
+	GetLeaderboardUsers(userIDs []int64) ([]*LeaderboardUser, error)
+}
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"time"
+)
+
+// MatchAwardCount is how many times a user received an award type
+type MatchAwardCount struct {
+	AwardType domain.MatchAwardType
+	Count     int
+}
+
+// MatchAwardDatabasePort defines the storage of MVP votes and match awards
+type MatchAwardDatabasePort interface {
+	// OpenVoting saves the voting with the stat awards of its match, unless the match already has one.
+	// It reports whether the voting was opened.
+	OpenVoting(voting *domain.MvpVoting, awards []*domain.MatchAward) (bool, error)
+	GetVoting(matchResultID int64) (*domain.MvpVoting, error)
+	// GetExpiredVotings returns votings past their closing time that have not been closed yet
+	GetExpiredVotings(now time.Time) ([]*domain.MvpVoting, error)
+	// CloseVoting marks the voting closed and saves its MVP awards, unless another instance closed it first.
+	// It reports whether the voting was closed.
+	CloseVoting(voting *domain.MvpVoting, closedAt time.Time, awards []*domain.MatchAward) (bool, error)
+
+	SaveVote(vote *domain.MvpVote) error
+	// GetVote returns the voter's vote in the match, or nil when they have not voted
+	GetVote(matchResultID, voterID int64) (*domain.MvpVote, error)
+	CountVotes(matchResultID int64) ([]*domain.MvpVoteCount, error)
+
+	GetAwardsByMatchResult(matchResultID int64) ([]*domain.MatchAward, error)
+	GetAwardCountsByUser(userID int64) ([]*MatchAwardCount, error)
+}
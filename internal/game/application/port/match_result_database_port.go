@@ -1,11 +1,18 @@
 package port
 
-import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+)
 
 // MatchResultDatabasePort defines the interface for match result persistence
 type MatchResultDatabasePort interface {
 	Save(result *domain.MatchResult) (*domain.MatchResult, error)
+	// SaveWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveWithContext(ctx context.Context, result *domain.MatchResult) (*domain.MatchResult, error)
 	GetByGameID(gameID int64) (*domain.MatchResult, error)
 	SavePlayerStats(stats []*domain.MatchPlayerStat) error
+	// SavePlayerStatsWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SavePlayerStatsWithContext(ctx context.Context, stats []*domain.MatchPlayerStat) error
 	GetPlayerStatsByMatchResult(matchResultID int64) ([]*domain.MatchPlayerStat, error)
 }
@@ -0,0 +1,29 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"time"
+)
+
+// PickemStanding is a user's scored pick'em record in a contest
+type PickemStanding struct {
+	UserID       int64
+	Points       int
+	CorrectPicks int
+	ScoredPicks  int
+}
+
+// PickemDatabasePort defines the storage of pick'em predictions
+type PickemDatabasePort interface {
+	// SavePrediction creates the user's prediction of the game or replaces its predicted team.
+	// It re-checks Game.CanPredict at now on the game row locked in the same transaction,
+	// so a pick cannot land after the game activated or started detection in between.
+	SavePrediction(prediction *domain.PickemPrediction, now time.Time) error
+	GetPrediction(gameID, userID int64) (*domain.PickemPrediction, error)
+	GetUserPredictions(contestID, userID int64) ([]*domain.PickemPrediction, error)
+	// ScoreGame scores the game's predictions not yet scored against this winner and returns how many were scored
+	ScoreGame(gameID, winnerTeamID int64, points int, scoredAt time.Time) (int64, error)
+	// GetStandings ranks the users of a contest by points, then correct picks
+	GetStandings(contestID int64, pagination *commonDto.PaginationRequest) ([]*PickemStanding, int64, error)
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+	"time"
+)
+
+// PlayerStatsCachePort caches computed player career statistics
+type PlayerStatsCachePort interface {
+	// Get returns nil without an error on a cache miss
+	Get(ctx context.Context, userID int64) (*domain.PlayerCareerStats, error)
+	Set(ctx context.Context, stats *domain.PlayerCareerStats, ttl time.Duration) error
+	Invalidate(ctx context.Context, userIDs ...int64) error
+}
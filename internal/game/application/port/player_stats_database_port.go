@@ -0,0 +1,9 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+// PlayerStatsDatabasePort defines the read queries behind player career statistics
+type PlayerStatsDatabasePort interface {
+	// GetPlayerGameStats returns every detected game line of the user with its game result and contest
+	GetPlayerGameStats(userID int64) ([]*domain.PlayerGameStat, error)
+}
@@ -0,0 +1,49 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"context"
+)
+
+// RatingTeamMember is a member of a contest team, used to seed the contest by rating
+type RatingTeamMember struct {
+	TeamID   int64
+	TeamName string
+	UserID   int64
+}
+
+// RatingUpdate holds every rating changed by one game, saved together
+type RatingUpdate struct {
+	Players []*domain.PlayerRating
+	Teams   []*domain.TeamRating
+	History []*domain.RatingHistory
+}
+
+// RatingDatabasePort defines the storage of player and roster ratings
+type RatingDatabasePort interface {
+	// GetPlayerRatings returns the ratings of the users that have one
+	GetPlayerRatings(userIDs []int64) ([]*domain.PlayerRating, error)
+	// GetTeamRatings returns the ratings of the rosters that have one
+	GetTeamRatings(rosterKeys []string) ([]*domain.TeamRating, error)
+	// GetPlayerRatingsForUpdate locks the users' ratings until the transaction carried by ctx ends (see transaction.Manager).
+	// Users without a rating get the initial one first, so their first rated games are serialized as well.
+	GetPlayerRatingsForUpdate(ctx context.Context, userIDs []int64) ([]*domain.PlayerRating, error)
+	// GetTeamRatingsForUpdate locks the rosters' ratings that exist until the transaction carried by ctx ends
+	GetTeamRatingsForUpdate(ctx context.Context, rosterKeys []string) ([]*domain.TeamRating, error)
+	GetAllPlayerRatings() ([]*domain.PlayerRating, error)
+	GetAllTeamRatings() ([]*domain.TeamRating, error)
+
+	// HasGameHistory reports whether the game was already rated
+	HasGameHistory(gameID int64) (bool, error)
+	// HasGameHistoryWithContext joins the transaction carried by ctx (see transaction.Manager)
+	HasGameHistoryWithContext(ctx context.Context, gameID int64) (bool, error)
+	SaveRatingUpdate(update *RatingUpdate) error
+	// SaveRatingUpdateWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveRatingUpdateWithContext(ctx context.Context, update *RatingUpdate) error
+	// GetHistory returns a subject's rating changes, newest first
+	GetHistory(subjectType domain.RatingSubjectType, subjectKey string, pagination *commonDto.PaginationRequest) ([]*domain.RatingHistory, int64, error)
+
+	// GetContestTeamMembers returns the members of the contest's teams, excluding waitlisted teams
+	GetContestTeamMembers(contestID int64) ([]*RatingTeamMember, error)
+}
@@ -0,0 +1,22 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+)
+
+// RosterDatabasePort defines the interface for roster lock report and roster change request persistence
+type RosterDatabasePort interface {
+	// Roster lock report operations
+	SaveLockReport(report *domain.RosterLockReport) (*domain.RosterLockReport, error)
+	// SaveLockReportWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveLockReportWithContext(ctx context.Context, report *domain.RosterLockReport) (*domain.RosterLockReport, error)
+	GetLockReportByContestID(contestID int64) (*domain.RosterLockReport, error)
+
+	// Roster change request operations
+	SaveChangeRequest(request *domain.RosterChangeRequest) (*domain.RosterChangeRequest, error)
+	GetChangeRequestByID(requestID int64) (*domain.RosterChangeRequest, error)
+	GetChangeRequestsByContestID(contestID int64, status *domain.RosterChangeStatus) ([]*domain.RosterChangeRequest, error)
+	HasPendingChangeRequest(teamID int64) (bool, error)
+	UpdateChangeRequest(request *domain.RosterChangeRequest) error
+}
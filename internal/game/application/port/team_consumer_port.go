@@ -18,3 +18,19 @@ type TeamPersistenceConsumerPort interface {
 	// IsRunning returns whether the consumer is currently running
 	IsRunning() bool
 }
+
+// TeamDeadLetterHandler is the function type for handling dead-lettered messages.
+// It receives the raw body, since malformed messages are dead-lettered as well.
+type TeamDeadLetterHandler func(ctx context.Context, body []byte) error
+
+// TeamDeadLetterConsumerPort defines the interface for draining the team persistence dead-letter queue
+type TeamDeadLetterConsumerPort interface {
+	// Start begins consuming messages from the dead-letter queue
+	Start(ctx context.Context, handler TeamDeadLetterHandler) error
+
+	// Stop gracefully stops the consumer
+	Stop() error
+
+	// IsRunning returns whether the consumer is currently running
+	IsRunning() bool
+}
@@ -1,31 +1,61 @@
 package port
 
-import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+)
 
 // TeamDatabasePort defines the interface for team config operations
 type TeamDatabasePort interface {
 	// Team operations
 	Save(team *domain.Team) (*domain.Team, error)
+	// SaveWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveWithContext(ctx context.Context, team *domain.Team) (*domain.Team, error)
 	GetByID(teamID int64) (*domain.Team, error)
 	GetByContestID(contestID int64) ([]*domain.Team, error)
 	GetByContestAndName(contestID int64, teamName string) (*domain.Team, error)
 	CountByContestID(contestID int64) (int, error)
+	CountRegisteredByContestID(contestID int64) (int, error)
+	// CountRegisteredByContestIDWithContext joins the transaction carried by ctx (see transaction.Manager)
+	CountRegisteredByContestIDWithContext(ctx context.Context, contestID int64) (int, error)
+	// GetWaitlistedByContestID returns waitlisted teams in waitlist order
+	GetWaitlistedByContestID(contestID int64) ([]*domain.Team, error)
+	// GetWaitlistedByContestIDWithContext joins the transaction carried by ctx (see transaction.Manager)
+	GetWaitlistedByContestIDWithContext(ctx context.Context, contestID int64) ([]*domain.Team, error)
 	Update(team *domain.Team) error
+	// UpdateWithContext joins the transaction carried by ctx (see transaction.Manager)
+	UpdateWithContext(ctx context.Context, team *domain.Team) error
 	Delete(teamID int64) error
+	// DeleteWithContext joins the transaction carried by ctx (see transaction.Manager)
+	DeleteWithContext(ctx context.Context, teamID int64) error
 	DeleteByContestID(contestID int64) error
 
 	// TeamMember operations
 	SaveMember(member *domain.TeamMember) (*domain.TeamMember, error)
+	// SaveMemberWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveMemberWithContext(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error)
 	SaveMemberBatch(members []*domain.TeamMember) error
+	// SaveMemberBatchWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveMemberBatchWithContext(ctx context.Context, members []*domain.TeamMember) error
+	// SaveTeamsWithMembers creates the teams and their members in one transaction
+	SaveTeamsWithMembers(teams []*TeamWithMembers) error
+	// SaveTeamsWithMembersWithContext joins the transaction carried by ctx (see transaction.Manager)
+	SaveTeamsWithMembersWithContext(ctx context.Context, teams []*TeamWithMembers) error
 	GetMemberByID(id int64) (*domain.TeamMember, error)
 	GetMembersByTeamID(teamID int64) ([]*domain.TeamMember, error)
 	GetMemberByTeamAndUser(teamID, userID int64) (*domain.TeamMember, error)
 	GetMemberCountByTeamID(teamID int64) (int, error)
 	GetLeaderByTeamID(teamID int64) (*domain.TeamMember, error)
 	UpdateMember(member *domain.TeamMember) error
+	// UpdateMemberWithContext joins the transaction carried by ctx (see transaction.Manager)
+	UpdateMemberWithContext(ctx context.Context, member *domain.TeamMember) error
 	DeleteMember(id int64) error
 	DeleteMemberByTeamAndUser(teamID, userID int64) error
+	// DeleteMemberByTeamAndUserWithContext joins the transaction carried by ctx (see transaction.Manager)
+	DeleteMemberByTeamAndUserWithContext(ctx context.Context, teamID, userID int64) error
 	DeleteAllMembersByTeamID(teamID int64) error
+	// DeleteAllMembersByTeamIDWithContext joins the transaction carried by ctx (see transaction.Manager)
+	DeleteAllMembersByTeamIDWithContext(ctx context.Context, teamID int64) error
 
 	// Contest-based queries
 	GetTeamsByContestWithMembers(contestID int64) ([]*TeamWithMembers, error)
@@ -0,0 +1,16 @@
+package port
+
+import "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+// TeamDeadLetterDatabasePort defines the interface for dead-lettered team persistence events and processed idempotency keys
+type TeamDeadLetterDatabasePort interface {
+	// Dead letter operations
+	SaveDeadLetter(deadLetter *domain.TeamDeadLetter) (*domain.TeamDeadLetter, error)
+	GetDeadLetterByID(deadLetterID int64) (*domain.TeamDeadLetter, error)
+	GetDeadLetters(offset, limit int, status *domain.DeadLetterStatus) ([]*domain.TeamDeadLetter, int64, error)
+	UpdateDeadLetter(deadLetter *domain.TeamDeadLetter) error
+
+	// Idempotency operations
+	HasProcessedEvent(idempotencyKey string) (bool, error)
+	SaveProcessedEvent(event *domain.ProcessedTeamEvent) error
+}
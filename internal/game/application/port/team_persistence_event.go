@@ -24,14 +24,21 @@ type TeamMemberPersistence struct {
 
 // TeamPersistenceEvent represents an event for async DB persistence
 type TeamPersistenceEvent struct {
-	EventID    string                   `json:"event_id"`
-	EventType  TeamPersistenceEventType `json:"event_type"`
-	Timestamp  time.Time                `json:"timestamp"`
-	RetryCount int                      `json:"retry_count"`
-	ContestID  int64                    `json:"contest_id"`
-	TeamID     int64                    `json:"team_id"`
-	TeamName   *string                  `json:"team_name,omitempty"`
-	Members    []*TeamMemberPersistence `json:"members,omitempty"`
+	EventID   string                   `json:"event_id"`
+	EventType TeamPersistenceEventType `json:"event_type"`
+	// IdempotencyKey stays the same across retries and replays so duplicate deliveries are applied once
+	IdempotencyKey string                   `json:"idempotency_key"`
+	Timestamp      time.Time                `json:"timestamp"`
+	RetryCount     int                      `json:"retry_count"`
+	LastError      string                   `json:"last_error,omitempty"`
+	ContestID      int64                    `json:"contest_id"`
+	TeamID         int64                    `json:"team_id"`
+	TeamName       *string                  `json:"team_name,omitempty"`
+	Members        []*TeamMemberPersistence `json:"members,omitempty"`
+	// Waitlisted marks a finalized team beyond the contest's MaxTeamCount
+	Waitlisted bool `json:"waitlisted,omitempty"`
+	// WaitlistedAt is when the team was finalized onto the waitlist; unlike Timestamp it survives replays
+	WaitlistedAt *time.Time `json:"waitlisted_at,omitempty"`
 	// For single member operations
 	MemberUserID   *int64          `json:"member_user_id,omitempty"`
 	MemberType     *TeamMemberType `json:"member_type,omitempty"`
@@ -42,3 +49,18 @@ const (
 	// MaxRetryCount is the maximum number of retries before sending to DLQ
 	MaxRetryCount = 3
 )
+
+// RecordFailure counts a failed attempt and reports whether the event has used up its retries and belongs in the DLQ
+func (e *TeamPersistenceEvent) RecordFailure(err error) bool {
+	e.RetryCount++
+	e.LastError = err.Error()
+	return e.RetryCount > MaxRetryCount
+}
+
+// ResetForReplay clears the retry state so a dead-lettered event gets a full retry budget again.
+// WaitlistedAt is kept so a replayed finalize keeps its place on the waitlist.
+func (e *TeamPersistenceEvent) ResetForReplay() {
+	e.RetryCount = 0
+	e.LastError = ""
+	e.Timestamp = time.Now()
+}
@@ -42,10 +42,20 @@ type CachedTeam struct {
 	CurrentCount int        `json:"current_count"`
 	LeaderUserID int64      `json:"leader_user_id"`
 	CreatedAt    time.Time  `json:"created_at"`
+	ModifiedAt   time.Time  `json:"modified_at"`
 	IsFinalized  bool       `json:"is_finalized"`
 	FinalizedAt  *time.Time `json:"finalized_at,omitempty"`
 }
 
+// LastModifiedAt returns when the team or its members last changed (ModifiedAt).
+// Teams cached before ModifiedAt was tracked fall back to their creation time.
+func (t *CachedTeam) LastModifiedAt() time.Time {
+	if t.ModifiedAt.IsZero() {
+		return t.CreatedAt
+	}
+	return t.ModifiedAt
+}
+
 // TeamInvite represents a pending team invitation
 type TeamInvite struct {
 	ContestID    int64        `json:"contest_id"`
@@ -99,9 +109,15 @@ type TeamRedisPort interface {
 
 	// Finalized team counting (for contest-wide team readiness)
 	IncrementFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error)
+	DecrementFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error)
 	GetFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error)
+	SetFinalizedTeamCount(ctx context.Context, contestID int64, count int64) error
 
 	// Cleanup
 	ClearTeam(ctx context.Context, contestID int64) error
 	ExtendTTL(ctx context.Context, contestID int64, newTTL time.Duration) error
+
+	// Reconciliation lock (one cache/DB repair per contest at a time)
+	AcquireReconcileLock(ctx context.Context, contestID int64, ttl time.Duration) (bool, error)
+	ReleaseReconcileLock(ctx context.Context, contestID int64) error
 }
@@ -0,0 +1,454 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RatingService keeps the Glicko-2 ratings of players and rosters, updated from every finished game with a match result.
+// Each player is rated against the composite of the opposing roster, and each roster against the other roster.
+type RatingService struct {
+	ratingRepo        port.RatingDatabasePort
+	leaderboardRepo   port.LeaderboardDatabasePort
+	gameDBPort        port.GameDatabasePort
+	matchResultDBPort port.MatchResultDatabasePort
+	leaderboard       *LeaderboardService
+	userQueryPort     userQueryPort.UserQueryPort
+	contestRepo       contestPort.ContestDatabasePort
+	accessChecker     contestPort.ContestAccessPort
+	txManager         transaction.Transactor
+}
+
+func NewRatingService(
+	ratingRepo port.RatingDatabasePort,
+	leaderboardRepo port.LeaderboardDatabasePort,
+	gameDBPort port.GameDatabasePort,
+	matchResultDBPort port.MatchResultDatabasePort,
+	leaderboard *LeaderboardService,
+	userQueryPort userQueryPort.UserQueryPort,
+) *RatingService {
+	return &RatingService{
+		ratingRepo:        ratingRepo,
+		leaderboardRepo:   leaderboardRepo,
+		gameDBPort:        gameDBPort,
+		matchResultDBPort: matchResultDBPort,
+		leaderboard:       leaderboard,
+		userQueryPort:     userQueryPort,
+	}
+}
+
+// SetContestRepository sets the contest repository (to avoid circular dependency)
+func (s *RatingService) SetContestRepository(repository contestPort.ContestDatabasePort) {
+	s.contestRepo = repository
+}
+
+// SetTransactionManager makes a game's ratings be read under row locks and saved in the same transaction,
+// so games sharing players are rated one after the other on every server instance
+func (s *RatingService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// SetAccessChecker sets the contest access checker that hides the seeding of private contests
+func (s *RatingService) SetAccessChecker(checker contestPort.ContestAccessPort) {
+	s.accessChecker = checker
+}
+
+// ratedSide is one roster of a rated game with the ratings of its members before the game
+type ratedSide struct {
+	teamName  string
+	rosterKey string
+	won       bool
+	players   []*domain.PlayerRating
+	before    []domain.Glicko2
+	team      *domain.TeamRating
+	teamStart domain.Glicko2
+}
+
+// HandleGameEvent rates game.finished events; other game events are ignored
+func (s *RatingService) HandleGameEvent(ctx context.Context, event *port.GameEvent) error {
+	if event.EventType != port.GameEventFinished {
+		return nil
+	}
+
+	return s.rateGame(ctx, event.GameID)
+}
+
+func (s *RatingService) rateGame(ctx context.Context, gameID int64) error {
+	game, err := s.gameDBPort.GetByID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrGameNotFound) {
+			log.Printf("[Rating] Skipping deleted game %d", gameID)
+			return nil
+		}
+		return err
+	}
+	if game.GameStatus != domain.GameStatusFinished {
+		return nil
+	}
+
+	result, err := s.matchResultDBPort.GetByGameID(gameID)
+	if err != nil {
+		if errors.Is(err, exception.ErrMatchResultNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	playedAt := time.Now()
+	if game.EndedAt != nil {
+		playedAt = *game.EndedAt
+	}
+
+	members, err := s.leaderboardRepo.GetGameTeamMembers(gameID)
+	if err != nil {
+		return err
+	}
+
+	var update *port.RatingUpdate
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		winner, loser, err := s.loadSides(txCtx, result, members, playedAt)
+		if err != nil {
+			return err
+		}
+		if winner == nil || loser == nil {
+			log.Printf("[Rating] Skipping game %d without both rosters", gameID)
+			return nil
+		}
+
+		// Checked under the rating locks, so a redelivered event waits for the first delivery and then skips
+		rated, err := s.ratingRepo.HasGameHistoryWithContext(txCtx, gameID)
+		if err != nil {
+			return err
+		}
+		if rated {
+			return nil
+		}
+
+		update = &port.RatingUpdate{}
+		rateSide(update, gameID, winner, loser, playedAt)
+		rateSide(update, gameID, loser, winner, playedAt)
+		return s.ratingRepo.SaveRatingUpdateWithContext(txCtx, update)
+	})
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+
+	s.pushToLeaderboard(ctx, update)
+	return nil
+}
+
+// loadSides returns the winning and losing rosters with their current ratings decayed up to playedAt.
+// The ratings stay locked until the transaction carried by ctx ends.
+func (s *RatingService) loadSides(
+	ctx context.Context,
+	result *domain.MatchResult,
+	members []*port.LeaderboardTeamMember,
+	playedAt time.Time,
+) (*ratedSide, *ratedSide, error) {
+	userIDs := make(map[int64][]int64, 2)
+	teamNames := make(map[int64]string, 2)
+	var allUserIDs []int64
+	for _, member := range members {
+		if member.TeamID != result.WinnerTeamID && member.TeamID != result.LoserTeamID {
+			continue
+		}
+		userIDs[member.TeamID] = append(userIDs[member.TeamID], member.UserID)
+		teamNames[member.TeamID] = member.TeamName
+		allUserIDs = append(allUserIDs, member.UserID)
+	}
+	if len(userIDs[result.WinnerTeamID]) == 0 || len(userIDs[result.LoserTeamID]) == 0 {
+		return nil, nil, nil
+	}
+
+	playerRatings, err := s.ratingRepo.GetPlayerRatingsForUpdate(ctx, allUserIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	playersByID := make(map[int64]*domain.PlayerRating, len(playerRatings))
+	for _, rating := range playerRatings {
+		playersByID[rating.UserID] = rating
+	}
+
+	rosterKeys := []string{domain.RosterKey(userIDs[result.WinnerTeamID]), domain.RosterKey(userIDs[result.LoserTeamID])}
+	teamRatings, err := s.ratingRepo.GetTeamRatingsForUpdate(ctx, rosterKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	teamsByKey := make(map[string]*domain.TeamRating, len(teamRatings))
+	for _, rating := range teamRatings {
+		teamsByKey[rating.RosterKey] = rating
+	}
+
+	newSide := func(teamID int64, rosterKey string, won bool) *ratedSide {
+		side := &ratedSide{teamName: teamNames[teamID], rosterKey: rosterKey, won: won}
+		for _, userID := range userIDs[teamID] {
+			player, ok := playersByID[userID]
+			if !ok {
+				player = domain.NewPlayerRating(userID)
+			}
+			side.players = append(side.players, player)
+			side.before = append(side.before, player.Current(playedAt))
+		}
+
+		team, ok := teamsByKey[rosterKey]
+		if !ok {
+			team = domain.NewTeamRating(rosterKey, side.teamName)
+		}
+		side.team = team
+		side.teamStart = side.team.Current(playedAt)
+		return side
+	}
+
+	return newSide(result.WinnerTeamID, rosterKeys[0], true), newSide(result.LoserTeamID, rosterKeys[1], false), nil
+}
+
+// rateSide rates the side's players against the opposing composite and its roster against the opposing roster
+func rateSide(update *port.RatingUpdate, gameID int64, side, opponent *ratedSide, playedAt time.Time) {
+	score := 0.0
+	if side.won {
+		score = 1
+	}
+
+	opponentComposite := domain.CompositeRating(opponent.before)
+	for i, player := range side.players {
+		after := side.before[i].Update(opponentComposite, score)
+		player.RecordGame(after, side.won, playedAt)
+		update.Players = append(update.Players, player)
+		update.History = append(update.History, domain.NewRatingHistory(
+			domain.RatingSubjectPlayer, domain.PlayerRatingSubjectKey(player.UserID), gameID, side.won, side.before[i], after,
+		))
+	}
+
+	after := side.teamStart.Update(opponent.teamStart, score)
+	side.team.RecordGame(after, side.won, side.teamName, playedAt)
+	update.Teams = append(update.Teams, side.team)
+	update.History = append(update.History, domain.NewRatingHistory(
+		domain.RatingSubjectTeam, side.rosterKey, gameID, side.won, side.teamStart, after,
+	))
+}
+
+// pushToLeaderboard updates the rating leaderboards with the conservative ratings decayed up to now, as the rating
+// endpoints show them; failures are only logged since a leaderboard rebuild reseeds them
+func (s *RatingService) pushToLeaderboard(ctx context.Context, update *port.RatingUpdate) {
+	if s.leaderboard == nil {
+		return
+	}
+
+	now := time.Now()
+	players := make(map[string]float64, len(update.Players))
+	for _, player := range update.Players {
+		players[domain.PlayerLeaderboardMember(player.UserID)] = player.Current(now).ConservativeRating()
+	}
+	teams := make(map[string]float64, len(update.Teams))
+	teamNames := make(map[string]string, len(update.Teams))
+	for _, team := range update.Teams {
+		teams[team.RosterKey] = team.Current(now).ConservativeRating()
+		teamNames[team.RosterKey] = team.TeamName
+	}
+
+	if err := s.leaderboard.SetRatings(ctx, players, teams, teamNames); err != nil {
+		log.Printf("[Rating] Failed to update rating leaderboards: %v", err)
+	}
+}
+
+// GetPlayerRating returns a user's rating; users without rated games have the initial rating
+func (s *RatingService) GetPlayerRating(userID int64) (*dto.PlayerRatingResponse, error) {
+	if _, err := s.userQueryPort.FindById(userID); err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingRepo.GetPlayerRatings([]int64{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	rating := domain.NewPlayerRating(userID)
+	if len(ratings) > 0 {
+		rating = ratings[0]
+	}
+
+	return &dto.PlayerRatingResponse{
+		UserID:         userID,
+		RatingResponse: dto.NewRatingResponse(rating.Current(time.Now()), rating.GamesPlayed, rating.Wins, rating.LastPlayedAt),
+	}, nil
+}
+
+// GetTeamRating returns the rating of a roster that has played rated games together
+func (s *RatingService) GetTeamRating(rosterKey string) (*dto.TeamRatingResponse, error) {
+	memberIDs, err := parseRosterKey(rosterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.ratingRepo.GetTeamRatings([]string{domain.RosterKey(memberIDs)})
+	if err != nil {
+		return nil, err
+	}
+	if len(ratings) == 0 {
+		return nil, exception.ErrRatingNotFound
+	}
+
+	rating := ratings[0]
+	return &dto.TeamRatingResponse{
+		RosterKey:      rating.RosterKey,
+		TeamName:       rating.TeamName,
+		MemberIDs:      memberIDs,
+		RatingResponse: dto.NewRatingResponse(rating.Current(time.Now()), rating.GamesPlayed, rating.Wins, rating.LastPlayedAt),
+	}, nil
+}
+
+// GetPlayerRatingHistory returns a user's rating changes, newest first
+func (s *RatingService) GetPlayerRatingHistory(userID int64, req *dto.RatingHistoryRequest) (*commonDto.PaginationResponse, error) {
+	if _, err := s.userQueryPort.FindById(userID); err != nil {
+		return nil, err
+	}
+	return s.getHistory(domain.RatingSubjectPlayer, domain.PlayerRatingSubjectKey(userID), req)
+}
+
+// GetTeamRatingHistory returns a roster's rating changes, newest first
+func (s *RatingService) GetTeamRatingHistory(rosterKey string, req *dto.RatingHistoryRequest) (*commonDto.PaginationResponse, error) {
+	memberIDs, err := parseRosterKey(rosterKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.getHistory(domain.RatingSubjectTeam, domain.RosterKey(memberIDs), req)
+}
+
+func (s *RatingService) getHistory(
+	subjectType domain.RatingSubjectType,
+	subjectKey string,
+	req *dto.RatingHistoryRequest,
+) (*commonDto.PaginationResponse, error) {
+	pagination := commonDto.NewPaginationRequest(req.Page, req.PageSize)
+	history, total, err := s.ratingRepo.GetHistory(subjectType, subjectKey, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.RatingHistoryResponse, len(history))
+	for i, h := range history {
+		responses[i] = dto.NewRatingHistoryResponse(h)
+	}
+	return commonDto.NewPaginationResponse(responses, pagination.Page, pagination.PageSize, total), nil
+}
+
+// GetContestSeeding orders a contest's teams by conservative rating, for seeding its bracket (userID is 0 for anonymous).
+// A roster that never played together is rated by the composite of its members.
+func (s *RatingService) GetContestSeeding(contestID, userID int64) (*dto.ContestSeedingResponse, error) {
+	if _, err := s.contestRepo.GetContestById(contestID); err != nil {
+		return nil, err
+	}
+
+	if s.accessChecker != nil {
+		if err := s.accessChecker.CheckViewAccess(contestID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := s.ratingRepo.GetContestTeamMembers(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	var teamIDs []int64
+	teamNames := make(map[int64]string)
+	userIDs := make(map[int64][]int64)
+	var allUserIDs []int64
+	for _, member := range members {
+		if _, ok := userIDs[member.TeamID]; !ok {
+			teamIDs = append(teamIDs, member.TeamID)
+		}
+		teamNames[member.TeamID] = member.TeamName
+		userIDs[member.TeamID] = append(userIDs[member.TeamID], member.UserID)
+		allUserIDs = append(allUserIDs, member.UserID)
+	}
+
+	rosterKeys := make([]string, len(teamIDs))
+	for i, teamID := range teamIDs {
+		rosterKeys[i] = domain.RosterKey(userIDs[teamID])
+	}
+
+	teamRatings, err := s.ratingRepo.GetTeamRatings(rosterKeys)
+	if err != nil {
+		return nil, err
+	}
+	teamsByKey := make(map[string]*domain.TeamRating, len(teamRatings))
+	for _, rating := range teamRatings {
+		teamsByKey[rating.RosterKey] = rating
+	}
+
+	playerRatings, err := s.ratingRepo.GetPlayerRatings(allUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	playersByID := make(map[int64]*domain.PlayerRating, len(playerRatings))
+	for _, rating := range playerRatings {
+		playersByID[rating.UserID] = rating
+	}
+
+	now := time.Now()
+	teams := make([]*dto.SeededTeamResponse, 0, len(teamIDs))
+	for i, teamID := range teamIDs {
+		var rating domain.Glicko2
+		team, rosterRated := teamsByKey[rosterKeys[i]]
+		if rosterRated {
+			rating = team.Current(now)
+		} else {
+			memberRatings := make([]domain.Glicko2, 0, len(userIDs[teamID]))
+			for _, memberID := range userIDs[teamID] {
+				if player, ok := playersByID[memberID]; ok {
+					memberRatings = append(memberRatings, player.Current(now))
+				} else {
+					memberRatings = append(memberRatings, domain.NewGlicko2())
+				}
+			}
+			rating = domain.CompositeRating(memberRatings)
+		}
+
+		teams = append(teams, &dto.SeededTeamResponse{
+			TeamID:             teamID,
+			TeamName:           teamNames[teamID],
+			RosterKey:          rosterKeys[i],
+			MemberIDs:          userIDs[teamID],
+			Rating:             roundTo2(rating.Rating),
+			Deviation:          roundTo2(rating.Deviation),
+			ConservativeRating: roundTo2(rating.ConservativeRating()),
+			RosterRated:        rosterRated,
+		})
+	}
+
+	// Ties keep registration order, the order teams were created in
+	sort.SliceStable(teams, func(i, j int) bool {
+		return teams[i].ConservativeRating > teams[j].ConservativeRating
+	})
+	for i, team := range teams {
+		team.Seed = i + 1
+	}
+
+	return &dto.ContestSeedingResponse{ContestID: contestID, Teams: teams}, nil
+}
+
+// parseRosterKey validates a roster key from a request, accepting member IDs in any order
+func parseRosterKey(rosterKey string) ([]int64, error) {
+	memberIDs := rosterMemberIDs(rosterKey)
+	if len(memberIDs) == 0 || len(memberIDs) != strings.Count(rosterKey, "-")+1 {
+		return nil, exception.ErrInvalidRosterKey
+	}
+	sort.Slice(memberIDs, func(i, j int) bool { return memberIDs[i] < memberIDs[j] })
+	return memberIDs, nil
+}
@@ -0,0 +1,492 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// RegistrationCloseInterval is how often the registration close job checks for contests to lock
+const RegistrationCloseInterval = time.Minute
+
+// RosterService locks contest rosters at registration close and handles staff-approved roster changes afterwards
+type RosterService struct {
+	teamService         *TeamService
+	teamDBRepository    port.TeamDatabasePort
+	teamRedisRepo       port.TeamRedisPort
+	rosterRepository    port.RosterDatabasePort
+	contestRepository   contestPort.ContestDatabasePort
+	permissionChecker   contestPort.ContestPermissionPort
+	notificationHandler notificationPort.NotificationHandlerPort
+	txManager           transaction.Transactor
+}
+
+func NewRosterService(
+	teamService *TeamService,
+	teamDBRepository port.TeamDatabasePort,
+	teamRedisRepo port.TeamRedisPort,
+	rosterRepository port.RosterDatabasePort,
+	contestRepository contestPort.ContestDatabasePort,
+) *RosterService {
+	return &RosterService{
+		teamService:       teamService,
+		teamDBRepository:  teamDBRepository,
+		teamRedisRepo:     teamRedisRepo,
+		rosterRepository:  rosterRepository,
+		contestRepository: contestRepository,
+	}
+}
+
+// SetContestRepository sets the contest repository (to avoid circular dependency)
+func (s *RosterService) SetContestRepository(repository contestPort.ContestDatabasePort) {
+	s.contestRepository = repository
+}
+
+// SetPermissionChecker sets the contest permission checker (to resolve circular dependency)
+func (s *RosterService) SetPermissionChecker(checker contestPort.ContestPermissionPort) {
+	s.permissionChecker = checker
+}
+
+// SetNotificationHandler sets the notification handler (to avoid circular dependency)
+func (s *RosterService) SetNotificationHandler(handler notificationPort.NotificationHandlerPort) {
+	s.notificationHandler = handler
+}
+
+// SetTransactionManager sets the transaction manager so dropped teams, the roster lock and its report commit together
+func (s *RosterService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// LockDueContests locks rosters of every contest whose registration close time has passed
+func (s *RosterService) LockDueContests(ctx context.Context) {
+	if s.contestRepository == nil {
+		return
+	}
+
+	contests, err := s.contestRepository.GetContestsDueForRosterLock(time.Now())
+	if err != nil {
+		log.Printf("[RosterService] Failed to query contests due for roster lock: %v", err)
+		return
+	}
+
+	for _, contest := range contests {
+		report, err := s.LockContestRoster(ctx, contest)
+		if err != nil {
+			log.Printf("[RosterService] Failed to lock rosters for contest %d: %v", contest.ContestID, err)
+			continue
+		}
+
+		log.Printf("[RosterService] Locked rosters for contest %d (finalized: %d, dropped: %d)",
+			contest.ContestID, report.FinalizedTeamCount, report.DroppedTeamCount)
+	}
+}
+
+// LockContestRoster force-finalizes complete teams, drops incomplete teams and freezes the contest rosters
+func (s *RosterService) LockContestRoster(ctx context.Context, contest *contestDomain.Contest) (*domain.RosterLockReport, error) {
+	now := time.Now()
+	if err := contest.LockRoster(now); err != nil {
+		return nil, err
+	}
+
+	report := domain.NewRosterLockReport(contest.ContestID, now)
+	handledTeamIDs := make(map[int64]bool)
+
+	// Teams already persisted in DB
+	teams, err := s.teamDBRepository.GetTeamsByContestWithMembers(contest.ContestID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Team still being built in the Redis cache. It is only read here and finalized or dropped
+	// after the DB commit, so a failed lock leaves the cache as it was for the next run.
+	var lockCachedTeam func(ctx context.Context, contestID int64) error
+	cachedTeam, err := s.teamRedisRepo.GetTeam(ctx, contest.ContestID)
+	if err == nil && cachedTeam != nil {
+		// Cached team IDs differ from DB IDs, so skip its persisted copy below
+		if persistedTeam := findPersistedTeam(teams, cachedTeam); persistedTeam != nil {
+			handledTeamIDs[persistedTeam.Team.TeamID] = true
+		}
+
+		isFinalized, _ := s.teamRedisRepo.IsFinalized(ctx, contest.ContestID)
+		switch {
+		case isFinalized:
+			report.AddFinalized()
+		case cachedTeam.CurrentCount >= cachedTeam.MaxMembers:
+			lockCachedTeam = s.teamService.ForceFinalizeTeam
+			report.AddFinalized()
+		default:
+			members, err := s.teamRedisRepo.GetAllMembers(ctx, contest.ContestID)
+			if err != nil {
+				return nil, err
+			}
+			lockCachedTeam = s.teamService.DropCachedTeam
+			report.AddDropped(toDroppedCachedTeam(cachedTeam, members))
+		}
+	}
+
+	var droppedTeams []*port.TeamWithMembers
+	for _, teamWithMembers := range teams {
+		if handledTeamIDs[teamWithMembers.Team.TeamID] {
+			continue
+		}
+
+		if len(teamWithMembers.Members) >= contest.TotalTeamMember {
+			report.AddFinalized()
+			continue
+		}
+
+		droppedTeams = append(droppedTeams, teamWithMembers)
+		report.AddDropped(toDroppedTeam(teamWithMembers, contest.TotalTeamMember))
+	}
+
+	// Dropped teams, the waitlist promotions they free slots for, the lock flag and the report commit together,
+	// so a failure leaves the rosters untouched for the next run
+	var savedReport *domain.RosterLockReport
+	var promotedTeams []*domain.Team
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		for _, teamWithMembers := range droppedTeams {
+			if err := s.teamDBRepository.DeleteAllMembersByTeamIDWithContext(txCtx, teamWithMembers.Team.TeamID); err != nil {
+				return err
+			}
+			if err := s.teamDBRepository.DeleteWithContext(txCtx, teamWithMembers.Team.TeamID); err != nil {
+				return err
+			}
+		}
+
+		if len(droppedTeams) > 0 {
+			var err error
+			if promotedTeams, err = s.teamService.PromoteFromWaitlist(txCtx, contest); err != nil {
+				return err
+			}
+		}
+
+		if err := s.contestRepository.UpdateContestWithContext(txCtx, contest); err != nil {
+			return err
+		}
+
+		var err error
+		savedReport, err = s.rosterRepository.SaveLockReportWithContext(txCtx, report)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Registration has closed, so members can no longer change the cached team in between
+	if lockCachedTeam != nil {
+		if err := lockCachedTeam(ctx, contest.ContestID); err != nil {
+			log.Printf("[RosterService] Failed to apply the roster lock to the cached team of contest %d: %v", contest.ContestID, err)
+		}
+	}
+
+	go s.sendTeamDroppedNotifications(contest, savedReport.DroppedTeams)
+	s.teamService.notifyPromotedTeams(contest, promotedTeams)
+
+	return savedReport, nil
+}
+
+// GetLockReport returns the registration close report of a contest (Staff only)
+func (s *RosterService) GetLockReport(contestID, userID int64) (*dto.RosterLockReportResponse, error) {
+	if err := s.checkStaffPermission(contestID, userID); err != nil {
+		return nil, err
+	}
+
+	report, err := s.rosterRepository.GetLockReportByContestID(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToRosterLockReportResponse(report), nil
+}
+
+// RequestRosterChange submits a roster change for staff approval (Team leader only, after roster lock)
+func (s *RosterService) RequestRosterChange(contestID, userID int64, req *dto.CreateRosterChangeRequest) (*dto.RosterChangeRequestResponse, error) {
+	contest, err := s.contestRepository.GetContestById(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contest.IsRosterLocked() {
+		return nil, exception.ErrRosterNotLocked
+	}
+
+	if contest.IsTerminalState() {
+		return nil, exception.ErrContestNotActive
+	}
+
+	team, err := s.teamDBRepository.GetUserTeamInContest(contestID, userID)
+	if err != nil {
+		return nil, exception.ErrNotTeamMember
+	}
+
+	requester, err := s.teamDBRepository.GetMemberByTeamAndUser(team.TeamID, userID)
+	if err != nil {
+		return nil, exception.ErrNotTeamMember
+	}
+
+	if !requester.IsLeader() {
+		return nil, exception.ErrNoPermissionToKick
+	}
+
+	changeRequest := domain.NewRosterChangeRequest(
+		contestID,
+		team.TeamID,
+		userID,
+		req.ChangeType,
+		req.TargetUserID,
+		req.ReplacementUserID,
+		req.Reason,
+	)
+	if err := changeRequest.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRosterChange(contest, changeRequest); err != nil {
+		return nil, err
+	}
+
+	hasPending, err := s.rosterRepository.HasPendingChangeRequest(team.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if hasPending {
+		return nil, exception.ErrRosterChangeAlreadyRequested
+	}
+
+	savedRequest, err := s.rosterRepository.SaveChangeRequest(changeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToRosterChangeRequestResponse(savedRequest), nil
+}
+
+// GetRosterChangeRequests returns roster change requests of a contest (Staff only)
+func (s *RosterService) GetRosterChangeRequests(contestID, userID int64, status *domain.RosterChangeStatus) ([]*dto.RosterChangeRequestResponse, error) {
+	if err := s.checkStaffPermission(contestID, userID); err != nil {
+		return nil, err
+	}
+
+	requests, err := s.rosterRepository.GetChangeRequestsByContestID(contestID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ToRosterChangeRequestResponses(requests), nil
+}
+
+// ApproveRosterChange approves a pending roster change and applies it to the DB roster (Staff only)
+func (s *RosterService) ApproveRosterChange(contestID, requestID, userID int64, note string) (*dto.RosterChangeRequestResponse, error) {
+	contest, changeRequest, err := s.getReviewableRequest(contestID, requestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Roster may have changed since the request was submitted
+	if err := s.validateRosterChange(contest, changeRequest); err != nil {
+		return nil, err
+	}
+
+	if err := changeRequest.Approve(userID, note); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyRosterChange(changeRequest); err != nil {
+		return nil, err
+	}
+
+	if err := s.rosterRepository.UpdateChangeRequest(changeRequest); err != nil {
+		return nil, err
+	}
+
+	go s.sendRosterChangeReviewedNotification(contest, changeRequest)
+
+	return dto.ToRosterChangeRequestResponse(changeRequest), nil
+}
+
+// RejectRosterChange rejects a pending roster change (Staff only)
+func (s *RosterService) RejectRosterChange(contestID, requestID, userID int64, note string) (*dto.RosterChangeRequestResponse, error) {
+	contest, changeRequest, err := s.getReviewableRequest(contestID, requestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := changeRequest.Reject(userID, note); err != nil {
+		return nil, err
+	}
+
+	if err := s.rosterRepository.UpdateChangeRequest(changeRequest); err != nil {
+		return nil, err
+	}
+
+	go s.sendRosterChangeReviewedNotification(contest, changeRequest)
+
+	return dto.ToRosterChangeRequestResponse(changeRequest), nil
+}
+
+func (s *RosterService) getReviewableRequest(contestID, requestID, userID int64) (*contestDomain.Contest, *domain.RosterChangeRequest, error) {
+	if err := s.checkStaffPermission(contestID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	contest, err := s.contestRepository.GetContestById(contestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changeRequest, err := s.rosterRepository.GetChangeRequestByID(requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if changeRequest.ContestID != contestID {
+		return nil, nil, exception.ErrRosterChangeRequestNotFound
+	}
+
+	if !changeRequest.IsPending() {
+		return nil, nil, exception.ErrRosterChangeRequestNotPending
+	}
+
+	return contest, changeRequest, nil
+}
+
+// validateRosterChange checks the requested change against the current DB roster
+func (s *RosterService) validateRosterChange(contest *contestDomain.Contest, changeRequest *domain.RosterChangeRequest) error {
+	if changeRequest.TargetUserID != nil {
+		target, err := s.teamDBRepository.GetMemberByTeamAndUser(changeRequest.TeamID, *changeRequest.TargetUserID)
+		if err != nil {
+			return exception.ErrTeamMemberNotFound
+		}
+		if target.IsLeader() {
+			return exception.ErrCannotKickLeader
+		}
+	}
+
+	if changeRequest.ReplacementUserID != nil {
+		existingTeam, err := s.teamDBRepository.GetUserTeamInContest(contest.ContestID, *changeRequest.ReplacementUserID)
+		if err != nil && !errors.Is(err, exception.ErrTeamNotFound) {
+			return err
+		}
+		if existingTeam != nil {
+			return exception.ErrTeamMemberAlreadyExists
+		}
+	}
+
+	if changeRequest.ChangeType == domain.RosterChangeTypeAdd {
+		memberCount, err := s.teamDBRepository.GetMemberCountByTeamID(changeRequest.TeamID)
+		if err != nil {
+			return err
+		}
+		if memberCount >= contest.TotalTeamMember {
+			return exception.ErrTeamIsFull
+		}
+	}
+
+	return nil
+}
+
+// applyRosterChange writes an approved change to the DB roster
+func (s *RosterService) applyRosterChange(changeRequest *domain.RosterChangeRequest) error {
+	if changeRequest.TargetUserID != nil {
+		if err := s.teamDBRepository.DeleteMemberByTeamAndUser(changeRequest.TeamID, *changeRequest.TargetUserID); err != nil {
+			return err
+		}
+	}
+
+	if changeRequest.ReplacementUserID != nil {
+		member := domain.NewTeamMemberAsMember(changeRequest.TeamID, *changeRequest.ReplacementUserID)
+		member.JoinedAt = time.Now()
+		if _, err := s.teamDBRepository.SaveMember(member); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkStaffPermission checks that the user may change rosters of the contest
+func (s *RosterService) checkStaffPermission(contestID, userID int64) error {
+	if s.permissionChecker == nil {
+		return exception.ErrPermissionDenied
+	}
+	return s.permissionChecker.CheckPermission(contestID, userID, contestDomain.ContestActionManageGames)
+}
+
+func toDroppedCachedTeam(cachedTeam *port.CachedTeam, members []*port.CachedTeamMember) *domain.DroppedTeam {
+	teamName := ""
+	if cachedTeam.TeamName != nil {
+		teamName = *cachedTeam.TeamName
+	}
+
+	memberUserIDs := make([]int64, len(members))
+	for i, m := range members {
+		memberUserIDs[i] = m.UserID
+	}
+
+	return &domain.DroppedTeam{
+		TeamID:        cachedTeam.TeamID,
+		TeamName:      teamName,
+		LeaderUserID:  cachedTeam.LeaderUserID,
+		MemberUserIDs: memberUserIDs,
+		MemberCount:   len(members),
+		RequiredCount: cachedTeam.MaxMembers,
+		Reason:        domain.DroppedTeamReasonIncomplete,
+	}
+}
+
+func toDroppedTeam(teamWithMembers *port.TeamWithMembers, requiredCount int) *domain.DroppedTeam {
+	var leaderUserID int64
+	memberUserIDs := make([]int64, len(teamWithMembers.Members))
+	for i, m := range teamWithMembers.Members {
+		memberUserIDs[i] = m.UserID
+		if m.IsLeader() {
+			leaderUserID = m.UserID
+		}
+	}
+
+	return &domain.DroppedTeam{
+		TeamID:        teamWithMembers.Team.TeamID,
+		TeamName:      teamWithMembers.Team.TeamName,
+		LeaderUserID:  leaderUserID,
+		MemberUserIDs: memberUserIDs,
+		MemberCount:   len(teamWithMembers.Members),
+		RequiredCount: requiredCount,
+		Reason:        domain.DroppedTeamReasonIncomplete,
+	}
+}
+
+// sendTeamDroppedNotifications notifies every member of the dropped teams
+func (s *RosterService) sendTeamDroppedNotifications(contest *contestDomain.Contest, droppedTeams domain.DroppedTeams) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	for _, team := range droppedTeams {
+		for _, userID := range team.MemberUserIDs {
+			if err := s.notificationHandler.HandleTeamDropped(userID, contest.ContestID, contest.Title, team.TeamName); err != nil {
+				log.Printf("Failed to send team dropped notification to user %d: %v", userID, err)
+			}
+		}
+	}
+}
+
+// sendRosterChangeReviewedNotification notifies the requesting leader of the review outcome
+func (s *RosterService) sendRosterChangeReviewedNotification(contest *contestDomain.Contest, changeRequest *domain.RosterChangeRequest) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	approved := changeRequest.Status == domain.RosterChangeStatusApproved
+	if err := s.notificationHandler.HandleRosterChangeReviewed(changeRequest.RequestedBy, contest.ContestID, contest.Title, approved, changeRequest.ReviewNote); err != nil {
+		log.Printf("Failed to send roster change reviewed notification: %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+)
+
+// unparsableDeadLetterType marks dead letters whose body could not be decoded as a TeamPersistenceEvent
+const unparsableDeadLetterType = "unparsable"
+
+// TeamDeadLetterService records team persistence events that failed all retries and lets admins replay or discard them
+type TeamDeadLetterService struct {
+	deadLetterDBRepository port.TeamDeadLetterDatabasePort
+	persistencePublisher   port.TeamPersistencePublisherPort
+}
+
+func NewTeamDeadLetterService(
+	deadLetterDBRepository port.TeamDeadLetterDatabasePort,
+	persistencePublisher port.TeamPersistencePublisherPort,
+) *TeamDeadLetterService {
+	return &TeamDeadLetterService{
+		deadLetterDBRepository: deadLetterDBRepository,
+		persistencePublisher:   persistencePublisher,
+	}
+}
+
+// RecordDeadLetter stores a message drained from the dead-letter queue
+func (s *TeamDeadLetterService) RecordDeadLetter(ctx context.Context, body []byte) error {
+	var event port.TeamPersistenceEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		deadLetter := domain.NewTeamDeadLetter(unparsableDeadLetterType, string(body), fmt.Sprintf("malformed message: %v", err))
+		_, err := s.deadLetterDBRepository.SaveDeadLetter(deadLetter)
+		return err
+	}
+
+	deadLetter := domain.NewTeamDeadLetter(string(event.EventType), string(body), event.LastError)
+	deadLetter.EventID = event.EventID
+	deadLetter.IdempotencyKey = event.IdempotencyKey
+	deadLetter.ContestID = event.ContestID
+	deadLetter.TeamID = event.TeamID
+	deadLetter.RetryCount = event.RetryCount
+
+	if _, err := s.deadLetterDBRepository.SaveDeadLetter(deadLetter); err != nil {
+		return err
+	}
+
+	log.Printf("[DeadLetter] Recorded dead-lettered team persistence event: type=%s, contestID=%d, error=%s",
+		event.EventType, event.ContestID, event.LastError)
+	return nil
+}
+
+// GetDeadLetters returns dead-lettered events, newest first, optionally filtered by status (Admin only)
+func (s *TeamDeadLetterService) GetDeadLetters(offset, limit int, status *domain.DeadLetterStatus) ([]*dto.TeamDeadLetterResponse, int64, error) {
+	deadLetters, totalCount, err := s.deadLetterDBRepository.GetDeadLetters(offset, limit, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	return dto.ToTeamDeadLetterResponses(deadLetters), totalCount, nil
+}
+
+// GetDeadLetter returns a single dead-lettered event (Admin only)
+func (s *TeamDeadLetterService) GetDeadLetter(deadLetterID int64) (*dto.TeamDeadLetterResponse, error) {
+	deadLetter, err := s.deadLetterDBRepository.GetDeadLetterByID(deadLetterID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToTeamDeadLetterResponse(deadLetter), nil
+}
+
+// ReplayDeadLetter re-publishes a dead-lettered event to the persistence queue with a fresh retry budget (Admin only).
+// The idempotency key is kept, so an event that was in fact applied is not applied twice.
+func (s *TeamDeadLetterService) ReplayDeadLetter(ctx context.Context, deadLetterID, adminID int64) (*dto.TeamDeadLetterResponse, error) {
+	deadLetter, err := s.deadLetterDBRepository.GetDeadLetterByID(deadLetterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deadLetter.IsPending() {
+		return nil, exception.ErrDeadLetterNotPending
+	}
+
+	var event port.TeamPersistenceEvent
+	if err := json.Unmarshal([]byte(deadLetter.Payload), &event); err != nil {
+		return nil, exception.ErrDeadLetterMalformed
+	}
+	event.ResetForReplay()
+
+	if err := s.publish(ctx, &event); err != nil {
+		return nil, err
+	}
+
+	if err := deadLetter.MarkReplayed(adminID); err != nil {
+		return nil, err
+	}
+
+	if err := s.deadLetterDBRepository.UpdateDeadLetter(deadLetter); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DeadLetter] Replayed dead letter %d (type=%s, contestID=%d) by admin %d",
+		deadLetter.DeadLetterID, deadLetter.EventType, deadLetter.ContestID, adminID)
+
+	return dto.ToTeamDeadLetterResponse(deadLetter), nil
+}
+
+// DiscardDeadLetter marks a dead-lettered event as dropped without re-processing it (Admin only)
+func (s *TeamDeadLetterService) DiscardDeadLetter(deadLetterID, adminID int64) (*dto.TeamDeadLetterResponse, error) {
+	deadLetter, err := s.deadLetterDBRepository.GetDeadLetterByID(deadLetterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deadLetter.MarkDiscarded(adminID); err != nil {
+		return nil, err
+	}
+
+	if err := s.deadLetterDBRepository.UpdateDeadLetter(deadLetter); err != nil {
+		return nil, err
+	}
+
+	return dto.ToTeamDeadLetterResponse(deadLetter), nil
+}
+
+func (s *TeamDeadLetterService) publish(ctx context.Context, event *port.TeamPersistenceEvent) error {
+	switch event.EventType {
+	case port.TeamPersistenceCreated:
+		return s.persistencePublisher.PublishTeamCreated(ctx, event)
+	case port.TeamPersistenceMemberAdded:
+		return s.persistencePublisher.PublishMemberAdded(ctx, event)
+	case port.TeamPersistenceMemberRemoved:
+		return s.persistencePublisher.PublishMemberRemoved(ctx, event)
+	case port.TeamPersistenceFinalized:
+		return s.persistencePublisher.PublishTeamFinalized(ctx, event)
+	case port.TeamPersistenceDeleted:
+		return s.persistencePublisher.PublishTeamDeleted(ctx, event)
+	default:
+		return exception.ErrDeadLetterMalformed
+	}
+}
@@ -6,25 +6,60 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 )
 
 // TeamPersistenceHandler handles DB persistence for team events
 type TeamPersistenceHandler struct {
-	teamDBRepository port.TeamDatabasePort
+	teamDBRepository       port.TeamDatabasePort
+	deadLetterDBRepository port.TeamDeadLetterDatabasePort
 }
 
 // NewTeamPersistenceHandler creates a new persistence handler
-func NewTeamPersistenceHandler(teamDBRepository port.TeamDatabasePort) *TeamPersistenceHandler {
+func NewTeamPersistenceHandler(
+	teamDBRepository port.TeamDatabasePort,
+	deadLetterDBRepository port.TeamDeadLetterDatabasePort,
+) *TeamPersistenceHandler {
 	return &TeamPersistenceHandler{
-		teamDBRepository: teamDBRepository,
+		teamDBRepository:       teamDBRepository,
+		deadLetterDBRepository: deadLetterDBRepository,
 	}
 }
 
-// HandleTeamPersistence handles team persistence events
+// HandleTeamPersistence handles team persistence events.
+// Events whose idempotency key was already applied are skipped, so duplicate deliveries are harmless.
 func (h *TeamPersistenceHandler) HandleTeamPersistence(ctx context.Context, event *port.TeamPersistenceEvent) error {
 	log.Printf("Handling team persistence event: type=%s, contestID=%d, teamID=%d",
 		event.EventType, event.ContestID, event.TeamID)
 
+	if event.IdempotencyKey != "" {
+		processed, err := h.deadLetterDBRepository.HasProcessedEvent(event.IdempotencyKey)
+		if err != nil {
+			return fmt.Errorf("failed to check idempotency key: %w", err)
+		}
+		if processed {
+			log.Printf("Skipping duplicate team persistence event: key=%s, type=%s",
+				event.IdempotencyKey, event.EventType)
+			return nil
+		}
+	}
+
+	if err := h.applyEvent(ctx, event); err != nil {
+		return err
+	}
+
+	if event.IdempotencyKey != "" {
+		processedEvent := domain.NewProcessedTeamEvent(event.IdempotencyKey, string(event.EventType))
+		if err := h.deadLetterDBRepository.SaveProcessedEvent(processedEvent); err != nil {
+			// The change is already applied, so retrying would do more harm than a missing key
+			log.Printf("Failed to record idempotency key %s: %v", event.IdempotencyKey, err)
+		}
+	}
+
+	return nil
+}
+
+func (h *TeamPersistenceHandler) applyEvent(ctx context.Context, event *port.TeamPersistenceEvent) error {
 	switch event.EventType {
 	case port.TeamPersistenceCreated:
 		return h.handleTeamCreated(ctx, event)
@@ -48,27 +83,32 @@ func (h *TeamPersistenceHandler) handleTeamCreated(ctx context.Context, event *p
 		teamName = *event.TeamName
 	}
 
-	// Create team in DB
-	team := domain.NewTeam(event.ContestID, teamName)
-	savedTeam, err := h.teamDBRepository.Save(team)
-	if err != nil {
-		return fmt.Errorf("failed to save team: %w", err)
+	// A retried event whose earlier attempt already saved the team resumes instead of creating a duplicate
+	savedTeam, err := h.teamDBRepository.GetByContestAndName(event.ContestID, teamName)
+	if err != nil || savedTeam == nil {
+		team := domain.NewTeam(event.ContestID, teamName)
+		savedTeam, err = h.teamDBRepository.Save(team)
+		if err != nil {
+			return fmt.Errorf("failed to save team: %w", err)
+		}
 	}
 
 	// Save members if present
-	if len(event.Members) > 0 {
-		for _, member := range event.Members {
-			memberType := domain.TeamMemberTypeMember
-			if member.MemberType == port.TeamMemberTypeLeader {
-				memberType = domain.TeamMemberTypeLeader
-			}
+	for _, member := range event.Members {
+		if existing, err := h.teamDBRepository.GetMemberByTeamAndUser(savedTeam.TeamID, member.UserID); err == nil && existing != nil {
+			continue
+		}
 
-			dbMember := domain.NewTeamMember(savedTeam.TeamID, member.UserID, memberType)
-			dbMember.JoinedAt = member.JoinedAt
+		memberType := domain.TeamMemberTypeMember
+		if member.MemberType == port.TeamMemberTypeLeader {
+			memberType = domain.TeamMemberTypeLeader
+		}
 
-			if _, err := h.teamDBRepository.SaveMember(dbMember); err != nil {
-				return fmt.Errorf("failed to save member %d: %w", member.UserID, err)
-			}
+		dbMember := domain.NewTeamMember(savedTeam.TeamID, member.UserID, memberType)
+		dbMember.JoinedAt = member.JoinedAt
+
+		if _, err := h.teamDBRepository.SaveMember(dbMember); err != nil {
+			return fmt.Errorf("failed to save member %d: %w", member.UserID, err)
 		}
 	}
 
@@ -108,6 +148,11 @@ func (h *TeamPersistenceHandler) handleMemberAdded(ctx context.Context, event *p
 		targetTeam = teams[0] // Fallback to first team
 	}
 
+	if existing, err := h.teamDBRepository.GetMemberByTeamAndUser(targetTeam.TeamID, *event.MemberUserID); err == nil && existing != nil {
+		log.Printf("Member already persisted, skipping: teamID=%d, userID=%d", targetTeam.TeamID, *event.MemberUserID)
+		return nil
+	}
+
 	memberType := domain.TeamMemberTypeMember
 	if event.MemberType != nil && *event.MemberType == port.TeamMemberTypeLeader {
 		memberType = domain.TeamMemberTypeLeader
@@ -202,12 +247,22 @@ func (h *TeamPersistenceHandler) handleTeamFinalized(ctx context.Context, event
 			}
 		}
 
+		if event.Waitlisted && !existingTeam.IsWaitlisted() {
+			existingTeam.Waitlist(waitlistedAt(event))
+			if err := h.teamDBRepository.Update(existingTeam); err != nil {
+				return fmt.Errorf("failed to waitlist team: %w", err)
+			}
+		}
+
 		log.Printf("Successfully updated finalized team: teamID=%d", existingTeam.TeamID)
 		return nil
 	}
 
 	// Create new team
 	team := domain.NewTeam(event.ContestID, teamName)
+	if event.Waitlisted {
+		team.Waitlist(waitlistedAt(event))
+	}
 	savedTeam, err := h.teamDBRepository.Save(team)
 	if err != nil {
 		return fmt.Errorf("failed to save team: %w", err)
@@ -234,6 +289,18 @@ func (h *TeamPersistenceHandler) handleTeamFinalized(ctx context.Context, event
 	return nil
 }
 
+// waitlistedAt orders the waitlist by finalize time rather than by consumer delivery time.
+// Events published before WaitlistedAt existed fall back to their timestamp.
+func waitlistedAt(event *port.TeamPersistenceEvent) time.Time {
+	if event.WaitlistedAt != nil {
+		return *event.WaitlistedAt
+	}
+	if event.Timestamp.IsZero() {
+		return time.Now()
+	}
+	return event.Timestamp
+}
+
 // handleTeamDeleted removes team from DB
 func (h *TeamPersistenceHandler) handleTeamDeleted(ctx context.Context, event *port.TeamPersistenceEvent) error {
 	// Find the team by contest ID
@@ -0,0 +1,520 @@
+package application
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	// TeamReconciliationInterval is how often the scheduled reconciliation runs
+	TeamReconciliationInterval = 10 * time.Minute
+
+	// reconcileGracePeriod skips cached teams changed recently, whose write-behind events may still be in flight
+	reconcileGracePeriod = 5 * time.Minute
+	reconcileLockTTL     = 30 * time.Second
+)
+
+// TeamReconciliationService compares the Redis team cache with MySQL for a contest, reports drift and repairs it
+type TeamReconciliationService struct {
+	teamDBRepository  port.TeamDatabasePort
+	teamRedisRepo     port.TeamRedisPort
+	contestRepository contestPort.ContestDatabasePort
+	userQueryRepo     userQueryPort.UserQueryPort
+	oauth2Repository  oauth2Port.OAuth2DatabasePort
+	txManager         transaction.Transactor
+}
+
+func NewTeamReconciliationService(
+	teamDBRepository port.TeamDatabasePort,
+	teamRedisRepo port.TeamRedisPort,
+	contestRepository contestPort.ContestDatabasePort,
+	userQueryRepo userQueryPort.UserQueryPort,
+	oauth2Repository oauth2Port.OAuth2DatabasePort,
+) *TeamReconciliationService {
+	return &TeamReconciliationService{
+		teamDBRepository:  teamDBRepository,
+		teamRedisRepo:     teamRedisRepo,
+		contestRepository: contestRepository,
+		userQueryRepo:     userQueryRepo,
+		oauth2Repository:  oauth2Repository,
+	}
+}
+
+// SetTransactionManager makes the MySQL repair writes of a contest commit or roll back together
+func (s *TeamReconciliationService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// SetContestRepository sets the contest repository (to avoid circular dependency)
+func (s *TeamReconciliationService) SetContestRepository(repository contestPort.ContestDatabasePort) {
+	s.contestRepository = repository
+}
+
+// reconcileState holds both sides of a contest's team data for comparison and repair
+type reconcileState struct {
+	contest       *contestDomain.Contest
+	cachedTeam    *port.CachedTeam
+	cachedMembers []*port.CachedTeamMember
+	dbTeams       []*port.TeamWithMembers
+	dbTeam        *port.TeamWithMembers
+}
+
+// CheckContest compares both stores for a contest without changing anything
+func (s *TeamReconciliationService) CheckContest(ctx context.Context, contestID int64) (*domain.TeamReconciliationReport, error) {
+	contest, err := s.contestRepository.GetContestById(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, _, err := s.compare(ctx, contest)
+	return report, err
+}
+
+// ReconcileContest compares both stores for a contest and repairs any drift using the given source of truth
+func (s *TeamReconciliationService) ReconcileContest(ctx context.Context, contestID int64, direction domain.ReconcileDirection) (*domain.TeamReconciliationReport, error) {
+	if err := direction.Validate(); err != nil {
+		return nil, err
+	}
+
+	contest, err := s.contestRepository.GetContestById(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reconcile(ctx, contest, direction)
+}
+
+// RunScheduledReconciliation repairs pending contests from the cache and reports drift for active contests.
+// Once a contest is active, teams are read from MySQL, so drift there is only reported for staff to repair.
+func (s *TeamReconciliationService) RunScheduledReconciliation(ctx context.Context) {
+	if s.contestRepository == nil {
+		return
+	}
+
+	contests, err := s.contestRepository.GetContestsByStatuses([]contestDomain.ContestStatus{
+		contestDomain.ContestStatusPending,
+		contestDomain.ContestStatusActive,
+	})
+	if err != nil {
+		log.Printf("[TeamReconciliation] Failed to query contests: %v", err)
+		return
+	}
+
+	for _, contest := range contests {
+		report, state, err := s.compare(ctx, contest)
+		if err != nil {
+			log.Printf("[TeamReconciliation] Failed to compare contest %d: %v", contest.ContestID, err)
+			continue
+		}
+
+		if !report.HasDrift() {
+			continue
+		}
+
+		recentlyModified := state.cachedTeam != nil && time.Since(state.cachedTeam.LastModifiedAt()) < reconcileGracePeriod
+		if !contest.IsPending() || recentlyModified {
+			log.Printf("[TeamReconciliation] Drift detected for contest %d: %d item(s), not auto-repaired",
+				contest.ContestID, len(report.Drifts))
+			continue
+		}
+
+		report, err = s.reconcile(ctx, contest, domain.ReconcileCacheToDB)
+		if err != nil {
+			log.Printf("[TeamReconciliation] Failed to repair contest %d: %v", contest.ContestID, err)
+			continue
+		}
+
+		log.Printf("[TeamReconciliation] Repaired contest %d from cache: %d drift item(s)",
+			contest.ContestID, len(report.Drifts))
+	}
+}
+
+func (s *TeamReconciliationService) reconcile(ctx context.Context, contest *contestDomain.Contest, direction domain.ReconcileDirection) (*domain.TeamReconciliationReport, error) {
+	acquired, err := s.acquireLock(ctx, contest.ContestID)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, exception.ErrReconciliationBusy
+	}
+	defer s.releaseLock(ctx, contest.ContestID)
+
+	report, state, err := s.compare(ctx, contest)
+	if err != nil {
+		return nil, err
+	}
+
+	if !report.HasDrift() {
+		return report, nil
+	}
+
+	if report.HasTeamDrift() {
+		switch direction {
+		case domain.ReconcileCacheToDB:
+			err = s.repairDBFromCache(ctx, state, report)
+		case domain.ReconcileDBToCache:
+			err = s.repairCacheFromDB(ctx, state)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The finalized counter is always rebuilt from MySQL, since StartContest counts teams there
+	if err := s.rebuildFinalizedCount(ctx, contest); err != nil {
+		return nil, err
+	}
+
+	report.MarkRepaired(direction)
+	return report, nil
+}
+
+// compare loads both stores and lists every difference between them
+func (s *TeamReconciliationService) compare(ctx context.Context, contest *contestDomain.Contest) (*domain.TeamReconciliationReport, *reconcileState, error) {
+	report := domain.NewTeamReconciliationReport(contest.ContestID)
+	state := &reconcileState{contest: contest}
+
+	dbTeams, err := s.teamDBRepository.GetTeamsByContestWithMembers(contest.ContestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	state.dbTeams = dbTeams
+	report.DBTeamCount = len(dbTeams)
+	report.DBCompleteTeamCount = countCompleteTeams(dbTeams, contest.TotalTeamMember)
+
+	finalizedCount, err := s.teamRedisRepo.GetFinalizedTeamCount(ctx, contest.ContestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	report.CacheFinalizedCount = finalizedCount
+
+	if finalizedCount != int64(report.DBCompleteTeamCount) {
+		report.AddDrift(&domain.TeamDrift{
+			Type:        domain.TeamDriftFinalizedCount,
+			CacheValue:  fmt.Sprintf("%d", finalizedCount),
+			DBValue:     fmt.Sprintf("%d", report.DBCompleteTeamCount),
+			Description: "finalized team count in cache differs from complete teams in DB",
+		})
+	}
+
+	cachedTeam, err := s.teamRedisRepo.GetTeam(ctx, contest.ContestID)
+	if err != nil {
+		// No cached team (expired or never created), nothing more to compare
+		return report, state, nil
+	}
+
+	cachedMembers, err := s.teamRedisRepo.GetAllMembers(ctx, contest.ContestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state.cachedTeam = cachedTeam
+	state.cachedMembers = cachedMembers
+	report.CacheTeamFound = true
+
+	state.dbTeam = findPersistedTeam(dbTeams, cachedTeam)
+	if state.dbTeam == nil {
+		report.AddDrift(&domain.TeamDrift{
+			Type:        domain.TeamDriftMissingInDB,
+			TeamID:      cachedTeam.TeamID,
+			CacheValue:  cachedTeamName(cachedTeam),
+			Description: "cached team has no matching team in DB",
+		})
+		return report, state, nil
+	}
+
+	if name := cachedTeamName(cachedTeam); name != state.dbTeam.Team.TeamName {
+		report.AddDrift(&domain.TeamDrift{
+			Type:        domain.TeamDriftNameMismatch,
+			TeamID:      state.dbTeam.Team.TeamID,
+			CacheValue:  name,
+			DBValue:     state.dbTeam.Team.TeamName,
+			Description: "team name differs between cache and DB",
+		})
+	}
+
+	dbMembers := make(map[int64]*domain.TeamMember, len(state.dbTeam.Members))
+	for _, m := range state.dbTeam.Members {
+		dbMembers[m.UserID] = m
+	}
+
+	cachedByUser := make(map[int64]bool, len(cachedMembers))
+	for _, cm := range cachedMembers {
+		userID := cm.UserID
+		cachedByUser[userID] = true
+
+		dbMember, ok := dbMembers[userID]
+		if !ok {
+			report.AddDrift(&domain.TeamDrift{
+				Type:        domain.TeamDriftMemberMissingInDB,
+				TeamID:      state.dbTeam.Team.TeamID,
+				UserID:      &userID,
+				CacheValue:  string(cm.MemberType),
+				Description: "member exists in cache but not in DB",
+			})
+			continue
+		}
+
+		if string(dbMember.MemberType) != string(cm.MemberType) {
+			report.AddDrift(&domain.TeamDrift{
+				Type:        domain.TeamDriftMemberTypeMismatch,
+				TeamID:      state.dbTeam.Team.TeamID,
+				UserID:      &userID,
+				CacheValue:  string(cm.MemberType),
+				DBValue:     string(dbMember.MemberType),
+				Description: "member role differs between cache and DB",
+			})
+		}
+	}
+
+	for _, m := range state.dbTeam.Members {
+		if cachedByUser[m.UserID] {
+			continue
+		}
+		userID := m.UserID
+		report.AddDrift(&domain.TeamDrift{
+			Type:        domain.TeamDriftMemberMissingInCache,
+			TeamID:      state.dbTeam.Team.TeamID,
+			UserID:      &userID,
+			DBValue:     string(m.MemberType),
+			Description: "member exists in DB but not in cache",
+		})
+	}
+
+	return report, state, nil
+}
+
+// repairDBFromCache makes MySQL match the cached team. The writes share one transaction,
+// so a failed repair leaves MySQL as it was and the next run sees the same drift.
+func (s *TeamReconciliationService) repairDBFromCache(ctx context.Context, state *reconcileState, report *domain.TeamReconciliationReport) error {
+	if state.cachedTeam == nil {
+		return nil
+	}
+
+	return transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if state.dbTeam == nil {
+			return s.createDBTeamFromCache(txCtx, state)
+		}
+		return s.repairDBTeamDrift(txCtx, state, report)
+	})
+}
+
+// createDBTeamFromCache writes a cached team that never reached MySQL
+func (s *TeamReconciliationService) createDBTeamFromCache(ctx context.Context, state *reconcileState) error {
+	team := domain.NewTeam(state.contest.ContestID, cachedTeamName(state.cachedTeam))
+	savedTeam, err := s.teamDBRepository.SaveWithContext(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	members := make([]*domain.TeamMember, len(state.cachedMembers))
+	for i, cm := range state.cachedMembers {
+		members[i] = toDBTeamMember(savedTeam.TeamID, cm)
+	}
+	return s.teamDBRepository.SaveMemberBatchWithContext(ctx, members)
+}
+
+// repairDBTeamDrift applies each reported drift of the persisted team
+func (s *TeamReconciliationService) repairDBTeamDrift(ctx context.Context, state *reconcileState, report *domain.TeamReconciliationReport) error {
+
+	teamID := state.dbTeam.Team.TeamID
+	cachedByUser := make(map[int64]*port.CachedTeamMember, len(state.cachedMembers))
+	for _, cm := range state.cachedMembers {
+		cachedByUser[cm.UserID] = cm
+	}
+	dbByUser := make(map[int64]*domain.TeamMember, len(state.dbTeam.Members))
+	for _, m := range state.dbTeam.Members {
+		dbByUser[m.UserID] = m
+	}
+
+	for _, drift := range report.Drifts {
+		switch drift.Type {
+		case domain.TeamDriftNameMismatch:
+			state.dbTeam.Team.TeamName = drift.CacheValue
+			if err := s.teamDBRepository.UpdateWithContext(ctx, state.dbTeam.Team); err != nil {
+				return err
+			}
+		case domain.TeamDriftMemberMissingInDB:
+			if _, err := s.teamDBRepository.SaveMemberWithContext(ctx, toDBTeamMember(teamID, cachedByUser[*drift.UserID])); err != nil {
+				return err
+			}
+		case domain.TeamDriftMemberMissingInCache:
+			if err := s.teamDBRepository.DeleteMemberByTeamAndUserWithContext(ctx, teamID, *drift.UserID); err != nil {
+				return err
+			}
+		case domain.TeamDriftMemberTypeMismatch:
+			dbMember := dbByUser[*drift.UserID]
+			dbMember.MemberType = domain.TeamMemberType(drift.CacheValue)
+			if err := s.teamDBRepository.UpdateMemberWithContext(ctx, dbMember); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// repairCacheFromDB rebuilds the cached team from MySQL. Pending invites of the team are discarded.
+func (s *TeamReconciliationService) repairCacheFromDB(ctx context.Context, state *reconcileState) error {
+	if state.cachedTeam == nil {
+		return nil
+	}
+
+	if err := s.teamRedisRepo.ClearTeam(ctx, state.contest.ContestID); err != nil {
+		return err
+	}
+
+	// Cached team has no DB counterpart, so the DB says it should not exist
+	if state.dbTeam == nil {
+		return nil
+	}
+
+	var leader *port.CachedTeamMember
+	others := make([]*port.CachedTeamMember, 0, len(state.dbTeam.Members))
+	for _, m := range state.dbTeam.Members {
+		member := s.toCachedTeamMember(state.contest.ContestID, m)
+		if m.IsLeader() && leader == nil {
+			leader = member
+			continue
+		}
+		others = append(others, member)
+	}
+
+	if leader == nil {
+		return exception.ErrTeamMemberNotFound
+	}
+
+	teamName := state.dbTeam.Team.TeamName
+	cachedTeam := &port.CachedTeam{
+		ContestID:    state.contest.ContestID,
+		TeamID:       state.dbTeam.Team.TeamID,
+		TeamName:     &teamName,
+		MaxMembers:   state.contest.TotalTeamMember,
+		CurrentCount: 1,
+		LeaderUserID: leader.UserID,
+		CreatedAt:    state.cachedTeam.CreatedAt,
+	}
+
+	if err := s.teamRedisRepo.CreateTeam(ctx, cachedTeam, leader, DefaultTeamTTL); err != nil {
+		return err
+	}
+
+	for _, member := range others {
+		if err := s.teamRedisRepo.AddMember(ctx, member, DefaultTeamTTL); err != nil {
+			return err
+		}
+	}
+
+	if state.cachedTeam.IsFinalized {
+		return s.teamRedisRepo.MarkAsFinalized(ctx, state.contest.ContestID)
+	}
+
+	return nil
+}
+
+func (s *TeamReconciliationService) rebuildFinalizedCount(ctx context.Context, contest *contestDomain.Contest) error {
+	dbTeams, err := s.teamDBRepository.GetTeamsByContestWithMembers(contest.ContestID)
+	if err != nil {
+		return err
+	}
+
+	count := countCompleteTeams(dbTeams, contest.TotalTeamMember)
+	return s.teamRedisRepo.SetFinalizedTeamCount(ctx, contest.ContestID, int64(count))
+}
+
+func (s *TeamReconciliationService) toCachedTeamMember(contestID int64, m *domain.TeamMember) *port.CachedTeamMember {
+	memberType := port.TeamMemberTypeMember
+	if m.IsLeader() {
+		memberType = port.TeamMemberTypeLeader
+	}
+
+	member := &port.CachedTeamMember{
+		UserID:     m.UserID,
+		ContestID:  contestID,
+		TeamID:     m.TeamID,
+		MemberType: memberType,
+		JoinedAt:   m.JoinedAt,
+	}
+
+	if user, err := s.userQueryRepo.FindById(m.UserID); err == nil && user != nil {
+		member.Username = user.Username
+		member.Tag = user.Tag
+	}
+
+	if discordAccount, err := s.oauth2Repository.FindDiscordAccountByUserId(m.UserID); err == nil && discordAccount != nil {
+		member.DiscordID = discordAccount.DiscordId
+	}
+
+	return member
+}
+
+// acquireLock prevents the scheduled job and an admin repair from touching the same contest at once
+func (s *TeamReconciliationService) acquireLock(ctx context.Context, contestID int64) (bool, error) {
+	return s.teamRedisRepo.AcquireReconcileLock(ctx, contestID, reconcileLockTTL)
+}
+
+func (s *TeamReconciliationService) releaseLock(ctx context.Context, contestID int64) {
+	if err := s.teamRedisRepo.ReleaseReconcileLock(ctx, contestID); err != nil {
+		log.Printf("[TeamReconciliation] Failed to release lock for contest %d: %v", contestID, err)
+	}
+}
+
+// findPersistedTeam finds the DB team written for a cached team.
+// Write-behind persistence assigns new DB IDs, so teams are matched by leader membership, then by name.
+func findPersistedTeam(dbTeams []*port.TeamWithMembers, cachedTeam *port.CachedTeam) *port.TeamWithMembers {
+	for _, t := range dbTeams {
+		for _, m := range t.Members {
+			if m.UserID == cachedTeam.LeaderUserID {
+				return t
+			}
+		}
+	}
+
+	if cachedTeam.TeamName != nil {
+		for _, t := range dbTeams {
+			if t.Team.TeamName == *cachedTeam.TeamName {
+				return t
+			}
+		}
+	}
+
+	return nil
+}
+
+func countCompleteTeams(dbTeams []*port.TeamWithMembers, teamSize int) int {
+	count := 0
+	for _, t := range dbTeams {
+		if len(t.Members) >= teamSize {
+			count++
+		}
+	}
+	return count
+}
+
+func cachedTeamName(cachedTeam *port.CachedTeam) string {
+	if cachedTeam.TeamName == nil {
+		return ""
+	}
+	return *cachedTeam.TeamName
+}
+
+func toDBTeamMember(teamID int64, cm *port.CachedTeamMember) *domain.TeamMember {
+	memberType := domain.TeamMemberTypeMember
+	if cm.MemberType == port.TeamMemberTypeLeader {
+		memberType = domain.TeamMemberTypeLeader
+	}
+
+	member := domain.NewTeamMember(teamID, cm.UserID, memberType)
+	member.JoinedAt = cm.JoinedAt
+	return member
+}
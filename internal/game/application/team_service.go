@@ -2,14 +2,17 @@ package application
 
 import (
 	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 	notificationPort "github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
 	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
 	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
 	"context"
+	"errors"
 	"log"
 	"time"
 )
@@ -28,6 +31,8 @@ type TeamService struct {
 	eventPublisher       port.TeamEventPublisherPort
 	persistencePublisher port.TeamPersistencePublisherPort
 	notificationHandler  notificationPort.NotificationHandlerPort
+	eligibilityChecker   contestPort.ContestEligibilityPort
+	txManager            transaction.Transactor
 }
 
 func NewTeamService(
@@ -60,6 +65,24 @@ func (s *TeamService) SetContestRepository(repository contestPort.ContestDatabas
 	s.contestRepository = repository
 }
 
+// SetEligibilityChecker sets the contest eligibility checker (to avoid circular dependency)
+func (s *TeamService) SetEligibilityChecker(checker contestPort.ContestEligibilityPort) {
+	s.eligibilityChecker = checker
+}
+
+// SetTransactionManager sets the transaction manager so waitlist promotions run under the contest row lock
+func (s *TeamService) SetTransactionManager(txManager transaction.Transactor) {
+	s.txManager = txManager
+}
+
+// checkEligibility enforces the contest eligibility constraints on a user joining a team
+func (s *TeamService) checkEligibility(contest *contestDomain.Contest, userID int64) error {
+	if s.eligibilityChecker == nil {
+		return nil
+	}
+	return s.eligibilityChecker.CheckEligibility(contest, userID)
+}
+
 // CreateTeamInCache creates a new team in Redis cache with the creator as leader
 func (s *TeamService) CreateTeamInCache(ctx context.Context, contestID, leaderUserID int64, teamName *string) (*port.CachedTeam, error) {
 	// Get contest for max members and Discord channel info
@@ -73,6 +96,10 @@ func (s *TeamService) CreateTeamInCache(ctx context.Context, contestID, leaderUs
 		return nil, exception.ErrContestNotActive
 	}
 
+	if err := s.checkRosterEditable(contest); err != nil {
+		return nil, err
+	}
+
 	// Check if user already has a team in this contest
 	existingTeam, _ := s.teamRedisRepo.GetTeam(ctx, contestID)
 	if existingTeam != nil {
@@ -143,11 +170,11 @@ func (s *TeamService) CreateTeamInCache(ctx context.Context, contestID, leaderUs
 
 	// Publish member joined event if contest has Discord integration
 	if contest.HasDiscordIntegration() {
-		go s.publishMemberJoinedEventForContest(ctx, contest, leader, 1, maxMembers)
+		s.publishMemberJoinedEventForContest(ctx, contest, leader, 1, maxMembers)
 	}
 
 	// Publish team created for persistence (Write-Behind)
-	go s.publishTeamCreatedForPersistence(ctx, cachedTeam, leader)
+	s.publishTeamCreatedForPersistence(ctx, cachedTeam, leader)
 
 	return cachedTeam, nil
 }
@@ -213,6 +240,10 @@ func (s *TeamService) InviteMember(ctx context.Context, contestID, inviterUserID
 		return nil, exception.ErrContestNotActive
 	}
 
+	if err := s.checkRosterEditable(contest); err != nil {
+		return nil, err
+	}
+
 	// Check if inviter is a member
 	isMember, err := s.teamRedisRepo.IsMember(ctx, contestID, inviterUserID)
 	if err != nil || !isMember {
@@ -242,6 +273,11 @@ func (s *TeamService) InviteMember(ctx context.Context, contestID, inviterUserID
 		return nil, exception.ErrTeamIsFull
 	}
 
+	// Check invitee eligibility
+	if err := s.checkEligibility(contest, inviteeUserID); err != nil {
+		return nil, err
+	}
+
 	// Get inviter's info
 	inviter, err := s.userQueryRepo.FindById(inviterUserID)
 	if err != nil {
@@ -283,9 +319,9 @@ func (s *TeamService) InviteMember(ctx context.Context, contestID, inviterUserID
 		return nil, err
 	}
 
-	// Publish invite event via RabbitMQ
+	// Publish invite event (relayed to RabbitMQ through the outbox)
 	if contest.HasDiscordIntegration() {
-		go s.publishInviteEventForContest(ctx, contest, inviterUserID, inviterDiscordID, inviter.Username, inviteeUserID, inviteeDiscordID, invitee.Username)
+		s.publishInviteEventForContest(ctx, contest, inviterUserID, inviterDiscordID, inviter.Username, inviteeUserID, inviteeDiscordID, invitee.Username)
 	}
 
 	// Get team name for notification
@@ -315,6 +351,10 @@ func (s *TeamService) AcceptInvite(ctx context.Context, contestID, inviteeUserID
 		return nil, err
 	}
 
+	if err := s.checkRosterEditable(contest); err != nil {
+		return nil, err
+	}
+
 	// Get team info to get teamID
 	cachedTeam, err := s.teamRedisRepo.GetTeam(ctx, contestID)
 	if err != nil {
@@ -332,6 +372,11 @@ func (s *TeamService) AcceptInvite(ctx context.Context, contestID, inviteeUserID
 		return nil, exception.ErrTeamIsFull
 	}
 
+	// Re-check eligibility, the invitee's profile may have changed since the invite
+	if err := s.checkEligibility(contest, inviteeUserID); err != nil {
+		return nil, err
+	}
+
 	// Accept the invite
 	if err := s.teamRedisRepo.AcceptInvite(ctx, contestID, inviteeUserID); err != nil {
 		return nil, err
@@ -372,7 +417,7 @@ func (s *TeamService) AcceptInvite(ctx context.Context, contestID, inviteeUserID
 	// Publish member joined event
 	if contest.HasDiscordIntegration() {
 		newCount := memberCount + 1
-		go s.publishMemberJoinedEventForContest(ctx, contest, member, newCount, maxMembers)
+		s.publishMemberJoinedEventForContest(ctx, contest, member, newCount, maxMembers)
 	}
 
 	// Send SSE notification to inviter (the leader or whoever invited)
@@ -383,7 +428,7 @@ func (s *TeamService) AcceptInvite(ctx context.Context, contestID, inviteeUserID
 	go s.sendTeamInviteAcceptedNotification(cachedTeam.LeaderUserID, invitee.Username, teamName, 0, contestID)
 
 	// Publish member added for persistence (Write-Behind)
-	go s.publishMemberAddedForPersistence(ctx, cachedTeam, member)
+	s.publishMemberAddedForPersistence(ctx, cachedTeam, member)
 
 	return member, nil
 }
@@ -428,6 +473,10 @@ func (s *TeamService) KickMember(ctx context.Context, contestID, kickerUserID, t
 		return exception.ErrContestNotActive
 	}
 
+	if err := s.checkRosterEditable(contest); err != nil {
+		return err
+	}
+
 	// Get kicker and verify they're the leader
 	kicker, err := s.teamRedisRepo.GetMember(ctx, contestID, kickerUserID)
 	if err != nil {
@@ -474,6 +523,10 @@ func (s *TeamService) LeaveTeam(ctx context.Context, contestID, userID int64) er
 		return exception.ErrContestNotActive
 	}
 
+	if err := s.checkRosterEditable(contest); err != nil {
+		return err
+	}
+
 	// Get member
 	member, err := s.teamRedisRepo.GetMember(ctx, contestID, userID)
 	if err != nil {
@@ -506,6 +559,10 @@ func (s *TeamService) TransferLeadership(ctx context.Context, contestID, current
 		return exception.ErrContestNotActive
 	}
 
+	if err := s.checkRosterEditable(contest); err != nil {
+		return err
+	}
+
 	// Verify current leader
 	currentLeader, err := s.teamRedisRepo.GetMember(ctx, contestID, currentLeaderUserID)
 	if err != nil {
@@ -542,6 +599,20 @@ func (s *TeamService) FinalizeTeam(ctx context.Context, contestID, userID int64)
 		return exception.ErrNoPermissionToDelete
 	}
 
+	return s.finalizeTeam(ctx, contestID, leader)
+}
+
+// ForceFinalizeTeam finalizes a complete cached team without the leader's action (used at registration close)
+func (s *TeamService) ForceFinalizeTeam(ctx context.Context, contestID int64) error {
+	leader, err := s.teamRedisRepo.GetLeader(ctx, contestID)
+	if err != nil {
+		return err
+	}
+
+	return s.finalizeTeam(ctx, contestID, leader)
+}
+
+func (s *TeamService) finalizeTeam(ctx context.Context, contestID int64, leader *port.CachedTeamMember) error {
 	// Check if already finalized
 	isFinalized, _ := s.teamRedisRepo.IsFinalized(ctx, contestID)
 	if isFinalized {
@@ -570,26 +641,33 @@ func (s *TeamService) FinalizeTeam(ctx context.Context, contestID, userID int64)
 		return err
 	}
 
+	contest, _ := s.contestRepository.GetContestById(contestID)
+
+	// Increment finalized team count; teams finalized beyond MaxTeamCount go to the waitlist
+	waitlisted := false
+	finalizedCount, err := s.teamRedisRepo.IncrementFinalizedTeamCount(ctx, contestID)
+	if err != nil {
+		log.Printf("[TeamService] Failed to increment finalized team count for contest %d: %v", contestID, err)
+	} else if contest != nil && contest.MaxTeamCount > 0 {
+		waitlisted = int(finalizedCount) > contest.MaxTeamCount
+	}
+
 	// Publish team finalized for persistence (Write-Behind)
 	// DB persistence happens asynchronously via RabbitMQ consumer
-	go s.publishTeamFinalizedForPersistence(ctx, cachedTeam, members)
+	s.publishTeamFinalizedForPersistence(ctx, cachedTeam, members, waitlisted)
 
 	// Publish finalized event for Discord notification
-	contest, _ := s.contestRepository.GetContestById(contestID)
 	if contest != nil && contest.HasDiscordIntegration() {
 		memberUserIDs := make([]int64, len(members))
 		for i, m := range members {
 			memberUserIDs[i] = m.UserID
 		}
-		go s.publishTeamFinalizedEventForContest(ctx, contest, leader, len(members), memberUserIDs)
+		s.publishTeamFinalizedEventForContest(ctx, contest, leader, len(members), memberUserIDs)
 	}
 
-	// Increment finalized team count and check if all teams are ready
-	finalizedCount, err := s.teamRedisRepo.IncrementFinalizedTeamCount(ctx, contestID)
-	if err != nil {
-		log.Printf("[TeamService] Failed to increment finalized team count for contest %d: %v", contestID, err)
-	} else if contest != nil && int(finalizedCount) == contest.MaxTeamCount {
-		go s.publishContestTeamsReadyEvent(ctx, contestID, int(finalizedCount))
+	// Check if all teams are ready
+	if err == nil && contest != nil && int(finalizedCount) == contest.MaxTeamCount {
+		s.publishContestTeamsReadyEvent(ctx, contestID, int(finalizedCount))
 	}
 
 	return nil
@@ -660,6 +738,15 @@ func (s *TeamService) GetMember(ctx context.Context, contestID, userID int64) (*
 
 // DeleteTeam deletes the entire team (Leader only)
 func (s *TeamService) DeleteTeam(ctx context.Context, contestID, userID int64) error {
+	contest, err := s.contestRepository.GetContestById(contestID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkRosterEditable(contest); err != nil {
+		return err
+	}
+
 	// Check if finalized
 	isFinalized, _ := s.teamRedisRepo.IsFinalized(ctx, contestID)
 	if isFinalized {
@@ -682,7 +769,23 @@ func (s *TeamService) DeleteTeam(ctx context.Context, contestID, userID int64) e
 		if err := s.teamDBRepository.DeleteAllMembersByTeamID(team.TeamID); err != nil {
 			return err
 		}
-		return s.teamDBRepository.Delete(team.TeamID)
+		if err := s.teamDBRepository.Delete(team.TeamID); err != nil {
+			return err
+		}
+
+		if _, err := s.teamRedisRepo.DecrementFinalizedTeamCount(ctx, contestID); err != nil {
+			log.Printf("[TeamService] Failed to decrement finalized team count for contest %d: %v", contestID, err)
+		}
+
+		// A registered team withdrawing before the start frees its slot for the waitlist
+		if !team.IsWaitlisted() && contest.IsPending() {
+			promoted, err := s.PromoteFromWaitlist(ctx, contest)
+			if err != nil {
+				log.Printf("[TeamService] Failed to promote from waitlist in contest %d: %v", contestID, err)
+			}
+			s.notifyPromotedTeams(contest, promoted)
+		}
+		return nil
 	}
 
 	// Get team info before deletion for persistence event
@@ -704,12 +807,312 @@ func (s *TeamService) DeleteTeam(ctx context.Context, contestID, userID int64) e
 
 	// Publish team deleted for persistence (Write-Behind)
 	if cachedTeam != nil {
-		go s.publishTeamDeletedForPersistence(ctx, cachedTeam)
+		s.publishTeamDeletedForPersistence(ctx, cachedTeam)
 	}
 
 	return nil
 }
 
+// GetWaitlistPosition returns the waitlist position of the leader's team (1-based)
+func (s *TeamService) GetWaitlistPosition(ctx context.Context, contestID, userID int64) (*dto.WaitlistPositionResponse, error) {
+	team, err := s.teamDBRepository.GetUserTeamInContest(contestID, userID)
+	if err != nil {
+		return nil, exception.ErrNotTeamMember
+	}
+
+	member, err := s.teamDBRepository.GetMemberByTeamAndUser(team.TeamID, userID)
+	if err != nil {
+		return nil, exception.ErrNotTeamMember
+	}
+
+	if !member.IsLeader() {
+		return nil, exception.ErrNotTeamLeader
+	}
+
+	if !team.IsWaitlisted() {
+		return nil, exception.ErrTeamNotWaitlisted
+	}
+
+	waitlist, err := s.teamDBRepository.GetWaitlistedByContestID(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, waitlisted := range waitlist {
+		if waitlisted.TeamID != team.TeamID {
+			continue
+		}
+
+		return &dto.WaitlistPositionResponse{
+			ContestID:    contestID,
+			TeamID:       team.TeamID,
+			TeamName:     team.TeamName,
+			Position:     i + 1,
+			WaitlistSize: len(waitlist),
+			WaitlistedAt: *waitlisted.WaitlistedAt,
+		}, nil
+	}
+
+	return nil, exception.ErrTeamNotWaitlisted
+}
+
+// PromoteFromWaitlist gives the contest's free slots to waitlisted teams, in waitlist order.
+// The contest row is locked while registered teams are counted, so concurrent withdrawals cannot
+// promote past MaxTeamCount. Joins the transaction carried by ctx; callers notify the promoted teams
+// with notifyPromotedTeams once it commits.
+func (s *TeamService) PromoteFromWaitlist(ctx context.Context, contest *contestDomain.Contest) ([]*domain.Team, error) {
+	if contest.MaxTeamCount <= 0 {
+		return nil, nil
+	}
+
+	var promoted []*domain.Team
+	err := transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		promoted = nil
+		if _, err := s.contestRepository.GetContestByIdForUpdate(txCtx, contest.ContestID); err != nil {
+			return err
+		}
+
+		registeredCount, err := s.teamDBRepository.CountRegisteredByContestIDWithContext(txCtx, contest.ContestID)
+		if err != nil {
+			return err
+		}
+		freeSlots := contest.MaxTeamCount - registeredCount
+		if freeSlots <= 0 {
+			return nil
+		}
+
+		waitlist, err := s.teamDBRepository.GetWaitlistedByContestIDWithContext(txCtx, contest.ContestID)
+		if err != nil {
+			return err
+		}
+
+		for _, team := range waitlist {
+			if len(promoted) == freeSlots {
+				break
+			}
+			if err := team.PromoteFromWaitlist(); err != nil {
+				return err
+			}
+			if err := s.teamDBRepository.UpdateWithContext(txCtx, team); err != nil {
+				return err
+			}
+			promoted = append(promoted, team)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range promoted {
+		log.Printf("[TeamService] Team %d promoted from waitlist in contest %d", team.TeamID, contest.ContestID)
+	}
+	return promoted, nil
+}
+
+// notifyPromotedTeams notifies the members of teams promoted from the waitlist
+func (s *TeamService) notifyPromotedTeams(contest *contestDomain.Contest, teams []*domain.Team) {
+	for _, team := range teams {
+		go s.sendTeamPromotedNotifications(contest, team)
+	}
+}
+
+// DropCachedTeam removes an unfinalized team from the Redis cache and its write-behind DB copy
+func (s *TeamService) DropCachedTeam(ctx context.Context, contestID int64) error {
+	cachedTeam, err := s.teamRedisRepo.GetTeam(ctx, contestID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.teamRedisRepo.ClearTeam(ctx, contestID); err != nil {
+		return err
+	}
+
+	s.publishTeamDeletedForPersistence(ctx, cachedTeam)
+
+	return nil
+}
+
+// checkRosterEditable blocks direct roster edits once registration has closed;
+// changes after the roster lock go through staff-approved roster change requests
+func (s *TeamService) checkRosterEditable(contest *contestDomain.Contest) error {
+	if contest.IsRosterLocked() || contest.IsRegistrationClosed(time.Now()) {
+		return exception.ErrRosterLocked
+	}
+	return nil
+}
+
+// CreateDraftedTeams persists the complete teams produced by a captain draft.
+// Drafted rosters are final, so the teams are written straight to DB instead of being built in the Redis team cache,
+// all in one transaction; players who already have a team in the contest are rejected. Like cache-built teams,
+// drafted teams need an editable roster, go to the waitlist beyond MaxTeamCount and count as finalized in the cache.
+// Creating the same drafted teams again returns the existing teams, so a completed draft that failed to save can retry.
+func (s *TeamService) CreateDraftedTeams(ctx context.Context, contestID int64, draftedTeams []*domain.DraftedTeam) ([]*domain.Team, error) {
+	contest, err := s.contestRepository.GetContestById(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contest.IsActive() && !contest.IsPending() {
+		return nil, exception.ErrContestNotActive
+	}
+
+	if err := s.checkRosterEditable(contest); err != nil {
+		return nil, err
+	}
+
+	existingTeams, err := s.findDraftedTeams(contestID, draftedTeams)
+	if err != nil {
+		return nil, err
+	}
+	if existingTeams != nil {
+		return existingTeams, nil
+	}
+
+	now := time.Now()
+	drafted := make(map[int64]bool)
+	teams := make([]*port.TeamWithMembers, 0, len(draftedTeams))
+	for _, draftedTeam := range draftedTeams {
+		if len(draftedTeam.MemberUserIDs)+1 > contest.TotalTeamMember {
+			return nil, exception.ErrTeamIsFull
+		}
+
+		team := domain.NewTeam(contestID, draftedTeam.TeamName)
+		if err := team.Validate(); err != nil {
+			return nil, err
+		}
+
+		members := make([]*domain.TeamMember, 0, len(draftedTeam.MemberUserIDs)+1)
+		leader := domain.NewTeamMemberAsLeader(0, draftedTeam.CaptainUserID)
+		leader.JoinedAt = now
+		members = append(members, leader)
+		for _, userID := range draftedTeam.MemberUserIDs {
+			member := domain.NewTeamMemberAsMember(0, userID)
+			member.JoinedAt = now
+			members = append(members, member)
+		}
+
+		for _, member := range members {
+			if drafted[member.UserID] {
+				return nil, exception.ErrPlayerAlreadyInTeam
+			}
+			drafted[member.UserID] = true
+
+			if err := s.checkNoTeamInContest(ctx, contestID, member.UserID); err != nil {
+				return nil, err
+			}
+		}
+
+		teams = append(teams, &port.TeamWithMembers{Team: team, Members: members})
+	}
+
+	// The contest row lock keeps waitlist promotions from filling the slots counted here
+	err = transaction.Run(ctx, s.txManager, func(txCtx context.Context) error {
+		if _, err := s.contestRepository.GetContestByIdForUpdate(txCtx, contestID); err != nil {
+			return err
+		}
+
+		if contest.MaxTeamCount > 0 {
+			registeredCount, err := s.teamDBRepository.CountRegisteredByContestIDWithContext(txCtx, contestID)
+			if err != nil {
+				return err
+			}
+			for i, team := range teams {
+				if registeredCount+i >= contest.MaxTeamCount {
+					team.Team.Waitlist(now)
+				}
+			}
+		}
+
+		return s.teamDBRepository.SaveTeamsWithMembersWithContext(txCtx, teams)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The reconciliation job rebuilds the counter from MySQL if this fails
+	for range teams {
+		if _, err := s.teamRedisRepo.IncrementFinalizedTeamCount(ctx, contestID); err != nil {
+			log.Printf("[TeamService] Failed to increment finalized team count for contest %d: %v", contestID, err)
+			break
+		}
+	}
+
+	savedTeams := make([]*domain.Team, len(teams))
+	for i, team := range teams {
+		savedTeams[i] = team.Team
+	}
+	return savedTeams, nil
+}
+
+// findDraftedTeams returns the teams already created for the drafted rosters, or nil when none were.
+// Each captain must lead a team of the drafted name with exactly the drafted members.
+func (s *TeamService) findDraftedTeams(contestID int64, draftedTeams []*domain.DraftedTeam) ([]*domain.Team, error) {
+	teams := make([]*domain.Team, 0, len(draftedTeams))
+	for _, draftedTeam := range draftedTeams {
+		team, err := s.teamDBRepository.GetUserTeamInContest(contestID, draftedTeam.CaptainUserID)
+		if errors.Is(err, exception.ErrTeamNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if team.TeamName != draftedTeam.TeamName {
+			return nil, nil
+		}
+
+		members, err := s.teamDBRepository.GetMembersByTeamID(team.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		if !isDraftedRoster(members, draftedTeam) {
+			return nil, nil
+		}
+
+		teams = append(teams, team)
+	}
+	return teams, nil
+}
+
+// isDraftedRoster reports whether the members are the captain as leader and exactly the drafted players
+func isDraftedRoster(members []*domain.TeamMember, draftedTeam *domain.DraftedTeam) bool {
+	if len(members) != len(draftedTeam.MemberUserIDs)+1 {
+		return false
+	}
+
+	byUser := make(map[int64]*domain.TeamMember, len(members))
+	for _, member := range members {
+		byUser[member.UserID] = member
+	}
+
+	if leader, ok := byUser[draftedTeam.CaptainUserID]; !ok || !leader.IsLeader() {
+		return false
+	}
+	for _, userID := range draftedTeam.MemberUserIDs {
+		if _, ok := byUser[userID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// checkNoTeamInContest rejects a user who is already on a cached or persisted team of the contest
+func (s *TeamService) checkNoTeamInContest(ctx context.Context, contestID, userID int64) error {
+	isMember, _ := s.teamRedisRepo.IsMember(ctx, contestID, userID)
+	if isMember {
+		return exception.ErrPlayerAlreadyInTeam
+	}
+
+	_, err := s.teamDBRepository.GetUserTeamInContest(contestID, userID)
+	if err == nil {
+		return exception.ErrPlayerAlreadyInTeam
+	}
+	if !errors.Is(err, exception.ErrTeamNotFound) {
+		return err
+	}
+	return nil
+}
+
 // Event publishing helper methods for contest-based events
 
 func (s *TeamService) publishInviteEventForContest(
@@ -858,6 +1261,25 @@ func (s *TeamService) sendTeamInviteRejectedNotification(inviterUserID int64, in
 
 // Write-Behind Pattern: Persistence event publishing helper methods
 
+// sendTeamPromotedNotifications notifies every member of a team promoted from the waitlist
+func (s *TeamService) sendTeamPromotedNotifications(contest *contestDomain.Contest, team *domain.Team) {
+	if s.notificationHandler == nil {
+		return
+	}
+
+	members, err := s.teamDBRepository.GetMembersByTeamID(team.TeamID)
+	if err != nil {
+		log.Printf("[TeamService] Failed to load members of promoted team %d: %v", team.TeamID, err)
+		return
+	}
+
+	for _, member := range members {
+		if err := s.notificationHandler.HandleTeamPromotedFromWaitlist(member.UserID, contest.ContestID, contest.Title, team.TeamName); err != nil {
+			log.Printf("Failed to send waitlist promotion notification to user %d: %v", member.UserID, err)
+		}
+	}
+}
+
 // publishTeamCreatedForPersistence publishes event for async DB persistence
 func (s *TeamService) publishTeamCreatedForPersistence(ctx context.Context, cachedTeam *port.CachedTeam, leader *port.CachedTeamMember) {
 	if s.persistencePublisher == nil {
@@ -903,7 +1325,7 @@ func (s *TeamService) publishMemberAddedForPersistence(ctx context.Context, cach
 }
 
 // publishTeamFinalizedForPersistence publishes event for async DB persistence
-func (s *TeamService) publishTeamFinalizedForPersistence(ctx context.Context, cachedTeam *port.CachedTeam, members []*port.CachedTeamMember) {
+func (s *TeamService) publishTeamFinalizedForPersistence(ctx context.Context, cachedTeam *port.CachedTeam, members []*port.CachedTeamMember, waitlisted bool) {
 	if s.persistencePublisher == nil {
 		return
 	}
@@ -918,10 +1340,15 @@ func (s *TeamService) publishTeamFinalizedForPersistence(ctx context.Context, ca
 	}
 
 	event := &port.TeamPersistenceEvent{
-		TeamID:    cachedTeam.TeamID,
-		ContestID: cachedTeam.ContestID,
-		TeamName:  cachedTeam.TeamName,
-		Members:   persistenceMembers,
+		TeamID:     cachedTeam.TeamID,
+		ContestID:  cachedTeam.ContestID,
+		TeamName:   cachedTeam.TeamName,
+		Members:    persistenceMembers,
+		Waitlisted: waitlisted,
+	}
+	if waitlisted {
+		waitlistedAt := time.Now()
+		event.WaitlistedAt = &waitlistedAt
 	}
 
 	if err := s.persistencePublisher.PublishTeamFinalized(ctx, event); err != nil {
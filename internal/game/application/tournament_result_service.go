@@ -118,9 +118,52 @@ func (s *TournamentResultService) GetContestResult(contestID int64) (*dto.Contes
 		TotalRounds:   totalRounds,
 		Champion:      champion,
 		Rounds:        rounds,
+		Placements:    buildPlacements(rounds, totalRounds, champion),
 	}, nil
 }
 
+// buildPlacements derives final placements from the champion and the losers of finished games
+func buildPlacements(rounds []dto.RoundResult, totalRounds int, champion *dto.TeamSummary) []dto.TeamPlacement {
+	placements := make([]dto.TeamPlacement, 0)
+	if champion != nil {
+		placements = append(placements, dto.TeamPlacement{
+			TeamID:    champion.TeamID,
+			TeamName:  champion.TeamName,
+			Placement: 1,
+		})
+	}
+
+	for _, round := range rounds {
+		for _, game := range round.Games {
+			if game.MatchResult == nil {
+				continue
+			}
+
+			loserName := ""
+			for _, team := range game.Teams {
+				if team.TeamID == game.MatchResult.LoserTeamID {
+					loserName = team.TeamName
+				}
+			}
+
+			placements = append(placements, dto.TeamPlacement{
+				TeamID:    game.MatchResult.LoserTeamID,
+				TeamName:  loserName,
+				Placement: GetEliminationPlacement(round.Round, totalRounds),
+			})
+		}
+	}
+
+	sort.SliceStable(placements, func(i, j int) bool {
+		if placements[i].Placement != placements[j].Placement {
+			return placements[i].Placement < placements[j].Placement
+		}
+		return placements[i].TeamID < placements[j].TeamID
+	})
+
+	return placements
+}
+
 // buildGameResult constructs a GameResult for a single game
 func (s *TournamentResultService) buildGameResult(game *domain.Game, teamNameMap map[int64]string) dto.GameResult {
 	gr := dto.GameResult{
@@ -173,6 +173,12 @@ func GetRoundName(round, totalRounds int) string {
 	}
 }
 
+// GetEliminationPlacement returns the final placement of a team knocked out in the given round
+// (final loser 2nd, semi-final losers 3rd, quarter-final losers 5th, ...)
+func GetEliminationPlacement(round, totalRounds int) int {
+	return 1<<(totalRounds-round) + 1
+}
+
 // ShuffleAndAllocateTeams shuffles all registered teams and assigns them to first round games
 // This should be called when the contest starts or when team recruitment is complete
 func (s *TournamentService) ShuffleAndAllocateTeams(contestID int64) error {
@@ -229,11 +235,19 @@ func (s *TournamentService) ShuffleAndAllocateTeams(contestID int64) error {
 // ShuffleAndAllocateTeamsWithResult shuffles teams and returns the allocation result
 func (s *TournamentService) ShuffleAndAllocateTeamsWithResult(contestID int64, gameTeamRepo port.GameTeamDatabasePort) (*TeamAllocationResult, error) {
 	// Get all teams for the contest
-	teams, err := s.teamRepository.GetByContestID(contestID)
+	allTeams, err := s.teamRepository.GetByContestID(contestID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Waitlisted teams never get a bracket slot
+	teams := make([]*domain.Team, 0, len(allTeams))
+	for _, team := range allTeams {
+		if !team.IsWaitlisted() {
+			teams = append(teams, team)
+		}
+	}
+
 	if len(teams) == 0 {
 		return nil, exception.ErrNoTeamsToAllocate
 	}
@@ -0,0 +1,141 @@
+package domain
+
+import "time"
+
+// BadgeCode identifies a badge of the achievement catalog
+type BadgeCode string
+
+const (
+	// BadgeFirstContest is awarded when a user is accepted into a contest for the first time
+	BadgeFirstContest BadgeCode = "FIRST_CONTEST"
+	// BadgeSquadUp is awarded when a team of two or more players the user belongs to is finalized
+	BadgeSquadUp BadgeCode = "SQUAD_UP"
+	// BadgeContestsPlayed10 is awarded once the user has finished games in 10 different contests
+	BadgeContestsPlayed10 BadgeCode = "CONTESTS_PLAYED_10"
+	// BadgeKills30 is awarded for 30 or more kills in a single detected match
+	BadgeKills30 BadgeCode = "KILLS_30"
+	// BadgePerfect13To0 is awarded to the winners of a 13-0 match
+	BadgePerfect13To0 BadgeCode = "PERFECT_13_0"
+	// BadgeFirstTournamentWin is awarded to the winners of a tournament final
+	BadgeFirstTournamentWin BadgeCode = "FIRST_TOURNAMENT_WIN"
+)
+
+// Badge is the displayed description of a badge
+type Badge struct {
+	Code        BadgeCode
+	Name        string
+	Description string
+}
+
+// AchievementTrigger is the event an achievement rule is evaluated on
+type AchievementTrigger string
+
+const (
+	AchievementTriggerContestJoined AchievementTrigger = "CONTEST_JOINED"
+	AchievementTriggerTeamFinalized AchievementTrigger = "TEAM_FINALIZED"
+	AchievementTriggerGameFinished  AchievementTrigger = "GAME_FINISHED"
+)
+
+// AchievementFacts is what is known about a user when a trigger fires.
+// Only the facts of the trigger are filled: game facts are zero on contest and team triggers.
+type AchievementFacts struct {
+	UserID int64
+
+	// TeamSize is the number of members of the finalized team
+	TeamSize int
+
+	// ContestsPlayed is the number of contests the user finished games in, this game included
+	ContestsPlayed int
+	// Kills is zero when the match has no player stats (manual results)
+	Kills int
+	// WonMatch reports whether the user's team won the match result
+	WonMatch    bool
+	WinnerScore int
+	LoserScore  int
+	// WonTournamentFinal reports whether the user's team won the last game of a tournament bracket
+	WonTournamentFinal bool
+}
+
+// AchievementRule awards its badge when the condition holds on the trigger's facts
+type AchievementRule struct {
+	Badge     Badge
+	Trigger   AchievementTrigger
+	Condition func(facts *AchievementFacts) bool
+}
+
+// AchievementRules is the badge catalog, in display order
+var AchievementRules = []*AchievementRule{
+	{
+		Badge:     Badge{Code: BadgeFirstContest, Name: "First Contest", Description: "Got accepted into a contest for the first time"},
+		Trigger:   AchievementTriggerContestJoined,
+		Condition: func(facts *AchievementFacts) bool { return true },
+	},
+	{
+		Badge:     Badge{Code: BadgeSquadUp, Name: "Squad Up", Description: "Finalized a team with other players"},
+		Trigger:   AchievementTriggerTeamFinalized,
+		Condition: func(facts *AchievementFacts) bool { return facts.TeamSize >= 2 },
+	},
+	{
+		Badge:     Badge{Code: BadgeContestsPlayed10, Name: "Regular", Description: "Played in 10 contests"},
+		Trigger:   AchievementTriggerGameFinished,
+		Condition: func(facts *AchievementFacts) bool { return facts.ContestsPlayed >= 10 },
+	},
+	{
+		Badge:     Badge{Code: BadgeKills30, Name: "Thirty Bomb", Description: "Got 30 kills in a single match"},
+		Trigger:   AchievementTriggerGameFinished,
+		Condition: func(facts *AchievementFacts) bool { return facts.Kills >= 30 },
+	},
+	{
+		Badge:   Badge{Code: BadgePerfect13To0, Name: "Flawless", Description: "Won a match 13-0"},
+		Trigger: AchievementTriggerGameFinished,
+		Condition: func(facts *AchievementFacts) bool {
+			return facts.WonMatch && facts.WinnerScore == 13 && facts.LoserScore == 0
+		},
+	},
+	{
+		Badge:     Badge{Code: BadgeFirstTournamentWin, Name: "Champion", Description: "Won a tournament for the first time"},
+		Trigger:   AchievementTriggerGameFinished,
+		Condition: func(facts *AchievementFacts) bool { return facts.WonTournamentFinal },
+	},
+}
+
+// FindBadge returns the catalog badge of code; badges removed from the catalog are not found
+func FindBadge(code BadgeCode) (*Badge, bool) {
+	for _, rule := range AchievementRules {
+		if rule.Badge.Code == code {
+			return &rule.Badge, true
+		}
+	}
+	return nil, false
+}
+
+// EvaluateAchievements returns the badges whose rules of trigger hold on facts
+func EvaluateAchievements(trigger AchievementTrigger, facts *AchievementFacts) []BadgeCode {
+	var codes []BadgeCode
+	for _, rule := range AchievementRules {
+		if rule.Trigger == trigger && rule.Condition(facts) {
+			codes = append(codes, rule.Badge.Code)
+		}
+	}
+	return codes
+}
+
+// UserBadge is a badge a user earned; each badge is earned once
+type UserBadge struct {
+	UserBadgeID int64     `gorm:"column:user_badge_id;primaryKey;autoIncrement" json:"user_badge_id"`
+	UserID      int64     `gorm:"column:user_id;type:bigint;not null" json:"user_id"`
+	BadgeCode   BadgeCode `gorm:"column:badge_code;type:varchar(64);not null" json:"badge_code"`
+	AwardedAt   time.Time `gorm:"column:awarded_at;type:datetime;not null" json:"awarded_at"`
+}
+
+func NewUserBadge(userID int64, code BadgeCode, awardedAt time.Time) *UserBadge {
+	return &UserBadge{
+		UserID:    userID,
+		BadgeCode: code,
+		AwardedAt: awardedAt,
+	}
+}
+
+func (b *UserBadge) TableName() string {
+	return "user_badges"
+}
@@ -413,6 +413,20 @@ func (g *Game) FinishGame() error {
 	return nil
 }
 
+// CancelGame transitions game to CANCELLED and stops match detection
+func (g *Game) CancelGame() error {
+	if err := g.TransitionTo(GameStatusCancelled); err != nil {
+		return err
+	}
+	if g.DetectionStatus == DetectionStatusDetecting {
+		g.DetectionStatus = DetectionStatusFailed
+	}
+	now := time.Now()
+	g.EndedAt = &now
+	g.ModifiedAt = now
+	return nil
+}
+
 // IsDetecting returns true if the game is actively detecting matches
 func (g *Game) IsDetecting() bool {
 	return g.GameStatus == GameStatusActive && g.DetectionStatus == DetectionStatusDetecting
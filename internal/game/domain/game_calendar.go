@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// CalendarProductID identifies this service as the producer of iCalendar feeds (RFC 5545 PRODID)
+	CalendarProductID = "-//GAMERS//Contest Schedule//EN"
+	// calendarUIDDomain makes event UIDs globally unique; it must never change or subscribers see duplicates
+	calendarUIDDomain = "games.gamers"
+	// calendarLineLimit is the maximum line length in octets before folding
+	calendarLineLimit = 75
+	// calendarFeedTokenBytes is the entropy of a feed token, hex encoded to twice the length
+	calendarFeedTokenBytes = 24
+	// defaultCalendarEventMinutes is used when a game has neither an end time nor a detection window
+	defaultCalendarEventMinutes = 120
+)
+
+// CalendarFeedToken is the secret in a user's private calendar feed URL.
+// Calendar apps cannot send auth headers, so the token alone grants read access to the user's schedule.
+type CalendarFeedToken struct {
+	UserID    int64     `gorm:"column:user_id;primaryKey" json:"user_id"`
+	Token     string    `gorm:"column:token;type:varchar(64);not null;uniqueIndex" json:"token"`
+	CreatedAt time.Time `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+// NewCalendarFeedToken issues a fresh random token; saving it replaces the user's previous token
+func NewCalendarFeedToken(userID int64) (*CalendarFeedToken, error) {
+	b := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return &CalendarFeedToken{
+		UserID: userID,
+		Token:  hex.EncodeToString(b),
+	}, nil
+}
+
+func (t *CalendarFeedToken) TableName() string {
+	return "calendar_feed_tokens"
+}
+
+// CalendarEvent is one VEVENT of a feed
+type CalendarEvent struct {
+	UID          string
+	Summary      string
+	Description  string
+	Start        time.Time
+	End          time.Time
+	LastModified time.Time
+	// Sequence must grow whenever the event changes so clients replace their copy
+	Sequence int64
+}
+
+// IsOnCalendar reports whether the game belongs in a feed: it has a start time and was not cancelled.
+// Dropping cancelled games from the feed is what removes them from subscribed calendars.
+func (g *Game) IsOnCalendar() bool {
+	return g.GameStatus != GameStatusCancelled && g.CalendarStart() != nil
+}
+
+// CalendarStart returns the scheduled start time, or the actual start time for games started without a schedule
+func (g *Game) CalendarStart() *time.Time {
+	if g.ScheduledStartTime != nil {
+		return g.ScheduledStartTime
+	}
+	return g.StartedAt
+}
+
+// CalendarEnd returns the actual end time once the game has ended, otherwise the end of its detection window
+func (g *Game) CalendarEnd() time.Time {
+	start := *g.CalendarStart()
+	if g.EndedAt != nil && g.EndedAt.After(start) {
+		return *g.EndedAt
+	}
+
+	minutes := g.DetectionWindowMinutes
+	if minutes <= 0 {
+		minutes = defaultCalendarEventMinutes
+	}
+	return start.Add(time.Duration(minutes) * time.Minute)
+}
+
+// CalendarUID returns the stable UID of the game's event, so a rescheduled game updates the existing event
+func (g *Game) CalendarUID() string {
+	return fmt.Sprintf("game-%d@%s", g.GameID, calendarUIDDomain)
+}
+
+// CalendarSequence derives the event revision from the modification time.
+// ModifiedAt only moves forward, so the value grows every time the game changes.
+func (g *Game) CalendarSequence() int64 {
+	if g.ModifiedAt.Before(g.CreatedAt) {
+		return 0
+	}
+	return int64(g.ModifiedAt.Sub(g.CreatedAt) / time.Second)
+}
+
+// RenderCalendar renders a VCALENDAR document with CRLF line endings as required by RFC 5545
+func RenderCalendar(name string, events []CalendarEvent, now time.Time) []byte {
+	var sb strings.Builder
+	write := func(line string) {
+		sb.WriteString(foldCalendarLine(line))
+		sb.WriteString("\r\n")
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:" + CalendarProductID)
+	write("CALSCALE:GREGORIAN")
+	write("METHOD:PUBLISH")
+	write("X-WR-CALNAME:" + escapeCalendarText(name))
+
+	stamp := formatCalendarTime(now)
+	for _, event := range events {
+		write("BEGIN:VEVENT")
+		write("UID:" + event.UID)
+		write("DTSTAMP:" + stamp)
+		write("DTSTART:" + formatCalendarTime(event.Start))
+		write("DTEND:" + formatCalendarTime(event.End))
+		write("LAST-MODIFIED:" + formatCalendarTime(event.LastModified))
+		write(fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+		write("SUMMARY:" + escapeCalendarText(event.Summary))
+		if event.Description != "" {
+			write("DESCRIPTION:" + escapeCalendarText(event.Description))
+		}
+		write("END:VEVENT")
+	}
+
+	write("END:VCALENDAR")
+	return []byte(sb.String())
+}
+
+// formatCalendarTime formats a UTC date-time (RFC 5545 FORM #2)
+func formatCalendarTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeCalendarText escapes TEXT values (RFC 5545 3.3.11)
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(text)
+}
+
+// foldCalendarLine splits lines longer than 75 octets, continuing with a leading space (RFC 5545 3.1).
+// Lines are only split between runes so multi-byte team names stay valid UTF-8.
+func foldCalendarLine(line string) string {
+	if len(line) <= calendarLineLimit {
+		return line
+	}
+
+	var sb strings.Builder
+	width := 0
+	limit := calendarLineLimit
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			sb.WriteString("\r\n ")
+			width = 0
+			// The leading space counts toward the continuation line's length
+			limit = calendarLineLimit - 1
+		}
+		sb.WriteRune(r)
+		width += size
+	}
+	return sb.String()
+}
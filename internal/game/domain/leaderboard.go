@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LeaderboardSubject is what a leaderboard ranks
+type LeaderboardSubject string
+
+const (
+	LeaderboardSubjectPlayers LeaderboardSubject = "players"
+	// LeaderboardSubjectTeams ranks rosters, so a team keeps its standing across contests while its members stay together
+	LeaderboardSubjectTeams LeaderboardSubject = "teams"
+)
+
+func (s LeaderboardSubject) IsValid() bool {
+	return s == LeaderboardSubjectPlayers || s == LeaderboardSubjectTeams
+}
+
+// LeaderboardMetric is the value a leaderboard is ranked by
+type LeaderboardMetric string
+
+const (
+	// LeaderboardMetricWins counts tournament wins (finals won)
+	LeaderboardMetricWins LeaderboardMetric = "wins"
+	// LeaderboardMetricPlacement sums placement points earned from GameTeam.Grade in every finished game
+	LeaderboardMetricPlacement LeaderboardMetric = "placement"
+	// LeaderboardMetricKills sums kills from detected matches
+	LeaderboardMetricKills LeaderboardMetric = "kills"
+	// LeaderboardMetricACS is the average combat score per round, players only
+	LeaderboardMetricACS LeaderboardMetric = "acs"
+	// LeaderboardMetricRating is the conservative Glicko-2 rating, all time only.
+	// It is decayed for inactivity when written, after each rated game and on a rebuild, not continuously.
+	LeaderboardMetricRating LeaderboardMetric = "rating"
+)
+
+func (m LeaderboardMetric) IsValid() bool {
+	switch m {
+	case LeaderboardMetricWins, LeaderboardMetricPlacement, LeaderboardMetricKills, LeaderboardMetricACS, LeaderboardMetricRating:
+		return true
+	default:
+		return false
+	}
+}
+
+// Supports reports whether the metric is tracked for the subject.
+// A team's ACS would only restate its players', so it is tracked for players alone.
+func (m LeaderboardMetric) Supports(subject LeaderboardSubject) bool {
+	if m == LeaderboardMetricACS {
+		return subject == LeaderboardSubjectPlayers
+	}
+	return m.IsValid()
+}
+
+// IsSeasonal reports whether the metric is also tracked per season.
+// Ratings carry over between seasons, so they only have an all-time leaderboard.
+func (m LeaderboardMetric) IsSeasonal() bool {
+	return m != LeaderboardMetricRating
+}
+
+// LeaderboardScope is the period a leaderboard covers: all time, or a season (contest series)
+type LeaderboardScope string
+
+// LeaderboardScopeGlobal covers every finished game
+const LeaderboardScopeGlobal LeaderboardScope = "global"
+
+// SeasonLeaderboardScope covers the games of the contests in a series
+func SeasonLeaderboardScope(seriesID int64) LeaderboardScope {
+	return LeaderboardScope(fmt.Sprintf("season:%d", seriesID))
+}
+
+// MinACSRounds is the number of rounds a player must play before appearing on the ACS leaderboard
+const MinACSRounds = 50
+
+// LeaderboardEntry is a ranked member of a leaderboard; Rank starts at 1
+type LeaderboardEntry struct {
+	Member string
+	Score  float64
+	Rank   int64
+}
+
+// PlayerLeaderboardMember is the member name of a user in player leaderboards
+func PlayerLeaderboardMember(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
+
+// RosterKey identifies a roster by its sorted member IDs, independent of the team or contest it played in
+func RosterKey(userIDs []int64) string {
+	sorted := make([]int64, len(userIDs))
+	copy(sorted, userIDs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	parts := make([]string, len(sorted))
+	for i, id := range sorted {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, "-")
+}
+
+// PlacementPoints converts a grade into placement points: 1 for last place and 2 more per place above it,
+// so a win in a two-team game is worth three times a loss
+func PlacementPoints(grade, teamCount int) int {
+	if grade < 1 || grade > teamCount {
+		return 0
+	}
+	return 2*(teamCount-grade) + 1
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+// MvpVotingWindow is how long the players of a match can vote for its MVP after the result is recorded
+const MvpVotingWindow = 24 * time.Hour
+
+// MatchAwardType is the kind of award a player earns in a match
+type MatchAwardType string
+
+const (
+	// MatchAwardMVP goes to the player with the most MVP votes when voting closes
+	MatchAwardMVP MatchAwardType = "MVP"
+	// MatchAwardTopACS goes to the player with the highest average combat score
+	MatchAwardTopACS MatchAwardType = "TOP_ACS"
+	// MatchAwardMostHeadshots goes to the player with the most headshots
+	MatchAwardMostHeadshots MatchAwardType = "MOST_HEADSHOTS"
+)
+
+// MatchAwardTypes lists the award types in display order
+var MatchAwardTypes = []MatchAwardType{MatchAwardMVP, MatchAwardTopACS, MatchAwardMostHeadshots}
+
+// MatchAward is an award a player earned in a detected match. Tied players each receive the award.
+type MatchAward struct {
+	MatchAwardID  int64          `gorm:"column:match_award_id;primaryKey;autoIncrement" json:"match_award_id"`
+	MatchResultID int64          `gorm:"column:match_result_id;type:bigint;not null" json:"match_result_id"`
+	GameID        int64          `gorm:"column:game_id;type:bigint;not null" json:"game_id"`
+	UserID        int64          `gorm:"column:user_id;type:bigint;not null" json:"user_id"`
+	AwardType     MatchAwardType `gorm:"column:award_type;type:varchar(32);not null" json:"award_type"`
+	// Value is what won the award: the ACS, the headshot count or the MVP vote count
+	Value     float64   `gorm:"column:value;type:double;not null" json:"value"`
+	CreatedAt time.Time `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+func (a *MatchAward) TableName() string {
+	return "match_awards"
+}
+
+// ComputeStatAwards returns the awards decided by the player stats of a match: top ACS and most headshots
+func ComputeStatAwards(result *MatchResult, stats []*MatchPlayerStat) []*MatchAward {
+	var awards []*MatchAward
+
+	if result.RoundsPlayed > 0 {
+		awards = append(awards, topAwards(result, MatchAwardTopACS, stats, func(s *MatchPlayerStat) float64 {
+			return float64(s.Score) / float64(result.RoundsPlayed)
+		})...)
+	}
+	awards = append(awards, topAwards(result, MatchAwardMostHeadshots, stats, func(s *MatchPlayerStat) float64 {
+		return float64(s.Headshots)
+	})...)
+
+	return awards
+}
+
+// topAwards gives the award to every player sharing the highest positive value
+func topAwards(result *MatchResult, awardType MatchAwardType, stats []*MatchPlayerStat, value func(*MatchPlayerStat) float64) []*MatchAward {
+	best := 0.0
+	for _, stat := range stats {
+		if v := value(stat); v > best {
+			best = v
+		}
+	}
+	if best <= 0 {
+		return nil
+	}
+
+	var awards []*MatchAward
+	for _, stat := range stats {
+		if value(stat) == best {
+			awards = append(awards, &MatchAward{
+				MatchResultID: result.MatchResultID,
+				GameID:        result.GameID,
+				UserID:        stat.UserID,
+				AwardType:     awardType,
+				Value:         roundStat(best),
+			})
+		}
+	}
+	return awards
+}
+
+// MvpVoting is the MVP vote of a match, open to the players of its lineup until ClosesAt
+type MvpVoting struct {
+	MatchResultID int64      `gorm:"column:match_result_id;primaryKey" json:"match_result_id"`
+	GameID        int64      `gorm:"column:game_id;type:bigint;not null" json:"game_id"`
+	ClosesAt      time.Time  `gorm:"column:closes_at;type:datetime;not null" json:"closes_at"`
+	ClosedAt      *time.Time `gorm:"column:closed_at;type:datetime" json:"closed_at,omitempty"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+// NewMvpVoting opens the MVP vote of a match for MvpVotingWindow after its result was recorded
+func NewMvpVoting(result *MatchResult) *MvpVoting {
+	return &MvpVoting{
+		MatchResultID: result.MatchResultID,
+		GameID:        result.GameID,
+		ClosesAt:      result.CreatedAt.Add(MvpVotingWindow),
+	}
+}
+
+func (v *MvpVoting) TableName() string {
+	return "mvp_votings"
+}
+
+// IsOpen reports whether votes are still accepted at now
+func (v *MvpVoting) IsOpen(now time.Time) bool {
+	return v.ClosedAt == nil && now.Before(v.ClosesAt)
+}
+
+// CanVote checks that voterID may vote for candidateID: both must be in the lineup and a player cannot vote for themselves
+func (v *MvpVoting) CanVote(lineup []*MatchPlayerStat, voterID, candidateID int64, now time.Time) error {
+	if !v.IsOpen(now) {
+		return exception.ErrMvpVotingClosed
+	}
+
+	var voterPlayed, candidatePlayed bool
+	for _, stat := range lineup {
+		voterPlayed = voterPlayed || stat.UserID == voterID
+		candidatePlayed = candidatePlayed || stat.UserID == candidateID
+	}
+	if !voterPlayed {
+		return exception.ErrNotMatchParticipant
+	}
+	if !candidatePlayed {
+		return exception.ErrInvalidMvpCandidate
+	}
+	if voterID == candidateID {
+		return exception.ErrCannotVoteForSelf
+	}
+	return nil
+}
+
+// MvpVoteCount is the number of votes a candidate received
+type MvpVoteCount struct {
+	CandidateID int64
+	Votes       int
+}
+
+// MvpAwards gives the MVP award to every candidate sharing the most votes; no votes means no MVP
+func (v *MvpVoting) MvpAwards(counts []*MvpVoteCount) []*MatchAward {
+	most := 0
+	for _, count := range counts {
+		if count.Votes > most {
+			most = count.Votes
+		}
+	}
+	if most == 0 {
+		return nil
+	}
+
+	var awards []*MatchAward
+	for _, count := range counts {
+		if count.Votes == most {
+			awards = append(awards, &MatchAward{
+				MatchResultID: v.MatchResultID,
+				GameID:        v.GameID,
+				UserID:        count.CandidateID,
+				AwardType:     MatchAwardMVP,
+				Value:         float64(most),
+			})
+		}
+	}
+	return awards
+}
+
+// MvpVote is a player's MVP vote in a match; each player votes once
+type MvpVote struct {
+	MvpVoteID     int64     `gorm:"column:mvp_vote_id;primaryKey;autoIncrement" json:"mvp_vote_id"`
+	MatchResultID int64     `gorm:"column:match_result_id;type:bigint;not null;uniqueIndex:idx_mvp_votes_voter" json:"match_result_id"`
+	VoterID       int64     `gorm:"column:voter_id;type:bigint;not null;uniqueIndex:idx_mvp_votes_voter" json:"voter_id"`
+	CandidateID   int64     `gorm:"column:candidate_id;type:bigint;not null" json:"candidate_id"`
+	CreatedAt     time.Time `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+func NewMvpVote(matchResultID, voterID, candidateID int64) *MvpVote {
+	return &MvpVote{
+		MatchResultID: matchResultID,
+		VoterID:       voterID,
+		CandidateID:   candidateID,
+	}
+}
+
+func (v *MvpVote) TableName() string {
+	return "mvp_votes"
+}
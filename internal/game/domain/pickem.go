@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+// PickemPointsPerRound is the virtual points a correct pick earns per bracket round, so later rounds are worth more
+const PickemPointsPerRound = 10
+
+// PickemPoints returns the points a correct pick of a game in round earns
+func PickemPoints(round int) int {
+	if round < 1 {
+		round = 1
+	}
+	return PickemPointsPerRound * round
+}
+
+// CanPredict checks that the game takes pick'em predictions at now.
+// Predictions are open for pending bracket games and lock at ScheduledStartTime or when the game activates, whichever comes first.
+func (g *Game) CanPredict(now time.Time) error {
+	if !g.IsTournamentGame() {
+		return exception.ErrPickemNotAvailable
+	}
+	if g.GameStatus != GameStatusPending || g.DetectionStatus != DetectionStatusNone {
+		return exception.ErrPickemLocked
+	}
+	if g.ScheduledStartTime != nil && !now.Before(*g.ScheduledStartTime) {
+		return exception.ErrPickemLocked
+	}
+	return nil
+}
+
+// PickemPrediction is a user's predicted winner of a bracket game; each user predicts a game once and can change it until it locks
+type PickemPrediction struct {
+	PickemPredictionID int64 `gorm:"column:pickem_prediction_id;primaryKey;autoIncrement" json:"pickem_prediction_id"`
+	ContestID          int64 `gorm:"column:contest_id;type:bigint;not null" json:"contest_id"`
+	GameID             int64 `gorm:"column:game_id;type:bigint;not null" json:"game_id"`
+	UserID             int64 `gorm:"column:user_id;type:bigint;not null" json:"user_id"`
+	PredictedTeamID    int64 `gorm:"column:predicted_team_id;type:bigint;not null" json:"predicted_team_id"`
+	// Correct, ScoredAt and ScoredWinnerTeamID stay nil until the game's result is scored
+	Correct            *bool      `gorm:"column:correct;type:boolean" json:"correct,omitempty"`
+	Points             int        `gorm:"column:points;type:int;not null;default:0" json:"points"`
+	ScoredAt           *time.Time `gorm:"column:scored_at;type:datetime" json:"scored_at,omitempty"`
+	ScoredWinnerTeamID *int64     `gorm:"column:scored_winner_team_id;type:bigint" json:"scored_winner_team_id,omitempty"`
+	CreatedAt          time.Time  `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+	ModifiedAt         time.Time  `gorm:"column:modified_at;type:datetime;autoUpdateTime" json:"modified_at"`
+}
+
+func NewPickemPrediction(game *Game, userID, predictedTeamID int64) *PickemPrediction {
+	return &PickemPrediction{
+		ContestID:       game.ContestID,
+		GameID:          game.GameID,
+		UserID:          userID,
+		PredictedTeamID: predictedTeamID,
+	}
+}
+
+func (p *PickemPrediction) TableName() string {
+	return "pickem_predictions"
+}
@@ -0,0 +1,241 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// RecentFormSize is the number of latest games shown as a player's recent form
+const RecentFormSize = 10
+
+// PlayerGameStat is one player's line in one detected game, joined with the game's result
+type PlayerGameStat struct {
+	GameID       int64
+	ContestID    int64
+	ContestTitle string
+	TeamID       int64
+	AgentName    string
+	MapName      string
+	Won          bool
+	RoundsPlayed int
+	Kills        int
+	Deaths       int
+	Assists      int
+	Score        int
+	Headshots    int
+	Bodyshots    int
+	Legshots     int
+	PlayedAt     time.Time
+}
+
+// StatLine aggregates player stats over a set of games
+type StatLine struct {
+	GamesPlayed  int `json:"games_played"`
+	Wins         int `json:"wins"`
+	Losses       int `json:"losses"`
+	RoundsPlayed int `json:"rounds_played"`
+	Kills        int `json:"kills"`
+	Deaths       int `json:"deaths"`
+	Assists      int `json:"assists"`
+	Score        int `json:"score"`
+	Headshots    int `json:"headshots"`
+	Bodyshots    int `json:"bodyshots"`
+	Legshots     int `json:"legshots"`
+
+	WinRate float64 `json:"win_rate"`
+	// KDRatio equals the kill count for a player who never died, as in the Valorant scoreboard
+	KDRatio  float64 `json:"kd_ratio"`
+	KDARatio float64 `json:"kda_ratio"`
+	// AverageCombatScore is the score per round, the in-game ACS
+	AverageCombatScore float64 `json:"average_combat_score"`
+	KillsPerRound      float64 `json:"kills_per_round"`
+	HeadshotPercent    float64 `json:"headshot_percent"`
+}
+
+func (l *StatLine) add(g *PlayerGameStat) {
+	l.GamesPlayed++
+	if g.Won {
+		l.Wins++
+	} else {
+		l.Losses++
+	}
+	l.RoundsPlayed += g.RoundsPlayed
+	l.Kills += g.Kills
+	l.Deaths += g.Deaths
+	l.Assists += g.Assists
+	l.Score += g.Score
+	l.Headshots += g.Headshots
+	l.Bodyshots += g.Bodyshots
+	l.Legshots += g.Legshots
+}
+
+// finalize derives the ratios from the totals
+func (l *StatLine) finalize() {
+	if l.GamesPlayed > 0 {
+		l.WinRate = roundStat(float64(l.Wins) * 100 / float64(l.GamesPlayed))
+	}
+
+	deaths := float64(l.Deaths)
+	if deaths == 0 {
+		deaths = 1
+	}
+	l.KDRatio = roundStat(float64(l.Kills) / deaths)
+	l.KDARatio = roundStat(float64(l.Kills+l.Assists) / deaths)
+
+	if l.RoundsPlayed > 0 {
+		l.AverageCombatScore = roundStat(float64(l.Score) / float64(l.RoundsPlayed))
+		l.KillsPerRound = roundStat(float64(l.Kills) / float64(l.RoundsPlayed))
+	}
+	if shots := l.Headshots + l.Bodyshots + l.Legshots; shots > 0 {
+		l.HeadshotPercent = roundStat(float64(l.Headshots) * 100 / float64(shots))
+	}
+}
+
+// ContestStatLine is a player's stats within one contest
+type ContestStatLine struct {
+	ContestID    int64  `json:"contest_id"`
+	ContestTitle string `json:"contest_title"`
+	StatLine
+}
+
+// AgentStatLine is a player's stats on one agent
+type AgentStatLine struct {
+	AgentName string `json:"agent_name"`
+	StatLine
+}
+
+// MapStatLine is a player's stats on one map
+type MapStatLine struct {
+	MapName string `json:"map_name"`
+	StatLine
+}
+
+// RecentGame is a single game of a player's recent form
+type RecentGame struct {
+	GameID             int64     `json:"game_id"`
+	ContestID          int64     `json:"contest_id"`
+	ContestTitle       string    `json:"contest_title"`
+	AgentName          string    `json:"agent_name"`
+	MapName            string    `json:"map_name"`
+	Won                bool      `json:"won"`
+	Kills              int       `json:"kills"`
+	Deaths             int       `json:"deaths"`
+	Assists            int       `json:"assists"`
+	AverageCombatScore float64   `json:"average_combat_score"`
+	PlayedAt           time.Time `json:"played_at"`
+}
+
+// PlayerCareerStats is a player's statistics across every detected contest game
+type PlayerCareerStats struct {
+	UserID     int64             `json:"user_id"`
+	Lifetime   StatLine          `json:"lifetime"`
+	Contests   []ContestStatLine `json:"contests"`
+	Agents     []AgentStatLine   `json:"agents"`
+	Maps       []MapStatLine     `json:"maps"`
+	RecentForm []RecentGame      `json:"recent_form"`
+	// ComputedAt tells clients how old a cached profile is
+	ComputedAt time.Time `json:"computed_at"`
+}
+
+// BuildPlayerCareerStats aggregates a player's game lines.
+// Contests are ordered by latest game, agents and maps by games played, recent form newest first.
+func BuildPlayerCareerStats(userID int64, games []*PlayerGameStat, now time.Time) *PlayerCareerStats {
+	sorted := make([]*PlayerGameStat, len(games))
+	copy(sorted, games)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].PlayedAt.Equal(sorted[j].PlayedAt) {
+			return sorted[i].PlayedAt.After(sorted[j].PlayedAt)
+		}
+		return sorted[i].GameID > sorted[j].GameID
+	})
+
+	stats := &PlayerCareerStats{
+		UserID:     userID,
+		Contests:   []ContestStatLine{},
+		Agents:     []AgentStatLine{},
+		Maps:       []MapStatLine{},
+		RecentForm: []RecentGame{},
+		ComputedAt: now,
+	}
+
+	contestIndex := make(map[int64]int)
+	agentIndex := make(map[string]int)
+	mapIndex := make(map[string]int)
+
+	for _, g := range sorted {
+		stats.Lifetime.add(g)
+
+		// Sorted newest first, so contests are appended in order of their latest game
+		i, ok := contestIndex[g.ContestID]
+		if !ok {
+			i = len(stats.Contests)
+			contestIndex[g.ContestID] = i
+			stats.Contests = append(stats.Contests, ContestStatLine{ContestID: g.ContestID, ContestTitle: g.ContestTitle})
+		}
+		stats.Contests[i].add(g)
+
+		if g.AgentName != "" {
+			i, ok := agentIndex[g.AgentName]
+			if !ok {
+				i = len(stats.Agents)
+				agentIndex[g.AgentName] = i
+				stats.Agents = append(stats.Agents, AgentStatLine{AgentName: g.AgentName})
+			}
+			stats.Agents[i].add(g)
+		}
+
+		if g.MapName != "" {
+			i, ok := mapIndex[g.MapName]
+			if !ok {
+				i = len(stats.Maps)
+				mapIndex[g.MapName] = i
+				stats.Maps = append(stats.Maps, MapStatLine{MapName: g.MapName})
+			}
+			stats.Maps[i].add(g)
+		}
+
+		if len(stats.RecentForm) < RecentFormSize {
+			recent := RecentGame{
+				GameID:       g.GameID,
+				ContestID:    g.ContestID,
+				ContestTitle: g.ContestTitle,
+				AgentName:    g.AgentName,
+				MapName:      g.MapName,
+				Won:          g.Won,
+				Kills:        g.Kills,
+				Deaths:       g.Deaths,
+				Assists:      g.Assists,
+				PlayedAt:     g.PlayedAt,
+			}
+			if g.RoundsPlayed > 0 {
+				recent.AverageCombatScore = roundStat(float64(g.Score) / float64(g.RoundsPlayed))
+			}
+			stats.RecentForm = append(stats.RecentForm, recent)
+		}
+	}
+
+	stats.Lifetime.finalize()
+	for i := range stats.Contests {
+		stats.Contests[i].finalize()
+	}
+	for i := range stats.Agents {
+		stats.Agents[i].finalize()
+	}
+	for i := range stats.Maps {
+		stats.Maps[i].finalize()
+	}
+
+	sort.SliceStable(stats.Agents, func(i, j int) bool {
+		return stats.Agents[i].GamesPlayed > stats.Agents[j].GamesPlayed
+	})
+	sort.SliceStable(stats.Maps, func(i, j int) bool {
+		return stats.Maps[i].GamesPlayed > stats.Maps[j].GamesPlayed
+	})
+
+	return stats
+}
+
+func roundStat(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,314 @@
+package domain
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Glicko-2 constants (Glickman, "Example of the Glicko-2 system")
+const (
+	// InitialRating, InitialDeviation and InitialVolatility describe a player or roster without rated games
+	InitialRating     = 1500.0
+	InitialDeviation  = 350.0
+	InitialVolatility = 0.06
+
+	// glickoScale converts between the Glicko and Glicko-2 scales
+	glickoScale = 173.7178
+	// glickoTau constrains how fast volatility changes; smaller values suit games with fewer upsets
+	glickoTau = 0.5
+	// glickoEpsilon is the convergence tolerance of the volatility iteration
+	glickoEpsilon = 0.000001
+
+	// RatingPeriod is the inactivity period after which a rating's deviation grows by its volatility
+	RatingPeriod = 7 * 24 * time.Hour
+	// ProvisionalDeviation is the deviation above which a rating is still considered provisional
+	ProvisionalDeviation = 110.0
+)
+
+// RatingSubjectType tells whether a rating history row belongs to a player or a roster
+type RatingSubjectType string
+
+const (
+	RatingSubjectPlayer RatingSubjectType = "PLAYER"
+	RatingSubjectTeam   RatingSubjectType = "TEAM"
+)
+
+// Glicko2 is a rating with its deviation and volatility, on the Glicko scale
+type Glicko2 struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+}
+
+// NewGlicko2 returns the rating of a newcomer
+func NewGlicko2() Glicko2 {
+	return Glicko2{Rating: InitialRating, Deviation: InitialDeviation, Volatility: InitialVolatility}
+}
+
+// ConservativeRating is the rating the player is 95% likely to be above; leaderboards and seeding rank by it
+// so a few lucky wins cannot outrank an established record
+func (g Glicko2) ConservativeRating() float64 {
+	return g.Rating - 2*g.Deviation
+}
+
+// IsProvisional reports whether the rating is still too uncertain to be meaningful
+func (g Glicko2) IsProvisional() bool {
+	return g.Deviation > ProvisionalDeviation
+}
+
+// Decay grows the deviation for the rating periods without games since lastPlayedAt, capped at InitialDeviation
+func (g Glicko2) Decay(lastPlayedAt *time.Time, now time.Time) Glicko2 {
+	if lastPlayedAt == nil || !now.After(*lastPlayedAt) {
+		return g
+	}
+
+	periods := math.Floor(float64(now.Sub(*lastPlayedAt)) / float64(RatingPeriod))
+	if periods < 1 {
+		return g
+	}
+
+	phi := g.Deviation / glickoScale
+	phi = math.Sqrt(phi*phi + periods*g.Volatility*g.Volatility)
+	g.Deviation = math.Min(phi*glickoScale, InitialDeviation)
+	return g
+}
+
+// GlickoResult is a game of a rating period: the opponent's rating and the score, 1 for a win and 0 for a loss
+type GlickoResult struct {
+	Opponent Glicko2
+	Score    float64
+}
+
+// Update returns the rating after a single game against opponent; score is 1 for a win and 0 for a loss.
+// Every game is its own rating period, as is usual for online play.
+func (g Glicko2) Update(opponent Glicko2, score float64) Glicko2 {
+	return g.UpdatePeriod([]GlickoResult{{Opponent: opponent, Score: score}})
+}
+
+// UpdatePeriod returns the rating after the games of one rating period (steps 2 to 8 of Glicko-2).
+// A period without games only grows the deviation.
+func (g Glicko2) UpdatePeriod(results []GlickoResult) Glicko2 {
+	mu := (g.Rating - InitialRating) / glickoScale
+	phi := g.Deviation / glickoScale
+
+	if len(results) == 0 {
+		g.Deviation = math.Min(math.Sqrt(phi*phi+g.Volatility*g.Volatility)*glickoScale, InitialDeviation)
+		return g
+	}
+
+	var vInverse, improvement float64
+	for _, result := range results {
+		muJ := (result.Opponent.Rating - InitialRating) / glickoScale
+		phiJ := result.Opponent.Deviation / glickoScale
+
+		gPhiJ := 1 / math.Sqrt(1+3*phiJ*phiJ/(math.Pi*math.Pi))
+		expected := 1 / (1 + math.Exp(-gPhiJ*(mu-muJ)))
+		vInverse += gPhiJ * gPhiJ * expected * (1 - expected)
+		improvement += gPhiJ * (result.Score - expected)
+	}
+	v := 1 / vInverse
+	delta := v * improvement
+
+	sigma := newVolatility(phi, g.Volatility, v, delta)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*improvement
+
+	return Glicko2{
+		Rating:     newMu*glickoScale + InitialRating,
+		Deviation:  math.Min(newPhi*glickoScale, InitialDeviation),
+		Volatility: sigma,
+	}
+}
+
+// newVolatility solves for the new volatility with the Illinois algorithm (step 5 of Glicko-2)
+func newVolatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(glickoTau*glickoTau)
+	}
+
+	upper := a
+	var lower float64
+	if delta*delta > phi*phi+v {
+		lower = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*glickoTau) < 0 {
+			k++
+		}
+		lower = a - k*glickoTau
+	}
+
+	fUpper, fLower := f(upper), f(lower)
+	for math.Abs(lower-upper) > glickoEpsilon {
+		c := upper + (upper-lower)*fUpper/(fLower-fUpper)
+		fC := f(c)
+		if fC*fLower <= 0 {
+			upper, fUpper = lower, fLower
+		} else {
+			fUpper /= 2
+		}
+		lower, fLower = c, fC
+	}
+
+	return math.Exp(upper / 2)
+}
+
+// CompositeRating combines the ratings of a roster into one opponent: the mean rating and the root mean square deviation
+func CompositeRating(ratings []Glicko2) Glicko2 {
+	if len(ratings) == 0 {
+		return NewGlicko2()
+	}
+
+	var rating, variance, volatility float64
+	for _, r := range ratings {
+		rating += r.Rating
+		variance += r.Deviation * r.Deviation
+		volatility += r.Volatility
+	}
+	n := float64(len(ratings))
+	return Glicko2{
+		Rating:     rating / n,
+		Deviation:  math.Sqrt(variance / n),
+		Volatility: volatility / n,
+	}
+}
+
+// PlayerRating is a user's platform rating
+type PlayerRating struct {
+	UserID       int64      `gorm:"column:user_id;primaryKey" json:"user_id"`
+	Rating       float64    `gorm:"column:rating;type:double;not null" json:"rating"`
+	Deviation    float64    `gorm:"column:deviation;type:double;not null" json:"deviation"`
+	Volatility   float64    `gorm:"column:volatility;type:double;not null" json:"volatility"`
+	GamesPlayed  int        `gorm:"column:games_played;type:int;not null;default:0" json:"games_played"`
+	Wins         int        `gorm:"column:wins;type:int;not null;default:0" json:"wins"`
+	LastPlayedAt *time.Time `gorm:"column:last_played_at;type:datetime" json:"last_played_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+	ModifiedAt   time.Time  `gorm:"column:modified_at;type:datetime;autoUpdateTime" json:"modified_at"`
+}
+
+// NewPlayerRating returns the rating of a user without rated games
+func NewPlayerRating(userID int64) *PlayerRating {
+	r := &PlayerRating{UserID: userID}
+	r.SetGlicko(NewGlicko2())
+	return r
+}
+
+func (r *PlayerRating) TableName() string {
+	return "player_ratings"
+}
+
+func (r *PlayerRating) Glicko() Glicko2 {
+	return Glicko2{Rating: r.Rating, Deviation: r.Deviation, Volatility: r.Volatility}
+}
+
+func (r *PlayerRating) SetGlicko(g Glicko2) {
+	r.Rating, r.Deviation, r.Volatility = g.Rating, g.Deviation, g.Volatility
+}
+
+// Current returns the rating with its deviation decayed for inactivity up to now
+func (r *PlayerRating) Current(now time.Time) Glicko2 {
+	return r.Glicko().Decay(r.LastPlayedAt, now)
+}
+
+// RecordGame stores the rating after a game played at playedAt
+func (r *PlayerRating) RecordGame(g Glicko2, won bool, playedAt time.Time) {
+	r.SetGlicko(g)
+	r.GamesPlayed++
+	if won {
+		r.Wins++
+	}
+	r.LastPlayedAt = &playedAt
+}
+
+// TeamRating is the platform rating of a roster, identified by RosterKey across contests
+type TeamRating struct {
+	RosterKey    string     `gorm:"column:roster_key;type:varchar(191);primaryKey" json:"roster_key"`
+	TeamName     string     `gorm:"column:team_name;type:varchar(50);not null" json:"team_name"`
+	Rating       float64    `gorm:"column:rating;type:double;not null" json:"rating"`
+	Deviation    float64    `gorm:"column:deviation;type:double;not null" json:"deviation"`
+	Volatility   float64    `gorm:"column:volatility;type:double;not null" json:"volatility"`
+	GamesPlayed  int        `gorm:"column:games_played;type:int;not null;default:0" json:"games_played"`
+	Wins         int        `gorm:"column:wins;type:int;not null;default:0" json:"wins"`
+	LastPlayedAt *time.Time `gorm:"column:last_played_at;type:datetime" json:"last_played_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+	ModifiedAt   time.Time  `gorm:"column:modified_at;type:datetime;autoUpdateTime" json:"modified_at"`
+}
+
+// NewTeamRating returns the rating of a roster without rated games
+func NewTeamRating(rosterKey, teamName string) *TeamRating {
+	r := &TeamRating{RosterKey: rosterKey, TeamName: teamName}
+	r.SetGlicko(NewGlicko2())
+	return r
+}
+
+func (r *TeamRating) TableName() string {
+	return "team_ratings"
+}
+
+func (r *TeamRating) Glicko() Glicko2 {
+	return Glicko2{Rating: r.Rating, Deviation: r.Deviation, Volatility: r.Volatility}
+}
+
+func (r *TeamRating) SetGlicko(g Glicko2) {
+	r.Rating, r.Deviation, r.Volatility = g.Rating, g.Deviation, g.Volatility
+}
+
+// Current returns the rating with its deviation decayed for inactivity up to now
+func (r *TeamRating) Current(now time.Time) Glicko2 {
+	return r.Glicko().Decay(r.LastPlayedAt, now)
+}
+
+// RecordGame stores the rating after a game played at playedAt under the roster's latest team name
+func (r *TeamRating) RecordGame(g Glicko2, won bool, teamName string, playedAt time.Time) {
+	r.SetGlicko(g)
+	r.GamesPlayed++
+	if won {
+		r.Wins++
+	}
+	r.TeamName = teamName
+	r.LastPlayedAt = &playedAt
+}
+
+// RatingHistory records a rating change caused by a game
+type RatingHistory struct {
+	RatingHistoryID int64             `gorm:"column:rating_history_id;primaryKey;autoIncrement" json:"rating_history_id"`
+	SubjectType     RatingSubjectType `gorm:"column:subject_type;type:varchar(8);not null" json:"subject_type"`
+	SubjectKey      string            `gorm:"column:subject_key;type:varchar(191);not null" json:"subject_key"`
+	GameID          int64             `gorm:"column:game_id;type:bigint;not null" json:"game_id"`
+	Won             bool              `gorm:"column:won;not null" json:"won"`
+	RatingBefore    float64           `gorm:"column:rating_before;type:double;not null" json:"rating_before"`
+	RatingAfter     float64           `gorm:"column:rating_after;type:double;not null" json:"rating_after"`
+	DeviationBefore float64           `gorm:"column:deviation_before;type:double;not null" json:"deviation_before"`
+	DeviationAfter  float64           `gorm:"column:deviation_after;type:double;not null" json:"deviation_after"`
+	VolatilityAfter float64           `gorm:"column:volatility_after;type:double;not null" json:"volatility_after"`
+	CreatedAt       time.Time         `gorm:"column:created_at;type:datetime;autoCreateTime" json:"created_at"`
+}
+
+// NewRatingHistory records the change from before (already decayed for inactivity) to after
+func NewRatingHistory(subjectType RatingSubjectType, subjectKey string, gameID int64, won bool, before, after Glicko2) *RatingHistory {
+	return &RatingHistory{
+		SubjectType:     subjectType,
+		SubjectKey:      subjectKey,
+		GameID:          gameID,
+		Won:             won,
+		RatingBefore:    before.Rating,
+		RatingAfter:     after.Rating,
+		DeviationBefore: before.Deviation,
+		DeviationAfter:  after.Deviation,
+		VolatilityAfter: after.Volatility,
+	}
+}
+
+func (h *RatingHistory) TableName() string {
+	return "rating_history"
+}
+
+// PlayerRatingSubjectKey is the history subject key of a user
+func PlayerRatingSubjectKey(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// DroppedTeamReason describes why a team was dropped at registration close
+type DroppedTeamReason string
+
+const (
+	DroppedTeamReasonIncomplete DroppedTeamReason = "INCOMPLETE_ROSTER"
+)
+
+// DroppedTeam is a snapshot of a team removed by the registration close job
+type DroppedTeam struct {
+	TeamID        int64             `json:"team_id"`
+	TeamName      string            `json:"team_name"`
+	LeaderUserID  int64             `json:"leader_user_id"`
+	MemberUserIDs []int64           `json:"member_user_ids"`
+	MemberCount   int               `json:"member_count"`
+	RequiredCount int               `json:"required_count"`
+	Reason        DroppedTeamReason `json:"reason"`
+}
+
+// DroppedTeams is stored as a JSON column
+type DroppedTeams []*DroppedTeam
+
+func (d DroppedTeams) Value() (driver.Value, error) {
+	if d == nil {
+		return "[]", nil
+	}
+	return json.Marshal(d)
+}
+
+func (d *DroppedTeams) Scan(value interface{}) error {
+	if value == nil {
+		*d = DroppedTeams{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for DroppedTeams")
+	}
+
+	return json.Unmarshal(data, d)
+}
+
+// RosterLockReport records the outcome of locking a contest's rosters at registration close
+type RosterLockReport struct {
+	ReportID           int64        `gorm:"column:report_id;primaryKey;autoIncrement" json:"report_id"`
+	ContestID          int64        `gorm:"column:contest_id;type:bigint;not null;uniqueIndex:idx_roster_lock_reports_contest" json:"contest_id"`
+	LockedAt           time.Time    `gorm:"column:locked_at;type:datetime;not null" json:"locked_at"`
+	FinalizedTeamCount int          `gorm:"column:finalized_team_count;type:int;not null" json:"finalized_team_count"`
+	DroppedTeamCount   int          `gorm:"column:dropped_team_count;type:int;not null" json:"dropped_team_count"`
+	DroppedTeams       DroppedTeams `gorm:"column:dropped_teams;type:json" json:"dropped_teams"`
+	CreatedAt          time.Time    `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+func NewRosterLockReport(contestID int64, lockedAt time.Time) *RosterLockReport {
+	return &RosterLockReport{
+		ContestID:    contestID,
+		LockedAt:     lockedAt,
+		DroppedTeams: DroppedTeams{},
+		CreatedAt:    time.Now(),
+	}
+}
+
+func (r *RosterLockReport) TableName() string {
+	return "roster_lock_reports"
+}
+
+// AddFinalized counts a team that was kept (already or force-finalized)
+func (r *RosterLockReport) AddFinalized() {
+	r.FinalizedTeamCount++
+}
+
+// AddDropped records a team that was removed for an incomplete roster
+func (r *RosterLockReport) AddDropped(team *DroppedTeam) {
+	r.DroppedTeams = append(r.DroppedTeams, team)
+	r.DroppedTeamCount = len(r.DroppedTeams)
+}
+
+// RosterChangeType represents the kind of roster change requested after the lock
+type RosterChangeType string
+
+const (
+	RosterChangeTypeAdd     RosterChangeType = "ADD"
+	RosterChangeTypeRemove  RosterChangeType = "REMOVE"
+	RosterChangeTypeReplace RosterChangeType = "REPLACE"
+)
+
+func (t RosterChangeType) IsValid() bool {
+	switch t {
+	case RosterChangeTypeAdd, RosterChangeTypeRemove, RosterChangeTypeReplace:
+		return true
+	default:
+		return false
+	}
+}
+
+// RosterChangeStatus represents the review state of a roster change request
+type RosterChangeStatus string
+
+const (
+	RosterChangeStatusPending  RosterChangeStatus = "PENDING"
+	RosterChangeStatusApproved RosterChangeStatus = "APPROVED"
+	RosterChangeStatusRejected RosterChangeStatus = "REJECTED"
+)
+
+// RosterChangeRequest is a leader's request to change a locked roster, applied only after staff approval
+type RosterChangeRequest struct {
+	RequestID         int64              `gorm:"column:request_id;primaryKey;autoIncrement" json:"request_id"`
+	ContestID         int64              `gorm:"column:contest_id;type:bigint;not null" json:"contest_id"`
+	TeamID            int64              `gorm:"column:team_id;type:bigint;not null" json:"team_id"`
+	RequestedBy       int64              `gorm:"column:requested_by;type:bigint;not null" json:"requested_by"`
+	ChangeType        RosterChangeType   `gorm:"column:change_type;type:varchar(16);not null" json:"change_type"`
+	TargetUserID      *int64             `gorm:"column:target_user_id;type:bigint" json:"target_user_id,omitempty"`
+	ReplacementUserID *int64             `gorm:"column:replacement_user_id;type:bigint" json:"replacement_user_id,omitempty"`
+	Reason            string             `gorm:"column:reason;type:varchar(500)" json:"reason,omitempty"`
+	Status            RosterChangeStatus `gorm:"column:status;type:varchar(16);not null" json:"status"`
+	ReviewedBy        *int64             `gorm:"column:reviewed_by;type:bigint" json:"reviewed_by,omitempty"`
+	ReviewNote        string             `gorm:"column:review_note;type:varchar(500)" json:"review_note,omitempty"`
+	ReviewedAt        *time.Time         `gorm:"column:reviewed_at;type:datetime" json:"reviewed_at,omitempty"`
+	CreatedAt         time.Time          `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+func NewRosterChangeRequest(
+	contestID, teamID, requestedBy int64,
+	changeType RosterChangeType,
+	targetUserID, replacementUserID *int64,
+	reason string,
+) *RosterChangeRequest {
+	return &RosterChangeRequest{
+		ContestID:         contestID,
+		TeamID:            teamID,
+		RequestedBy:       requestedBy,
+		ChangeType:        changeType,
+		TargetUserID:      targetUserID,
+		ReplacementUserID: replacementUserID,
+		Reason:            reason,
+		Status:            RosterChangeStatusPending,
+		CreatedAt:         time.Now(),
+	}
+}
+
+func (r *RosterChangeRequest) TableName() string {
+	return "roster_change_requests"
+}
+
+func (r *RosterChangeRequest) Validate() error {
+	if !r.ChangeType.IsValid() {
+		return exception.ErrInvalidRosterChangeType
+	}
+
+	needsTarget := r.ChangeType == RosterChangeTypeRemove || r.ChangeType == RosterChangeTypeReplace
+	if needsTarget && r.TargetUserID == nil {
+		return exception.ErrRosterChangeTargetRequired
+	}
+
+	needsReplacement := r.ChangeType == RosterChangeTypeAdd || r.ChangeType == RosterChangeTypeReplace
+	if needsReplacement && r.ReplacementUserID == nil {
+		return exception.ErrRosterChangeReplacementRequired
+	}
+
+	return nil
+}
+
+func (r *RosterChangeRequest) IsPending() bool {
+	return r.Status == RosterChangeStatusPending
+}
+
+// Approve marks the request as approved by staff
+func (r *RosterChangeRequest) Approve(reviewerID int64, note string) error {
+	return r.review(RosterChangeStatusApproved, reviewerID, note)
+}
+
+// Reject marks the request as rejected by staff
+func (r *RosterChangeRequest) Reject(reviewerID int64, note string) error {
+	return r.review(RosterChangeStatusRejected, reviewerID, note)
+}
+
+func (r *RosterChangeRequest) review(status RosterChangeStatus, reviewerID int64, note string) error {
+	if !r.IsPending() {
+		return exception.ErrRosterChangeRequestNotPending
+	}
+
+	now := time.Now()
+	r.Status = status
+	r.ReviewedBy = &reviewerID
+	r.ReviewNote = note
+	r.ReviewedAt = &now
+	return nil
+}
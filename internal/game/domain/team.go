@@ -5,19 +5,38 @@ import (
 	"time"
 )
 
+// TeamStatus represents whether a team holds a contest slot or waits for one
+type TeamStatus string
+
+const (
+	TeamStatusRegistered TeamStatus = "REGISTERED"
+	// TeamStatusWaitlisted is a finalized team beyond the contest's MaxTeamCount
+	TeamStatusWaitlisted TeamStatus = "WAITLISTED"
+)
+
+// DraftedTeam is a roster picked by a captain in a captain draft
+type DraftedTeam struct {
+	CaptainUserID int64
+	MemberUserIDs []int64
+	TeamName      string
+}
+
 // Team represents a team registered for a contest
 type Team struct {
-	TeamID     int64     `gorm:"column:team_id;primaryKey;autoIncrement" json:"team_id"`
-	ContestID  int64     `gorm:"column:contest_id;type:bigint;not null" json:"contest_id"`
-	TeamName   string    `gorm:"column:team_name;type:varchar(50);not null" json:"team_name"`
-	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
-	ModifiedAt time.Time `gorm:"column:modified_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"modified_at"`
+	TeamID       int64      `gorm:"column:team_id;primaryKey;autoIncrement" json:"team_id"`
+	ContestID    int64      `gorm:"column:contest_id;type:bigint;not null" json:"contest_id"`
+	TeamName     string     `gorm:"column:team_name;type:varchar(50);not null" json:"team_name"`
+	Status       TeamStatus `gorm:"column:status;type:varchar(16);not null;default:REGISTERED" json:"status"`
+	WaitlistedAt *time.Time `gorm:"column:waitlisted_at;type:timestamp" json:"waitlisted_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+	ModifiedAt   time.Time  `gorm:"column:modified_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"modified_at"`
 }
 
 func NewTeam(contestID int64, teamName string) *Team {
 	return &Team{
 		ContestID: contestID,
 		TeamName:  teamName,
+		Status:    TeamStatusRegistered,
 	}
 }
 
@@ -41,6 +60,27 @@ func (t *Team) Validate() error {
 	return nil
 }
 
+func (t *Team) IsWaitlisted() bool {
+	return t.Status == TeamStatusWaitlisted
+}
+
+// Waitlist puts the team on the contest waitlist; the waitlist is ordered by WaitlistedAt
+func (t *Team) Waitlist(now time.Time) {
+	t.Status = TeamStatusWaitlisted
+	t.WaitlistedAt = &now
+}
+
+// PromoteFromWaitlist gives a waitlisted team the slot freed by a withdrawn team
+func (t *Team) PromoteFromWaitlist() error {
+	if !t.IsWaitlisted() {
+		return exception.ErrTeamNotWaitlisted
+	}
+
+	t.Status = TeamStatusRegistered
+	t.WaitlistedAt = nil
+	return nil
+}
+
 // TeamMemberType represents the type of team membership
 type TeamMemberType string
 
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+// DeadLetterStatus represents how a dead-lettered team persistence event was resolved
+type DeadLetterStatus string
+
+const (
+	DeadLetterStatusPending   DeadLetterStatus = "PENDING"
+	DeadLetterStatusReplayed  DeadLetterStatus = "REPLAYED"
+	DeadLetterStatusDiscarded DeadLetterStatus = "DISCARDED"
+)
+
+func (s DeadLetterStatus) IsValid() bool {
+	switch s {
+	case DeadLetterStatusPending, DeadLetterStatusReplayed, DeadLetterStatusDiscarded:
+		return true
+	default:
+		return false
+	}
+}
+
+// TeamDeadLetter is a team persistence event that failed all retries and was moved to the dead-letter queue
+type TeamDeadLetter struct {
+	DeadLetterID   int64            `gorm:"column:dead_letter_id;primaryKey;autoIncrement" json:"dead_letter_id"`
+	EventID        string           `gorm:"column:event_id;type:varchar(64)" json:"event_id"`
+	IdempotencyKey string           `gorm:"column:idempotency_key;type:varchar(128)" json:"idempotency_key"`
+	EventType      string           `gorm:"column:event_type;type:varchar(64);not null" json:"event_type"`
+	ContestID      int64            `gorm:"column:contest_id;type:bigint" json:"contest_id"`
+	TeamID         int64            `gorm:"column:team_id;type:bigint" json:"team_id"`
+	Payload        string           `gorm:"column:payload;type:text;not null" json:"payload"`
+	LastError      string           `gorm:"column:last_error;type:varchar(1000)" json:"last_error"`
+	RetryCount     int              `gorm:"column:retry_count;type:int;not null" json:"retry_count"`
+	Status         DeadLetterStatus `gorm:"column:status;type:varchar(16);not null" json:"status"`
+	ResolvedBy     *int64           `gorm:"column:resolved_by;type:bigint" json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time       `gorm:"column:resolved_at;type:datetime" json:"resolved_at,omitempty"`
+	CreatedAt      time.Time        `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+func NewTeamDeadLetter(eventType, payload, lastError string) *TeamDeadLetter {
+	if len(lastError) > 1000 {
+		lastError = lastError[:1000]
+	}
+
+	return &TeamDeadLetter{
+		EventType: eventType,
+		Payload:   payload,
+		LastError: lastError,
+		Status:    DeadLetterStatusPending,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (d *TeamDeadLetter) TableName() string {
+	return "team_persistence_dead_letters"
+}
+
+func (d *TeamDeadLetter) IsPending() bool {
+	return d.Status == DeadLetterStatusPending
+}
+
+// MarkReplayed records that an admin re-published the event to the persistence queue
+func (d *TeamDeadLetter) MarkReplayed(adminID int64) error {
+	return d.resolve(DeadLetterStatusReplayed, adminID)
+}
+
+// MarkDiscarded records that an admin dropped the event without re-processing it
+func (d *TeamDeadLetter) MarkDiscarded(adminID int64) error {
+	return d.resolve(DeadLetterStatusDiscarded, adminID)
+}
+
+func (d *TeamDeadLetter) resolve(status DeadLetterStatus, adminID int64) error {
+	if !d.IsPending() {
+		return exception.ErrDeadLetterNotPending
+	}
+
+	now := time.Now()
+	d.Status = status
+	d.ResolvedBy = &adminID
+	d.ResolvedAt = &now
+	return nil
+}
+
+// ProcessedTeamEvent records an idempotency key whose persistence event has already been applied
+type ProcessedTeamEvent struct {
+	IdempotencyKey string    `gorm:"column:idempotency_key;type:varchar(128);primaryKey" json:"idempotency_key"`
+	EventType      string    `gorm:"column:event_type;type:varchar(64);not null" json:"event_type"`
+	ProcessedAt    time.Time `gorm:"column:processed_at;type:datetime;not null" json:"processed_at"`
+}
+
+func NewProcessedTeamEvent(idempotencyKey, eventType string) *ProcessedTeamEvent {
+	return &ProcessedTeamEvent{
+		IdempotencyKey: idempotencyKey,
+		EventType:      eventType,
+		ProcessedAt:    time.Now(),
+	}
+}
+
+func (e *ProcessedTeamEvent) TableName() string {
+	return "team_persistence_processed_events"
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"time"
+)
+
+// TeamDriftType describes how the Redis team cache and MySQL disagree
+type TeamDriftType string
+
+const (
+	TeamDriftMissingInDB          TeamDriftType = "TEAM_MISSING_IN_DB"
+	TeamDriftNameMismatch         TeamDriftType = "TEAM_NAME_MISMATCH"
+	TeamDriftMemberMissingInDB    TeamDriftType = "MEMBER_MISSING_IN_DB"
+	TeamDriftMemberMissingInCache TeamDriftType = "MEMBER_MISSING_IN_CACHE"
+	TeamDriftMemberTypeMismatch   TeamDriftType = "MEMBER_TYPE_MISMATCH"
+	TeamDriftFinalizedCount       TeamDriftType = "FINALIZED_COUNT_MISMATCH"
+)
+
+// ReconcileDirection decides which store is treated as the source of truth when repairing drift
+type ReconcileDirection string
+
+const (
+	// ReconcileCacheToDB repairs MySQL from the Redis cache (the write-behind source)
+	ReconcileCacheToDB ReconcileDirection = "CACHE_TO_DB"
+	// ReconcileDBToCache repairs the Redis cache from MySQL
+	ReconcileDBToCache ReconcileDirection = "DB_TO_CACHE"
+)
+
+func (d ReconcileDirection) IsValid() bool {
+	switch d {
+	case ReconcileCacheToDB, ReconcileDBToCache:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d ReconcileDirection) Validate() error {
+	if !d.IsValid() {
+		return exception.ErrInvalidReconcileDirection
+	}
+	return nil
+}
+
+// TeamDrift is a single difference found between the Redis cache and MySQL
+type TeamDrift struct {
+	Type        TeamDriftType `json:"type"`
+	TeamID      int64         `json:"team_id,omitempty"`
+	UserID      *int64        `json:"user_id,omitempty"`
+	CacheValue  string        `json:"cache_value,omitempty"`
+	DBValue     string        `json:"db_value,omitempty"`
+	Description string        `json:"description"`
+}
+
+// TeamReconciliationReport is the result of comparing one contest's teams across both stores
+type TeamReconciliationReport struct {
+	ContestID           int64               `json:"contest_id"`
+	CheckedAt           time.Time           `json:"checked_at"`
+	CacheTeamFound      bool                `json:"cache_team_found"`
+	CacheFinalizedCount int64               `json:"cache_finalized_count"`
+	DBTeamCount         int                 `json:"db_team_count"`
+	DBCompleteTeamCount int                 `json:"db_complete_team_count"`
+	Drifts              []*TeamDrift        `json:"drifts"`
+	Direction           *ReconcileDirection `json:"direction,omitempty"`
+	Repaired            bool                `json:"repaired"`
+}
+
+func NewTeamReconciliationReport(contestID int64) *TeamReconciliationReport {
+	return &TeamReconciliationReport{
+		ContestID: contestID,
+		CheckedAt: time.Now(),
+		Drifts:    []*TeamDrift{},
+	}
+}
+
+func (r *TeamReconciliationReport) AddDrift(drift *TeamDrift) {
+	r.Drifts = append(r.Drifts, drift)
+}
+
+func (r *TeamReconciliationReport) HasDrift() bool {
+	return len(r.Drifts) > 0
+}
+
+// HasTeamDrift reports drift in the team itself, excluding the contest-wide finalized counter
+func (r *TeamReconciliationReport) HasTeamDrift() bool {
+	for _, drift := range r.Drifts {
+		if drift.Type != TeamDriftFinalizedCount {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *TeamReconciliationReport) MarkRepaired(direction ReconcileDirection) {
+	r.Direction = &direction
+	r.Repaired = true
+}
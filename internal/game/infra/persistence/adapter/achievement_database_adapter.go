@@ -0,0 +1,76 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AchievementDatabaseAdapter implements AchievementDatabasePort using GORM
+type AchievementDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewAchievementDatabaseAdapter(db *gorm.DB) *AchievementDatabaseAdapter {
+	return &AchievementDatabaseAdapter{db: db}
+}
+
+func (a *AchievementDatabaseAdapter) AwardBadges(badges []*domain.UserBadge) ([]*domain.UserBadge, error) {
+	var awarded []*domain.UserBadge
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		awarded = nil
+		for _, badge := range badges {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(badge)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				awarded = append(awarded, badge)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return awarded, nil
+}
+
+func (a *AchievementDatabaseAdapter) GetUserBadges(userID int64) ([]*domain.UserBadge, error) {
+	var badges []*domain.UserBadge
+	err := a.db.Where("user_id = ?", userID).
+		Order("awarded_at ASC, user_badge_id ASC").
+		Find(&badges).Error
+	if err != nil {
+		return nil, err
+	}
+	return badges, nil
+}
+
+func (a *AchievementDatabaseAdapter) CountContestsPlayed(userIDs []int64) (map[int64]int, error) {
+	counts := make(map[int64]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		UserID   int64
+		Contests int
+	}
+	err := a.db.Table("team_members tm").
+		Select("tm.user_id, COUNT(DISTINCT g.contest_id) AS contests").
+		Joins("JOIN game_teams gt ON gt.team_id = tm.team_id").
+		Joins("JOIN games g ON g.game_id = gt.game_id").
+		Where("tm.user_id IN ? AND g.game_status = ?", userIDs, domain.GameStatusFinished).
+		Group("tm.user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.UserID] = row.Contests
+	}
+	return counts, nil
+}
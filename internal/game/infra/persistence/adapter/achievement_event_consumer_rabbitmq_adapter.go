@@ -0,0 +1,215 @@
+package adapter
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Routing keys of the events the achievement queue is bound to
+var (
+	achievementGameFinishedKey    = string(port.GameEventFinished)
+	achievementTeamFinalizedKey   = "game." + string(port.TeamEventTypeTeamFinalized)
+	achievementContestAcceptedKey = "contest." + string(contestPort.EventTypeApplicationAccepted)
+)
+
+// AchievementEventConsumerRabbitMQAdapter implements AchievementEventConsumerPort.
+// It turns the game, team and contest events of the achievement queue into achievement events by routing key.
+type AchievementEventConsumerRabbitMQAdapter struct {
+	connection *config.RabbitMQConnection
+	queue      string
+	running    bool
+	stopCh     chan struct{}
+	mu         sync.RWMutex
+}
+
+// NewAchievementEventConsumerRabbitMQAdapter creates a consumer of the given achievement queue
+func NewAchievementEventConsumerRabbitMQAdapter(connection *config.RabbitMQConnection, queue string) *AchievementEventConsumerRabbitMQAdapter {
+	return &AchievementEventConsumerRabbitMQAdapter{
+		connection: connection,
+		queue:      queue,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start begins consuming messages from the queue
+func (a *AchievementEventConsumerRabbitMQAdapter) Start(
+	ctx context.Context,
+	handler port.AchievementEventHandler,
+) error {
+	a.mu.Lock()
+	if a.running {
+		a.mu.Unlock()
+		return fmt.Errorf("consumer is already running")
+	}
+	a.running = true
+	a.stopCh = make(chan struct{})
+	a.mu.Unlock()
+
+	channel, err := a.connection.GetChannel()
+	if err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	if err := channel.Qos(1, 0, false); err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to set QoS: %w", err)
+	}
+
+	deliveries, err := channel.Consume(
+		a.queue, // queue
+		"",      // consumer tag (auto-generated)
+		false,   // auto-ack (manual ack for reliability)
+		false,   // exclusive
+		false,   // no-local
+		false,   // no-wait
+		nil,     // args
+	)
+	if err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	log.Printf("Achievement event consumer started, listening on queue: %s", a.queue)
+
+	go a.processMessages(ctx, deliveries, handler)
+
+	return nil
+}
+
+// processMessages handles incoming messages
+func (a *AchievementEventConsumerRabbitMQAdapter) processMessages(
+	ctx context.Context,
+	deliveries <-chan amqp.Delivery,
+	handler port.AchievementEventHandler,
+) {
+	for {
+		select {
+		case <-a.stopCh:
+			log.Printf("Achievement event consumer stopped: %s", a.queue)
+			return
+		case <-ctx.Done():
+			log.Printf("Achievement event consumer context cancelled: %s", a.queue)
+			a.setRunning(false)
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				log.Printf("Achievement event consumer channel closed: %s", a.queue)
+				a.setRunning(false)
+				return
+			}
+			a.handleDelivery(ctx, delivery, handler)
+		}
+	}
+}
+
+// handleDelivery processes a single message
+func (a *AchievementEventConsumerRabbitMQAdapter) handleDelivery(
+	ctx context.Context,
+	delivery amqp.Delivery,
+	handler port.AchievementEventHandler,
+) {
+	event, err := a.decode(delivery.RoutingKey, delivery.Body)
+	if err != nil {
+		log.Printf("Failed to decode %s event from %s: %v", delivery.RoutingKey, a.queue, err)
+		// Reject without requeue for malformed messages
+		_ = delivery.Nack(false, false)
+		return
+	}
+	if event == nil {
+		_ = delivery.Ack(false)
+		return
+	}
+
+	processCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := handler(processCtx, event); err != nil {
+		log.Printf("Failed to handle %s event (contestID=%d, gameID=%d) from %s, requeueing: %v",
+			delivery.RoutingKey, event.ContestID, event.GameID, a.queue, err)
+		time.Sleep(gameEventRequeueDelay)
+		_ = delivery.Nack(false, true)
+		return
+	}
+
+	if err := delivery.Ack(false); err != nil {
+		log.Printf("Failed to ack achievement event: %v", err)
+	}
+}
+
+// decode maps a message to its achievement event; messages of other routing keys decode to nil
+func (a *AchievementEventConsumerRabbitMQAdapter) decode(routingKey string, body []byte) (*port.AchievementEvent, error) {
+	switch routingKey {
+	case achievementGameFinishedKey:
+		var event port.GameEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		return &port.AchievementEvent{
+			Trigger:   domain.AchievementTriggerGameFinished,
+			ContestID: event.ContestID,
+			GameID:    event.GameID,
+		}, nil
+
+	case achievementTeamFinalizedKey:
+		var event port.TeamFinalizedEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		return &port.AchievementEvent{
+			Trigger:   domain.AchievementTriggerTeamFinalized,
+			ContestID: event.ContestID,
+			UserIDs:   event.MemberUserIDs,
+		}, nil
+
+	case achievementContestAcceptedKey:
+		var event contestPort.ContestApplicationEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		return &port.AchievementEvent{
+			Trigger:   domain.AchievementTriggerContestJoined,
+			ContestID: event.ContestID,
+			UserIDs:   []int64{event.UserID},
+		}, nil
+	}
+	return nil, nil
+}
+
+// Stop gracefully stops the consumer
+func (a *AchievementEventConsumerRabbitMQAdapter) Stop() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.running {
+		return nil
+	}
+
+	close(a.stopCh)
+	a.running = false
+	return nil
+}
+
+// IsRunning returns whether the consumer is currently running
+func (a *AchievementEventConsumerRabbitMQAdapter) IsRunning() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.running
+}
+
+// setRunning safely sets the running state
+func (a *AchievementEventConsumerRabbitMQAdapter) setRunning(running bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.running = running
+}
@@ -0,0 +1,83 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+
+	"gorm.io/gorm"
+)
+
+// ContestResultExportDatabaseAdapter implements ContestResultExportDatabasePort using GORM
+type ContestResultExportDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewContestResultExportDatabaseAdapter(db *gorm.DB) *ContestResultExportDatabaseAdapter {
+	return &ContestResultExportDatabaseAdapter{db: db}
+}
+
+func (a *ContestResultExportDatabaseAdapter) GetRosterMembers(contestID int64) ([]*port.ResultExportMember, error) {
+	var members []*port.ResultExportMember
+	err := a.db.Table("team_members tm").
+		Select("tm.team_id, tm.user_id, tm.member_type, u.username, u.tag, u.riot_name, u.riot_tag, da.discord_id").
+		Joins("JOIN teams t ON t.team_id = tm.team_id").
+		Joins("JOIN users u ON u.id = tm.user_id").
+		Joins("LEFT JOIN discord_accounts da ON da.user_id = u.id").
+		Where("t.contest_id = ?", contestID).
+		Order("tm.team_id ASC, tm.member_type ASC, tm.id ASC").
+		Scan(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (a *ContestResultExportDatabaseAdapter) GetGamesWithResults(contestID int64) ([]*port.ResultExportGame, error) {
+	var games []*port.ResultExportGame
+	err := a.db.Table("games g").
+		Select("g.game_id, g.game_status, g.round, g.match_number, g.started_at, "+
+			"mr.winner_team_id, mr.loser_team_id, mr.winner_score, mr.loser_score, mr.map_name, "+
+			"mr.rounds_played, mr.game_started_at, mr.game_duration").
+		Joins("LEFT JOIN match_results mr ON mr.game_id = g.game_id").
+		Where("g.contest_id = ?", contestID).
+		Order("g.round IS NULL, g.round ASC, g.match_number ASC, g.game_id ASC").
+		Scan(&games).Error
+	if err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+func (a *ContestResultExportDatabaseAdapter) GetGameTeams(contestID int64) ([]*port.ResultExportGameTeam, error) {
+	var gameTeams []*port.ResultExportGameTeam
+	err := a.db.Table("game_teams gt").
+		Select("gt.game_id, gt.team_id, gt.grade").
+		Joins("JOIN games g ON g.game_id = gt.game_id").
+		Where("g.contest_id = ?", contestID).
+		Order("gt.game_id ASC, gt.game_team_id ASC").
+		Scan(&gameTeams).Error
+	if err != nil {
+		return nil, err
+	}
+	return gameTeams, nil
+}
+
+// GetPlayerStatTotals groups by team too, so a player who switched teams is listed once per team
+func (a *ContestResultExportDatabaseAdapter) GetPlayerStatTotals(contestID int64) ([]*port.ResultExportPlayerTotals, error) {
+	var totals []*port.ResultExportPlayerTotals
+	err := a.db.Table("match_player_stats mps").
+		Select("mps.user_id, mps.team_id, u.username, u.tag, u.riot_name, u.riot_tag, "+
+			"COUNT(DISTINCT mps.match_result_id) AS games_played, SUM(mr.rounds_played) AS rounds_played, "+
+			"SUM(mps.kills) AS kills, SUM(mps.deaths) AS deaths, SUM(mps.assists) AS assists, SUM(mps.score) AS score, "+
+			"SUM(mps.headshots) AS headshots, SUM(mps.bodyshots) AS bodyshots, SUM(mps.legshots) AS legshots").
+		Joins("JOIN match_results mr ON mr.match_result_id = mps.match_result_id").
+		Joins("JOIN games g ON g.game_id = mr.game_id").
+		Joins("JOIN users u ON u.id = mps.user_id").
+		Where("g.contest_id = ?", contestID).
+		Group("mps.user_id, mps.team_id, u.username, u.tag, u.riot_name, u.riot_tag").
+		Order("score DESC, mps.user_id ASC").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
@@ -0,0 +1,145 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// calendarGameCondition keeps games that can be placed on a calendar, mirroring Game.IsOnCalendar
+const calendarGameCondition = "games.game_status <> ? AND (games.scheduled_start_time IS NOT NULL OR games.started_at IS NOT NULL)"
+
+// GameCalendarDatabaseAdapter implements GameCalendarDatabasePort using GORM
+type GameCalendarDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewGameCalendarDatabaseAdapter(db *gorm.DB) *GameCalendarDatabaseAdapter {
+	return &GameCalendarDatabaseAdapter{db: db}
+}
+
+func (a *GameCalendarDatabaseAdapter) GetCalendarGamesByContest(contestID int64) ([]*port.CalendarGame, error) {
+	return a.findCalendarGames(a.db.Where("games.contest_id = ?", contestID))
+}
+
+func (a *GameCalendarDatabaseAdapter) GetCalendarGamesByUser(userID int64) ([]*port.CalendarGame, error) {
+	return a.findCalendarGames(a.db.Where(
+		"games.game_id IN (SELECT gt.game_id FROM game_teams gt JOIN team_members tm ON tm.team_id = gt.team_id WHERE tm.user_id = ?)",
+		userID,
+	))
+}
+
+func (a *GameCalendarDatabaseAdapter) GetFeedTokenByUserID(userID int64) (*domain.CalendarFeedToken, error) {
+	var token domain.CalendarFeedToken
+	if err := a.db.Where("user_id = ?", userID).First(&token).Error; err != nil {
+		return nil, a.translateError(err)
+	}
+	return &token, nil
+}
+
+func (a *GameCalendarDatabaseAdapter) GetFeedTokenByToken(token string) (*domain.CalendarFeedToken, error) {
+	var feedToken domain.CalendarFeedToken
+	if err := a.db.Where("token = ?", token).First(&feedToken).Error; err != nil {
+		return nil, a.translateError(err)
+	}
+	return &feedToken, nil
+}
+
+func (a *GameCalendarDatabaseAdapter) SaveFeedToken(token *domain.CalendarFeedToken) error {
+	return a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token", "created_at"}),
+	}).Create(token).Error
+}
+
+// findCalendarGames loads the games matched by scope with their contest titles, round counts and team names
+func (a *GameCalendarDatabaseAdapter) findCalendarGames(scope *gorm.DB) ([]*port.CalendarGame, error) {
+	var games []*domain.Game
+	if err := a.db.Model(&domain.Game{}).
+		Where(scope).
+		Where(calendarGameCondition, domain.GameStatusCancelled).
+		Order("games.game_id ASC").
+		Find(&games).Error; err != nil {
+		return nil, err
+	}
+
+	if len(games) == 0 {
+		return []*port.CalendarGame{}, nil
+	}
+
+	gameIDs := make([]int64, 0, len(games))
+	contestIDs := make([]int64, 0)
+	seenContests := make(map[int64]bool)
+	for _, g := range games {
+		gameIDs = append(gameIDs, g.GameID)
+		if !seenContests[g.ContestID] {
+			seenContests[g.ContestID] = true
+			contestIDs = append(contestIDs, g.ContestID)
+		}
+	}
+
+	var contests []struct {
+		ContestID   int64
+		Title       string
+		TotalRounds int
+	}
+	if err := a.db.Table("contests").
+		Select("contests.contest_id, contests.title, "+
+			"(SELECT COALESCE(MAX(g.round), 0) FROM games g WHERE g.contest_id = contests.contest_id) AS total_rounds").
+		Where("contests.contest_id IN ?", contestIDs).
+		Scan(&contests).Error; err != nil {
+		return nil, err
+	}
+
+	var teamNames []struct {
+		GameID   int64
+		TeamName string
+	}
+	if err := a.db.Table("game_teams gt").
+		Select("gt.game_id, t.team_name").
+		Joins("JOIN teams t ON t.team_id = gt.team_id").
+		Where("gt.game_id IN ?", gameIDs).
+		Order("gt.game_team_id ASC").
+		Scan(&teamNames).Error; err != nil {
+		return nil, err
+	}
+
+	titles := make(map[int64]string, len(contests))
+	totalRounds := make(map[int64]int, len(contests))
+	for _, contest := range contests {
+		titles[contest.ContestID] = contest.Title
+		totalRounds[contest.ContestID] = contest.TotalRounds
+	}
+
+	calendarGames := make(map[int64]*port.CalendarGame, len(games))
+	result := make([]*port.CalendarGame, 0, len(games))
+	for _, g := range games {
+		calendarGame := &port.CalendarGame{
+			Game:         g,
+			ContestTitle: titles[g.ContestID],
+			TotalRounds:  totalRounds[g.ContestID],
+			TeamNames:    []string{},
+		}
+		calendarGames[g.GameID] = calendarGame
+		result = append(result, calendarGame)
+	}
+
+	for _, row := range teamNames {
+		if calendarGame, ok := calendarGames[row.GameID]; ok {
+			calendarGame.TeamNames = append(calendarGame.TeamNames, row.TeamName)
+		}
+	}
+
+	return result, nil
+}
+
+func (a *GameCalendarDatabaseAdapter) translateError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return exception.ErrCalendarFeedNotFound
+	}
+	return err
+}
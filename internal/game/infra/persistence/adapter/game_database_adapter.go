@@ -2,7 +2,9 @@ package adapter
 
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -74,7 +76,12 @@ func (a *GameDatabaseAdapter) GetByContestAndRound(contestID int64, round int) (
 }
 
 func (a *GameDatabaseAdapter) Update(game *domain.Game) error {
-	result := a.db.Save(game)
+	return a.UpdateWithContext(context.Background(), game)
+}
+
+// UpdateWithContext saves the game, joining the transaction carried by ctx if any
+func (a *GameDatabaseAdapter) UpdateWithContext(ctx context.Context, game *domain.Game) error {
+	result := transaction.DB(ctx, a.db).Save(game)
 	if result.Error != nil {
 		return a.translateError(result.Error)
 	}
@@ -0,0 +1,166 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// gameEventRequeueDelay throttles redelivery when handling a game event fails (e.g. Redis outage)
+const gameEventRequeueDelay = 5 * time.Second
+
+// GameEventConsumerRabbitMQAdapter implements GameEventConsumerPort for one game event queue
+type GameEventConsumerRabbitMQAdapter struct {
+	connection *config.RabbitMQConnection
+	queue      string
+	running    bool
+	stopCh     chan struct{}
+	mu         sync.RWMutex
+}
+
+// NewGameEventConsumerRabbitMQAdapter creates a consumer of the given game event queue
+func NewGameEventConsumerRabbitMQAdapter(connection *config.RabbitMQConnection, queue string) *GameEventConsumerRabbitMQAdapter {
+	return &GameEventConsumerRabbitMQAdapter{
+		connection: connection,
+		queue:      queue,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start begins consuming messages from the queue
+func (a *GameEventConsumerRabbitMQAdapter) Start(
+	ctx context.Context,
+	handler port.GameEventHandler,
+) error {
+	a.mu.Lock()
+	if a.running {
+		a.mu.Unlock()
+		return fmt.Errorf("consumer is already running")
+	}
+	a.running = true
+	a.stopCh = make(chan struct{})
+	a.mu.Unlock()
+
+	channel, err := a.connection.GetChannel()
+	if err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	// Set prefetch to 1 so games are applied in the order they finished
+	if err := channel.Qos(1, 0, false); err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to set QoS: %w", err)
+	}
+
+	deliveries, err := channel.Consume(
+		a.queue, // queue
+		"",      // consumer tag (auto-generated)
+		false,   // auto-ack (manual ack for reliability)
+		false,   // exclusive
+		false,   // no-local
+		false,   // no-wait
+		nil,     // args
+	)
+	if err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	log.Printf("Game event consumer started, listening on queue: %s", a.queue)
+
+	go a.processMessages(ctx, deliveries, handler)
+
+	return nil
+}
+
+// processMessages handles incoming messages
+func (a *GameEventConsumerRabbitMQAdapter) processMessages(
+	ctx context.Context,
+	deliveries <-chan amqp.Delivery,
+	handler port.GameEventHandler,
+) {
+	for {
+		select {
+		case <-a.stopCh:
+			log.Printf("Game event consumer stopped: %s", a.queue)
+			return
+		case <-ctx.Done():
+			log.Printf("Game event consumer context cancelled: %s", a.queue)
+			a.setRunning(false)
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				log.Printf("Game event consumer channel closed: %s", a.queue)
+				a.setRunning(false)
+				return
+			}
+			a.handleDelivery(ctx, delivery, handler)
+		}
+	}
+}
+
+// handleDelivery processes a single message
+func (a *GameEventConsumerRabbitMQAdapter) handleDelivery(
+	ctx context.Context,
+	delivery amqp.Delivery,
+	handler port.GameEventHandler,
+) {
+	var event port.GameEvent
+	if err := json.Unmarshal(delivery.Body, &event); err != nil {
+		log.Printf("Failed to unmarshal game event from %s: %v", a.queue, err)
+		// Reject without requeue for malformed messages
+		_ = delivery.Nack(false, false)
+		return
+	}
+
+	processCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := handler(processCtx, &event); err != nil {
+		log.Printf("Failed to handle game event %s (gameID=%d) from %s, requeueing: %v",
+			event.EventType, event.GameID, a.queue, err)
+		time.Sleep(gameEventRequeueDelay)
+		_ = delivery.Nack(false, true)
+		return
+	}
+
+	if err := delivery.Ack(false); err != nil {
+		log.Printf("Failed to ack game event: %v", err)
+	}
+}
+
+// Stop gracefully stops the consumer
+func (a *GameEventConsumerRabbitMQAdapter) Stop() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.running {
+		return nil
+	}
+
+	close(a.stopCh)
+	a.running = false
+	return nil
+}
+
+// IsRunning returns whether the consumer is currently running
+func (a *GameEventConsumerRabbitMQAdapter) IsRunning() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.running
+}
+
+// setRunning safely sets the running state
+func (a *GameEventConsumerRabbitMQAdapter) setRunning(running bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.running = running
+}
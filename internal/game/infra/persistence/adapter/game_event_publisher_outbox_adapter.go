@@ -0,0 +1,81 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	outboxPort "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GameEventPublisherOutboxAdapter writes game events to the transactional outbox; the outbox relay publishes them to RabbitMQ
+type GameEventPublisherOutboxAdapter struct {
+	outbox   outboxPort.OutboxPort
+	exchange string
+}
+
+func NewGameEventPublisherOutboxAdapter(
+	outbox outboxPort.OutboxPort,
+	exchange string,
+) *GameEventPublisherOutboxAdapter {
+	return &GameEventPublisherOutboxAdapter{
+		outbox:   outbox,
+		exchange: exchange,
+	}
+}
+
+func (a *GameEventPublisherOutboxAdapter) PublishGameEvent(ctx context.Context, event *port.GameEvent) error {
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.publish(ctx, event.GameID, event.EventID, string(event.EventType), event.Timestamp, event)
+}
+
+func (a *GameEventPublisherOutboxAdapter) PublishMatchDetectedEvent(ctx context.Context, event *port.MatchDetectedEvent) error {
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.publish(ctx, event.GameID, event.EventID, string(event.EventType), event.Timestamp, event)
+}
+
+func (a *GameEventPublisherOutboxAdapter) publish(
+	ctx context.Context,
+	gameID int64,
+	messageID, routingKey string,
+	timestamp time.Time,
+	payload interface{},
+) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	err = a.outbox.Enqueue(ctx, &outboxPort.OutboxMessage{
+		AggregateType: outboxPort.AggregateGame,
+		AggregateID:   gameID,
+		Exchange:      a.exchange,
+		RoutingKey:    routingKey,
+		MessageID:     messageID,
+		Body:          body,
+		Headers: map[string]interface{}{
+			"event_type": routingKey,
+		},
+		OccurredAt: timestamp,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish game event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+	"gorm.io/gorm"
+)
+
+// LeaderboardDatabaseAdapter implements LeaderboardDatabasePort using GORM
+type LeaderboardDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewLeaderboardDatabaseAdapter(db *gorm.DB) *LeaderboardDatabaseAdapter {
+	return &LeaderboardDatabaseAdapter{db: db}
+}
+
+func (a *LeaderboardDatabaseAdapter) GetGameTeamMembers(gameID int64) ([]*port.LeaderboardTeamMember, error) {
+	var members []*port.LeaderboardTeamMember
+	err := a.db.Table("game_teams gt").
+		Select("gt.team_id, t.team_name, tm.user_id").
+		Joins("JOIN teams t ON t.team_id = gt.team_id").
+		Joins("JOIN team_members tm ON tm.team_id = gt.team_id").
+		Where("gt.game_id = ?", gameID).
+		Order("gt.team_id ASC, tm.user_id ASC").
+		Scan(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (a *LeaderboardDatabaseAdapter) GetFinishedGameIDs() ([]int64, error) {
+	var gameIDs []int64
+	err := a.db.Model(&domain.Game{}).
+		Where("game_status = ?", domain.GameStatusFinished).
+		Order("ended_at ASC, game_id ASC").
+		Pluck("game_id", &gameIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return gameIDs, nil
+}
+
+func (a *LeaderboardDatabaseAdapter) GetLeaderboardUsers(userIDs []int64) ([]*port.LeaderboardUser, error) {
+	var users []*port.LeaderboardUser
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	err := a.db.Table("users").
+		Select("id AS user_id, username, tag, avatar").
+		Where("id IN ?", userIDs).
+		Scan(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
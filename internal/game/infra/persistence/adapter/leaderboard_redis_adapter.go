@@ -0,0 +1,270 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// LeaderboardRedisAdapter implements LeaderboardCachePort using Redis sorted sets
+type LeaderboardRedisAdapter struct {
+	client *redis.Client
+}
+
+func NewLeaderboardRedisAdapter(client *redis.Client) *LeaderboardRedisAdapter {
+	return &LeaderboardRedisAdapter{
+		client: client,
+	}
+}
+
+// applyGameScript marks the game in KEYS[1] and, if it was not there yet, applies the encoded update.
+// Redis runs a script without interleaving other commands, so a game is applied to every key or to none.
+// The update refers to keys by their index in KEYS.
+var applyGameScript = redis.NewScript(`
+if redis.call('SADD', KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+
+local update = cjson.decode(ARGV[2])
+for _, op in ipairs(update.increments) do
+	redis.call('ZINCRBY', KEYS[op[1]], op[3], op[2])
+end
+for _, op in ipairs(update.totals) do
+	redis.call('HINCRBY', KEYS[op[1]], op[2], op[3])
+end
+for _, op in ipairs(update.fields) do
+	redis.call('HSET', KEYS[op[1]], op[2], op[3])
+end
+for _, op in ipairs(update.members) do
+	redis.call('SADD', KEYS[op[1]], op[2])
+end
+for _, op in ipairs(update.averages) do
+	local rounds = tonumber(redis.call('HGET', KEYS[op[2]], op[3] .. ':rounds') or 0)
+	if rounds >= op[4] then
+		local score = tonumber(redis.call('HGET', KEYS[op[2]], op[3] .. ':score') or 0)
+		redis.call('ZADD', KEYS[op[1]], math.floor(score / rounds * 100 + 0.5) / 100, op[3])
+	end
+end
+return 1
+`)
+
+// applyGameOps is the JSON form of a LeaderboardGameUpdate read by applyGameScript
+type applyGameOps struct {
+	Increments [][]interface{} `json:"increments"`
+	Totals     [][]interface{} `json:"totals"`
+	Fields     [][]interface{} `json:"fields"`
+	Members    [][]interface{} `json:"members"`
+	Averages   [][]interface{} `json:"averages"`
+}
+
+func (a *LeaderboardRedisAdapter) ApplyGame(ctx context.Context, gameID int64, update *port.LeaderboardGameUpdate) (bool, error) {
+	keys := []string{utils.GetLeaderboardProcessedGamesKey()}
+	indexes := make(map[string]int)
+	keyIndex := func(key string) int {
+		if index, ok := indexes[key]; ok {
+			return index
+		}
+		keys = append(keys, key)
+		indexes[key] = len(keys)
+		return len(keys)
+	}
+
+	ops := applyGameOps{
+		Increments: [][]interface{}{},
+		Totals:     [][]interface{}{},
+		Fields:     [][]interface{}{},
+		Members:    [][]interface{}{},
+		Averages:   [][]interface{}{},
+	}
+	for key, deltas := range update.Increments {
+		for member, delta := range deltas {
+			ops.Increments = append(ops.Increments, []interface{}{keyIndex(key), member, delta})
+		}
+	}
+	for key, increments := range update.Totals {
+		for field, increment := range increments {
+			ops.Totals = append(ops.Totals, []interface{}{keyIndex(key), field, increment})
+		}
+	}
+	for rosterKey, name := range update.TeamNames {
+		ops.Fields = append(ops.Fields, []interface{}{keyIndex(utils.GetLeaderboardTeamNamesKey()), rosterKey, name})
+	}
+	for userID, rosterKeys := range update.UserRosters {
+		for _, rosterKey := range rosterKeys {
+			ops.Members = append(ops.Members, []interface{}{keyIndex(utils.GetLeaderboardUserRostersKey(userID)), rosterKey})
+		}
+	}
+	for _, average := range update.Averages {
+		for _, member := range average.Members {
+			ops.Averages = append(ops.Averages, []interface{}{keyIndex(average.Key), keyIndex(average.TotalsKey), member, average.MinRounds})
+		}
+	}
+
+	payload, err := json.Marshal(ops)
+	if err != nil {
+		return false, err
+	}
+
+	applied, err := applyGameScript.Run(ctx, a.client, keys, gameID, payload).Int()
+	if err != nil {
+		return false, err
+	}
+	return applied == 1, nil
+}
+
+func (a *LeaderboardRedisAdapter) GetScores(ctx context.Context, key string, members []string) (map[string]float64, error) {
+	scores := make(map[string]float64, len(members))
+	if len(members) == 0 {
+		return scores, nil
+	}
+
+	pipe := a.client.Pipeline()
+	cmds := make([]*redis.FloatCmd, len(members))
+	for i, member := range members {
+		cmds[i] = pipe.ZScore(ctx, key, member)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		score, err := cmd.Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		scores[members[i]] = score
+	}
+	return scores, nil
+}
+
+func (a *LeaderboardRedisAdapter) SetScores(ctx context.Context, key string, scores map[string]float64) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, 0, len(scores))
+	for member, score := range scores {
+		members = append(members, redis.Z{Score: score, Member: member})
+	}
+	return a.client.ZAdd(ctx, key, members...).Err()
+}
+
+func (a *LeaderboardRedisAdapter) RemoveMembers(ctx context.Context, key string, members []string) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, len(members))
+	for i, member := range members {
+		values[i] = member
+	}
+	return a.client.ZRem(ctx, key, values...).Err()
+}
+
+func (a *LeaderboardRedisAdapter) GetRange(ctx context.Context, key string, offset, limit int) ([]*domain.LeaderboardEntry, error) {
+	members, err := a.client.ZRevRangeWithScores(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.LeaderboardEntry, 0, len(members))
+	for i, z := range members {
+		member, _ := z.Member.(string)
+		entries = append(entries, &domain.LeaderboardEntry{
+			Member: member,
+			Score:  z.Score,
+			Rank:   int64(offset + i + 1),
+		})
+	}
+	return entries, nil
+}
+
+func (a *LeaderboardRedisAdapter) Count(ctx context.Context, key string) (int64, error) {
+	return a.client.ZCard(ctx, key).Result()
+}
+
+func (a *LeaderboardRedisAdapter) GetEntry(ctx context.Context, key, member string) (*domain.LeaderboardEntry, error) {
+	pipe := a.client.Pipeline()
+	rankCmd := pipe.ZRevRank(ctx, key, member)
+	scoreCmd := pipe.ZScore(ctx, key, member)
+	if _, err := pipe.Exec(ctx); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domain.LeaderboardEntry{
+		Member: member,
+		Score:  scoreCmd.Val(),
+		Rank:   rankCmd.Val() + 1,
+	}, nil
+}
+
+func (a *LeaderboardRedisAdapter) SetTeamNames(ctx context.Context, names map[string]string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	values := make(map[string]interface{}, len(names))
+	for rosterKey, name := range names {
+		values[rosterKey] = name
+	}
+	return a.client.HSet(ctx, utils.GetLeaderboardTeamNamesKey(), values).Err()
+}
+
+func (a *LeaderboardRedisAdapter) GetTeamNames(ctx context.Context, rosterKeys []string) (map[string]string, error) {
+	names := make(map[string]string, len(rosterKeys))
+	if len(rosterKeys) == 0 {
+		return names, nil
+	}
+
+	values, err := a.client.HMGet(ctx, utils.GetLeaderboardTeamNamesKey(), rosterKeys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		if name, ok := value.(string); ok {
+			names[rosterKeys[i]] = name
+		}
+	}
+	return names, nil
+}
+
+func (a *LeaderboardRedisAdapter) GetUserRosters(ctx context.Context, userID int64) ([]string, error) {
+	return a.client.SMembers(ctx, utils.GetLeaderboardUserRostersKey(userID)).Result()
+}
+
+func (a *LeaderboardRedisAdapter) Clear(ctx context.Context) error {
+	pattern := utils.GetLeaderboardPatternKey()
+
+	var cursor uint64
+	for {
+		keys, nextCursor, err := a.client.Scan(ctx, cursor, pattern, 100).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			if err := a.client.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+
+		cursor = nextCursor
+		if cursor == 0 {
+			break
+		}
+	}
+
+	return nil
+}
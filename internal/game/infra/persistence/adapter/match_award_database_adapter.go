@@ -0,0 +1,161 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MatchAwardDatabaseAdapter implements MatchAwardDatabasePort using GORM
+type MatchAwardDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewMatchAwardDatabaseAdapter(db *gorm.DB) *MatchAwardDatabaseAdapter {
+	return &MatchAwardDatabaseAdapter{db: db}
+}
+
+func (a *MatchAwardDatabaseAdapter) OpenVoting(voting *domain.MvpVoting, awards []*domain.MatchAward) (bool, error) {
+	opened := false
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(voting)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		opened = true
+
+		if len(awards) == 0 {
+			return nil
+		}
+		return tx.Create(&awards).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return opened, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) GetVoting(matchResultID int64) (*domain.MvpVoting, error) {
+	var voting domain.MvpVoting
+	if err := a.db.Where("match_result_id = ?", matchResultID).First(&voting).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrMvpVotingNotFound
+		}
+		return nil, err
+	}
+	return &voting, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) GetExpiredVotings(now time.Time) ([]*domain.MvpVoting, error) {
+	var votings []*domain.MvpVoting
+	err := a.db.Where("closed_at IS NULL AND closes_at <= ?", now).
+		Order("closes_at ASC").
+		Find(&votings).Error
+	if err != nil {
+		return nil, err
+	}
+	return votings, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) CloseVoting(voting *domain.MvpVoting, closedAt time.Time, awards []*domain.MatchAward) (bool, error) {
+	closed := false
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.MvpVoting{}).
+			Where("match_result_id = ? AND closed_at IS NULL", voting.MatchResultID).
+			Update("closed_at", closedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		closed = true
+
+		if len(awards) == 0 {
+			return nil
+		}
+		return tx.Create(&awards).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	if closed {
+		voting.ClosedAt = &closedAt
+	}
+	return closed, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) SaveVote(vote *domain.MvpVote) error {
+	if err := a.db.Create(vote).Error; err != nil {
+		if a.isDuplicateKeyError(err) {
+			return exception.ErrMvpAlreadyVoted
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *MatchAwardDatabaseAdapter) GetVote(matchResultID, voterID int64) (*domain.MvpVote, error) {
+	var vote domain.MvpVote
+	err := a.db.Where("match_result_id = ? AND voter_id = ?", matchResultID, voterID).First(&vote).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &vote, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) CountVotes(matchResultID int64) ([]*domain.MvpVoteCount, error) {
+	var counts []*domain.MvpVoteCount
+	err := a.db.Model(&domain.MvpVote{}).
+		Select("candidate_id, COUNT(*) AS votes").
+		Where("match_result_id = ?", matchResultID).
+		Group("candidate_id").
+		Order("candidate_id ASC").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) GetAwardsByMatchResult(matchResultID int64) ([]*domain.MatchAward, error) {
+	var awards []*domain.MatchAward
+	err := a.db.Where("match_result_id = ?", matchResultID).
+		Order("match_award_id ASC").
+		Find(&awards).Error
+	if err != nil {
+		return nil, err
+	}
+	return awards, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) GetAwardCountsByUser(userID int64) ([]*port.MatchAwardCount, error) {
+	var counts []*port.MatchAwardCount
+	err := a.db.Model(&domain.MatchAward{}).
+		Select("award_type, COUNT(*) AS count").
+		Where("user_id = ?", userID).
+		Group("award_type").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (a *MatchAwardDatabaseAdapter) isDuplicateKeyError(err error) bool {
+	errMsg := err.Error()
+	return strings.Contains(errMsg, "Duplicate entry") ||
+		strings.Contains(errMsg, "1062")
+}
@@ -2,7 +2,9 @@ package adapter
 
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
 	"errors"
 
 	"gorm.io/gorm"
@@ -18,7 +20,12 @@ func NewMatchResultDatabaseAdapter(db *gorm.DB) *MatchResultDatabaseAdapter {
 }
 
 func (a *MatchResultDatabaseAdapter) Save(result *domain.MatchResult) (*domain.MatchResult, error) {
-	if err := a.db.Create(result).Error; err != nil {
+	return a.SaveWithContext(context.Background(), result)
+}
+
+// SaveWithContext saves the match result, joining the transaction carried by ctx if any
+func (a *MatchResultDatabaseAdapter) SaveWithContext(ctx context.Context, result *domain.MatchResult) (*domain.MatchResult, error) {
+	if err := transaction.DB(ctx, a.db).Create(result).Error; err != nil {
 		return nil, a.translateError(err)
 	}
 	return result, nil
@@ -33,10 +40,15 @@ func (a *MatchResultDatabaseAdapter) GetByGameID(gameID int64) (*domain.MatchRes
 }
 
 func (a *MatchResultDatabaseAdapter) SavePlayerStats(stats []*domain.MatchPlayerStat) error {
+	return a.SavePlayerStatsWithContext(context.Background(), stats)
+}
+
+// SavePlayerStatsWithContext saves the player stats, joining the transaction carried by ctx if any
+func (a *MatchResultDatabaseAdapter) SavePlayerStatsWithContext(ctx context.Context, stats []*domain.MatchPlayerStat) error {
 	if len(stats) == 0 {
 		return nil
 	}
-	if err := a.db.Create(&stats).Error; err != nil {
+	if err := transaction.DB(ctx, a.db).Create(&stats).Error; err != nil {
 		return err
 	}
 	return nil
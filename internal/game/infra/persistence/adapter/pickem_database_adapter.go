@@ -0,0 +1,112 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PickemDatabaseAdapter implements PickemDatabasePort using GORM
+type PickemDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewPickemDatabaseAdapter(db *gorm.DB) *PickemDatabaseAdapter {
+	return &PickemDatabaseAdapter{db: db}
+}
+
+func (a *PickemDatabaseAdapter) SavePrediction(prediction *domain.PickemPrediction, now time.Time) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		// Activating the game or starting its detection updates the same row, so they wait for this pick or it sees them
+		var game domain.Game
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("game_id = ?", prediction.GameID).
+			First(&game).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return exception.ErrGameNotFound
+			}
+			return err
+		}
+		if err := game.CanPredict(now); err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "game_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"predicted_team_id", "modified_at"}),
+		}).Create(prediction).Error
+	})
+}
+
+func (a *PickemDatabaseAdapter) GetPrediction(gameID, userID int64) (*domain.PickemPrediction, error) {
+	var prediction domain.PickemPrediction
+	err := a.db.Where("game_id = ? AND user_id = ?", gameID, userID).First(&prediction).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &prediction, nil
+}
+
+func (a *PickemDatabaseAdapter) GetUserPredictions(contestID, userID int64) ([]*domain.PickemPrediction, error) {
+	var predictions []*domain.PickemPrediction
+	err := a.db.Where("contest_id = ? AND user_id = ?", contestID, userID).
+		Order("game_id ASC").
+		Find(&predictions).Error
+	if err != nil {
+		return nil, err
+	}
+	return predictions, nil
+}
+
+func (a *PickemDatabaseAdapter) ScoreGame(gameID, winnerTeamID int64, points int, scoredAt time.Time) (int64, error) {
+	result := a.db.Model(&domain.PickemPrediction{}).
+		Where("game_id = ? AND (scored_winner_team_id IS NULL OR scored_winner_team_id <> ?)", gameID, winnerTeamID).
+		Updates(map[string]interface{}{
+			"correct":               gorm.Expr("predicted_team_id = ?", winnerTeamID),
+			"points":                gorm.Expr("CASE WHEN predicted_team_id = ? THEN ? ELSE 0 END", winnerTeamID, points),
+			"scored_at":             scoredAt,
+			"scored_winner_team_id": winnerTeamID,
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (a *PickemDatabaseAdapter) GetStandings(
+	contestID int64,
+	pagination *commonDto.PaginationRequest,
+) ([]*port.PickemStanding, int64, error) {
+	scored := func() *gorm.DB {
+		return a.db.Model(&domain.PickemPrediction{}).
+			Where("contest_id = ? AND scored_at IS NOT NULL", contestID)
+	}
+
+	var totalCount int64
+	if err := scored().Distinct("user_id").Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var standings []*port.PickemStanding
+	err := scored().
+		Select("user_id, SUM(points) AS points, SUM(CASE WHEN correct THEN 1 ELSE 0 END) AS correct_picks, COUNT(*) AS scored_picks").
+		Group("user_id").
+		Order("points DESC, correct_picks DESC, user_id ASC").
+		Offset(pagination.GetOffset()).
+		Limit(pagination.GetLimit()).
+		Scan(&standings).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return standings, totalCount, nil
+}
@@ -0,0 +1,34 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+
+	"gorm.io/gorm"
+)
+
+// PlayerStatsDatabaseAdapter implements PlayerStatsDatabasePort using GORM
+type PlayerStatsDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewPlayerStatsDatabaseAdapter(db *gorm.DB) *PlayerStatsDatabaseAdapter {
+	return &PlayerStatsDatabaseAdapter{db: db}
+}
+
+func (a *PlayerStatsDatabaseAdapter) GetPlayerGameStats(userID int64) ([]*domain.PlayerGameStat, error) {
+	var stats []*domain.PlayerGameStat
+	err := a.db.Table("match_player_stats mps").
+		Select("g.game_id, g.contest_id, c.title AS contest_title, mps.team_id, mps.agent_name, mr.map_name, "+
+			"mr.winner_team_id = mps.team_id AS won, mr.rounds_played, mps.kills, mps.deaths, mps.assists, mps.score, "+
+			"mps.headshots, mps.bodyshots, mps.legshots, mr.game_started_at AS played_at").
+		Joins("JOIN match_results mr ON mr.match_result_id = mps.match_result_id").
+		Joins("JOIN games g ON g.game_id = mr.game_id").
+		Joins("JOIN contests c ON c.contest_id = g.contest_id").
+		Where("mps.user_id = ?", userID).
+		Order("mr.game_started_at DESC, g.game_id DESC").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,59 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// PlayerStatsRedisAdapter implements PlayerStatsCachePort using Redis
+type PlayerStatsRedisAdapter struct {
+	client *redis.Client
+}
+
+func NewPlayerStatsRedisAdapter(client *redis.Client) *PlayerStatsRedisAdapter {
+	return &PlayerStatsRedisAdapter{
+		client: client,
+	}
+}
+
+func (a *PlayerStatsRedisAdapter) Get(ctx context.Context, userID int64) (*domain.PlayerCareerStats, error) {
+	data, err := a.client.Get(ctx, utils.GetPlayerStatsKey(userID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stats domain.PlayerCareerStats
+	if err := json.Unmarshal([]byte(data), &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func (a *PlayerStatsRedisAdapter) Set(ctx context.Context, stats *domain.PlayerCareerStats, ttl time.Duration) error {
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return a.client.Set(ctx, utils.GetPlayerStatsKey(stats.UserID), data, ttl).Err()
+}
+
+func (a *PlayerStatsRedisAdapter) Invalidate(ctx context.Context, userIDs ...int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, utils.GetPlayerStatsKey(userID))
+	}
+	return a.client.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,196 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RatingDatabaseAdapter implements RatingDatabasePort using GORM
+type RatingDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewRatingDatabaseAdapter(db *gorm.DB) *RatingDatabaseAdapter {
+	return &RatingDatabaseAdapter{db: db}
+}
+
+func (a *RatingDatabaseAdapter) GetPlayerRatings(userIDs []int64) ([]*domain.PlayerRating, error) {
+	var ratings []*domain.PlayerRating
+	if len(userIDs) == 0 {
+		return ratings, nil
+	}
+
+	if err := a.db.Where("user_id IN ?", userIDs).Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (a *RatingDatabaseAdapter) GetTeamRatings(rosterKeys []string) ([]*domain.TeamRating, error) {
+	var ratings []*domain.TeamRating
+	if len(rosterKeys) == 0 {
+		return ratings, nil
+	}
+
+	if err := a.db.Where("roster_key IN ?", rosterKeys).Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (a *RatingDatabaseAdapter) GetPlayerRatingsForUpdate(ctx context.Context, userIDs []int64) ([]*domain.PlayerRating, error) {
+	var ratings []*domain.PlayerRating
+	if len(userIDs) == 0 {
+		return ratings, nil
+	}
+
+	// Rows are created and locked in key order so that games sharing players cannot deadlock
+	sorted := append([]int64(nil), userIDs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	initial := make([]*domain.PlayerRating, len(sorted))
+	for i, userID := range sorted {
+		initial[i] = domain.NewPlayerRating(userID)
+	}
+
+	db := transaction.DB(ctx, a.db)
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&initial).Error; err != nil {
+		return nil, err
+	}
+
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id IN ?", sorted).
+		Order("user_id ASC").
+		Find(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (a *RatingDatabaseAdapter) GetTeamRatingsForUpdate(ctx context.Context, rosterKeys []string) ([]*domain.TeamRating, error) {
+	var ratings []*domain.TeamRating
+	if len(rosterKeys) == 0 {
+		return ratings, nil
+	}
+
+	err := transaction.DB(ctx, a.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("roster_key IN ?", rosterKeys).
+		Order("roster_key ASC").
+		Find(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (a *RatingDatabaseAdapter) GetAllPlayerRatings() ([]*domain.PlayerRating, error) {
+	var ratings []*domain.PlayerRating
+	if err := a.db.Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (a *RatingDatabaseAdapter) GetAllTeamRatings() ([]*domain.TeamRating, error) {
+	var ratings []*domain.TeamRating
+	if err := a.db.Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (a *RatingDatabaseAdapter) HasGameHistory(gameID int64) (bool, error) {
+	return a.HasGameHistoryWithContext(context.Background(), gameID)
+}
+
+func (a *RatingDatabaseAdapter) HasGameHistoryWithContext(ctx context.Context, gameID int64) (bool, error) {
+	var count int64
+	err := transaction.DB(ctx, a.db).Model(&domain.RatingHistory{}).
+		Where("game_id = ?", gameID).
+		Limit(1).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (a *RatingDatabaseAdapter) SaveRatingUpdate(update *port.RatingUpdate) error {
+	return a.SaveRatingUpdateWithContext(context.Background(), update)
+}
+
+func (a *RatingDatabaseAdapter) SaveRatingUpdateWithContext(ctx context.Context, update *port.RatingUpdate) error {
+	return transaction.DB(ctx, a.db).Transaction(func(tx *gorm.DB) error {
+		if len(update.Players) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"rating", "deviation", "volatility", "games_played", "wins", "last_played_at", "modified_at"}),
+			}).Create(&update.Players).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(update.Teams) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "roster_key"}},
+				DoUpdates: clause.AssignmentColumns([]string{"team_name", "rating", "deviation", "volatility", "games_played", "wins", "last_played_at", "modified_at"}),
+			}).Create(&update.Teams).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(update.History) > 0 {
+			if err := tx.Create(&update.History).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (a *RatingDatabaseAdapter) GetHistory(
+	subjectType domain.RatingSubjectType,
+	subjectKey string,
+	pagination *commonDto.PaginationRequest,
+) ([]*domain.RatingHistory, int64, error) {
+	query := a.db.Model(&domain.RatingHistory{}).
+		Where("subject_type = ? AND subject_key = ?", subjectType, subjectKey)
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var history []*domain.RatingHistory
+	err := query.
+		Order("created_at DESC, rating_history_id DESC").
+		Offset(pagination.GetOffset()).
+		Limit(pagination.GetLimit()).
+		Find(&history).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return history, totalCount, nil
+}
+
+func (a *RatingDatabaseAdapter) GetContestTeamMembers(contestID int64) ([]*port.RatingTeamMember, error) {
+	var members []*port.RatingTeamMember
+	err := a.db.Table("teams t").
+		Select("t.team_id, t.team_name, tm.user_id").
+		Joins("JOIN team_members tm ON tm.team_id = t.team_id").
+		Where("t.contest_id = ? AND t.status <> ?", contestID, domain.TeamStatusWaitlisted).
+		Order("t.team_id ASC, tm.user_id ASC").
+		Scan(&members).Error
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
@@ -0,0 +1,94 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// RosterDatabaseAdapter implements RosterDatabasePort using GORM
+type RosterDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewRosterDatabaseAdapter(db *gorm.DB) *RosterDatabaseAdapter {
+	return &RosterDatabaseAdapter{db: db}
+}
+
+func (a *RosterDatabaseAdapter) SaveLockReport(report *domain.RosterLockReport) (*domain.RosterLockReport, error) {
+	return a.SaveLockReportWithContext(context.Background(), report)
+}
+
+// SaveLockReportWithContext saves the report, joining the transaction carried by ctx if any
+func (a *RosterDatabaseAdapter) SaveLockReportWithContext(ctx context.Context, report *domain.RosterLockReport) (*domain.RosterLockReport, error) {
+	if err := transaction.DB(ctx, a.db).Create(report).Error; err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (a *RosterDatabaseAdapter) GetLockReportByContestID(contestID int64) (*domain.RosterLockReport, error) {
+	var report domain.RosterLockReport
+	if err := a.db.Where("contest_id = ?", contestID).First(&report).Error; err != nil {
+		return nil, a.translateError(err, exception.ErrRosterLockReportNotFound)
+	}
+	return &report, nil
+}
+
+func (a *RosterDatabaseAdapter) SaveChangeRequest(request *domain.RosterChangeRequest) (*domain.RosterChangeRequest, error) {
+	if err := a.db.Create(request).Error; err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (a *RosterDatabaseAdapter) GetChangeRequestByID(requestID int64) (*domain.RosterChangeRequest, error) {
+	var request domain.RosterChangeRequest
+	if err := a.db.Where("request_id = ?", requestID).First(&request).Error; err != nil {
+		return nil, a.translateError(err, exception.ErrRosterChangeRequestNotFound)
+	}
+	return &request, nil
+}
+
+func (a *RosterDatabaseAdapter) GetChangeRequestsByContestID(contestID int64, status *domain.RosterChangeStatus) ([]*domain.RosterChangeRequest, error) {
+	var requests []*domain.RosterChangeRequest
+
+	query := a.db.Where("contest_id = ?", contestID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Order("created_at ASC").Find(&requests).Error; err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+func (a *RosterDatabaseAdapter) HasPendingChangeRequest(teamID int64) (bool, error) {
+	var count int64
+	err := a.db.Model(&domain.RosterChangeRequest{}).
+		Where("team_id = ? AND status = ?", teamID, domain.RosterChangeStatusPending).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (a *RosterDatabaseAdapter) UpdateChangeRequest(request *domain.RosterChangeRequest) error {
+	return a.db.Save(request).Error
+}
+
+func (a *RosterDatabaseAdapter) translateError(err error, notFound error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
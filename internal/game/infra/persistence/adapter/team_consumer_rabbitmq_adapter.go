@@ -145,30 +145,35 @@ func (a *TeamPersistenceConsumerRabbitMQAdapter) handleDelivery(
 	}
 }
 
-// handleFailure handles a failed message processing
+// handleFailure handles a failed message processing.
+// Retries go through delay queues with exponential backoff; the final failure goes to the DLQ.
 func (a *TeamPersistenceConsumerRabbitMQAdapter) handleFailure(
 	delivery amqp.Delivery,
 	event *port.TeamPersistenceEvent,
 	err error,
 ) {
-	event.RetryCount++
-
-	if event.RetryCount >= port.MaxRetryCount {
-		// Max retries exceeded - send to DLQ
+	if event.RecordFailure(err) || event.RetryCount > len(config.TeamPersistenceRetryDelays) {
+		// Max retries exceeded - send to DLQ with the last error attached
 		log.Printf("Max retries exceeded for event %s (contestID=%d), sending to DLQ: %v",
 			event.EventID, event.ContestID, err)
-		// Reject without requeue - will go to DLQ due to queue configuration
-		_ = delivery.Nack(false, false)
+
+		if pubErr := a.republish(event, config.TeamPersistenceDLQRoutingKey); pubErr != nil {
+			log.Printf("Failed to publish event to DLQ, falling back to reject: %v", pubErr)
+			// Reject without requeue - will go to DLQ due to queue configuration
+			_ = delivery.Nack(false, false)
+			return
+		}
+
+		_ = delivery.Ack(false)
 		return
 	}
 
-	// Requeue for retry with updated retry count
 	// We need to republish with updated retry count since we can't modify the original message
-	log.Printf("Requeueing event %s for retry (attempt %d/%d): %v",
-		event.EventID, event.RetryCount, port.MaxRetryCount, err)
+	delay := config.TeamPersistenceRetryDelays[event.RetryCount-1]
+	log.Printf("Scheduling event %s for retry in %v (attempt %d/%d): %v",
+		event.EventID, delay, event.RetryCount, port.MaxRetryCount, err)
 
-	// Republish with incremented retry count
-	if pubErr := a.republishWithRetry(event); pubErr != nil {
+	if pubErr := a.republish(event, config.TeamPersistenceRetryRoutingKey(event.RetryCount)); pubErr != nil {
 		log.Printf("Failed to republish event for retry, sending to DLQ: %v", pubErr)
 		_ = delivery.Nack(false, false)
 		return
@@ -178,8 +183,8 @@ func (a *TeamPersistenceConsumerRabbitMQAdapter) handleFailure(
 	_ = delivery.Ack(false)
 }
 
-// republishWithRetry republishes the event with incremented retry count
-func (a *TeamPersistenceConsumerRabbitMQAdapter) republishWithRetry(event *port.TeamPersistenceEvent) error {
+// republish publishes the event with its updated retry state to the given routing key
+func (a *TeamPersistenceConsumerRabbitMQAdapter) republish(event *port.TeamPersistenceEvent, routingKey string) error {
 	channel, err := a.connection.GetChannel()
 	if err != nil {
 		return fmt.Errorf("failed to get channel: %w", err)
@@ -190,8 +195,6 @@ func (a *TeamPersistenceConsumerRabbitMQAdapter) republishWithRetry(event *port.
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	routingKey := string(event.EventType)
-
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -208,10 +211,11 @@ func (a *TeamPersistenceConsumerRabbitMQAdapter) republishWithRetry(event *port.
 			MessageId:    event.EventID,
 			Body:         body,
 			Headers: amqp.Table{
-				"event_type":  string(event.EventType),
-				"contest_id":  event.ContestID,
-				"team_id":     event.TeamID,
-				"retry_count": event.RetryCount,
+				"event_type":      string(event.EventType),
+				"contest_id":      event.ContestID,
+				"team_id":         event.TeamID,
+				"retry_count":     event.RetryCount,
+				"idempotency_key": event.IdempotencyKey,
 			},
 		},
 	)
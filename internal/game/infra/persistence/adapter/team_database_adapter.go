@@ -3,7 +3,9 @@ package adapter
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
 	"errors"
 	"strings"
 
@@ -21,11 +23,16 @@ func NewTeamDatabaseAdapter(db *gorm.DB) *TeamDatabaseAdapter {
 // Team operations
 
 func (a *TeamDatabaseAdapter) Save(team *domain.Team) (*domain.Team, error) {
+	return a.SaveWithContext(context.Background(), team)
+}
+
+// SaveWithContext creates the team, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) SaveWithContext(ctx context.Context, team *domain.Team) (*domain.Team, error) {
 	if err := team.Validate(); err != nil {
 		return nil, err
 	}
 
-	if err := a.db.Create(team).Error; err != nil {
+	if err := transaction.DB(ctx, a.db).Create(team).Error; err != nil {
 		return nil, a.translateError(err)
 	}
 
@@ -76,12 +83,54 @@ func (a *TeamDatabaseAdapter) CountByContestID(contestID int64) (int, error) {
 	return int(count), nil
 }
 
+func (a *TeamDatabaseAdapter) CountRegisteredByContestID(contestID int64) (int, error) {
+	return a.CountRegisteredByContestIDWithContext(context.Background(), contestID)
+}
+
+// CountRegisteredByContestIDWithContext counts registered teams, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) CountRegisteredByContestIDWithContext(ctx context.Context, contestID int64) (int, error) {
+	var count int64
+	result := transaction.DB(ctx, a.db).Model(&domain.Team{}).
+		Where("contest_id = ? AND status = ?", contestID, domain.TeamStatusRegistered).
+		Count(&count)
+
+	if result.Error != nil {
+		return 0, a.translateError(result.Error)
+	}
+
+	return int(count), nil
+}
+
+func (a *TeamDatabaseAdapter) GetWaitlistedByContestID(contestID int64) ([]*domain.Team, error) {
+	return a.GetWaitlistedByContestIDWithContext(context.Background(), contestID)
+}
+
+// GetWaitlistedByContestIDWithContext returns the waitlist, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) GetWaitlistedByContestIDWithContext(ctx context.Context, contestID int64) ([]*domain.Team, error) {
+	var teams []*domain.Team
+	result := transaction.DB(ctx, a.db).
+		Where("contest_id = ? AND status = ?", contestID, domain.TeamStatusWaitlisted).
+		Order("waitlisted_at ASC, team_id ASC").
+		Find(&teams)
+
+	if result.Error != nil {
+		return nil, a.translateError(result.Error)
+	}
+
+	return teams, nil
+}
+
 func (a *TeamDatabaseAdapter) Update(team *domain.Team) error {
+	return a.UpdateWithContext(context.Background(), team)
+}
+
+// UpdateWithContext saves the team, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) UpdateWithContext(ctx context.Context, team *domain.Team) error {
 	if err := team.Validate(); err != nil {
 		return err
 	}
 
-	result := a.db.Save(team)
+	result := transaction.DB(ctx, a.db).Save(team)
 	if result.Error != nil {
 		return a.translateError(result.Error)
 	}
@@ -90,7 +139,12 @@ func (a *TeamDatabaseAdapter) Update(team *domain.Team) error {
 }
 
 func (a *TeamDatabaseAdapter) Delete(teamID int64) error {
-	result := a.db.Where("team_id = ?", teamID).Delete(&domain.Team{})
+	return a.DeleteWithContext(context.Background(), teamID)
+}
+
+// DeleteWithContext deletes the team, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) DeleteWithContext(ctx context.Context, teamID int64) error {
+	result := transaction.DB(ctx, a.db).Where("team_id = ?", teamID).Delete(&domain.Team{})
 
 	if result.Error != nil {
 		return a.translateError(result.Error)
@@ -116,11 +170,16 @@ func (a *TeamDatabaseAdapter) DeleteByContestID(contestID int64) error {
 // TeamMember operations
 
 func (a *TeamDatabaseAdapter) SaveMember(member *domain.TeamMember) (*domain.TeamMember, error) {
+	return a.SaveMemberWithContext(context.Background(), member)
+}
+
+// SaveMemberWithContext creates the member, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) SaveMemberWithContext(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error) {
 	if err := member.Validate(); err != nil {
 		return nil, err
 	}
 
-	if err := a.db.Create(member).Error; err != nil {
+	if err := transaction.DB(ctx, a.db).Create(member).Error; err != nil {
 		return nil, a.translateMemberError(err)
 	}
 
@@ -128,6 +187,11 @@ func (a *TeamDatabaseAdapter) SaveMember(member *domain.TeamMember) (*domain.Tea
 }
 
 func (a *TeamDatabaseAdapter) SaveMemberBatch(members []*domain.TeamMember) error {
+	return a.SaveMemberBatchWithContext(context.Background(), members)
+}
+
+// SaveMemberBatchWithContext creates the members, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) SaveMemberBatchWithContext(ctx context.Context, members []*domain.TeamMember) error {
 	if len(members) == 0 {
 		return nil
 	}
@@ -138,13 +202,49 @@ func (a *TeamDatabaseAdapter) SaveMemberBatch(members []*domain.TeamMember) erro
 		}
 	}
 
-	if err := a.db.Create(&members).Error; err != nil {
+	if err := transaction.DB(ctx, a.db).Create(&members).Error; err != nil {
 		return a.translateMemberError(err)
 	}
 
 	return nil
 }
 
+func (a *TeamDatabaseAdapter) SaveTeamsWithMembers(teams []*port.TeamWithMembers) error {
+	return a.SaveTeamsWithMembersWithContext(context.Background(), teams)
+}
+
+// SaveTeamsWithMembersWithContext creates the teams and their members all or none,
+// joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) SaveTeamsWithMembersWithContext(ctx context.Context, teams []*port.TeamWithMembers) error {
+	for _, team := range teams {
+		if err := team.Team.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return transaction.DB(ctx, a.db).Transaction(func(tx *gorm.DB) error {
+		for _, team := range teams {
+			if err := tx.Create(team.Team).Error; err != nil {
+				return a.translateError(err)
+			}
+
+			for _, member := range team.Members {
+				member.TeamID = team.Team.TeamID
+				if err := member.Validate(); err != nil {
+					return err
+				}
+			}
+			if len(team.Members) == 0 {
+				continue
+			}
+			if err := tx.Create(&team.Members).Error; err != nil {
+				return a.translateMemberError(err)
+			}
+		}
+		return nil
+	})
+}
+
 func (a *TeamDatabaseAdapter) GetMemberByID(id int64) (*domain.TeamMember, error) {
 	var member domain.TeamMember
 	result := a.db.First(&member, id)
@@ -201,11 +301,16 @@ func (a *TeamDatabaseAdapter) GetLeaderByTeamID(teamID int64) (*domain.TeamMembe
 }
 
 func (a *TeamDatabaseAdapter) UpdateMember(member *domain.TeamMember) error {
+	return a.UpdateMemberWithContext(context.Background(), member)
+}
+
+// UpdateMemberWithContext saves the member, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) UpdateMemberWithContext(ctx context.Context, member *domain.TeamMember) error {
 	if err := member.Validate(); err != nil {
 		return err
 	}
 
-	result := a.db.Save(member)
+	result := transaction.DB(ctx, a.db).Save(member)
 	if result.Error != nil {
 		return a.translateMemberError(result.Error)
 	}
@@ -228,7 +333,12 @@ func (a *TeamDatabaseAdapter) DeleteMember(id int64) error {
 }
 
 func (a *TeamDatabaseAdapter) DeleteMemberByTeamAndUser(teamID, userID int64) error {
-	result := a.db.Where("team_id = ? AND user_id = ?", teamID, userID).Delete(&domain.TeamMember{})
+	return a.DeleteMemberByTeamAndUserWithContext(context.Background(), teamID, userID)
+}
+
+// DeleteMemberByTeamAndUserWithContext deletes the member, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) DeleteMemberByTeamAndUserWithContext(ctx context.Context, teamID, userID int64) error {
+	result := transaction.DB(ctx, a.db).Where("team_id = ? AND user_id = ?", teamID, userID).Delete(&domain.TeamMember{})
 
 	if result.Error != nil {
 		return a.translateMemberError(result.Error)
@@ -242,7 +352,12 @@ func (a *TeamDatabaseAdapter) DeleteMemberByTeamAndUser(teamID, userID int64) er
 }
 
 func (a *TeamDatabaseAdapter) DeleteAllMembersByTeamID(teamID int64) error {
-	result := a.db.Where("team_id = ?", teamID).Delete(&domain.TeamMember{})
+	return a.DeleteAllMembersByTeamIDWithContext(context.Background(), teamID)
+}
+
+// DeleteAllMembersByTeamIDWithContext deletes the team's members, joining the transaction carried by ctx if any
+func (a *TeamDatabaseAdapter) DeleteAllMembersByTeamIDWithContext(ctx context.Context, teamID int64) error {
+	result := transaction.DB(ctx, a.db).Where("team_id = ?", teamID).Delete(&domain.TeamMember{})
 
 	if result.Error != nil {
 		return a.translateMemberError(result.Error)
@@ -0,0 +1,148 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// deadLetterRequeueDelay throttles redelivery when recording a dead letter fails (e.g. DB outage)
+const deadLetterRequeueDelay = 5 * time.Second
+
+// TeamDeadLetterConsumerRabbitMQAdapter implements TeamDeadLetterConsumerPort
+type TeamDeadLetterConsumerRabbitMQAdapter struct {
+	connection *config.RabbitMQConnection
+	running    bool
+	stopCh     chan struct{}
+	mu         sync.RWMutex
+}
+
+// NewTeamDeadLetterConsumerRabbitMQAdapter creates a new dead-letter consumer adapter
+func NewTeamDeadLetterConsumerRabbitMQAdapter(connection *config.RabbitMQConnection) *TeamDeadLetterConsumerRabbitMQAdapter {
+	return &TeamDeadLetterConsumerRabbitMQAdapter{
+		connection: connection,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start begins consuming messages from the dead-letter queue
+func (a *TeamDeadLetterConsumerRabbitMQAdapter) Start(
+	ctx context.Context,
+	handler port.TeamDeadLetterHandler,
+) error {
+	a.mu.Lock()
+	if a.running {
+		a.mu.Unlock()
+		return fmt.Errorf("consumer is already running")
+	}
+	a.running = true
+	a.stopCh = make(chan struct{})
+	a.mu.Unlock()
+
+	channel, err := a.connection.GetChannel()
+	if err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	deliveries, err := channel.Consume(
+		config.TeamPersistenceDLQ, // queue
+		"",                        // consumer tag (auto-generated)
+		false,                     // auto-ack (manual ack for reliability)
+		false,                     // exclusive
+		false,                     // no-local
+		false,                     // no-wait
+		nil,                       // args
+	)
+	if err != nil {
+		a.setRunning(false)
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	log.Printf("Team dead-letter consumer started, listening on queue: %s", config.TeamPersistenceDLQ)
+
+	go a.processMessages(ctx, deliveries, handler)
+
+	return nil
+}
+
+// processMessages handles incoming dead-lettered messages
+func (a *TeamDeadLetterConsumerRabbitMQAdapter) processMessages(
+	ctx context.Context,
+	deliveries <-chan amqp.Delivery,
+	handler port.TeamDeadLetterHandler,
+) {
+	for {
+		select {
+		case <-a.stopCh:
+			log.Println("Team dead-letter consumer stopped")
+			return
+		case <-ctx.Done():
+			log.Println("Team dead-letter consumer context cancelled")
+			a.setRunning(false)
+			return
+		case delivery, ok := <-deliveries:
+			if !ok {
+				log.Println("Team dead-letter consumer channel closed")
+				a.setRunning(false)
+				return
+			}
+			a.handleDelivery(ctx, delivery, handler)
+		}
+	}
+}
+
+// handleDelivery records a single dead-lettered message
+func (a *TeamDeadLetterConsumerRabbitMQAdapter) handleDelivery(
+	ctx context.Context,
+	delivery amqp.Delivery,
+	handler port.TeamDeadLetterHandler,
+) {
+	processCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := handler(processCtx, delivery.Body); err != nil {
+		log.Printf("Failed to record dead-lettered team persistence event, requeueing: %v", err)
+		time.Sleep(deadLetterRequeueDelay)
+		_ = delivery.Nack(false, true)
+		return
+	}
+
+	if err := delivery.Ack(false); err != nil {
+		log.Printf("Failed to ack dead-lettered message: %v", err)
+	}
+}
+
+// Stop gracefully stops the consumer
+func (a *TeamDeadLetterConsumerRabbitMQAdapter) Stop() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.running {
+		return nil
+	}
+
+	close(a.stopCh)
+	a.running = false
+	return nil
+}
+
+// IsRunning returns whether the consumer is currently running
+func (a *TeamDeadLetterConsumerRabbitMQAdapter) IsRunning() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.running
+}
+
+// setRunning safely sets the running state
+func (a *TeamDeadLetterConsumerRabbitMQAdapter) setRunning(running bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.running = running
+}
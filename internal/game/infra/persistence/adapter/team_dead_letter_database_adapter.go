@@ -0,0 +1,76 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TeamDeadLetterDatabaseAdapter implements TeamDeadLetterDatabasePort using GORM
+type TeamDeadLetterDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewTeamDeadLetterDatabaseAdapter(db *gorm.DB) *TeamDeadLetterDatabaseAdapter {
+	return &TeamDeadLetterDatabaseAdapter{db: db}
+}
+
+func (a *TeamDeadLetterDatabaseAdapter) SaveDeadLetter(deadLetter *domain.TeamDeadLetter) (*domain.TeamDeadLetter, error) {
+	if err := a.db.Create(deadLetter).Error; err != nil {
+		return nil, err
+	}
+	return deadLetter, nil
+}
+
+func (a *TeamDeadLetterDatabaseAdapter) GetDeadLetterByID(deadLetterID int64) (*domain.TeamDeadLetter, error) {
+	var deadLetter domain.TeamDeadLetter
+	if err := a.db.Where("dead_letter_id = ?", deadLetterID).First(&deadLetter).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrDeadLetterNotFound
+		}
+		return nil, err
+	}
+	return &deadLetter, nil
+}
+
+func (a *TeamDeadLetterDatabaseAdapter) GetDeadLetters(offset, limit int, status *domain.DeadLetterStatus) ([]*domain.TeamDeadLetter, int64, error) {
+	var deadLetters []*domain.TeamDeadLetter
+	var totalCount int64
+
+	query := a.db.Model(&domain.TeamDeadLetter{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&deadLetters).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deadLetters, totalCount, nil
+}
+
+func (a *TeamDeadLetterDatabaseAdapter) UpdateDeadLetter(deadLetter *domain.TeamDeadLetter) error {
+	return a.db.Save(deadLetter).Error
+}
+
+func (a *TeamDeadLetterDatabaseAdapter) HasProcessedEvent(idempotencyKey string) (bool, error) {
+	var count int64
+	err := a.db.Model(&domain.ProcessedTeamEvent{}).
+		Where("idempotency_key = ?", idempotencyKey).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (a *TeamDeadLetterDatabaseAdapter) SaveProcessedEvent(event *domain.ProcessedTeamEvent) error {
+	return a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(event).Error
+}
@@ -0,0 +1,158 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	outboxPort "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamEventPublisherOutboxAdapter writes team events to the transactional outbox; the outbox relay publishes them to RabbitMQ
+type TeamEventPublisherOutboxAdapter struct {
+	outbox   outboxPort.OutboxPort
+	exchange string
+}
+
+func NewTeamEventPublisherOutboxAdapter(outbox outboxPort.OutboxPort, exchange string) *TeamEventPublisherOutboxAdapter {
+	return &TeamEventPublisherOutboxAdapter{
+		outbox:   outbox,
+		exchange: exchange,
+	}
+}
+
+func (a *TeamEventPublisherOutboxAdapter) PublishTeamInviteEvent(
+	ctx context.Context,
+	event *port.TeamInviteEvent,
+) error {
+	// Ensure event has ID and timestamp
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.enqueue(ctx, event.ContestID, event.EventID, event.EventType, event.Timestamp, event, map[string]interface{}{
+		"event_type":              string(event.EventType),
+		"contest_id":              event.ContestID,
+		"inviter_user_id":         event.InviterUserID,
+		"inviter_discord_id":      event.InviterDiscordID,
+		"invitee_user_id":         event.InviteeUserID,
+		"invitee_discord_id":      event.InviteeDiscordID,
+		"discord_guild_id":        event.DiscordGuildID,
+		"discord_text_channel_id": event.DiscordTextChannelID,
+	})
+}
+
+func (a *TeamEventPublisherOutboxAdapter) PublishTeamMemberEvent(
+	ctx context.Context,
+	event *port.TeamMemberEvent,
+) error {
+	// Ensure event has ID and timestamp
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.enqueue(ctx, event.ContestID, event.EventID, event.EventType, event.Timestamp, event, map[string]interface{}{
+		"event_type":              string(event.EventType),
+		"contest_id":              event.ContestID,
+		"user_id":                 event.UserID,
+		"discord_user_id":         event.DiscordUserID,
+		"discord_guild_id":        event.DiscordGuildID,
+		"discord_text_channel_id": event.DiscordTextChannelID,
+		"current_member_count":    event.CurrentMemberCount,
+		"max_members":             event.MaxMembers,
+	})
+}
+
+func (a *TeamEventPublisherOutboxAdapter) PublishTeamFinalizedEvent(
+	ctx context.Context,
+	event *port.TeamFinalizedEvent,
+) error {
+	// Ensure event has ID and timestamp
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.enqueue(ctx, event.ContestID, event.EventID, event.EventType, event.Timestamp, event, map[string]interface{}{
+		"event_type":              string(event.EventType),
+		"contest_id":              event.ContestID,
+		"leader_user_id":          event.LeaderUserID,
+		"leader_discord_id":       event.LeaderDiscordID,
+		"discord_guild_id":        event.DiscordGuildID,
+		"discord_text_channel_id": event.DiscordTextChannelID,
+		"member_count":            event.MemberCount,
+	})
+}
+
+func (a *TeamEventPublisherOutboxAdapter) PublishContestTeamsReadyEvent(
+	ctx context.Context,
+	event *port.ContestTeamsReadyEvent,
+) error {
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	return a.enqueue(ctx, event.ContestID, event.EventID, event.EventType, event.Timestamp, event, map[string]interface{}{
+		"event_type":              string(event.EventType),
+		"contest_id":              event.ContestID,
+		"finalized_team_count":    event.FinalizedTeamCount,
+		"max_team_count":          event.MaxTeamCount,
+		"discord_guild_id":        event.DiscordGuildID,
+		"discord_text_channel_id": event.DiscordTextChannelID,
+	})
+}
+
+// enqueue stores the event in the outbox, ordered with the other events of its contest
+func (a *TeamEventPublisherOutboxAdapter) enqueue(
+	ctx context.Context,
+	contestID int64,
+	eventID string,
+	eventType port.TeamEventType,
+	timestamp time.Time,
+	event interface{},
+	headers map[string]interface{},
+) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return a.outbox.Enqueue(ctx, &outboxPort.OutboxMessage{
+		AggregateType: outboxPort.AggregateContest,
+		AggregateID:   contestID,
+		Exchange:      a.exchange,
+		RoutingKey:    a.buildRoutingKey(eventType),
+		MessageID:     eventID,
+		Body:          body,
+		Headers:       headers,
+		OccurredAt:    timestamp,
+	})
+}
+
+func (a *TeamEventPublisherOutboxAdapter) buildRoutingKey(eventType port.TeamEventType) string {
+	return fmt.Sprintf("game.%s", eventType)
+}
+
+// Close is a no-op; the RabbitMQ connection is owned by the outbox relay
+func (a *TeamEventPublisherOutboxAdapter) Close() error {
+	return nil
+}
+
+// HealthCheck always succeeds; events are buffered in the outbox while the broker is down
+func (a *TeamEventPublisherOutboxAdapter) HealthCheck(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,134 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	outboxPort "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamPersistencePublisherOutboxAdapter implements TeamPersistencePublisherPort.
+// Events go through the transactional outbox, so write-behind events of a contest reach the consumer in order.
+type TeamPersistencePublisherOutboxAdapter struct {
+	outbox   outboxPort.OutboxPort
+	exchange string
+}
+
+// NewTeamPersistencePublisherOutboxAdapter creates a new publisher adapter
+func NewTeamPersistencePublisherOutboxAdapter(
+	outbox outboxPort.OutboxPort,
+	exchange string,
+) *TeamPersistencePublisherOutboxAdapter {
+	return &TeamPersistencePublisherOutboxAdapter{
+		outbox:   outbox,
+		exchange: exchange,
+	}
+}
+
+// PublishTeamCreated publishes an event when a team is created in cache
+func (a *TeamPersistencePublisherOutboxAdapter) PublishTeamCreated(
+	ctx context.Context,
+	event *port.TeamPersistenceEvent,
+) error {
+	event.EventType = port.TeamPersistenceCreated
+	return a.publish(ctx, event)
+}
+
+// PublishMemberAdded publishes an event when a member is added to a team
+func (a *TeamPersistencePublisherOutboxAdapter) PublishMemberAdded(
+	ctx context.Context,
+	event *port.TeamPersistenceEvent,
+) error {
+	event.EventType = port.TeamPersistenceMemberAdded
+	return a.publish(ctx, event)
+}
+
+// PublishMemberRemoved publishes an event when a member is removed from a team
+func (a *TeamPersistencePublisherOutboxAdapter) PublishMemberRemoved(
+	ctx context.Context,
+	event *port.TeamPersistenceEvent,
+) error {
+	event.EventType = port.TeamPersistenceMemberRemoved
+	return a.publish(ctx, event)
+}
+
+// PublishTeamFinalized publishes an event when a team is finalized
+func (a *TeamPersistencePublisherOutboxAdapter) PublishTeamFinalized(
+	ctx context.Context,
+	event *port.TeamPersistenceEvent,
+) error {
+	event.EventType = port.TeamPersistenceFinalized
+	return a.publish(ctx, event)
+}
+
+// PublishTeamDeleted publishes an event when a team is deleted
+func (a *TeamPersistencePublisherOutboxAdapter) PublishTeamDeleted(
+	ctx context.Context,
+	event *port.TeamPersistenceEvent,
+) error {
+	event.EventType = port.TeamPersistenceDeleted
+	return a.publish(ctx, event)
+}
+
+// publish stores the event in the outbox
+func (a *TeamPersistencePublisherOutboxAdapter) publish(
+	ctx context.Context,
+	event *port.TeamPersistenceEvent,
+) error {
+	// Ensure event has ID and timestamp
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	if event.IdempotencyKey == "" {
+		event.IdempotencyKey = event.EventID
+	}
+
+	// Serialize event to JSON
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	// Build routing key from event type
+	routingKey := string(event.EventType)
+
+	err = a.outbox.Enqueue(ctx, &outboxPort.OutboxMessage{
+		AggregateType: outboxPort.AggregateContest,
+		AggregateID:   event.ContestID,
+		Exchange:      a.exchange,
+		RoutingKey:    routingKey,
+		MessageID:     event.EventID,
+		Body:          body,
+		Headers: map[string]interface{}{
+			"event_type":      string(event.EventType),
+			"contest_id":      event.ContestID,
+			"team_id":         event.TeamID,
+			"retry_count":     event.RetryCount,
+			"idempotency_key": event.IdempotencyKey,
+		},
+		OccurredAt: event.Timestamp,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+// Close is a no-op; the RabbitMQ connection is owned by the outbox relay
+func (a *TeamPersistencePublisherOutboxAdapter) Close() error {
+	return nil
+}
+
+// HealthCheck always succeeds; events are buffered in the outbox while the broker is down
+func (a *TeamPersistencePublisherOutboxAdapter) HealthCheck(ctx context.Context) error {
+	return nil
+}
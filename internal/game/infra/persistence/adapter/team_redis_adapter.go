@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -28,6 +29,7 @@ func (a *TeamRedisAdapter) CreateTeam(ctx context.Context, team *port.CachedTeam
 	pipe := a.client.Pipeline()
 
 	// Store team metadata
+	team.ModifiedAt = time.Now()
 	teamKey := utils.GetTeamKey(team.ContestID)
 	teamData, err := json.Marshal(team)
 	if err != nil {
@@ -84,6 +86,7 @@ func (a *TeamRedisAdapter) UpdateTeamCount(ctx context.Context, contestID int64,
 	}
 
 	team.CurrentCount = count
+	team.ModifiedAt = time.Now()
 	teamKey := utils.GetTeamKey(contestID)
 	teamData, err := json.Marshal(team)
 	if err != nil {
@@ -413,6 +416,7 @@ func (a *TeamRedisAdapter) TransferLeadership(ctx context.Context, contestID, cu
 		return err
 	}
 	team.LeaderUserID = newLeaderID
+	team.ModifiedAt = time.Now()
 	teamData, _ := json.Marshal(team)
 	teamKey := utils.GetTeamKey(contestID)
 	pipe.Set(ctx, teamKey, teamData, ttl)
@@ -441,6 +445,7 @@ func (a *TeamRedisAdapter) MarkAsFinalized(ctx context.Context, contestID int64)
 	now := time.Now()
 	team.IsFinalized = true
 	team.FinalizedAt = &now
+	team.ModifiedAt = now
 
 	teamKey := utils.GetTeamKey(contestID)
 	teamData, err := json.Marshal(team)
@@ -504,6 +509,12 @@ func (a *TeamRedisAdapter) IncrementFinalizedTeamCount(ctx context.Context, cont
 	return a.client.Incr(ctx, key).Result()
 }
 
+// DecrementFinalizedTeamCount atomically decrements the finalized team count when a finalized team is deleted
+func (a *TeamRedisAdapter) DecrementFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error) {
+	key := "contest:" + strconv.FormatInt(contestID, 10) + ":finalized_team_count"
+	return a.client.Decr(ctx, key).Result()
+}
+
 // GetFinalizedTeamCount returns the current finalized team count for a contest
 func (a *TeamRedisAdapter) GetFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error) {
 	key := "contest:" + strconv.FormatInt(contestID, 10) + ":finalized_team_count"
@@ -514,6 +525,12 @@ func (a *TeamRedisAdapter) GetFinalizedTeamCount(ctx context.Context, contestID
 	return val, err
 }
 
+// SetFinalizedTeamCount overwrites the finalized team count for a contest (used by reconciliation)
+func (a *TeamRedisAdapter) SetFinalizedTeamCount(ctx context.Context, contestID int64, count int64) error {
+	key := "contest:" + strconv.FormatInt(contestID, 10) + ":finalized_team_count"
+	return a.client.Set(ctx, key, count, 0).Err()
+}
+
 // ClearTeam removes all team-related data from Redis
 func (a *TeamRedisAdapter) ClearTeam(ctx context.Context, contestID int64) error {
 	// Get all members to clean up user tracking
@@ -578,3 +595,17 @@ func (a *TeamRedisAdapter) ExtendTTL(ctx context.Context, contestID int64, newTT
 
 	return nil
 }
+
+// AcquireReconcileLock takes the contest's reconciliation lock using SETNX
+func (a *TeamRedisAdapter) AcquireReconcileLock(ctx context.Context, contestID int64, ttl time.Duration) (bool, error) {
+	result, err := a.client.SetNX(ctx, utils.GetTeamReconcileLockKey(contestID), fmt.Sprintf("locked:%d", time.Now().UnixMilli()), ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	return result, nil
+}
+
+// ReleaseReconcileLock releases the contest's reconciliation lock
+func (a *TeamRedisAdapter) ReleaseReconcileLock(ctx context.Context, contestID int64) error {
+	return a.client.Del(ctx, utils.GetTeamReconcileLockKey(contestID)).Err()
+}
@@ -0,0 +1,58 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AchievementController struct {
+	router  *router.Router
+	service *application.AchievementService
+	helper  *handler.ControllerHelper
+}
+
+func NewAchievementController(
+	router *router.Router,
+	service *application.AchievementService,
+	helper *handler.ControllerHelper,
+) *AchievementController {
+	return &AchievementController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *AchievementController) RegisterRoutes() {
+	// The wildcard must be named :id like the user profile routes sharing the /api/users prefix
+	userGroup := c.router.PublicGroup("/api/users")
+	{
+		userGroup.GET("/:id/badges", c.GetUserBadges)
+	}
+}
+
+// GetUserBadges godoc
+// @Summary Get user badges
+// @Description Returns the achievement badges a user earned, oldest first: first contest, squad up, 10 contests played, a 30-kill match, a 13-0 win and a tournament win
+// @Tags games, achievements
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} response.Response{data=gameDto.UserBadgesResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/users/{id}/badges [get]
+func (c *AchievementController) GetUserBadges(ctx *gin.Context) {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	result, err := c.service.GetBadges(userID)
+	c.helper.RespondOK(ctx, result, err, "user badges retrieved successfully")
+}
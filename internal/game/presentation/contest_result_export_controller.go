@@ -0,0 +1,98 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContestResultExportController struct {
+	router  *router.Router
+	service *application.ContestResultExportService
+	helper  *handler.ControllerHelper
+}
+
+func NewContestResultExportController(
+	router *router.Router,
+	service *application.ContestResultExportService,
+	helper *handler.ControllerHelper,
+) *ContestResultExportController {
+	return &ContestResultExportController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *ContestResultExportController) RegisterRoutes() {
+	contestGroup := c.router.ProtectedGroup("/api/contests")
+	{
+		contestGroup.GET("/:id/result/export", c.ExportContestResult)
+	}
+}
+
+// ExportContestResult godoc
+// @Summary Export contest results
+// @Description Downloads final placements, team rosters with Riot and Discord IDs, per-game scores and maps, and per-player aggregated stats. JSON holds every section; CSV holds the section chosen by the section parameter. Requires the EXPORT_RESULTS contest permission.
+// @Tags games
+// @Produce json
+// @Produce text/csv
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param format query string false "Export format" Enums(json, csv) default(json)
+// @Param section query string false "CSV section" Enums(placements, rosters, games, players) default(placements)
+// @Success 200 {object} gameDto.ContestResultExport
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/result/export [get]
+func (c *ContestResultExportController) ExportContestResult(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req gameDto.ContestResultExportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	body, contentType, err := c.service.Export(contestID, userID, &req)
+	if err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportFileName(contestID, &req)))
+	ctx.Data(http.StatusOK, contentType, body)
+}
+
+// exportFileName names CSV files after their section, since each CSV holds a single table
+func exportFileName(contestID int64, req *gameDto.ContestResultExportRequest) string {
+	if req.Format != gameDto.ResultExportFormatCSV {
+		return fmt.Sprintf("contest-%d-result.json", contestID)
+	}
+
+	section := req.Section
+	if section == "" {
+		section = gameDto.ResultExportSectionPlacements
+	}
+	return fmt.Sprintf("contest-%d-%s.csv", contestID, section)
+}
@@ -0,0 +1,163 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+type GameCalendarController struct {
+	router  *router.Router
+	service *application.GameCalendarService
+	helper  *handler.ControllerHelper
+}
+
+func NewGameCalendarController(
+	router *router.Router,
+	service *application.GameCalendarService,
+	helper *handler.ControllerHelper,
+) *GameCalendarController {
+	return &GameCalendarController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *GameCalendarController) RegisterRoutes() {
+	contestGroup := c.router.OptionalAuthGroup("/api/contests")
+	{
+		contestGroup.GET("/:id/calendar.ics", c.GetContestCalendar)
+	}
+
+	privateGroup := c.router.ProtectedGroup("/api/calendar/me")
+	{
+		privateGroup.GET("", c.GetMyCalendarFeed)
+		privateGroup.POST("/regenerate", c.RegenerateMyCalendarFeed)
+	}
+
+	// Calendar apps cannot authenticate, so the user feed is authorized by its token alone
+	publicGroup := c.router.PublicGroup("/api/calendar/feeds")
+	{
+		publicGroup.GET("/:token", c.GetUserCalendar)
+	}
+}
+
+// GetContestCalendar godoc
+// @Summary Get the iCalendar feed of a contest
+// @Description iCalendar (RFC 5545) feed of every scheduled game in the contest with its teams and round name. Cancelled games are left out. Private contests are only visible to users with access.
+// @Tags calendars
+// @Produce text/calendar
+// @Param id path int true "Contest ID"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/calendar.ics [get]
+func (c *GameCalendarController) GetContestCalendar(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+
+	calendar, err := c.service.GetContestCalendar(contestID, userID)
+	if err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Data(http.StatusOK, calendarContentType, calendar)
+}
+
+// GetUserCalendar godoc
+// @Summary Get a private user iCalendar feed
+// @Description iCalendar (RFC 5545) feed of every game the token owner's teams play across contests. The token is the only credential; an optional .ics suffix is accepted.
+// @Tags calendars
+// @Produce text/calendar
+// @Param token path string true "Feed token"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 404 {object} response.Response
+// @Router /api/calendar/feeds/{token} [get]
+func (c *GameCalendarController) GetUserCalendar(ctx *gin.Context) {
+	token := strings.TrimSuffix(ctx.Param("token"), ".ics")
+
+	calendar, err := c.service.GetUserCalendar(token)
+	if err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
+	ctx.Data(http.StatusOK, calendarContentType, calendar)
+}
+
+// GetMyCalendarFeed godoc
+// @Summary Get my private calendar feed URL
+// @Description Returns the URL of the private iCalendar feed of the user's matches, issuing it on first request
+// @Tags calendars
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=gameDto.CalendarFeedResponse}
+// @Failure 401 {object} response.Response
+// @Router /api/calendar/me [get]
+func (c *GameCalendarController) GetMyCalendarFeed(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	token, err := c.service.GetFeedToken(userID)
+	c.respondFeed(ctx, token, err, "calendar feed retrieved successfully")
+}
+
+// RegenerateMyCalendarFeed godoc
+// @Summary Regenerate my private calendar feed URL
+// @Description Issues a new feed URL; subscriptions to the previous URL stop receiving updates
+// @Tags calendars
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=gameDto.CalendarFeedResponse}
+// @Failure 401 {object} response.Response
+// @Router /api/calendar/me/regenerate [post]
+func (c *GameCalendarController) RegenerateMyCalendarFeed(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	token, err := c.service.RegenerateFeedToken(userID)
+	c.respondFeed(ctx, token, err, "calendar feed regenerated successfully")
+}
+
+func (c *GameCalendarController) respondFeed(ctx *gin.Context, token *domain.CalendarFeedToken, err error, msg string) {
+	if err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
+	c.helper.RespondOK(ctx, gameDto.ToCalendarFeedResponse(token, feedURL(ctx, token.Token)), nil, msg)
+}
+
+// feedURL builds the absolute feed URL from the request, honoring the proxy's forwarded scheme
+func feedURL(ctx *gin.Context, token string) string {
+	scheme := "http"
+	if ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/calendar/feeds/%s.ics", scheme, ctx.Request.Host, token)
+}
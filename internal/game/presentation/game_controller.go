@@ -2,6 +2,7 @@ package presentation
 
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
 	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
 	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
@@ -48,12 +49,12 @@ func (c *GameController) RegisterRoutes() {
 		privateGroup.POST("/:id/cancel", c.CancelGame)
 	}
 
-	publicGroup := c.router.PublicGroup("/api/games")
+	publicGroup := c.router.OptionalAuthGroup("/api/games")
 	{
 		publicGroup.GET("/:id", c.GetGame)
 	}
 
-	contestGamesGroup := c.router.PublicGroup("/api/contests")
+	contestGamesGroup := c.router.OptionalAuthGroup("/api/contests")
 	{
 		contestGamesGroup.GET("/:id/games", c.GetGamesByContest)
 	}
@@ -66,7 +67,7 @@ func (c *GameController) RegisterRoutes() {
 		contestGamesProtected.POST("/:id/games/:gameId/detect", c.TriggerDetection)
 	}
 
-	contestGamesPublic := c.router.PublicGroup("/api/contests")
+	contestGamesPublic := c.router.OptionalAuthGroup("/api/contests")
 	{
 		contestGamesPublic.GET("/:id/games/:gameId/detection-status", c.GetDetectionStatus)
 		contestGamesPublic.GET("/:id/games/:gameId/result", c.GetMatchResult)
@@ -77,7 +78,7 @@ func (c *GameController) RegisterRoutes() {
 
 // CreateGame godoc
 // @Summary Create a new game
-// @Description Create a new game under a contest (creator becomes the leader)
+// @Description Create a new game under a contest (requires MANAGE_GAMES)
 // @Tags games
 // @Accept json
 // @Produce json
@@ -86,6 +87,7 @@ func (c *GameController) RegisterRoutes() {
 // @Success 201 {object} response.Response{data=gameDto.GameResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
 // @Router /api/games [post]
 func (c *GameController) CreateGame(ctx *gin.Context) {
 	var req gameDto.CreateGameRequest
@@ -94,12 +96,17 @@ func (c *GameController) CreateGame(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckContestPermission(req.ContestID, userID, contestDomain.ContestActionManageGames); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	game, err := c.service.CreateGame(&req)
 	c.helper.RespondCreated(ctx, gameDto.ToGameResponse(game), err, "game created successfully")
 }
@@ -122,6 +129,12 @@ func (c *GameController) GetGame(ctx *gin.Context) {
 		return
 	}
 
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+	if err := c.service.CheckGameViewAccess(gameID, userID); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	game, err := c.service.GetGame(gameID)
 	c.helper.RespondOK(ctx, gameDto.ToGameResponse(game), err, "game retrieved successfully")
 }
@@ -143,6 +156,12 @@ func (c *GameController) GetGamesByContest(ctx *gin.Context) {
 		return
 	}
 
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+	if err := c.service.CheckContestViewAccess(contestID, userID); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	games, err := c.service.GetGamesByContest(contestID)
 	if err != nil {
 		c.helper.RespondOK(ctx, nil, err, "")
@@ -159,7 +178,7 @@ func (c *GameController) GetGamesByContest(ctx *gin.Context) {
 
 // UpdateGame godoc
 // @Summary Update a game
-// @Description Update game details by game ID (Leader only, PENDING status only)
+// @Description Update game details by game ID (requires MANAGE_GAMES, PENDING status only)
 // @Tags games
 // @Accept json
 // @Produce json
@@ -184,19 +203,24 @@ func (c *GameController) UpdateGame(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckGamePermission(gameID, userID, contestDomain.ContestActionManageGames); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	game, err := c.service.UpdateGame(gameID, &req)
 	c.helper.RespondOK(ctx, gameDto.ToGameResponse(game), err, "game updated successfully")
 }
 
 // DeleteGame godoc
 // @Summary Delete a game
-// @Description Delete a game by game ID (Leader only, PENDING status only)
+// @Description Delete a game by game ID (contest staff with MANAGE_GAMES or team leader, PENDING status only)
 // @Tags games
 // @Accept json
 // @Produce json
@@ -227,7 +251,7 @@ func (c *GameController) DeleteGame(ctx *gin.Context) {
 
 // StartGame godoc
 // @Summary Start a game
-// @Description Transition game status from PENDING to ACTIVE (Leader only)
+// @Description Transition game status from PENDING to ACTIVE (requires MANAGE_GAMES)
 // @Tags games
 // @Accept json
 // @Produce json
@@ -246,19 +270,24 @@ func (c *GameController) StartGame(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckGamePermission(gameID, userID, contestDomain.ContestActionManageGames); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	game, err := c.service.StartGame(gameID)
 	c.helper.RespondOK(ctx, gameDto.ToGameResponse(game), err, "game started successfully")
 }
 
 // FinishGame godoc
 // @Summary Finish a game
-// @Description Transition game status from ACTIVE to FINISHED (Leader only)
+// @Description Transition game status from ACTIVE to FINISHED (requires MANAGE_GAMES)
 // @Tags games
 // @Accept json
 // @Produce json
@@ -277,19 +306,24 @@ func (c *GameController) FinishGame(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckGamePermission(gameID, userID, contestDomain.ContestActionManageGames); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	game, err := c.service.FinishGame(gameID)
 	c.helper.RespondOK(ctx, gameDto.ToGameResponse(game), err, "game finished successfully")
 }
 
 // CancelGame godoc
 // @Summary Cancel a game
-// @Description Transition game status to CANCELLED (Leader only)
+// @Description Transition game status to CANCELLED (contest staff with MANAGE_GAMES or team leader)
 // @Tags games
 // @Accept json
 // @Produce json
@@ -320,7 +354,7 @@ func (c *GameController) CancelGame(ctx *gin.Context) {
 
 // ScheduleGame godoc
 // @Summary Set game scheduled start time
-// @Description Staff sets the scheduled start time and detection window for a tournament game
+// @Description Staff sets the scheduled start time and detection window for a tournament game (requires MANAGE_GAMES)
 // @Tags games, match-detection
 // @Accept json
 // @Produce json
@@ -331,6 +365,7 @@ func (c *GameController) CancelGame(ctx *gin.Context) {
 // @Success 200 {object} response.Response{data=gameDto.ScheduleGameResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
 // @Router /api/contests/{contestId}/games/{gameId}/schedule [put]
 func (c *GameController) ScheduleGame(ctx *gin.Context) {
@@ -340,12 +375,17 @@ func (c *GameController) ScheduleGame(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckGamePermission(gameID, userID, contestDomain.ContestActionManageGames); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	var req gameDto.ScheduleGameRequest
 	if !c.helper.BindJSON(ctx, &req) {
 		return
@@ -362,7 +402,7 @@ func (c *GameController) ScheduleGame(ctx *gin.Context) {
 
 // SubmitManualResult godoc
 // @Summary Submit manual game result
-// @Description Staff manually inputs the game result (fallback when auto-detection fails)
+// @Description Staff manually inputs the game result (fallback when auto-detection fails, requires SUBMIT_RESULTS)
 // @Tags games, match-detection
 // @Accept json
 // @Produce json
@@ -373,6 +413,7 @@ func (c *GameController) ScheduleGame(ctx *gin.Context) {
 // @Success 201 {object} response.Response{data=gameDto.MatchResultResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
 // @Router /api/contests/{contestId}/games/{gameId}/result [post]
 func (c *GameController) SubmitManualResult(ctx *gin.Context) {
@@ -382,12 +423,17 @@ func (c *GameController) SubmitManualResult(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckGamePermission(gameID, userID, contestDomain.ContestActionSubmitResults); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	var req gameDto.ManualResultRequest
 	if !c.helper.BindJSON(ctx, &req) {
 		return
@@ -419,6 +465,12 @@ func (c *GameController) GetDetectionStatus(ctx *gin.Context) {
 		return
 	}
 
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+	if err := c.service.CheckGameViewAccess(gameID, userID); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	game, err := c.service.GetDetectionStatus(gameID)
 	if err != nil {
 		c.helper.HandleError(ctx, err)
@@ -445,6 +497,12 @@ func (c *GameController) GetMatchResult(ctx *gin.Context) {
 		return
 	}
 
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+	if err := c.service.CheckGameViewAccess(gameID, userID); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	result, err := c.matchDetectionSvc.GetMatchResult(gameID)
 	c.helper.RespondOK(ctx, result, err, "match result retrieved")
 }
@@ -466,13 +524,19 @@ func (c *GameController) GetMatchResultWithStats(ctx *gin.Context) {
 		return
 	}
 
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+	if err := c.service.CheckGameViewAccess(gameID, userID); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	result, err := c.matchDetectionSvc.GetMatchResultWithStats(gameID)
 	c.helper.RespondOK(ctx, result, err, "match result with stats retrieved")
 }
 
 // TriggerDetection godoc
 // @Summary Manually trigger match detection
-// @Description Staff manually triggers match detection for a specific game
+// @Description Staff manually triggers match detection for a specific game (requires SUBMIT_RESULTS)
 // @Tags games, match-detection
 // @Produce json
 // @Security BearerAuth
@@ -481,6 +545,7 @@ func (c *GameController) GetMatchResultWithStats(ctx *gin.Context) {
 // @Success 200 {object} response.Response
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
 // @Failure 404 {object} response.Response
 // @Router /api/contests/{contestId}/games/{gameId}/detect [post]
 func (c *GameController) TriggerDetection(ctx *gin.Context) {
@@ -490,12 +555,17 @@ func (c *GameController) TriggerDetection(ctx *gin.Context) {
 		return
 	}
 
-	_, ok := middleware.GetUserIdFromContext(ctx)
+	userID, ok := middleware.GetUserIdFromContext(ctx)
 	if !ok {
 		response.JSON(ctx, response.Error(401, "user not authenticated"))
 		return
 	}
 
+	if err := c.service.CheckGamePermission(gameID, userID, contestDomain.ContestActionSubmitResults); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	err = c.matchDetectionSvc.DetectMatchForGame(gameID)
 	if err != nil {
 		c.helper.HandleError(ctx, err)
@@ -522,6 +592,12 @@ func (c *GameController) GetContestResult(ctx *gin.Context) {
 		return
 	}
 
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+	if err := c.service.CheckContestViewAccess(contestID, userID); err != nil {
+		c.helper.HandleError(ctx, err)
+		return
+	}
+
 	result, err := c.tournamentResultSvc.GetContestResult(contestID)
 	c.helper.RespondOK(ctx, result, err, "contest result retrieved")
 }
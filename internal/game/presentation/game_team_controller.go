@@ -1,6 +1,7 @@
 package presentation
 
 import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
 	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
@@ -36,12 +37,12 @@ func (c *GameTeamController) RegisterRoutes() {
 		privateGroup.DELETE("/:id", c.DeleteGameTeam)
 	}
 
-	publicGroup := c.router.PublicGroup("/api/game-teams")
+	publicGroup := c.router.OptionalAuthGroup("/api/game-teams")
 	{
 		publicGroup.GET("/:id", c.GetGameTeam)
 	}
 
-	gameTeamsGroup := c.router.PublicGroup("/api/games/:id/game-teams")
+	gameTeamsGroup := c.router.OptionalAuthGroup("/api/games/:id/game-teams")
 	{
 		gameTeamsGroup.GET("", c.GetGameTeamsByGame)
 	}
@@ -90,7 +91,9 @@ func (c *GameTeamController) GetGameTeam(ctx *gin.Context) {
 		return
 	}
 
-	gameTeam, err := c.service.GetGameTeam(gameTeamID)
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+
+	gameTeam, err := c.service.GetGameTeam(gameTeamID, userID)
 	c.helper.RespondOK(ctx, gameDto.ToGameTeamResponse(gameTeam), err, "game team retrieved successfully")
 }
 
@@ -111,7 +114,9 @@ func (c *GameTeamController) GetGameTeamsByGame(ctx *gin.Context) {
 		return
 	}
 
-	gameTeams, err := c.service.GetGameTeamsByGame(gameID)
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+
+	gameTeams, err := c.service.GetGameTeamsByGame(gameID, userID)
 	if err != nil {
 		c.helper.RespondOK(ctx, nil, err, "")
 		return
@@ -0,0 +1,123 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LeaderboardController struct {
+	router  *router.Router
+	service *application.LeaderboardService
+	helper  *handler.ControllerHelper
+}
+
+func NewLeaderboardController(
+	router *router.Router,
+	service *application.LeaderboardService,
+	helper *handler.ControllerHelper,
+) *LeaderboardController {
+	return &LeaderboardController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *LeaderboardController) RegisterRoutes() {
+	publicGroup := c.router.PublicGroup("/api/leaderboards")
+	{
+		publicGroup.GET("/:subject/:metric", c.GetLeaderboard)
+	}
+
+	privateGroup := c.router.ProtectedGroup("/api/leaderboards")
+	{
+		privateGroup.GET("/:subject/:metric/me", c.GetMyPosition)
+	}
+
+	adminGroup := c.router.AdminGroup("/api/admin/leaderboards")
+	{
+		adminGroup.POST("/rebuild", c.RebuildLeaderboards)
+	}
+}
+
+// GetLeaderboard godoc
+// @Summary Get a leaderboard
+// @Description Ranks players or teams (stable rosters) by tournament wins, placement points from game grades, kills, average combat score (players with at least 50 rounds) or conservative Glicko-2 rating (all time only). Without season_id the all-time leaderboard is returned; with it, the leaderboard of that contest series.
+// @Tags leaderboards
+// @Produce json
+// @Param subject path string true "Subject" Enums(players, teams)
+// @Param metric path string true "Metric" Enums(wins, placement, kills, acs, rating)
+// @Param season_id query int false "Contest series ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.Response{data=commonDto.PaginationResponse{data=[]gameDto.LeaderboardEntryResponse}}
+// @Failure 400 {object} response.Response
+// @Router /api/leaderboards/{subject}/{metric} [get]
+func (c *LeaderboardController) GetLeaderboard(ctx *gin.Context) {
+	var req gameDto.LeaderboardRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	subject, metric := leaderboardPath(ctx)
+	result, err := c.service.GetLeaderboard(subject, metric, &req)
+	c.helper.RespondOK(ctx, result, err, "leaderboard retrieved successfully")
+}
+
+// GetMyPosition godoc
+// @Summary Get my leaderboard position
+// @Description Returns the user's rank on a player leaderboard, or the ranks of every roster the user played in on a team leaderboard
+// @Tags leaderboards
+// @Produce json
+// @Security BearerAuth
+// @Param subject path string true "Subject" Enums(players, teams)
+// @Param metric path string true "Metric" Enums(wins, placement, kills, acs, rating)
+// @Param season_id query int false "Contest series ID"
+// @Success 200 {object} response.Response{data=gameDto.LeaderboardPositionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /api/leaderboards/{subject}/{metric}/me [get]
+func (c *LeaderboardController) GetMyPosition(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req gameDto.LeaderboardRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	subject, metric := leaderboardPath(ctx)
+	result, err := c.service.GetMyPosition(subject, metric, req.SeasonID, userID)
+	c.helper.RespondOK(ctx, result, err, "leaderboard position retrieved successfully")
+}
+
+// RebuildLeaderboards godoc
+// @Summary Rebuild leaderboards
+// @Description Clears every leaderboard and replays all finished games in the order they ended (Admin only)
+// @Tags admin-leaderboards
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response{data=gameDto.LeaderboardRebuildResponse}
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/admin/leaderboards/rebuild [post]
+func (c *LeaderboardController) RebuildLeaderboards(ctx *gin.Context) {
+	result, err := c.service.Rebuild(ctx.Request.Context())
+	c.helper.RespondOK(ctx, result, err, "leaderboards rebuilt successfully")
+}
+
+func leaderboardPath(ctx *gin.Context) (domain.LeaderboardSubject, domain.LeaderboardMetric) {
+	return domain.LeaderboardSubject(ctx.Param("subject")), domain.LeaderboardMetric(ctx.Param("metric"))
+}
@@ -0,0 +1,134 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MatchAwardController struct {
+	router  *router.Router
+	service *application.MatchAwardService
+	helper  *handler.ControllerHelper
+}
+
+func NewMatchAwardController(
+	router *router.Router,
+	service *application.MatchAwardService,
+	helper *handler.ControllerHelper,
+) *MatchAwardController {
+	return &MatchAwardController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *MatchAwardController) RegisterRoutes() {
+	contestGamesProtected := c.router.ProtectedGroup("/api/contests")
+	{
+		contestGamesProtected.POST("/:id/games/:gameId/mvp-vote", c.VoteMvp)
+		contestGamesProtected.GET("/:id/games/:gameId/mvp-vote", c.GetMyMvpVote)
+	}
+
+	// The wildcard must be named :id like the user profile routes sharing the /api/users prefix
+	userGroup := c.router.PublicGroup("/api/users")
+	{
+		userGroup.GET("/:id/trophies", c.GetUserTrophies)
+	}
+}
+
+// VoteMvp godoc
+// @Summary Vote for the match MVP
+// @Description Votes for the MVP of a detected match. Only players of the match lineup can vote, once, for another player of the lineup, until voting closes 24 hours after the result was recorded.
+// @Tags games, match-awards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param gameId path int true "Game ID"
+// @Param request body gameDto.MvpVoteRequest true "MVP vote"
+// @Success 201 {object} response.Response{data=gameDto.MyMvpVoteResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{contestId}/games/{gameId}/mvp-vote [post]
+func (c *MatchAwardController) VoteMvp(ctx *gin.Context) {
+	gameID, err := strconv.ParseInt(ctx.Param("gameId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid game id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req gameDto.MvpVoteRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	result, err := c.service.Vote(gameID, userID, &req)
+	c.helper.RespondCreated(ctx, result, err, "mvp vote submitted successfully")
+}
+
+// GetMyMvpVote godoc
+// @Summary Get my MVP vote
+// @Description Returns the user's MVP vote in a detected match and whether voting is still open
+// @Tags games, match-awards
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param gameId path int true "Game ID"
+// @Success 200 {object} response.Response{data=gameDto.MyMvpVoteResponse}
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{contestId}/games/{gameId}/mvp-vote [get]
+func (c *MatchAwardController) GetMyMvpVote(ctx *gin.Context) {
+	gameID, err := strconv.ParseInt(ctx.Param("gameId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid game id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	result, err := c.service.GetMyVote(gameID, userID)
+	c.helper.RespondOK(ctx, result, err, "mvp vote retrieved successfully")
+}
+
+// GetUserTrophies godoc
+// @Summary Get user trophies
+// @Description Adds up the match awards a user received: MVPs voted by match players, top average combat scores and most headshots
+// @Tags games, match-awards
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} response.Response{data=gameDto.UserTrophiesResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/users/{id}/trophies [get]
+func (c *MatchAwardController) GetUserTrophies(ctx *gin.Context) {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	result, err := c.service.GetUserTrophies(userID)
+	c.helper.RespondOK(ctx, result, err, "user trophies retrieved successfully")
+}
@@ -0,0 +1,151 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PickemController struct {
+	router  *router.Router
+	service *application.PickemService
+	helper  *handler.ControllerHelper
+}
+
+func NewPickemController(
+	router *router.Router,
+	service *application.PickemService,
+	helper *handler.ControllerHelper,
+) *PickemController {
+	return &PickemController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *PickemController) RegisterRoutes() {
+	contestProtected := c.router.ProtectedGroup("/api/contests")
+	{
+		contestProtected.PUT("/:id/games/:gameId/pickem", c.Predict)
+		contestProtected.GET("/:id/pickem/my", c.GetMyPicks)
+	}
+
+	contestGroup := c.router.OptionalAuthGroup("/api/contests")
+	{
+		contestGroup.GET("/:id/pickem/leaderboard", c.GetLeaderboard)
+	}
+}
+
+// Predict godoc
+// @Summary Predict the winner of a bracket game
+// @Description Records the user's pick'em prediction of a tournament bracket game, replacing their earlier pick. Both teams must be set, and predictions lock at the game's scheduled start time or when it activates. A correct pick earns 10 virtual points per bracket round.
+// @Tags games, pickem
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param contestId path int true "Contest ID"
+// @Param gameId path int true "Game ID"
+// @Param request body gameDto.PickemPredictionRequest true "Predicted winner"
+// @Success 200 {object} response.Response{data=gameDto.PickemPredictionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{contestId}/games/{gameId}/pickem [put]
+func (c *PickemController) Predict(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	gameID, err := strconv.ParseInt(ctx.Param("gameId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid game id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req gameDto.PickemPredictionRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	result, err := c.service.Predict(contestID, gameID, userID, &req)
+	c.helper.RespondOK(ctx, result, err, "pickem prediction saved successfully")
+}
+
+// GetMyPicks godoc
+// @Summary Get my pick'em predictions
+// @Description Returns the user's predictions in a tournament contest with the points and correct picks of the scored ones
+// @Tags games, pickem
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Success 200 {object} response.Response{data=gameDto.MyPickemResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/pickem/my [get]
+func (c *PickemController) GetMyPicks(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	result, err := c.service.GetMyPicks(contestID, userID)
+	c.helper.RespondOK(ctx, result, err, "pickem predictions retrieved successfully")
+}
+
+// GetLeaderboard godoc
+// @Summary Get the pick'em leaderboard
+// @Description Ranks the users of a tournament contest by the virtual points of their scored predictions, then by correct picks
+// @Tags games, pickem
+// @Produce json
+// @Param id path int true "Contest ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.Response{data=commonDto.PaginationResponse{data=[]gameDto.PickemStandingResponse}}
+// @Failure 400 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/pickem/leaderboard [get]
+func (c *PickemController) GetLeaderboard(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	var req gameDto.PickemLeaderboardRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+
+	result, err := c.service.GetLeaderboard(contestID, userID, &req)
+	c.helper.RespondOK(ctx, result, err, "pickem leaderboard retrieved successfully")
+}
@@ -0,0 +1,61 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlayerStatsController struct {
+	router  *router.Router
+	service *application.PlayerStatsService
+	helper  *handler.ControllerHelper
+}
+
+func NewPlayerStatsController(
+	router *router.Router,
+	service *application.PlayerStatsService,
+	helper *handler.ControllerHelper,
+) *PlayerStatsController {
+	return &PlayerStatsController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *PlayerStatsController) RegisterRoutes() {
+	// The wildcard must be named :id like the user profile routes sharing the /api/users prefix
+	userGroup := c.router.OptionalAuthGroup("/api/users")
+	{
+		userGroup.GET("/:id/stats", c.GetPlayerStats)
+	}
+}
+
+// GetPlayerStats godoc
+// @Summary Get player career statistics
+// @Description Lifetime and per-contest K/D/A, headshot percentage, average combat score, agent and map breakdowns, win rate and the last 10 games, aggregated from detected matches. Results are cached for up to 30 minutes and refreshed when a new match of the player is detected. Private contests the viewer cannot access are left out of the per-contest lines and recent form.
+// @Tags games
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} response.Response{data=domain.PlayerCareerStats}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/users/{id}/stats [get]
+func (c *PlayerStatsController) GetPlayerStats(ctx *gin.Context) {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	viewerID, _ := middleware.GetUserIdFromContext(ctx)
+
+	stats, err := c.service.GetPlayerStats(userID, viewerID)
+	c.helper.RespondOK(ctx, stats, err, "player stats retrieved successfully")
+}
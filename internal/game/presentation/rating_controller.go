@@ -0,0 +1,156 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RatingController struct {
+	router  *router.Router
+	service *application.RatingService
+	helper  *handler.ControllerHelper
+}
+
+func NewRatingController(
+	router *router.Router,
+	service *application.RatingService,
+	helper *handler.ControllerHelper,
+) *RatingController {
+	return &RatingController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *RatingController) RegisterRoutes() {
+	publicGroup := c.router.PublicGroup("/api/ratings")
+	{
+		publicGroup.GET("/players/:id", c.GetPlayerRating)
+		publicGroup.GET("/players/:id/history", c.GetPlayerRatingHistory)
+		publicGroup.GET("/teams/:rosterKey", c.GetTeamRating)
+		publicGroup.GET("/teams/:rosterKey/history", c.GetTeamRatingHistory)
+	}
+
+	contestGroup := c.router.OptionalAuthGroup("/api/contests")
+	{
+		contestGroup.GET("/:id/seeding", c.GetContestSeeding)
+	}
+}
+
+// GetPlayerRating godoc
+// @Summary Get a player's rating
+// @Description Returns the user's Glicko-2 rating. The deviation grows for every week without games, so it is shown decayed up to now. Users without rated games have the initial rating of 1500 ± 350.
+// @Tags ratings
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} response.Response{data=gameDto.PlayerRatingResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/ratings/players/{id} [get]
+func (c *RatingController) GetPlayerRating(ctx *gin.Context) {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	result, err := c.service.GetPlayerRating(userID)
+	c.helper.RespondOK(ctx, result, err, "player rating retrieved successfully")
+}
+
+// GetPlayerRatingHistory godoc
+// @Summary Get a player's rating history
+// @Description Returns the rating change of every rated game of the user, newest first
+// @Tags ratings
+// @Produce json
+// @Param id path int true "User ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.Response{data=commonDto.PaginationResponse{data=[]gameDto.RatingHistoryResponse}}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/ratings/players/{id}/history [get]
+func (c *RatingController) GetPlayerRatingHistory(ctx *gin.Context) {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid user id"))
+		return
+	}
+
+	var req gameDto.RatingHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	result, err := c.service.GetPlayerRatingHistory(userID, &req)
+	c.helper.RespondOK(ctx, result, err, "player rating history retrieved successfully")
+}
+
+// GetTeamRating godoc
+// @Summary Get a roster's rating
+// @Description Returns the Glicko-2 rating of a roster, identified by its member user IDs joined by '-' in any order. A roster keeps its rating across contests while its members stay together.
+// @Tags ratings
+// @Produce json
+// @Param rosterKey path string true "Member user IDs joined by '-'"
+// @Success 200 {object} response.Response{data=gameDto.TeamRatingResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/ratings/teams/{rosterKey} [get]
+func (c *RatingController) GetTeamRating(ctx *gin.Context) {
+	result, err := c.service.GetTeamRating(ctx.Param("rosterKey"))
+	c.helper.RespondOK(ctx, result, err, "team rating retrieved successfully")
+}
+
+// GetTeamRatingHistory godoc
+// @Summary Get a roster's rating history
+// @Description Returns the rating change of every rated game of the roster, newest first
+// @Tags ratings
+// @Produce json
+// @Param rosterKey path string true "Member user IDs joined by '-'"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} response.Response{data=commonDto.PaginationResponse{data=[]gameDto.RatingHistoryResponse}}
+// @Failure 400 {object} response.Response
+// @Router /api/ratings/teams/{rosterKey}/history [get]
+func (c *RatingController) GetTeamRatingHistory(ctx *gin.Context) {
+	var req gameDto.RatingHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		response.JSON(ctx, response.BadRequest("invalid query parameters"))
+		return
+	}
+
+	result, err := c.service.GetTeamRatingHistory(ctx.Param("rosterKey"), &req)
+	c.helper.RespondOK(ctx, result, err, "team rating history retrieved successfully")
+}
+
+// GetContestSeeding godoc
+// @Summary Get contest seeding by rating
+// @Description Orders the contest's registered teams by conservative rating (rating minus twice the deviation), for seeding the bracket. Rosters without rated games together are rated by the composite of their members. Waitlisted teams are left out.
+// @Tags ratings
+// @Produce json
+// @Param id path int true "Contest ID"
+// @Success 200 {object} response.Response{data=gameDto.ContestSeedingResponse}
+// @Failure 400 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/seeding [get]
+func (c *RatingController) GetContestSeeding(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, _ := middleware.GetUserIdFromContext(ctx)
+
+	result, err := c.service.GetContestSeeding(contestID, userID)
+	c.helper.RespondOK(ctx, result, err, "contest seeding retrieved successfully")
+}
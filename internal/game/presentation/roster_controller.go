@@ -0,0 +1,220 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RosterController struct {
+	router  *router.Router
+	service *application.RosterService
+	helper  *handler.ControllerHelper
+}
+
+func NewRosterController(
+	router *router.Router,
+	service *application.RosterService,
+	helper *handler.ControllerHelper,
+) *RosterController {
+	return &RosterController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *RosterController) RegisterRoutes() {
+	rosterGroup := c.router.ProtectedGroup("/api/contests/:id/roster")
+	{
+		rosterGroup.GET("/lock-report", c.GetLockReport)
+		rosterGroup.POST("/change-requests", c.RequestRosterChange)
+		rosterGroup.GET("/change-requests", c.GetRosterChangeRequests)
+		rosterGroup.POST("/change-requests/:requestId/approve", c.ApproveRosterChange)
+		rosterGroup.POST("/change-requests/:requestId/reject", c.RejectRosterChange)
+	}
+}
+
+// GetLockReport godoc
+// @Summary Get roster lock report
+// @Description Get the registration close report with finalized and dropped teams (Staff only)
+// @Tags rosters
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Success 200 {object} response.Response{data=gameDto.RosterLockReportResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/roster/lock-report [get]
+func (c *RosterController) GetLockReport(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	report, err := c.service.GetLockReport(contestID, userID)
+	c.helper.RespondOK(ctx, report, err, "roster lock report retrieved successfully")
+}
+
+// RequestRosterChange godoc
+// @Summary Request a roster change
+// @Description Team leader requests adding, removing or replacing a member after the roster lock. Applied after staff approval.
+// @Tags rosters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param request body gameDto.CreateRosterChangeRequest true "Roster change request"
+// @Success 201 {object} response.Response{data=gameDto.RosterChangeRequestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/contests/{id}/roster/change-requests [post]
+func (c *RosterController) RequestRosterChange(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req gameDto.CreateRosterChangeRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	changeRequest, err := c.service.RequestRosterChange(contestID, userID, &req)
+	c.helper.RespondCreated(ctx, changeRequest, err, "roster change requested successfully")
+}
+
+// GetRosterChangeRequests godoc
+// @Summary Get roster change requests
+// @Description Get roster change requests of a contest, optionally filtered by status (Staff only)
+// @Tags rosters
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param status query string false "Request status (PENDING, APPROVED, REJECTED)"
+// @Success 200 {object} response.Response{data=[]gameDto.RosterChangeRequestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/contests/{id}/roster/change-requests [get]
+func (c *RosterController) GetRosterChangeRequests(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var status *domain.RosterChangeStatus
+	if statusParam := ctx.Query("status"); statusParam != "" {
+		s := domain.RosterChangeStatus(statusParam)
+		status = &s
+	}
+
+	requests, err := c.service.GetRosterChangeRequests(contestID, userID, status)
+	c.helper.RespondOK(ctx, requests, err, "roster change requests retrieved successfully")
+}
+
+// ApproveRosterChange godoc
+// @Summary Approve a roster change request
+// @Description Approve a pending roster change and apply it to the team roster (Staff only)
+// @Tags rosters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param requestId path int true "Roster change request ID"
+// @Param request body gameDto.ReviewRosterChangeRequest false "Review note"
+// @Success 200 {object} response.Response{data=gameDto.RosterChangeRequestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/roster/change-requests/{requestId}/approve [post]
+func (c *RosterController) ApproveRosterChange(ctx *gin.Context) {
+	c.reviewRosterChange(ctx, true)
+}
+
+// RejectRosterChange godoc
+// @Summary Reject a roster change request
+// @Description Reject a pending roster change (Staff only)
+// @Tags rosters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param requestId path int true "Roster change request ID"
+// @Param request body gameDto.ReviewRosterChangeRequest false "Review note"
+// @Success 200 {object} response.Response{data=gameDto.RosterChangeRequestResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/roster/change-requests/{requestId}/reject [post]
+func (c *RosterController) RejectRosterChange(ctx *gin.Context) {
+	c.reviewRosterChange(ctx, false)
+}
+
+func (c *RosterController) reviewRosterChange(ctx *gin.Context, approve bool) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	requestID, err := strconv.ParseInt(ctx.Param("requestId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid request id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	var req gameDto.ReviewRosterChangeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		// Allow empty body
+	}
+
+	if approve {
+		changeRequest, err := c.service.ApproveRosterChange(contestID, requestID, userID, req.Note)
+		c.helper.RespondOK(ctx, changeRequest, err, "roster change approved successfully")
+		return
+	}
+
+	changeRequest, err := c.service.RejectRosterChange(contestID, requestID, userID, req.Note)
+	c.helper.RespondOK(ctx, changeRequest, err, "roster change rejected successfully")
+}
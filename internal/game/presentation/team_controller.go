@@ -44,6 +44,7 @@ func (c *TeamController) RegisterRoutes() {
 		privateGroup.POST("/transfer", c.TransferLeadership)
 		privateGroup.POST("/finalize", c.FinalizeTeam)
 		privateGroup.DELETE("", c.DeleteTeam)
+		privateGroup.GET("/waitlist", c.GetWaitlistPosition)
 	}
 
 	membersGroup := c.router.ProtectedGroup("/api/contests/:id/team/members")
@@ -389,6 +390,37 @@ func (c *TeamController) DeleteTeam(ctx *gin.Context) {
 	c.helper.RespondNoContent(ctx, err)
 }
 
+// GetWaitlistPosition godoc
+// @Summary Get the team's waitlist position
+// @Description Get the waitlist position of the current user's team once the contest is full (Leader only)
+// @Tags teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Success 200 {object} response.Response{data=gameDto.WaitlistPositionResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/contests/{id}/team/waitlist [get]
+func (c *TeamController) GetWaitlistPosition(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	userID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	position, err := c.service.GetWaitlistPosition(ctx.Request.Context(), contestID, userID)
+	c.helper.RespondOK(ctx, position, err, "waitlist position retrieved successfully")
+}
+
 // GetMembers godoc
 // @Summary Get all team members
 // @Description Get all members of a contest team
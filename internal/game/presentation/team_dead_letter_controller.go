@@ -0,0 +1,168 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TeamDeadLetterController struct {
+	router  *router.Router
+	service *application.TeamDeadLetterService
+	helper  *handler.ControllerHelper
+}
+
+func NewTeamDeadLetterController(
+	router *router.Router,
+	service *application.TeamDeadLetterService,
+	helper *handler.ControllerHelper,
+) *TeamDeadLetterController {
+	return &TeamDeadLetterController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *TeamDeadLetterController) RegisterRoutes() {
+	adminGroup := c.router.AdminGroup("/api/admin/teams/dead-letters")
+	{
+		adminGroup.GET("", c.GetDeadLetters)
+		adminGroup.GET("/:deadLetterId", c.GetDeadLetter)
+		adminGroup.POST("/:deadLetterId/replay", c.ReplayDeadLetter)
+		adminGroup.POST("/:deadLetterId/discard", c.DiscardDeadLetter)
+	}
+}
+
+// GetDeadLetters godoc
+// @Summary Get dead-lettered team persistence events
+// @Description Get team persistence events that failed all retries, newest first (Admin only)
+// @Tags admin-teams
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Param status query string false "Dead letter status (PENDING, REPLAYED, DISCARDED)"
+// @Success 200 {object} response.Response{data=commonDto.PaginationResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/admin/teams/dead-letters [get]
+func (c *TeamDeadLetterController) GetDeadLetters(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	var status *domain.DeadLetterStatus
+	if statusParam := ctx.Query("status"); statusParam != "" {
+		s := domain.DeadLetterStatus(statusParam)
+		if !s.IsValid() {
+			response.JSON(ctx, response.BadRequest("invalid dead letter status"))
+			return
+		}
+		status = &s
+	}
+
+	paginationReq := commonDto.NewPaginationRequest(page, pageSize)
+
+	deadLetters, totalCount, err := c.service.GetDeadLetters(paginationReq.GetOffset(), paginationReq.GetLimit(), status)
+	if err != nil {
+		c.helper.RespondOK(ctx, nil, err, "")
+		return
+	}
+
+	paginationResp := commonDto.NewPaginationResponse(deadLetters, paginationReq.Page, paginationReq.PageSize, totalCount)
+	c.helper.RespondOK(ctx, paginationResp, nil, "dead letters retrieved successfully")
+}
+
+// GetDeadLetter godoc
+// @Summary Get a dead-lettered team persistence event
+// @Description Get a dead-lettered event with its payload and last error (Admin only)
+// @Tags admin-teams
+// @Produce json
+// @Security BearerAuth
+// @Param deadLetterId path int true "Dead letter ID"
+// @Success 200 {object} response.Response{data=gameDto.TeamDeadLetterResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/admin/teams/dead-letters/{deadLetterId} [get]
+func (c *TeamDeadLetterController) GetDeadLetter(ctx *gin.Context) {
+	deadLetterID, err := strconv.ParseInt(ctx.Param("deadLetterId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid dead letter id"))
+		return
+	}
+
+	deadLetter, err := c.service.GetDeadLetter(deadLetterID)
+	c.helper.RespondOK(ctx, deadLetter, err, "dead letter retrieved successfully")
+}
+
+// ReplayDeadLetter godoc
+// @Summary Replay a dead-lettered team persistence event
+// @Description Re-publish a pending dead-lettered event to the persistence queue with a fresh retry budget (Admin only)
+// @Tags admin-teams
+// @Produce json
+// @Security BearerAuth
+// @Param deadLetterId path int true "Dead letter ID"
+// @Success 200 {object} response.Response{data=gameDto.TeamDeadLetterResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/admin/teams/dead-letters/{deadLetterId}/replay [post]
+func (c *TeamDeadLetterController) ReplayDeadLetter(ctx *gin.Context) {
+	deadLetterID, err := strconv.ParseInt(ctx.Param("deadLetterId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid dead letter id"))
+		return
+	}
+
+	adminID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	deadLetter, err := c.service.ReplayDeadLetter(ctx.Request.Context(), deadLetterID, adminID)
+	c.helper.RespondOK(ctx, deadLetter, err, "dead letter replayed successfully")
+}
+
+// DiscardDeadLetter godoc
+// @Summary Discard a dead-lettered team persistence event
+// @Description Mark a pending dead-lettered event as discarded without re-processing it (Admin only)
+// @Tags admin-teams
+// @Produce json
+// @Security BearerAuth
+// @Param deadLetterId path int true "Dead letter ID"
+// @Success 200 {object} response.Response{data=gameDto.TeamDeadLetterResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/admin/teams/dead-letters/{deadLetterId}/discard [post]
+func (c *TeamDeadLetterController) DiscardDeadLetter(ctx *gin.Context) {
+	deadLetterID, err := strconv.ParseInt(ctx.Param("deadLetterId"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid dead letter id"))
+		return
+	}
+
+	adminID, ok := middleware.GetUserIdFromContext(ctx)
+	if !ok {
+		response.JSON(ctx, response.Error(401, "user not authenticated"))
+		return
+	}
+
+	deadLetter, err := c.service.DiscardDeadLetter(deadLetterID, adminID)
+	c.helper.RespondOK(ctx, deadLetter, err, "dead letter discarded successfully")
+}
@@ -0,0 +1,94 @@
+package presentation
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	gameDto "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TeamReconciliationController struct {
+	router  *router.Router
+	service *application.TeamReconciliationService
+	helper  *handler.ControllerHelper
+}
+
+func NewTeamReconciliationController(
+	router *router.Router,
+	service *application.TeamReconciliationService,
+	helper *handler.ControllerHelper,
+) *TeamReconciliationController {
+	return &TeamReconciliationController{
+		router:  router,
+		service: service,
+		helper:  helper,
+	}
+}
+
+func (c *TeamReconciliationController) RegisterRoutes() {
+	adminGroup := c.router.AdminGroup("/api/admin/contests/:id/teams/reconciliation")
+	{
+		adminGroup.GET("", c.CheckTeams)
+		adminGroup.POST("", c.ReconcileTeams)
+	}
+}
+
+// CheckTeams godoc
+// @Summary Check team drift between cache and DB
+// @Description Compare the Redis team cache with MySQL for a contest and report any drift without changing data (Admin only)
+// @Tags admin-teams
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Success 200 {object} response.Response{data=domain.TeamReconciliationReport}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Router /api/admin/contests/{id}/teams/reconciliation [get]
+func (c *TeamReconciliationController) CheckTeams(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	report, err := c.service.CheckContest(ctx.Request.Context(), contestID)
+	c.helper.RespondOK(ctx, report, err, "team reconciliation check completed")
+}
+
+// ReconcileTeams godoc
+// @Summary Repair team drift between cache and DB
+// @Description Repair drift for a contest using the cache (CACHE_TO_DB) or the DB (DB_TO_CACHE) as the source of truth (Admin only)
+// @Tags admin-teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Contest ID"
+// @Param request body gameDto.ReconcileTeamsRequest true "Repair direction"
+// @Success 200 {object} response.Response{data=domain.TeamReconciliationReport}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 409 {object} response.Response
+// @Router /api/admin/contests/{id}/teams/reconciliation [post]
+func (c *TeamReconciliationController) ReconcileTeams(ctx *gin.Context) {
+	contestID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		response.JSON(ctx, response.BadRequest("invalid contest id"))
+		return
+	}
+
+	var req gameDto.ReconcileTeamsRequest
+	if !c.helper.BindJSON(ctx, &req) {
+		return
+	}
+
+	report, err := c.service.ReconcileContest(ctx.Request.Context(), contestID, req.Direction)
+	c.helper.RespondOK(ctx, report, err, "team reconciliation completed")
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 
 	oauth2Port "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/application/port"
+	outboxPort "github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
 	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
 
 	"github.com/redis/go-redis/v9"
@@ -19,24 +20,56 @@ import (
 )
 
 type Dependencies struct {
-	GameController            *presentation.GameController
-	TeamController            *presentation.TeamController
-	GameTeamController        *presentation.GameTeamController
-	GameRepository            port.GameDatabasePort
-	TeamRepository            port.TeamDatabasePort
-	GameTeamRepository        port.GameTeamDatabasePort
-	TeamService               *application.TeamService
-	TeamPersistenceConsumer   port.TeamPersistenceConsumerPort
-	TeamPersistenceHandler    *application.TeamPersistenceHandler
-	GameSchedulerService      *application.GameSchedulerService
-	MatchDetectionService     *application.MatchDetectionService
-	TournamentResultService   *application.TournamentResultService
+	GameController          *presentation.GameController
+	TeamController          *presentation.TeamController
+	GameTeamController      *presentation.GameTeamController
+	GameTeamService         *application.GameTeamService
+	GameRepository          port.GameDatabasePort
+	TeamRepository          port.TeamDatabasePort
+	GameTeamRepository      port.GameTeamDatabasePort
+	GameService             *application.GameService
+	TeamService             *application.TeamService
+	TeamPersistenceConsumer port.TeamPersistenceConsumerPort
+	TeamPersistenceHandler  *application.TeamPersistenceHandler
+	DeadLetterConsumer      port.TeamDeadLetterConsumerPort
+	DeadLetterService       *application.TeamDeadLetterService
+	DeadLetterController    *presentation.TeamDeadLetterController
+	GameSchedulerService    *application.GameSchedulerService
+	RosterController        *presentation.RosterController
+	RosterService           *application.RosterService
+	ReconcileController     *presentation.TeamReconciliationController
+	ReconcileService        *application.TeamReconciliationService
+	MatchDetectionService   *application.MatchDetectionService
+	TournamentResultService *application.TournamentResultService
+	ContestCleanupService   *application.ContestCleanupService
+	CalendarController      *presentation.GameCalendarController
+	CalendarService         *application.GameCalendarService
+	ResultExportController  *presentation.ContestResultExportController
+	ResultExportService     *application.ContestResultExportService
+	PlayerStatsController   *presentation.PlayerStatsController
+	PlayerStatsService      *application.PlayerStatsService
+	LeaderboardController   *presentation.LeaderboardController
+	LeaderboardService      *application.LeaderboardService
+	LeaderboardConsumer     port.GameEventConsumerPort
+	RatingController        *presentation.RatingController
+	RatingService           *application.RatingService
+	RatingConsumer          port.GameEventConsumerPort
+	MatchAwardController    *presentation.MatchAwardController
+	MatchAwardService       *application.MatchAwardService
+	MatchAwardConsumer      port.GameEventConsumerPort
+	AchievementController   *presentation.AchievementController
+	AchievementService      *application.AchievementService
+	AchievementConsumer     port.AchievementEventConsumerPort
+	PickemController        *presentation.PickemController
+	PickemService           *application.PickemService
+	PickemConsumer          port.GameEventConsumerPort
 }
 
 func ProvideGameDependencies(
 	db *gorm.DB,
 	redisClient *redis.Client,
 	rabbitmqConn *config.RabbitMQConnection,
+	outbox outboxPort.OutboxPort,
 	router *router.Router,
 	contestRepository contestPort.ContestDatabasePort,
 	oauth2Repository oauth2Port.OAuth2DatabasePort,
@@ -49,19 +82,35 @@ func ProvideGameDependencies(
 	teamDatabaseAdapter := adapter.NewTeamDatabaseAdapter(db)
 	gameTeamDatabaseAdapter := adapter.NewGameTeamDatabaseAdapter(db)
 	matchResultDatabaseAdapter := adapter.NewMatchResultDatabaseAdapter(db)
+	rosterDatabaseAdapter := adapter.NewRosterDatabaseAdapter(db)
+	deadLetterDatabaseAdapter := adapter.NewTeamDeadLetterDatabaseAdapter(db)
+	calendarDatabaseAdapter := adapter.NewGameCalendarDatabaseAdapter(db)
+	resultExportDatabaseAdapter := adapter.NewContestResultExportDatabaseAdapter(db)
+	playerStatsDatabaseAdapter := adapter.NewPlayerStatsDatabaseAdapter(db)
+	leaderboardDatabaseAdapter := adapter.NewLeaderboardDatabaseAdapter(db)
+	ratingDatabaseAdapter := adapter.NewRatingDatabaseAdapter(db)
+	matchAwardDatabaseAdapter := adapter.NewMatchAwardDatabaseAdapter(db)
+	achievementDatabaseAdapter := adapter.NewAchievementDatabaseAdapter(db)
+	pickemDatabaseAdapter := adapter.NewPickemDatabaseAdapter(db)
 
 	// Redis Adapter for Team
 	teamRedisAdapter := adapter.NewTeamRedisAdapter(redisClient)
 
-	// RabbitMQ Event Publisher for Team
-	teamEventPublisher := adapter.NewTeamEventPublisherRabbitMQAdapter(
-		rabbitmqConn,
+	// Redis Adapter for Player Stats
+	playerStatsRedisAdapter := adapter.NewPlayerStatsRedisAdapter(redisClient)
+
+	// Redis Adapter for Leaderboards (sorted sets)
+	leaderboardRedisAdapter := adapter.NewLeaderboardRedisAdapter(redisClient)
+
+	// Event Publisher for Team (relayed through the outbox)
+	teamEventPublisher := adapter.NewTeamEventPublisherOutboxAdapter(
+		outbox,
 		rabbitmqConn.Config().Exchange,
 	)
 
-	// RabbitMQ Persistence Publisher for Write-Behind pattern
-	teamPersistencePublisher := adapter.NewTeamPersistencePublisherRabbitMQAdapter(
-		rabbitmqConn,
+	// Persistence Publisher for Write-Behind pattern (relayed through the outbox)
+	teamPersistencePublisher := adapter.NewTeamPersistencePublisherOutboxAdapter(
+		outbox,
 		rabbitmqConn.Config().Exchange,
 	)
 
@@ -71,12 +120,33 @@ func ProvideGameDependencies(
 		rabbitmqConn.Config().Exchange,
 	)
 
-	// Persistence Handler for DB operations
-	teamPersistenceHandler := application.NewTeamPersistenceHandler(teamDatabaseAdapter)
+	// RabbitMQ Dead-Letter Consumer (events that failed all retries)
+	deadLetterConsumer := adapter.NewTeamDeadLetterConsumerRabbitMQAdapter(rabbitmqConn)
 
-	// Game Event Publisher (RabbitMQ)
-	gameEventPublisher := adapter.NewGameEventPublisherRabbitMQAdapter(
-		rabbitmqConn,
+	// Persistence Handler for DB operations (idempotent per event key)
+	teamPersistenceHandler := application.NewTeamPersistenceHandler(teamDatabaseAdapter, deadLetterDatabaseAdapter)
+
+	// Dead Letter Service (record, replay and discard failed persistence events)
+	deadLetterService := application.NewTeamDeadLetterService(deadLetterDatabaseAdapter, teamPersistencePublisher)
+
+	// RabbitMQ Leaderboard Consumer (game.finished events)
+	leaderboardConsumer := adapter.NewGameEventConsumerRabbitMQAdapter(rabbitmqConn, config.LeaderboardQueue)
+
+	// RabbitMQ Rating Consumer (game.finished events)
+	ratingConsumer := adapter.NewGameEventConsumerRabbitMQAdapter(rabbitmqConn, config.RatingQueue)
+
+	// RabbitMQ Match Award Consumer (game.finished events)
+	matchAwardConsumer := adapter.NewGameEventConsumerRabbitMQAdapter(rabbitmqConn, config.MatchAwardQueue)
+
+	// RabbitMQ Achievement Consumer (game.finished, game.team.finalized and contest.application.accepted events)
+	achievementConsumer := adapter.NewAchievementEventConsumerRabbitMQAdapter(rabbitmqConn, config.AchievementQueue)
+
+	// RabbitMQ Pick'em Consumer (game.finished events)
+	pickemConsumer := adapter.NewGameEventConsumerRabbitMQAdapter(rabbitmqConn, config.PickemQueue)
+
+	// Game Event Publisher (relayed through the outbox)
+	gameEventPublisher := adapter.NewGameEventPublisherOutboxAdapter(
+		outbox,
 		rabbitmqConn.Config().Exchange,
 	)
 
@@ -117,11 +187,33 @@ func ProvideGameDependencies(
 		gameEventPublisher,
 		userQueryRepo,
 	)
+	matchDetectionService.SetPlayerStatsCache(playerStatsRedisAdapter)
+	matchDetectionService.SetMatchAwardRepository(matchAwardDatabaseAdapter)
+
+	// Roster Service (registration close roster lock + staff-approved roster changes)
+	rosterService := application.NewRosterService(
+		teamService,
+		teamDatabaseAdapter,
+		teamRedisAdapter,
+		rosterDatabaseAdapter,
+		contestRepository,
+	)
+
+	// Team Reconciliation Service (Redis team cache <-> MySQL drift repair)
+	reconciliationService := application.NewTeamReconciliationService(
+		teamDatabaseAdapter,
+		teamRedisAdapter,
+		contestRepository,
+		userQueryRepo,
+		oauth2Repository,
+	)
 
 	// Game Scheduler Service (with Redis distributed lock)
 	gameSchedulerService := application.NewGameSchedulerService(
 		gameDatabaseAdapter,
 		matchDetectionService,
+		rosterService,
+		reconciliationService,
 		gameEventPublisher,
 		redisClient,
 	)
@@ -135,6 +227,76 @@ func ProvideGameDependencies(
 		contestRepository,
 	)
 
+	// Contest Cleanup Service (cancels games and team caches of a cancelled contest)
+	contestCleanupService := application.NewContestCleanupService(
+		gameDatabaseAdapter,
+		teamDatabaseAdapter,
+		teamRedisAdapter,
+		gameEventPublisher,
+	)
+
+	// Game Calendar Service (iCalendar feeds of scheduled games)
+	calendarService := application.NewGameCalendarService(calendarDatabaseAdapter, contestRepository)
+
+	// Contest Result Export Service (CSV / JSON downloads for organizers)
+	resultExportService := application.NewContestResultExportService(
+		tournamentResultService,
+		resultExportDatabaseAdapter,
+		teamDatabaseAdapter,
+	)
+
+	// Player Stats Service (career stats from match_player_stats, cached in Redis)
+	playerStatsService := application.NewPlayerStatsService(
+		playerStatsDatabaseAdapter,
+		playerStatsRedisAdapter,
+		userQueryRepo,
+	)
+
+	// Leaderboard Service (Redis leaderboards updated on every finished game)
+	leaderboardService := application.NewLeaderboardService(
+		leaderboardRedisAdapter,
+		leaderboardDatabaseAdapter,
+		ratingDatabaseAdapter,
+		gameDatabaseAdapter,
+		gameTeamDatabaseAdapter,
+		matchResultDatabaseAdapter,
+	)
+
+	// Rating Service (Glicko-2 ratings updated on every finished game with a match result)
+	ratingService := application.NewRatingService(
+		ratingDatabaseAdapter,
+		leaderboardDatabaseAdapter,
+		gameDatabaseAdapter,
+		matchResultDatabaseAdapter,
+		leaderboardService,
+		userQueryRepo,
+	)
+
+	// Match Award Service (stat awards and MVP votes of detected matches)
+	matchAwardService := application.NewMatchAwardService(
+		matchAwardDatabaseAdapter,
+		matchResultDatabaseAdapter,
+		userQueryRepo,
+	)
+
+	// Achievement Service (badges earned from game, team and contest events)
+	achievementService := application.NewAchievementService(
+		achievementDatabaseAdapter,
+		gameDatabaseAdapter,
+		matchResultDatabaseAdapter,
+		leaderboardDatabaseAdapter,
+		userQueryRepo,
+	)
+
+	// Pick'em Service (bracket predictions scored when games finish)
+	pickemService := application.NewPickemService(
+		pickemDatabaseAdapter,
+		gameDatabaseAdapter,
+		gameTeamDatabaseAdapter,
+		matchResultDatabaseAdapter,
+		leaderboardDatabaseAdapter,
+	)
+
 	// Controllers
 	gameController := presentation.NewGameController(
 		router,
@@ -150,24 +312,121 @@ func ProvideGameDependencies(
 		controllerHelper,
 	)
 
+	rosterController := presentation.NewRosterController(
+		router,
+		rosterService,
+		controllerHelper,
+	)
+
+	reconciliationController := presentation.NewTeamReconciliationController(
+		router,
+		reconciliationService,
+		controllerHelper,
+	)
+
+	deadLetterController := presentation.NewTeamDeadLetterController(
+		router,
+		deadLetterService,
+		controllerHelper,
+	)
+
 	gameTeamController := presentation.NewGameTeamController(
 		router,
 		gameTeamService,
 		controllerHelper,
 	)
 
+	calendarController := presentation.NewGameCalendarController(
+		router,
+		calendarService,
+		controllerHelper,
+	)
+
+	resultExportController := presentation.NewContestResultExportController(
+		router,
+		resultExportService,
+		controllerHelper,
+	)
+
+	playerStatsController := presentation.NewPlayerStatsController(
+		router,
+		playerStatsService,
+		controllerHelper,
+	)
+
+	leaderboardController := presentation.NewLeaderboardController(
+		router,
+		leaderboardService,
+		controllerHelper,
+	)
+
+	ratingController := presentation.NewRatingController(
+		router,
+		ratingService,
+		controllerHelper,
+	)
+
+	matchAwardController := presentation.NewMatchAwardController(
+		router,
+		matchAwardService,
+		controllerHelper,
+	)
+
+	achievementController := presentation.NewAchievementController(
+		router,
+		achievementService,
+		controllerHelper,
+	)
+
+	pickemController := presentation.NewPickemController(
+		router,
+		pickemService,
+		controllerHelper,
+	)
+
 	return &Dependencies{
 		GameController:          gameController,
 		TeamController:          teamController,
 		GameTeamController:      gameTeamController,
+		GameTeamService:         gameTeamService,
 		GameRepository:          gameDatabaseAdapter,
 		TeamRepository:          teamDatabaseAdapter,
 		GameTeamRepository:      gameTeamDatabaseAdapter,
+		GameService:             gameService,
 		TeamService:             teamService,
 		TeamPersistenceConsumer: teamPersistenceConsumer,
 		TeamPersistenceHandler:  teamPersistenceHandler,
+		DeadLetterConsumer:      deadLetterConsumer,
+		DeadLetterService:       deadLetterService,
+		DeadLetterController:    deadLetterController,
 		GameSchedulerService:    gameSchedulerService,
+		RosterController:        rosterController,
+		RosterService:           rosterService,
+		ReconcileController:     reconciliationController,
+		ReconcileService:        reconciliationService,
 		MatchDetectionService:   matchDetectionService,
 		TournamentResultService: tournamentResultService,
+		ContestCleanupService:   contestCleanupService,
+		CalendarController:      calendarController,
+		CalendarService:         calendarService,
+		ResultExportController:  resultExportController,
+		ResultExportService:     resultExportService,
+		PlayerStatsController:   playerStatsController,
+		PlayerStatsService:      playerStatsService,
+		LeaderboardController:   leaderboardController,
+		LeaderboardService:      leaderboardService,
+		LeaderboardConsumer:     leaderboardConsumer,
+		RatingController:        ratingController,
+		RatingService:           ratingService,
+		RatingConsumer:          ratingConsumer,
+		MatchAwardController:    matchAwardController,
+		MatchAwardService:       matchAwardService,
+		MatchAwardConsumer:      matchAwardConsumer,
+		AchievementController:   achievementController,
+		AchievementService:      achievementService,
+		AchievementConsumer:     achievementConsumer,
+		PickemController:        pickemController,
+		PickemService:           pickemService,
+		PickemConsumer:          pickemConsumer,
 	}
 }
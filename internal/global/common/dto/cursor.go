@@ -0,0 +1,173 @@
+package dto
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// cursorKind records the Go type of the sort value so it is restored with the same type
+type cursorKind string
+
+const (
+	cursorKindTime   cursorKind = "t"
+	cursorKindInt    cursorKind = "i"
+	cursorKindString cursorKind = "s"
+)
+
+// cursor is the decoded form of an opaque cursor: the sort key and ID of the last row of a page.
+// The sort field and order are kept so a cursor cannot be replayed against a different ordering.
+type cursor struct {
+	SortBy string     `json:"s"`
+	Order  string     `json:"o"`
+	Kind   cursorKind `json:"k"`
+	Value  string     `json:"v"`
+	ID     int64      `json:"i"`
+}
+
+// CursorRequest represents keyset pagination parameters.
+// The first page has no cursor; later pages continue after the row the cursor points to.
+type CursorRequest struct {
+	Sort  *SortRequest
+	Limit int
+	after *cursor
+}
+
+// NewCursorRequest decodes the opaque cursor for the given ordering.
+// An empty cursor starts from the first page; the limit is clamped like PaginationRequest.PageSize.
+func NewCursorRequest(encoded string, limit int, sort *SortRequest) (*CursorRequest, error) {
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	req := &CursorRequest{Sort: sort, Limit: limit}
+	if encoded == "" {
+		return req, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, exception.ErrInvalidCursor
+	}
+
+	var after cursor
+	if err := json.Unmarshal(raw, &after); err != nil {
+		return nil, exception.ErrInvalidCursor
+	}
+	if after.SortBy != sort.SortBy || after.Order != sort.Order {
+		return nil, exception.ErrInvalidCursor
+	}
+	if _, err := after.sortValue(); err != nil {
+		return nil, exception.ErrInvalidCursor
+	}
+
+	req.after = &after
+	return req, nil
+}
+
+// IsFirstPage checks if the request has no cursor
+func (r *CursorRequest) IsFirstPage() bool {
+	return r.after == nil
+}
+
+// FetchLimit returns one row more than the page size so adapters can tell whether another page exists
+func (r *CursorRequest) FetchLimit() int {
+	return r.Limit + 1
+}
+
+// KeysetCondition returns the WHERE clause selecting rows after the cursor, or an empty clause on the first page.
+// The ID column breaks ties between rows sharing the same sort key.
+func (r *CursorRequest) KeysetCondition(sortColumn, idColumn string) (string, []interface{}) {
+	if r.after == nil {
+		return "", nil
+	}
+
+	op := "<"
+	if r.Sort.Order == "asc" {
+		op = ">"
+	}
+
+	value, _ := r.after.sortValue()
+	condition := fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", sortColumn, op, sortColumn, idColumn, op)
+	return condition, []interface{}{value, value, r.after.ID}
+}
+
+// OrderClause returns the ORDER BY clause matching KeysetCondition
+func (r *CursorRequest) OrderClause(sortColumn, idColumn string) string {
+	direction := "DESC"
+	if r.Sort.Order == "asc" {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s %s, %s %s", sortColumn, direction, idColumn, direction)
+}
+
+// EncodeCursor builds the opaque cursor pointing at a row with the given sort value and ID.
+// The sort value must be a time.Time, an integer or a string.
+func EncodeCursor(sort *SortRequest, value interface{}, id int64) string {
+	c := cursor{SortBy: sort.SortBy, Order: sort.Order, ID: id}
+
+	switch v := value.(type) {
+	case time.Time:
+		c.Kind, c.Value = cursorKindTime, v.UTC().Format(time.RFC3339Nano)
+	case int:
+		c.Kind, c.Value = cursorKindInt, strconv.Itoa(v)
+	case int64:
+		c.Kind, c.Value = cursorKindInt, strconv.FormatInt(v, 10)
+	default:
+		c.Kind, c.Value = cursorKindString, fmt.Sprint(v)
+	}
+
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (c *cursor) sortValue() (interface{}, error) {
+	switch c.Kind {
+	case cursorKindTime:
+		return time.Parse(time.RFC3339Nano, c.Value)
+	case cursorKindInt:
+		return strconv.ParseInt(c.Value, 10, 64)
+	case cursorKindString:
+		return c.Value, nil
+	default:
+		return nil, exception.ErrInvalidCursor
+	}
+}
+
+// CursorResponse represents a keyset-paginated response.
+// NextCursor is nil on the last page; there is no total count because counting is what keyset pagination avoids.
+type CursorResponse struct {
+	Data       interface{} `json:"data"`
+	NextCursor *string     `json:"next_cursor"`
+	HasMore    bool        `json:"has_more"`
+	Limit      int         `json:"limit"`
+}
+
+// NewCursorResponse creates a new CursorResponse
+func NewCursorResponse(data interface{}, nextCursor *string, limit int) *CursorResponse {
+	return &CursorResponse{
+		Data:       data,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+		Limit:      limit,
+	}
+}
+
+// TrimCursorPage drops the extra row fetched with FetchLimit and returns the cursor for the next page.
+// key returns the sort value and ID of a row.
+func TrimCursorPage[T any](rows []T, req *CursorRequest, key func(T) (interface{}, int64)) ([]T, *string) {
+	if len(rows) <= req.Limit {
+		return rows, nil
+	}
+
+	rows = rows[:req.Limit]
+	value, id := key(rows[len(rows)-1])
+	next := EncodeCursor(req.Sort, value, id)
+	return rows, &next
+}
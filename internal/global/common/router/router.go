@@ -58,6 +58,13 @@ func (r *Router) ProtectedGroup(path string) *gin.RouterGroup {
 	return group
 }
 
+// OptionalAuthGroup is a public group that still resolves the caller when a token is sent
+func (r *Router) OptionalAuthGroup(path string) *gin.RouterGroup {
+	group := r.engine.Group(path)
+	group.Use(r.authMiddleware.OptionalAuth())
+	return group
+}
+
 func (r *Router) AdminGroup(path string) *gin.RouterGroup {
 	group := r.engine.Group(path)
 	group.Use(r.authMiddleware.RequireAuth())
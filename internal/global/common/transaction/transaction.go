@@ -0,0 +1,52 @@
+package transaction
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// Transactor is implemented by Manager; services depend on it so tests can run without a database
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error
+}
+
+// Manager runs a function inside a DB transaction that is carried through the context,
+// so adapters resolving their connection with DB(ctx, ...) join the same transaction
+type Manager struct {
+	db *gorm.DB
+}
+
+func NewManager(db *gorm.DB) *Manager {
+	return &Manager{db: db}
+}
+
+// WithinTransaction commits when fn returns nil and rolls back otherwise.
+// Nested calls reuse the outer transaction.
+func (m *Manager) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// DB returns the transaction carried by ctx, or fallback when there is none
+func DB(ctx context.Context, fallback *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return fallback
+}
+
+// Run executes fn inside a transaction of t, or directly when no transactor is configured
+func Run(ctx context.Context, t Transactor, fn func(txCtx context.Context) error) error {
+	if t == nil {
+		return fn(ctx)
+	}
+	return t.WithinTransaction(ctx, fn)
+}
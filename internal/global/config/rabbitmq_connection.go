@@ -141,8 +141,52 @@ func (r *RabbitMQConnection) closeConnections() {
 const (
 	TeamPersistenceQueue = "team.persistence"
 	TeamPersistenceDLQ   = "team.persistence.dlq"
+
+	// TeamPersistenceDLQRoutingKey routes events that failed all retries to the DLQ
+	TeamPersistenceDLQRoutingKey = "team.dlq"
+	// teamPersistenceRetryReturnKey routes expired delay queue messages back to the main queue
+	teamPersistenceRetryReturnKey = "team.persistence.retry"
 )
 
+// TeamPersistenceRetryDelays holds the exponential backoff per retry attempt (one delay queue each)
+var TeamPersistenceRetryDelays = []time.Duration{
+	5 * time.Second,
+	30 * time.Second,
+	3 * time.Minute,
+}
+
+// TeamPersistenceRetryQueue returns the delay queue name for a retry attempt (1-based)
+func TeamPersistenceRetryQueue(attempt int) string {
+	return fmt.Sprintf("team.persistence.retry.%d", attempt)
+}
+
+// TeamPersistenceRetryRoutingKey returns the routing key of the delay queue for a retry attempt (1-based)
+func TeamPersistenceRetryRoutingKey(attempt int) string {
+	return fmt.Sprintf("team.retry.%d", attempt)
+}
+
+// Queue names for game event consumers
+const (
+	// LeaderboardQueue receives finished games to update the leaderboards
+	LeaderboardQueue = "game.leaderboard"
+	// RatingQueue receives finished games to update the player and roster ratings
+	RatingQueue = "game.rating"
+	// MatchAwardQueue receives finished games to hand out stat awards and open MVP votes
+	MatchAwardQueue = "game.awards"
+	// PickemQueue receives finished games to score the pick'em predictions
+	PickemQueue = "game.pickem"
+)
+
+// AchievementQueue receives the game, team and contest events the achievement rules are evaluated on
+const AchievementQueue = "achievements"
+
+// achievementRoutingKeys are the events that can earn badges
+var achievementRoutingKeys = []string{
+	"game.finished",
+	"game.team.finalized",
+	"contest.application.accepted",
+}
+
 func (r *RabbitMQConnection) SetupTopology() error {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -191,6 +235,143 @@ func (r *RabbitMQConnection) SetupTopology() error {
 		return err
 	}
 
+	// Setup game event consumer queues
+	if err := r.setupGameEventQueues(); err != nil {
+		return err
+	}
+
+	// Setup achievement queue
+	if err := r.setupAchievementQueue(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setupGameEventQueues creates the queues of the consumers of game events
+func (r *RabbitMQConnection) setupGameEventQueues() error {
+	_, err := r.channel.QueueDeclare(
+		LeaderboardQueue, // name
+		true,             // durable
+		false,            // delete when unused
+		false,            // exclusive
+		false,            // no-wait
+		nil,              // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare leaderboard queue: %w", err)
+	}
+
+	err = r.channel.QueueBind(
+		LeaderboardQueue,  // queue name
+		"game.finished",   // routing key
+		r.config.Exchange, // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to bind leaderboard queue: %w", err)
+	}
+
+	_, err = r.channel.QueueDeclare(
+		RatingQueue, // name
+		true,        // durable
+		false,       // delete when unused
+		false,       // exclusive
+		false,       // no-wait
+		nil,         // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare rating queue: %w", err)
+	}
+
+	err = r.channel.QueueBind(
+		RatingQueue,       // queue name
+		"game.finished",   // routing key
+		r.config.Exchange, // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to bind rating queue: %w", err)
+	}
+
+	_, err = r.channel.QueueDeclare(
+		MatchAwardQueue, // name
+		true,            // durable
+		false,           // delete when unused
+		false,           // exclusive
+		false,           // no-wait
+		nil,             // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare match award queue: %w", err)
+	}
+
+	err = r.channel.QueueBind(
+		MatchAwardQueue,   // queue name
+		"game.finished",   // routing key
+		r.config.Exchange, // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to bind match award queue: %w", err)
+	}
+
+	_, err = r.channel.QueueDeclare(
+		PickemQueue, // name
+		true,        // durable
+		false,       // delete when unused
+		false,       // exclusive
+		false,       // no-wait
+		nil,         // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare pickem queue: %w", err)
+	}
+
+	err = r.channel.QueueBind(
+		PickemQueue,       // queue name
+		"game.finished",   // routing key
+		r.config.Exchange, // exchange
+		false,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to bind pickem queue: %w", err)
+	}
+
+	return nil
+}
+
+// setupAchievementQueue creates the queue of the achievement consumer, bound to every event that can earn badges
+func (r *RabbitMQConnection) setupAchievementQueue() error {
+	_, err := r.channel.QueueDeclare(
+		AchievementQueue, // name
+		true,             // durable
+		false,            // delete when unused
+		false,            // exclusive
+		false,            // no-wait
+		nil,              // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare achievement queue: %w", err)
+	}
+
+	for _, routingKey := range achievementRoutingKeys {
+		err = r.channel.QueueBind(
+			AchievementQueue,  // queue name
+			routingKey,        // routing key
+			r.config.Exchange, // exchange
+			false,
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to bind achievement queue to %s: %w", routingKey, err)
+		}
+	}
+
 	return nil
 }
 
@@ -211,9 +392,9 @@ func (r *RabbitMQConnection) setupTeamPersistenceQueues() error {
 
 	// Bind DLQ to exchange
 	err = r.channel.QueueBind(
-		TeamPersistenceDLQ, // queue name
-		"team.dlq",         // routing key
-		r.config.Exchange,  // exchange
+		TeamPersistenceDLQ,           // queue name
+		TeamPersistenceDLQRoutingKey, // routing key
+		r.config.Exchange,            // exchange
 		false,
 		nil,
 	)
@@ -224,7 +405,7 @@ func (r *RabbitMQConnection) setupTeamPersistenceQueues() error {
 	// Declare main persistence queue with DLQ settings
 	queueArgs := amqp.Table{
 		"x-dead-letter-exchange":    r.config.Exchange,
-		"x-dead-letter-routing-key": "team.dlq",
+		"x-dead-letter-routing-key": TeamPersistenceDLQRoutingKey,
 	}
 
 	_, err = r.channel.QueueDeclare(
@@ -251,6 +432,39 @@ func (r *RabbitMQConnection) setupTeamPersistenceQueues() error {
 		return fmt.Errorf("failed to bind team persistence queue: %w", err)
 	}
 
+	// Delay queues for retries: messages wait for the TTL, then dead-letter back to the main queue
+	for i, delay := range TeamPersistenceRetryDelays {
+		attempt := i + 1
+		retryArgs := amqp.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    r.config.Exchange,
+			"x-dead-letter-routing-key": teamPersistenceRetryReturnKey,
+		}
+
+		_, err = r.channel.QueueDeclare(
+			TeamPersistenceRetryQueue(attempt), // name
+			true,                               // durable
+			false,                              // delete when unused
+			false,                              // exclusive
+			false,                              // no-wait
+			retryArgs,                          // arguments with TTL and return route
+		)
+		if err != nil {
+			return fmt.Errorf("failed to declare team persistence retry queue %d: %w", attempt, err)
+		}
+
+		err = r.channel.QueueBind(
+			TeamPersistenceRetryQueue(attempt),      // queue name
+			TeamPersistenceRetryRoutingKey(attempt), // routing key
+			r.config.Exchange,                       // exchange
+			false,
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to bind team persistence retry queue %d: %w", attempt, err)
+		}
+	}
+
 	return nil
 }
 
@@ -269,6 +483,23 @@ func (r *RabbitMQConnection) GetChannel() (*amqp.Channel, error) {
 	return r.channel, nil
 }
 
+// OpenChannel opens a new channel on the current connection, for callers that need
+// their own channel mode (such as publisher confirms). The caller closes it.
+func (r *RabbitMQConnection) OpenChannel() (*amqp.Channel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		return nil, fmt.Errorf("connection is closed")
+	}
+
+	if r.conn == nil || r.conn.IsClosed() {
+		return nil, fmt.Errorf("connection is not alive")
+	}
+
+	return r.conn.Channel()
+}
+
 func (r *RabbitMQConnection) Config() *RabbitMQConfig {
 	return r.config
 }
@@ -15,5 +15,5 @@ var (
 	ErrUnauthorized        = NewBusinessError(http.StatusUnauthorized, "Unauthorized access", "AU006")
 	ErrInternalServerError = NewBusinessError(http.StatusInternalServerError, "Internal server error", "AU007")
 
-	ErrPermissionDenied = NewBusinessError(http.StatusUnauthorized, "Permission denied", "AU008")
+	ErrPermissionDenied = NewBusinessError(http.StatusForbidden, "Permission denied", "AU008")
 )
@@ -38,4 +38,78 @@ var (
 	ErrContestNotActive           = NewBadRequestError("contest is not in active status", "CT032")
 	ErrCannotChangeLeaderRole     = NewBusinessError(http.StatusForbidden, "cannot change leader's role", "CT033")
 	ErrAlreadySameMemberType      = NewBadRequestError("member already has the same role", "CT034")
+
+	// Captain draft errors
+	ErrDraftOnlyCasualContest  = NewBadRequestError("captain draft is only available for casual contests", "CT035")
+	ErrDraftAlreadyExists      = NewBusinessError(http.StatusConflict, "draft is already in progress for this contest", "CT036")
+	ErrDraftNotFound           = NewNotFoundError("draft not found", "CT037")
+	ErrDraftNotInProgress      = NewBadRequestError("draft is not in progress", "CT038")
+	ErrDraftNotYourTurn        = NewBusinessError(http.StatusForbidden, "it is not your turn to pick", "CT039")
+	ErrDraftPlayerNotAvailable = NewBadRequestError("player is not available in the draft pool", "CT040")
+	ErrDraftNotEnoughCaptains  = NewBadRequestError("at least two captains are required", "CT041")
+	ErrDraftNotEnoughPlayers   = NewBadRequestError("not enough accepted applicants to fill every team", "CT042")
+	ErrDraftInvalidCaptain     = NewBadRequestError("captain must be a unique accepted applicant", "CT043")
+	ErrDraftInvalidPickTimeout = NewBadRequestError("pick timeout must be between 10 and 300 seconds", "CT044")
+	ErrDraftInvalidTeamSize    = NewBadRequestError("team size must be at least 2 for a captain draft", "CT045")
+	ErrDraftBusy               = NewBusinessError(http.StatusConflict, "another pick is being processed, please retry", "CT046")
+
+	// Registration deadline errors
+	ErrInvalidRegistrationDeadline = NewBadRequestError("registration close time must be before contest start time", "CT047")
+	ErrRosterAlreadyLocked         = NewBusinessError(http.StatusConflict, "contest rosters are already locked", "CT048")
+	ErrRegistrationClosed          = NewBadRequestError("contest registration is closed", "CT049")
+
+	// Cancellation errors
+	ErrContestAlreadyClosed = NewBusinessError(http.StatusConflict, "contest is already finished or cancelled", "CT050")
+
+	// Application form errors
+	ErrInvalidApplicationForm  = NewBadRequestError("application form must have between 1 and 20 questions", "CT051")
+	ErrInvalidFormQuestion     = NewBadRequestError("form question needs an id, a label, a valid type and at least two options for choices", "CT052")
+	ErrDuplicateFormQuestion   = NewBadRequestError("form question ids must be unique", "CT053")
+	ErrApplicationFormNotFound = NewNotFoundError("application form not found", "CT054")
+	ErrUnknownFormQuestion     = NewBadRequestError("answer refers to an unknown form question", "CT055")
+	ErrInvalidFormAnswer       = NewBadRequestError("answer does not match the form question", "CT056")
+	ErrRequiredAnswerMissing   = NewBadRequestError("a required form question is not answered", "CT057")
+
+	// Auto-accept rule errors
+	ErrInvalidAutoAcceptTier           = NewBadRequestError("auto-accept tiers must be between 0 and 27 with min tier not above max tier", "CT058")
+	ErrInvalidAutoAcceptCap            = NewBadRequestError("auto-accept cap must be positive", "CT059")
+	ErrInvalidAutoAcceptMismatchAction = NewBadRequestError("on mismatch must be LEAVE_PENDING or REJECT", "CT060")
+	ErrAutoAcceptRuleNotFound          = NewNotFoundError("auto-accept rule not found", "CT061")
+	ErrAutoAcceptGuildRequired         = NewBadRequestError("guild membership rule needs a contest with a discord server", "CT062")
+
+	// Eligibility errors
+	ErrInvalidEligibility        = NewBadRequestError("eligibility needs known regions, tiers between 0 and 27 with min tier not above max tier, a CURRENT or PEAK tier basis and a non-negative account age", "CT063")
+	ErrEligibilityGuildRequired  = NewBadRequestError("guild membership requirement needs a contest with a discord server", "CT064")
+	ErrIneligibleRegion          = NewBusinessError(http.StatusForbidden, "your valorant region is not allowed in this contest", "CT065")
+	ErrIneligibleTier            = NewBusinessError(http.StatusForbidden, "your valorant tier is outside the contest tier range", "CT066")
+	ErrIneligibleAccountAge      = NewBusinessError(http.StatusForbidden, "your account is too new for this contest", "CT067")
+	ErrIneligibleGuildMembership = NewBusinessError(http.StatusForbidden, "you must be a member of the contest discord server", "CT068")
+
+	// Template errors
+	ErrInvalidContestTemplateName = NewBadRequestError("template name must be between 1 and 100 characters", "CT069")
+	ErrContestTemplateNotFound    = NewNotFoundError("contest template not found", "CT070")
+	ErrNotContestTemplateOwner    = NewBusinessError(http.StatusForbidden, "only the template owner can use this template", "CT071")
+
+	// Series errors
+	ErrInvalidContestSeries     = NewBadRequestError("series needs a name of up to 100 characters, a start date and an end date after it", "CT072")
+	ErrInvalidSeriesRecurrence  = NewBadRequestError("recurrence needs a weekday, a HH:MM start time, a valid timezone, a positive interval and duration and a create-ahead window of 1 to 60 days", "CT073")
+	ErrInvalidSeriesPointsTable = NewBadRequestError("points table needs unique placements from 1 with non-negative points", "CT074")
+	ErrContestSeriesNotFound    = NewNotFoundError("contest series not found", "CT075")
+	ErrNotContestSeriesOwner    = NewBusinessError(http.StatusForbidden, "only the series owner can manage this series", "CT076")
+	ErrSeriesInstanceExists     = NewBusinessError(http.StatusConflict, "series already has a contest starting at this time", "CT086")
+
+	// Organizer errors
+	ErrInvalidContestRole      = NewBadRequestError("role must be one of ADMIN, REFEREE or MODERATOR", "CT077")
+	ErrOrganizerInviteNotFound = NewNotFoundError("organizer invite not found", "CT078")
+	ErrAlreadyContestOrganizer = NewBusinessError(http.StatusConflict, "user is already an organizer of this contest", "CT079")
+	ErrNotContestOrganizer     = NewBusinessError(http.StatusBadRequest, "user is not an organizer of this contest", "CT080")
+
+	// Visibility errors
+	ErrInvalidContestVisibility = NewBadRequestError("visibility must be one of PUBLIC, UNLISTED or PRIVATE", "CT081")
+	ErrInvalidJoinCode          = NewNotFoundError("join code is invalid or expired", "CT082")
+	ErrContestNotPrivate        = NewBadRequestError("join codes are only available for private contests", "CT083")
+
+	// Discovery errors
+	ErrInvalidDiscoveryFilter = NewBadRequestError("discovery filter has an unknown game type, contest type, status or sort, or an invalid date range or team size", "CT084")
+	ErrDiscoveryLoginRequired = NewBusinessError(http.StatusUnauthorized, "eligible_only and recommended ordering require login", "CT085")
 )
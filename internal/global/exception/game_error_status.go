@@ -44,6 +44,29 @@ var (
 	ErrInvalidTeamName         = NewBadRequestError("team name is required", "TM016")
 	ErrTeamNameTooLong         = NewBadRequestError("team name cannot exceed 50 characters", "TM017")
 	ErrTeamNameAlreadyExists   = NewBusinessError(http.StatusConflict, "team name already exists in this contest", "TM018")
+	ErrRosterLocked            = NewBusinessError(http.StatusForbidden, "rosters are locked, roster changes require staff approval", "TM019")
+	ErrTeamNotWaitlisted       = NewBusinessError(http.StatusNotFound, "team is not on the contest waitlist", "TM020")
+	ErrNotTeamLeader           = NewBusinessError(http.StatusForbidden, "only leader can view the waitlist position", "TM021")
+	ErrPlayerAlreadyInTeam     = NewBusinessError(http.StatusConflict, "player already has a team in this contest", "TM022")
+
+	// Roster change request errors
+	ErrRosterNotLocked                 = NewBadRequestError("rosters are not locked yet, edit the team directly", "RC001")
+	ErrRosterChangeRequestNotFound     = NewBusinessError(http.StatusNotFound, "roster change request not found", "RC002")
+	ErrRosterChangeRequestNotPending   = NewBadRequestError("roster change request is not pending", "RC003")
+	ErrInvalidRosterChangeType         = NewBadRequestError("invalid roster change type", "RC004")
+	ErrRosterChangeReplacementRequired = NewBadRequestError("replacement user is required for add and replace changes", "RC005")
+	ErrRosterChangeTargetRequired      = NewBadRequestError("target user is required for remove and replace changes", "RC006")
+	ErrRosterChangeAlreadyRequested    = NewBusinessError(http.StatusConflict, "team already has a pending roster change request", "RC007")
+	ErrRosterLockReportNotFound        = NewBusinessError(http.StatusNotFound, "roster lock report not found", "RC008")
+
+	// Team reconciliation errors
+	ErrInvalidReconcileDirection = NewBadRequestError("direction must be CACHE_TO_DB or DB_TO_CACHE", "RS001")
+	ErrReconciliationBusy        = NewBusinessError(http.StatusConflict, "team reconciliation is already running for this contest", "RS002")
+
+	// Team persistence dead letter errors
+	ErrDeadLetterNotFound   = NewBusinessError(http.StatusNotFound, "dead-lettered team persistence event not found", "DL001")
+	ErrDeadLetterNotPending = NewBusinessError(http.StatusConflict, "dead-lettered event has already been replayed or discarded", "DL002")
+	ErrDeadLetterMalformed  = NewBadRequestError("dead-lettered event payload is malformed and cannot be replayed", "DL003")
 
 	// ScoreTable errors
 	ErrScoreTableNotFound = NewBusinessError(http.StatusNotFound, "score table not found", "ST001")
@@ -67,4 +90,35 @@ var (
 	ErrInvalidGradeMin          = NewBadRequestError("grade must be at least 1", "GT004")
 	ErrGradeExceedsMaxTeamCount = NewBadRequestError("grade exceeds maximum team count", "GT005")
 	ErrDuplicateGradeInGame     = NewBusinessError(http.StatusConflict, "duplicate grade in the same game", "GT006")
+
+	// Calendar feed errors
+	ErrCalendarFeedNotFound = NewBusinessError(http.StatusNotFound, "calendar feed not found", "CF001")
+
+	// Result export errors
+	ErrInvalidExportFormat  = NewBadRequestError("export format must be csv or json", "RX001")
+	ErrInvalidExportSection = NewBadRequestError("export section must be placements, rosters, games or players", "RX002")
+
+	// Leaderboard errors
+	ErrInvalidLeaderboardSubject = NewBadRequestError("leaderboard subject must be players or teams", "LB001")
+	ErrInvalidLeaderboardMetric  = NewBadRequestError("leaderboard metric is not tracked for this subject", "LB002")
+	ErrLeaderboardNotSeasonal    = NewBadRequestError("leaderboard metric is not tracked per season", "LB003")
+
+	// Rating errors
+	ErrRatingNotFound   = NewBusinessError(http.StatusNotFound, "rating not found", "RT001")
+	ErrInvalidRosterKey = NewBadRequestError("roster key must be user ids joined by '-'", "RT002")
+
+	// MVP voting errors
+	ErrMvpVotingNotFound   = NewBusinessError(http.StatusNotFound, "mvp voting not found for this game", "MV001")
+	ErrMvpVotingClosed     = NewBusinessError(http.StatusConflict, "mvp voting is closed", "MV002")
+	ErrNotMatchParticipant = NewBusinessError(http.StatusForbidden, "only players of the match can vote", "MV003")
+	ErrInvalidMvpCandidate = NewBadRequestError("candidate did not play in the match", "MV004")
+	ErrCannotVoteForSelf   = NewBadRequestError("players cannot vote for themselves", "MV005")
+	ErrMvpAlreadyVoted     = NewBusinessError(http.StatusConflict, "you have already voted in this match", "MV006")
+
+	// Pick'em errors
+	ErrPickemNotAvailable       = NewBadRequestError("pick'em is only available for tournament bracket games", "PK001")
+	ErrPickemLocked             = NewBusinessError(http.StatusConflict, "predictions for this game are locked", "PK002")
+	ErrPickemTeamsNotSet        = NewBusinessError(http.StatusConflict, "both teams of the game must be set before predicting", "PK003")
+	ErrPickemTeamNotInGame      = NewBadRequestError("predicted team is not playing in this game", "PK004")
+	ErrPickemPredictionNotFound = NewBusinessError(http.StatusNotFound, "prediction not found", "PK005")
 )
@@ -3,5 +3,6 @@ package exception
 import "net/http"
 
 var (
-	ErrDBConnection = NewBusinessError(http.StatusInternalServerError, "config connection error", "GL001")
+	ErrDBConnection  = NewBusinessError(http.StatusInternalServerError, "config connection error", "GL001")
+	ErrInvalidCursor = NewBusinessError(http.StatusBadRequest, "invalid or expired cursor", "GL002")
 )
@@ -0,0 +1,21 @@
+package utils
+
+import "strconv"
+
+// EscapeSpreadsheetFormula stops user-written cells like "=HYPERLINK(...)" from running as formulas
+// when an exported CSV is opened in a spreadsheet
+func EscapeSpreadsheetFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		// Negative numbers are data, not formulas
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	default:
+		return cell
+	}
+}
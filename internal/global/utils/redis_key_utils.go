@@ -81,3 +81,63 @@ func GetDiscordBotGuildsKey() string {
 func GetDiscordGuildChannelsKey(guildID string) string {
 	return fmt.Sprintf("discord:cache:guild:%s:channels", guildID)
 }
+
+// Captain draft related Redis keys
+
+// GetDraftKey returns the key for a contest's captain draft state
+func GetDraftKey(contestId int64) string {
+	return fmt.Sprintf("contest:%d:draft", contestId)
+}
+
+// GetDraftLockKey returns the key for the per-contest draft pick lock
+func GetDraftLockKey(contestId int64) string {
+	return fmt.Sprintf("contest:%d:draft:lock", contestId)
+}
+
+// GetActiveDraftsKey returns the key for the set of contests with a draft in progress
+func GetActiveDraftsKey() string {
+	return "contest:drafts:active"
+}
+
+// GetTeamReconcileLockKey returns the key for the per-contest team reconciliation lock.
+// Kept outside the contest:%d:team* pattern so ClearTeam does not release it mid-repair.
+func GetTeamReconcileLockKey(contestId int64) string {
+	return fmt.Sprintf("contest:%d:reconcile:lock", contestId)
+}
+
+// GetPlayerStatsKey returns the key for a user's cached career statistics
+func GetPlayerStatsKey(userId int64) string {
+	return fmt.Sprintf("user:%d:stats", userId)
+}
+
+// Leaderboard Redis keys
+
+// GetLeaderboardKey returns the sorted set of a leaderboard, e.g. leaderboard:season:3:players:kills
+func GetLeaderboardKey(scope, subject, metric string) string {
+	return fmt.Sprintf("leaderboard:%s:%s:%s", scope, subject, metric)
+}
+
+// GetLeaderboardTotalsKey returns the hash of running totals behind averaged leaderboards
+func GetLeaderboardTotalsKey(scope, subject string) string {
+	return fmt.Sprintf("leaderboard:%s:%s:totals", scope, subject)
+}
+
+// GetLeaderboardTeamNamesKey returns the hash of the latest team name of each roster
+func GetLeaderboardTeamNamesKey() string {
+	return "leaderboard:teams:names"
+}
+
+// GetLeaderboardUserRostersKey returns the set of rosters a user has played in
+func GetLeaderboardUserRostersKey(userId int64) string {
+	return fmt.Sprintf("leaderboard:user:%d:rosters", userId)
+}
+
+// GetLeaderboardProcessedGamesKey returns the set of games already applied to the leaderboards
+func GetLeaderboardProcessedGamesKey() string {
+	return "leaderboard:processed"
+}
+
+// GetLeaderboardPatternKey matches every leaderboard key
+func GetLeaderboardPatternKey() string {
+	return "leaderboard:*"
+}
@@ -21,6 +21,10 @@ type NotificationListResponse struct {
 	Notifications []NotificationResponse `json:"notifications"`
 	UnreadCount   int64                  `json:"unread_count"`
 	Total         int64                  `json:"total"`
+
+	// NextCursor and HasMore are only set when the list was requested with a cursor
+	NextCursor *string `json:"next_cursor,omitempty"`
+	HasMore    bool    `json:"has_more,omitempty"`
 }
 
 // FromNotification converts a domain notification to a response DTO
@@ -60,4 +64,7 @@ type GetNotificationsRequest struct {
 	Limit  int  `form:"limit" binding:"omitempty,min=1,max=100"`
 	Offset int  `form:"offset" binding:"omitempty,min=0"`
 	Unread bool `form:"unread"`
+
+	// Cursor switches to keyset pagination when present, ignoring Offset; an empty value requests the first page
+	Cursor *string `form:"cursor"`
 }
@@ -1,6 +1,7 @@
 package application
 
 import (
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/port"
@@ -73,9 +74,20 @@ func (s *NotificationService) GetNotifications(userID int64, req *dto.GetNotific
 	}
 
 	var notifications []*domain.Notification
+	var nextCursor *string
 	var err error
 
-	if req.Unread {
+	if req.Cursor != nil {
+		cursor, cursorErr := commonDto.NewCursorRequest(*req.Cursor, limit, commonDto.NewSortRequest("created_at", "desc", nil))
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		notifications, err = s.databasePort.FindByUserIDCursor(userID, req.Unread, cursor)
+		notifications, nextCursor = commonDto.TrimCursorPage(notifications, cursor, func(n *domain.Notification) (interface{}, int64) {
+			return n.CreatedAt, n.ID
+		})
+	} else if req.Unread {
 		notifications, err = s.databasePort.FindUnreadByUserID(userID, limit, req.Offset)
 	} else {
 		notifications, err = s.databasePort.FindByUserID(userID, limit, req.Offset)
@@ -100,6 +112,8 @@ func (s *NotificationService) GetNotifications(userID int64, req *dto.GetNotific
 		Notifications: dto.FromNotifications(notifications),
 		UnreadCount:   unreadCount,
 		Total:         total,
+		NextCursor:    nextCursor,
+		HasMore:       nextCursor != nil,
 	}, nil
 }
 
@@ -203,6 +217,180 @@ func (s *NotificationService) HandleApplicationRejected(userID, contestID int64,
 	return s.CreateAndSendNotification(userID, domain.NotificationTypeApplicationRejected, title, message, data)
 }
 
+// HandleDraftUpdated pushes the live draft state to every connected participant.
+// Draft updates are frequent and short-lived, so they are sent over SSE without being stored.
+func (s *NotificationService) HandleDraftUpdated(userIDs []int64, contestID int64, event string, draft interface{}) error {
+	message := &domain.SSEMessage{
+		ID:      fmt.Sprintf("draft-%d-%d", contestID, time.Now().UnixNano()),
+		Type:    domain.NotificationTypeDraftUpdated,
+		Title:   "드래프트 진행",
+		Message: event,
+		Data: map[string]interface{}{
+			"contest_id": contestID,
+			"event":      event,
+			"draft":      draft,
+		},
+		Timestamp: time.Now(),
+	}
+
+	for _, userID := range userIDs {
+		if !s.sseManager.IsUserConnected(userID) {
+			continue
+		}
+		if err := s.sseManager.SendToUser(userID, message); err != nil {
+			log.Printf("Failed to send draft update to user %d: %v", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// HandleDraftCompleted handles captain draft completed event
+func (s *NotificationService) HandleDraftCompleted(userID, contestID int64, contestTitle, teamName string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+		"team_name":     teamName,
+	}
+
+	title := "드래프트 완료"
+	message := fmt.Sprintf("%s 대회 드래프트가 완료되었습니다. 소속 팀: %s", contestTitle, teamName)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeDraftCompleted, title, message, data)
+}
+
+// HandleTeamDropped handles team dropped at registration close event
+func (s *NotificationService) HandleTeamDropped(userID, contestID int64, contestTitle, teamName string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+		"team_name":     teamName,
+	}
+
+	title := "팀 등록 취소"
+	message := fmt.Sprintf("%s 대회 등록이 마감되어 인원이 부족한 %s 팀이 제외되었습니다.", contestTitle, teamName)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeTeamDropped, title, message, data)
+}
+
+// HandleTeamPromotedFromWaitlist handles waitlisted team promoted to a contest slot event
+func (s *NotificationService) HandleTeamPromotedFromWaitlist(userID, contestID int64, contestTitle, teamName string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+		"team_name":     teamName,
+	}
+
+	title := "대기열 승격"
+	message := fmt.Sprintf("%s 대회에 자리가 생겨 대기 중이던 %s 팀이 참가 팀으로 등록되었습니다.", contestTitle, teamName)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeTeamPromotedFromWaitlist, title, message, data)
+}
+
+// HandleRosterChangeReviewed handles roster change request approved/rejected event
+func (s *NotificationService) HandleRosterChangeReviewed(userID, contestID int64, contestTitle string, approved bool, note string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+		"note":          note,
+	}
+
+	notifType := domain.NotificationTypeRosterChangeApproved
+	title := "로스터 변경 승인"
+	message := fmt.Sprintf("%s 대회 로스터 변경 요청이 승인되었습니다.", contestTitle)
+	if !approved {
+		notifType = domain.NotificationTypeRosterChangeRejected
+		title = "로스터 변경 거절"
+		message = fmt.Sprintf("%s 대회 로스터 변경 요청이 거절되었습니다.", contestTitle)
+	}
+	if note != "" {
+		message += fmt.Sprintf(" 사유: %s", note)
+	}
+
+	return s.CreateAndSendNotification(userID, notifType, title, message, data)
+}
+
+// HandleContestStarted handles contest started event
+func (s *NotificationService) HandleContestStarted(userID, contestID int64, contestTitle string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+	}
+
+	title := "대회 시작"
+	message := fmt.Sprintf("%s 대회가 시작되었습니다.", contestTitle)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeContestStarted, title, message, data)
+}
+
+// HandleContestFinished handles contest finished event
+func (s *NotificationService) HandleContestFinished(userID, contestID int64, contestTitle string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+	}
+
+	title := "대회 종료"
+	message := fmt.Sprintf("%s 대회가 종료되었습니다.", contestTitle)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeContestFinished, title, message, data)
+}
+
+// HandleContestCancelled handles contest cancelled event
+func (s *NotificationService) HandleContestCancelled(userID, contestID int64, contestTitle, reason string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+		"reason":        reason,
+	}
+
+	title := "대회 취소"
+	message := fmt.Sprintf("%s 대회가 취소되었습니다. 사유: %s", contestTitle, reason)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeContestCancelled, title, message, data)
+}
+
+// HandleOrganizerInviteReceived handles contest organizer invite event
+func (s *NotificationService) HandleOrganizerInviteReceived(userID, contestID int64, contestTitle, role string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+		"role":          role,
+	}
+
+	title := "대회 운영진 초대"
+	message := fmt.Sprintf("%s 대회의 %s 운영진으로 초대되었습니다.", contestTitle, role)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeOrganizerInviteReceived, title, message, data)
+}
+
+// HandleContestInviteReceived handles private contest invite event
+func (s *NotificationService) HandleContestInviteReceived(userID, contestID int64, contestTitle string) error {
+	data := map[string]interface{}{
+		"contest_id":    contestID,
+		"contest_title": contestTitle,
+	}
+
+	title := "비공개 대회 초대"
+	message := fmt.Sprintf("%s 대회에 초대되었습니다.", contestTitle)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeContestInviteReceived, title, message, data)
+}
+
+// HandleBadgeAwarded handles badge awarded event
+func (s *NotificationService) HandleBadgeAwarded(userID int64, badgeCode, badgeName, badgeDescription string) error {
+	data := map[string]interface{}{
+		"badge_code":        badgeCode,
+		"badge_name":        badgeName,
+		"badge_description": badgeDescription,
+	}
+
+	title := "배지 획득"
+	message := fmt.Sprintf("%s 배지를 획득했습니다. %s", badgeName, badgeDescription)
+
+	return s.CreateAndSendNotification(userID, domain.NotificationTypeBadgeAwarded, title, message, data)
+}
+
 // CleanupOldNotifications removes old notifications
 func (s *NotificationService) CleanupOldNotifications(days int) error {
 	return s.databasePort.DeleteOldNotifications(days)
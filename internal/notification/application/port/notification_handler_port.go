@@ -11,4 +11,30 @@ type NotificationHandlerPort interface {
 	// Contest application notifications
 	HandleApplicationAccepted(userID, contestID int64, contestTitle string) error
 	HandleApplicationRejected(userID, contestID int64, contestTitle, reason string) error
+
+	// Captain draft notifications
+	// HandleDraftUpdated pushes live draft state over SSE only (not persisted)
+	HandleDraftUpdated(userIDs []int64, contestID int64, event string, draft interface{}) error
+	HandleDraftCompleted(userID, contestID int64, contestTitle, teamName string) error
+
+	// Roster lock notifications
+	HandleTeamDropped(userID, contestID int64, contestTitle, teamName string) error
+	HandleRosterChangeReviewed(userID, contestID int64, contestTitle string, approved bool, note string) error
+
+	// Contest waitlist notifications
+	HandleTeamPromotedFromWaitlist(userID, contestID int64, contestTitle, teamName string) error
+
+	// Contest lifecycle notifications
+	HandleContestStarted(userID, contestID int64, contestTitle string) error
+	HandleContestFinished(userID, contestID int64, contestTitle string) error
+	HandleContestCancelled(userID, contestID int64, contestTitle, reason string) error
+
+	// Contest organizer notifications
+	HandleOrganizerInviteReceived(userID, contestID int64, contestTitle, role string) error
+
+	// Private contest notifications
+	HandleContestInviteReceived(userID, contestID int64, contestTitle string) error
+
+	// Achievement notifications
+	HandleBadgeAwarded(userID int64, badgeCode, badgeName, badgeDescription string) error
 }
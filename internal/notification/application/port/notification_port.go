@@ -1,6 +1,7 @@
 package port
 
 import (
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/domain"
 	"context"
 )
@@ -19,6 +20,9 @@ type NotificationDatabasePort interface {
 	// FindUnreadByUserID finds unread notifications for a user
 	FindUnreadByUserID(userID int64, limit, offset int) ([]*domain.Notification, error)
 
+	// FindByUserIDCursor finds notifications after the cursor, newest first, fetching cursor.FetchLimit() rows
+	FindByUserIDCursor(userID int64, unreadOnly bool, cursor *commonDto.CursorRequest) ([]*domain.Notification, error)
+
 	// CountByUserID counts total notifications for a user
 	CountByUserID(userID int64) (int64, error)
 
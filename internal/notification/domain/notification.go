@@ -17,6 +17,32 @@ const (
 	// Contest application notifications
 	NotificationTypeApplicationAccepted NotificationType = "APPLICATION_ACCEPTED"
 	NotificationTypeApplicationRejected NotificationType = "APPLICATION_REJECTED"
+
+	// Captain draft notifications
+	NotificationTypeDraftUpdated   NotificationType = "DRAFT_UPDATED"
+	NotificationTypeDraftCompleted NotificationType = "DRAFT_COMPLETED"
+
+	// Roster lock notifications
+	NotificationTypeTeamDropped          NotificationType = "TEAM_DROPPED"
+	NotificationTypeRosterChangeApproved NotificationType = "ROSTER_CHANGE_APPROVED"
+	NotificationTypeRosterChangeRejected NotificationType = "ROSTER_CHANGE_REJECTED"
+
+	// Contest waitlist notifications
+	NotificationTypeTeamPromotedFromWaitlist NotificationType = "TEAM_PROMOTED_FROM_WAITLIST"
+
+	// Contest lifecycle notifications
+	NotificationTypeContestStarted   NotificationType = "CONTEST_STARTED"
+	NotificationTypeContestFinished  NotificationType = "CONTEST_FINISHED"
+	NotificationTypeContestCancelled NotificationType = "CONTEST_CANCELLED"
+
+	// Contest organizer notifications
+	NotificationTypeOrganizerInviteReceived NotificationType = "ORGANIZER_INVITE_RECEIVED"
+
+	// Private contest notifications
+	NotificationTypeContestInviteReceived NotificationType = "CONTEST_INVITE_RECEIVED"
+
+	// Achievement notifications
+	NotificationTypeBadgeAwarded NotificationType = "BADGE_AWARDED"
 )
 
 // Notification represents a user notification entity
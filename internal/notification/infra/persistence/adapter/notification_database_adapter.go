@@ -1,6 +1,7 @@
 package adapter
 
 import (
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/domain"
 	"time"
 
@@ -59,6 +60,27 @@ func (a *NotificationDatabaseAdapter) FindUnreadByUserID(userID int64, limit, of
 	return notifications, nil
 }
 
+// FindByUserIDCursor finds notifications after the cursor, newest first
+func (a *NotificationDatabaseAdapter) FindByUserIDCursor(userID int64, unreadOnly bool, cursor *commonDto.CursorRequest) ([]*domain.Notification, error) {
+	query := a.db.Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	if condition, args := cursor.KeysetCondition("created_at", "id"); condition != "" {
+		query = query.Where(condition, args...)
+	}
+
+	var notifications []*domain.Notification
+	err := query.Order(cursor.OrderClause("created_at", "id")).
+		Limit(cursor.FetchLimit()).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
 // CountByUserID counts total notifications for a user
 func (a *NotificationDatabaseAdapter) CountByUserID(userID int64) (int64, error) {
 	var count int64
@@ -3,10 +3,12 @@ package presentation
 import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/auth/middleware"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/router"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/response"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/application/dto"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/notification/infra/sse"
+	"errors"
 	"log"
 	"strconv"
 	"time"
@@ -112,7 +114,9 @@ func (c *NotificationController) SSEStream(ctx *gin.Context) {
 // @Param limit query int false "Number of notifications to return (default: 20, max: 100)"
 // @Param offset query int false "Offset for pagination"
 // @Param unread query bool false "Filter only unread notifications"
+// @Param cursor query string false "Keyset cursor from next_cursor; send empty for the first page. Ignores offset"
 // @Success 200 {object} response.Response{data=dto.NotificationListResponse}
+// @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
 // @Router /api/notifications [get]
 func (c *NotificationController) GetNotifications(ctx *gin.Context) {
@@ -129,6 +133,10 @@ func (c *NotificationController) GetNotifications(ctx *gin.Context) {
 	}
 
 	result, err := c.service.GetNotifications(userID, &req)
+	if errors.Is(err, exception.ErrInvalidCursor) {
+		response.JSON(ctx, response.BadRequest(err.Error()))
+		return
+	}
 	if err != nil {
 		response.JSON(ctx, response.InternalServerError(err.Error()))
 		return
@@ -0,0 +1,129 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// OutboxRelayInterval is how often pending outbox rows are published
+	OutboxRelayInterval = 2 * time.Second
+	// OutboxCleanupInterval is how often published rows past retention are deleted
+	OutboxCleanupInterval = time.Hour
+
+	outboxRelayBatchSize = 100
+	outboxRetention      = 7 * 24 * time.Hour
+
+	lockKeyOutboxRelay = "scheduler:lock:outbox_relay"
+	lockTTLOutboxRelay = 30 * time.Second
+)
+
+// OutboxRelayService publishes pending outbox rows to the broker.
+// Rows of the same aggregate are published in insertion order; a failed row holds back
+// the later rows of its aggregate until it succeeds, including after it is marked FAILED.
+type OutboxRelayService struct {
+	outboxRepository port.OutboxDatabasePort
+	broker           port.OutboxBrokerPort
+	redisClient      *redis.Client
+}
+
+func NewOutboxRelayService(
+	outboxRepository port.OutboxDatabasePort,
+	broker port.OutboxBrokerPort,
+	redisClient *redis.Client,
+) *OutboxRelayService {
+	return &OutboxRelayService{
+		outboxRepository: outboxRepository,
+		broker:           broker,
+		redisClient:      redisClient,
+	}
+}
+
+// RunRelay publishes one batch of due outbox rows. Only one instance relays at a time.
+func (s *OutboxRelayService) RunRelay() {
+	ctx := context.Background()
+
+	acquired, err := s.acquireLock(ctx)
+	if err != nil {
+		log.Printf("[Outbox] Failed to acquire relay lock: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer s.releaseLock(ctx)
+
+	s.RelayPending(ctx)
+}
+
+// RelayPending publishes one batch of due outbox rows without taking the relay lock
+func (s *OutboxRelayService) RelayPending(ctx context.Context) {
+	events, err := s.outboxRepository.GetPublishable(time.Now(), outboxRelayBatchSize)
+	if err != nil {
+		log.Printf("[Outbox] Failed to query pending events: %v", err)
+		return
+	}
+
+	blockedAggregates := make(map[string]bool)
+	for _, event := range events {
+		aggregateKey := event.AggregateKey()
+		if blockedAggregates[aggregateKey] {
+			continue
+		}
+
+		if err := s.broker.Publish(ctx, event); err != nil {
+			blockedAggregates[aggregateKey] = true
+			event.MarkAttemptFailed(err, time.Now())
+
+			if event.IsFailed() {
+				log.Printf("[Outbox] Event %d (%s) still failing after %d attempts, retrying at %s: %v",
+					event.OutboxID, event.RoutingKey, event.Attempts, event.NextAttemptAt.Format(time.RFC3339), err)
+			} else {
+				log.Printf("[Outbox] Failed to publish event %d (%s), retrying at %s: %v",
+					event.OutboxID, event.RoutingKey, event.NextAttemptAt.Format(time.RFC3339), err)
+			}
+		} else {
+			event.MarkPublished(time.Now())
+		}
+
+		if err := s.outboxRepository.Update(event); err != nil {
+			// Published rows left PENDING are sent again; consumers dedupe by message ID
+			log.Printf("[Outbox] Failed to update event %d: %v", event.OutboxID, err)
+			blockedAggregates[aggregateKey] = true
+		}
+	}
+}
+
+// RunCleanup deletes published rows older than the retention period
+func (s *OutboxRelayService) RunCleanup() {
+	deleted, err := s.outboxRepository.DeletePublishedBefore(time.Now().Add(-outboxRetention))
+	if err != nil {
+		log.Printf("[Outbox] Failed to clean up published events: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.Printf("[Outbox] Cleaned up %d published events", deleted)
+	}
+}
+
+// acquireLock attempts to acquire the relay lock using Redis SETNX
+func (s *OutboxRelayService) acquireLock(ctx context.Context) (bool, error) {
+	result, err := s.redisClient.SetNX(ctx, lockKeyOutboxRelay, fmt.Sprintf("locked:%d", time.Now().UnixMilli()), lockTTLOutboxRelay).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	return result, nil
+}
+
+// releaseLock releases the relay lock
+func (s *OutboxRelayService) releaseLock(ctx context.Context) {
+	if err := s.redisClient.Del(ctx, lockKeyOutboxRelay).Err(); err != nil {
+		log.Printf("[Outbox] Failed to release relay lock: %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxService stores broker messages in the outbox (implements port.OutboxPort)
+type OutboxService struct {
+	outboxRepository port.OutboxDatabasePort
+}
+
+func NewOutboxService(outboxRepository port.OutboxDatabasePort) *OutboxService {
+	return &OutboxService{
+		outboxRepository: outboxRepository,
+	}
+}
+
+// Enqueue writes the message to the outbox, inside the transaction carried by ctx if any
+func (s *OutboxService) Enqueue(ctx context.Context, message *port.OutboxMessage) error {
+	if message.MessageID == "" {
+		message.MessageID = uuid.New().String()
+	}
+	if message.OccurredAt.IsZero() {
+		message.OccurredAt = time.Now()
+	}
+
+	event := domain.NewOutboxEvent(
+		message.AggregateType,
+		message.AggregateID,
+		message.Exchange,
+		message.RoutingKey,
+		message.MessageID,
+		message.Body,
+		domain.OutboxHeaders(message.Headers),
+		message.OccurredAt,
+	)
+
+	if err := s.outboxRepository.Save(ctx, event); err != nil {
+		return fmt.Errorf("failed to write outbox event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+)
+
+// OutboxBrokerPort publishes relayed outbox rows to the message broker
+type OutboxBrokerPort interface {
+	Publish(ctx context.Context, event *domain.OutboxEvent) error
+}
@@ -0,0 +1,20 @@
+package port
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+	"time"
+)
+
+// OutboxDatabasePort defines the interface for outbox row persistence
+type OutboxDatabasePort interface {
+	// Save writes the row, joining the transaction carried by ctx if any
+	Save(ctx context.Context, event *domain.OutboxEvent) error
+
+	// GetPublishable returns due pending and failed rows, oldest first, skipping rows that have
+	// an older unpublished row for the same aggregate
+	GetPublishable(now time.Time, limit int) ([]*domain.OutboxEvent, error)
+
+	Update(event *domain.OutboxEvent) error
+	DeletePublishedBefore(cutoff time.Time) (int64, error)
+}
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// Aggregate types used to order outbox messages
+const (
+	AggregateContest = "contest"
+	AggregateGame    = "game"
+)
+
+// OutboxMessage is a broker message to be stored in the outbox until the relay publishes it
+type OutboxMessage struct {
+	// AggregateType and AggregateID group messages that must be published in order
+	AggregateType string
+	AggregateID   int64
+	Exchange      string
+	RoutingKey    string
+	MessageID     string
+	Body          []byte
+	Headers       map[string]interface{}
+	OccurredAt    time.Time
+}
+
+// OutboxPort stores broker messages in the outbox.
+// When ctx carries a transaction (transaction.Manager), the row is written inside it.
+type OutboxPort interface {
+	Enqueue(ctx context.Context, message *OutboxMessage) error
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// OutboxStatus represents the relay state of an outbox row
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "PENDING"
+	OutboxStatusPublished OutboxStatus = "PUBLISHED"
+	OutboxStatusFailed    OutboxStatus = "FAILED"
+)
+
+const (
+	// MaxOutboxAttempts is the number of publish attempts before a row is marked FAILED.
+	// FAILED rows are still retried at the capped backoff.
+	MaxOutboxAttempts = 10
+
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+)
+
+// OutboxHeaders holds broker message headers, stored as a JSON column
+type OutboxHeaders map[string]interface{}
+
+func (h OutboxHeaders) Value() (driver.Value, error) {
+	if h == nil {
+		return "{}", nil
+	}
+	return json.Marshal(h)
+}
+
+func (h *OutboxHeaders) Scan(value interface{}) error {
+	if value == nil {
+		*h = OutboxHeaders{}
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported type for OutboxHeaders")
+	}
+
+	return json.Unmarshal(data, h)
+}
+
+// OutboxEvent is a broker message written together with the state change that produced it
+// and published later by the outbox relay
+type OutboxEvent struct {
+	OutboxID      int64         `gorm:"column:outbox_id;primaryKey;autoIncrement" json:"outbox_id"`
+	AggregateType string        `gorm:"column:aggregate_type;type:varchar(32);not null" json:"aggregate_type"`
+	AggregateID   int64         `gorm:"column:aggregate_id;type:bigint;not null" json:"aggregate_id"`
+	Exchange      string        `gorm:"column:exchange;type:varchar(128);not null" json:"exchange"`
+	RoutingKey    string        `gorm:"column:routing_key;type:varchar(128);not null" json:"routing_key"`
+	MessageID     string        `gorm:"column:message_id;type:varchar(64);not null" json:"message_id"`
+	Payload       string        `gorm:"column:payload;type:text;not null" json:"payload"`
+	Headers       OutboxHeaders `gorm:"column:headers;type:json" json:"headers"`
+	Status        OutboxStatus  `gorm:"column:status;type:varchar(16);not null" json:"status"`
+	Attempts      int           `gorm:"column:attempts;type:int;not null" json:"attempts"`
+	NextAttemptAt time.Time     `gorm:"column:next_attempt_at;type:datetime;not null" json:"next_attempt_at"`
+	LastError     string        `gorm:"column:last_error;type:varchar(1000)" json:"last_error,omitempty"`
+	OccurredAt    time.Time     `gorm:"column:occurred_at;type:datetime;not null" json:"occurred_at"`
+	PublishedAt   *time.Time    `gorm:"column:published_at;type:datetime" json:"published_at,omitempty"`
+	CreatedAt     time.Time     `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+func NewOutboxEvent(
+	aggregateType string,
+	aggregateID int64,
+	exchange, routingKey, messageID string,
+	payload []byte,
+	headers OutboxHeaders,
+	occurredAt time.Time,
+) *OutboxEvent {
+	now := time.Now()
+	return &OutboxEvent{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Exchange:      exchange,
+		RoutingKey:    routingKey,
+		MessageID:     messageID,
+		Payload:       string(payload),
+		Headers:       headers,
+		Status:        OutboxStatusPending,
+		NextAttemptAt: now,
+		OccurredAt:    occurredAt,
+		CreatedAt:     now,
+	}
+}
+
+func (e *OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// AggregateKey identifies the aggregate whose events must be published in order
+func (e *OutboxEvent) AggregateKey() string {
+	return e.AggregateType + ":" + strconv.FormatInt(e.AggregateID, 10)
+}
+
+func (e *OutboxEvent) MarkPublished(now time.Time) {
+	e.Status = OutboxStatusPublished
+	e.Attempts++
+	e.LastError = ""
+	e.PublishedAt = &now
+}
+
+// MarkAttemptFailed schedules the next attempt with exponential backoff.
+// Once MaxOutboxAttempts is reached the row is marked FAILED and retried at the capped backoff,
+// so a broker outage longer than the backoff window does not drop the event.
+func (e *OutboxEvent) MarkAttemptFailed(err error, now time.Time) {
+	e.Attempts++
+	e.LastError = err.Error()
+	if len(e.LastError) > 1000 {
+		e.LastError = e.LastError[:1000]
+	}
+
+	backoff := outboxMaxBackoff
+	if e.Attempts >= MaxOutboxAttempts {
+		e.Status = OutboxStatusFailed
+	} else if shifted := outboxBaseBackoff << (e.Attempts - 1); shifted > 0 && shifted < outboxMaxBackoff {
+		backoff = shifted
+	}
+	e.NextAttemptAt = now.Add(backoff)
+}
+
+func (e *OutboxEvent) IsFailed() bool {
+	return e.Status == OutboxStatusFailed
+}
@@ -0,0 +1,98 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// OutboxBrokerRabbitMQAdapter publishes relayed outbox rows to RabbitMQ.
+// It publishes on its own channel in confirm mode, so a row is only reported as published
+// once the broker has acknowledged it.
+type OutboxBrokerRabbitMQAdapter struct {
+	connection *config.RabbitMQConnection
+	channel    *amqp.Channel
+	mu         sync.Mutex
+}
+
+func NewOutboxBrokerRabbitMQAdapter(connection *config.RabbitMQConnection) *OutboxBrokerRabbitMQAdapter {
+	return &OutboxBrokerRabbitMQAdapter{
+		connection: connection,
+	}
+}
+
+func (a *OutboxBrokerRabbitMQAdapter) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	channel, err := a.confirmChannel()
+	if err != nil {
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	confirmation, err := channel.PublishWithDeferredConfirmWithContext(
+		publishCtx,
+		event.Exchange,   // exchange
+		event.RoutingKey, // routing key
+		false,            // mandatory
+		false,            // immediate
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Timestamp:    event.OccurredAt,
+			MessageId:    event.MessageID,
+			Body:         []byte(event.Payload),
+			Headers:      amqp.Table(event.Headers),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to publish outbox event %d: %w", event.OutboxID, err)
+	}
+
+	acked, err := confirmation.WaitContext(publishCtx)
+	if err != nil {
+		// The confirm may still arrive on this channel; start the next publish on a fresh one
+		a.closeChannel()
+		return fmt.Errorf("failed to confirm outbox event %d: %w", event.OutboxID, err)
+	}
+	if !acked {
+		return fmt.Errorf("broker rejected outbox event %d", event.OutboxID)
+	}
+
+	return nil
+}
+
+// confirmChannel returns the confirm mode channel, opening a new one after the previous closed
+func (a *OutboxBrokerRabbitMQAdapter) confirmChannel() (*amqp.Channel, error) {
+	if a.channel != nil && !a.channel.IsClosed() {
+		return a.channel, nil
+	}
+
+	channel, err := a.connection.OpenChannel()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		channel.Close()
+		return nil, fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	a.channel = channel
+	return channel, nil
+}
+
+func (a *OutboxBrokerRabbitMQAdapter) closeChannel() {
+	if a.channel != nil {
+		a.channel.Close()
+		a.channel = nil
+	}
+}
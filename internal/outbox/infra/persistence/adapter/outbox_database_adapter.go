@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OutboxDatabaseAdapter implements OutboxDatabasePort using GORM
+type OutboxDatabaseAdapter struct {
+	db *gorm.DB
+}
+
+func NewOutboxDatabaseAdapter(db *gorm.DB) *OutboxDatabaseAdapter {
+	return &OutboxDatabaseAdapter{db: db}
+}
+
+func (a *OutboxDatabaseAdapter) Save(ctx context.Context, event *domain.OutboxEvent) error {
+	return transaction.DB(ctx, a.db).Create(event).Error
+}
+
+// GetPublishable returns due PENDING and FAILED rows whose aggregate has no older unpublished row
+func (a *OutboxDatabaseAdapter) GetPublishable(now time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	var events []*domain.OutboxEvent
+
+	unpublished := []domain.OutboxStatus{domain.OutboxStatusPending, domain.OutboxStatusFailed}
+
+	err := a.db.
+		Where("o.status IN ? AND o.next_attempt_at <= ?", unpublished, now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox_events p
+			WHERE p.status IN ? AND p.aggregate_type = o.aggregate_type
+			  AND p.aggregate_id = o.aggregate_id AND p.outbox_id < o.outbox_id
+		)`, unpublished).
+		Table("outbox_events o").
+		Order("o.outbox_id ASC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (a *OutboxDatabaseAdapter) Update(event *domain.OutboxEvent) error {
+	return a.db.Save(event).Error
+}
+
+func (a *OutboxDatabaseAdapter) DeletePublishedBefore(cutoff time.Time) (int64, error) {
+	result := a.db.
+		Where("status = ? AND published_at < ?", domain.OutboxStatusPublished, cutoff).
+		Delete(&domain.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/transaction"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/infra/persistence/adapter"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// Dependencies holds all outbox dependencies
+type Dependencies struct {
+	Service            *application.OutboxService
+	RelayService       *application.OutboxRelayService
+	TransactionManager *transaction.Manager
+}
+
+// ProvideOutboxDependencies creates and wires the transactional outbox
+func ProvideOutboxDependencies(
+	db *gorm.DB,
+	redisClient *redis.Client,
+	rabbitmqConn *config.RabbitMQConnection,
+) *Dependencies {
+	outboxDatabaseAdapter := adapter.NewOutboxDatabaseAdapter(db)
+	outboxBrokerAdapter := adapter.NewOutboxBrokerRabbitMQAdapter(rabbitmqConn)
+
+	outboxService := application.NewOutboxService(outboxDatabaseAdapter)
+	relayService := application.NewOutboxRelayService(outboxDatabaseAdapter, outboxBrokerAdapter, redisClient)
+
+	return &Dependencies{
+		Service:            outboxService,
+		RelayService:       relayService,
+		TransactionManager: transaction.NewManager(db),
+	}
+}
@@ -27,6 +27,8 @@ type UserResponse struct {
 	Email      string    `json:"email"`
 	CreatedAt  time.Time `json:"created_at"`
 	ModifiedAt time.Time `json:"modified_at"`
+
+	Badges []*UserBadgeResponse `json:"badges,omitempty"`
 }
 
 type MyUserResponse struct {
@@ -49,4 +51,13 @@ type MyUserResponse struct {
 	PeakTier           *int       `json:"peak_tier,omitempty"`
 	PeakTierPatched    *string    `json:"peak_tier_patched,omitempty"`
 	ValorantUpdatedAt  *time.Time `json:"valorant_updated_at,omitempty"`
+
+	Badges []*UserBadgeResponse `json:"badges,omitempty"`
+}
+
+type UserBadgeResponse struct {
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	AwardedAt   time.Time `json:"awarded_at"`
 }
@@ -0,0 +1,16 @@
+package port
+
+import "time"
+
+// UserBadge is a badge a user earned, as shown on their profile
+type UserBadge struct {
+	Code        string
+	Name        string
+	Description string
+	AwardedAt   time.Time
+}
+
+// UserBadgeQueryPort reads the badges users earned from achievements
+type UserBadgeQueryPort interface {
+	GetUserBadges(userID int64) ([]*UserBadge, error)
+}
@@ -8,13 +8,15 @@ import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/command"
 	userPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/user/domain"
+	"log"
 )
 
 type UserService struct {
-	userQueryPort    userPort.UserQueryPort
-	userCommandPort  command.UserCommandPort
-	passwordHasher   password.Hasher
-	oauth2DbPort     port.OAuth2DatabasePort
+	userQueryPort   userPort.UserQueryPort
+	userCommandPort command.UserCommandPort
+	passwordHasher  password.Hasher
+	oauth2DbPort    port.OAuth2DatabasePort
+	badgeQueryPort  userPort.UserBadgeQueryPort
 }
 
 func NewUserService(
@@ -31,6 +33,11 @@ func NewUserService(
 	}
 }
 
+// SetBadgeQueryPort sets the reader of earned badges (to avoid circular dependency)
+func (s *UserService) SetBadgeQueryPort(badgeQueryPort userPort.UserBadgeQueryPort) {
+	s.badgeQueryPort = badgeQueryPort
+}
+
 func (s *UserService) CreateUser(req dto.CreateUserRequest) (*dto.UserResponse, error) {
 	user, err := domain.NewUser(req.Email, req.Password, req.Username, req.Tag, req.Bio, req.Avatar)
 	if err != nil {
@@ -55,7 +62,9 @@ func (s *UserService) GetUserById(id int64) (*dto.UserResponse, error) {
 		return nil, err
 	}
 
-	return toUserResponse(user), nil
+	resp := toUserResponse(user)
+	resp.Badges = s.getBadges(id)
+	return resp, nil
 }
 
 func (s *UserService) UpdateUser(id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error) {
@@ -125,7 +134,33 @@ func (s *UserService) GetMyInfo(id int64) (*dto.MyUserResponse, error) {
 		}
 	}
 
-	return toMyUserResponseWithAvatar(user, avatarURL), nil
+	resp := toMyUserResponseWithAvatar(user, avatarURL)
+	resp.Badges = s.getBadges(id)
+	return resp, nil
+}
+
+// getBadges returns the user's badges; a failed read leaves them out instead of failing the profile
+func (s *UserService) getBadges(id int64) []*dto.UserBadgeResponse {
+	if s.badgeQueryPort == nil {
+		return nil
+	}
+
+	badges, err := s.badgeQueryPort.GetUserBadges(id)
+	if err != nil {
+		log.Printf("Failed to get badges of user %d: %v", id, err)
+		return nil
+	}
+
+	responses := make([]*dto.UserBadgeResponse, 0, len(badges))
+	for _, badge := range badges {
+		responses = append(responses, &dto.UserBadgeResponse{
+			Code:        badge.Code,
+			Name:        badge.Name,
+			Description: badge.Description,
+			AwardedAt:   badge.AwardedAt,
+		})
+	}
+	return responses
 }
 
 func toUserResponse(user *domain.User) *dto.UserResponse {
@@ -16,6 +16,7 @@ import (
 
 type Dependencies struct {
 	Controller      *presentation.UserController
+	Service         *application.UserService
 	UserQueryRepo   userQueryPort.UserQueryPort
 	UserCommandRepo userCommandPort.UserCommandPort
 }
@@ -37,6 +38,7 @@ func ProvideUserDependencies(db *gorm.DB, router *router.Router, oauth2DbPort oa
 
 	return &Dependencies{
 		Controller:      userController,
+		Service:         userService,
 		UserQueryRepo:   userQueryAdapter,
 		UserCommandRepo: userCommandAdapter,
 	}
@@ -0,0 +1,164 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	userDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/user/domain"
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// FakeApplicationFormDatabasePort holds one contest's form and answers
+type FakeApplicationFormDatabasePort struct {
+	port.ContestApplicationFormDatabasePort
+
+	form    *domain.ContestApplicationForm
+	answers []*domain.ContestApplicationAnswer
+}
+
+func (f *FakeApplicationFormDatabasePort) GetFormByContestId(contestId int64) (*domain.ContestApplicationForm, error) {
+	if f.form == nil {
+		return nil, exception.ErrApplicationFormNotFound
+	}
+	return f.form, nil
+}
+
+func (f *FakeApplicationFormDatabasePort) GetAnswersByContestId(contestId int64) ([]*domain.ContestApplicationAnswer, error) {
+	return f.answers, nil
+}
+
+// MockContestPermissionPort mocks the ContestPermissionPort interface
+type MockContestPermissionPort struct {
+	mock.Mock
+}
+
+func (m *MockContestPermissionPort) CheckPermission(contestId, userId int64, action domain.ContestAction) error {
+	args := m.Called(contestId, userId, action)
+	return args.Error(0)
+}
+
+// MockContestAccessPort mocks the ContestAccessPort interface
+type MockContestAccessPort struct {
+	mock.Mock
+}
+
+func (m *MockContestAccessPort) CheckViewAccess(contestId, userId int64) error {
+	args := m.Called(contestId, userId)
+	return args.Error(0)
+}
+
+// FakeFormUserQueryPort resolves applicants by ID
+type FakeFormUserQueryPort struct {
+	userQueryPort.UserQueryPort
+
+	users map[int64]*userDomain.User
+}
+
+func (f *FakeFormUserQueryPort) FindById(id int64) (*userDomain.User, error) {
+	if user, ok := f.users[id]; ok {
+		return user, nil
+	}
+	return nil, exception.ErrUserNotFound
+}
+
+// ==================== Helper Functions ====================
+
+func setupApplicationFormService(visibility domain.ContestVisibility) (*application.ContestApplicationFormService, *FakeApplicationFormDatabasePort, *MockContestPermissionPort, *MockContestAccessPort) {
+	contest := &domain.Contest{ContestID: 1, ContestStatus: domain.ContestStatusPending, Visibility: visibility}
+
+	mockContestDB := new(MockContestDatabasePort)
+	mockContestDB.On("GetContestById", int64(1)).Return(contest, nil)
+
+	formRepo := &FakeApplicationFormDatabasePort{
+		form: domain.NewContestApplicationForm(1, domain.FormQuestions{
+			{QuestionID: "q1", Label: "Main role", Type: domain.FormQuestionTypeText},
+			{QuestionID: "q2", Label: "=cmd|' /C calc'!A0", Type: domain.FormQuestionTypeText},
+		}),
+	}
+	userQuery := &FakeFormUserQueryPort{users: map[int64]*userDomain.User{
+		10: {Id: 10, Username: "@evil", Tag: "KR1"},
+	}}
+
+	mockPermission := new(MockContestPermissionPort)
+	mockAccess := new(MockContestAccessPort)
+
+	service := application.NewContestApplicationFormService(formRepo, mockContestDB, nil, mockPermission, userQuery)
+	service.SetAccessChecker(mockAccess)
+
+	return service, formRepo, mockPermission, mockAccess
+}
+
+// ==================== GetForm Tests ====================
+
+func TestContestApplicationFormService_GetForm_PublicContest(t *testing.T) {
+	service, _, _, mockAccess := setupApplicationFormService(domain.ContestVisibilityPublic)
+
+	form, err := service.GetForm(1, 20)
+
+	assert.NoError(t, err)
+	assert.Len(t, form.Questions, 2)
+	mockAccess.AssertNotCalled(t, "CheckViewAccess", mock.Anything, mock.Anything)
+}
+
+func TestContestApplicationFormService_GetForm_PrivateContestWithAccess(t *testing.T) {
+	service, _, _, mockAccess := setupApplicationFormService(domain.ContestVisibilityPrivate)
+	mockAccess.On("CheckViewAccess", int64(1), int64(20)).Return(nil)
+
+	form, err := service.GetForm(1, 20)
+
+	assert.NoError(t, err)
+	assert.Len(t, form.Questions, 2)
+	mockAccess.AssertExpectations(t)
+}
+
+func TestContestApplicationFormService_GetForm_PrivateContestWithoutAccess(t *testing.T) {
+	service, _, _, mockAccess := setupApplicationFormService(domain.ContestVisibilityPrivate)
+	mockAccess.On("CheckViewAccess", int64(1), int64(30)).Return(exception.ErrContestNotFound)
+
+	form, err := service.GetForm(1, 30)
+
+	assert.ErrorIs(t, err, exception.ErrContestNotFound)
+	assert.Nil(t, form)
+}
+
+// ==================== ExportAnswers Tests ====================
+
+func TestContestApplicationFormService_ExportAnswers_EscapesFormulas(t *testing.T) {
+	service, formRepo, mockPermission, _ := setupApplicationFormService(domain.ContestVisibilityPublic)
+	mockPermission.On("CheckPermission", int64(1), int64(20), domain.ContestActionManageApplications).Return(nil)
+	submittedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	formRepo.answers = []*domain.ContestApplicationAnswer{
+		domain.NewContestApplicationAnswer(1, 10, domain.ApplicationAnswers{
+			{QuestionID: "q1", Value: `=HYPERLINK("http://evil.example","Duelist")`},
+			{QuestionID: "q2", Value: "-5"},
+		}, submittedAt),
+	}
+
+	data, err := service.ExportAnswers(1, 20)
+	assert.NoError(t, err)
+
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user_id", "username", "tag", "submitted_at", "Main role", "'=cmd|' /C calc'!A0"}, rows[0])
+	assert.Equal(t, []string{"10", "'@evil", "KR1", "2026-03-01T12:00:00Z", `'=HYPERLINK("http://evil.example","Duelist")`, "-5"}, rows[1])
+}
+
+func TestContestApplicationFormService_ExportAnswers_RequiresPermission(t *testing.T) {
+	service, _, mockPermission, _ := setupApplicationFormService(domain.ContestVisibilityPublic)
+	mockPermission.On("CheckPermission", int64(1), int64(30), domain.ContestActionManageApplications).Return(exception.ErrPermissionDenied)
+
+	data, err := service.ExportAnswers(1, 30)
+
+	assert.ErrorIs(t, err, exception.ErrPermissionDenied)
+	assert.Nil(t, data)
+}
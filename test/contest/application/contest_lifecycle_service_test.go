@@ -0,0 +1,213 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	gamePort "github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// FakeLifecycleContestDatabasePort keeps contests in memory; due lists what the auto-start query returns
+type FakeLifecycleContestDatabasePort struct {
+	port.ContestDatabasePort
+
+	contests map[int64]*domain.Contest
+	due      []*domain.Contest
+	updates  int
+}
+
+func (f *FakeLifecycleContestDatabasePort) GetContestById(contestId int64) (*domain.Contest, error) {
+	contest, ok := f.contests[contestId]
+	if !ok {
+		return nil, exception.ErrContestNotFound
+	}
+	return contest, nil
+}
+
+func (f *FakeLifecycleContestDatabasePort) UpdateContest(contest *domain.Contest) error {
+	f.updates++
+	f.contests[contest.ContestID] = contest
+	return nil
+}
+
+func (f *FakeLifecycleContestDatabasePort) GetContestsDueForAutoStart(now time.Time) ([]*domain.Contest, error) {
+	return f.due, nil
+}
+
+func (f *FakeLifecycleContestDatabasePort) GetContestsByStatuses(statuses []domain.ContestStatus) ([]*domain.Contest, error) {
+	var contests []*domain.Contest
+	for _, contest := range f.contests {
+		for _, status := range statuses {
+			if contest.ContestStatus == status {
+				contests = append(contests, contest)
+			}
+		}
+	}
+	return contests, nil
+}
+
+// FakeLifecycleTeamDatabasePort reports the registered team count, or countErr
+type FakeLifecycleTeamDatabasePort struct {
+	gamePort.TeamDatabasePort
+
+	registered int
+	countErr   error
+}
+
+func (f *FakeLifecycleTeamDatabasePort) CountRegisteredByContestID(contestID int64) (int, error) {
+	return f.registered, f.countErr
+}
+
+func (f *FakeLifecycleTeamDatabasePort) GetTeamsByContestWithMembers(contestID int64) ([]*gamePort.TeamWithMembers, error) {
+	return nil, nil
+}
+
+// FakeLifecycleGameDatabasePort returns the games of each contest
+type FakeLifecycleGameDatabasePort struct {
+	gamePort.GameDatabasePort
+
+	games map[int64][]*gameDomain.Game
+}
+
+func (f *FakeLifecycleGameDatabasePort) GetByContestID(contestID int64) ([]*gameDomain.Game, error) {
+	return f.games[contestID], nil
+}
+
+// ==================== Helper Functions ====================
+
+func setupLifecycleService(contests ...*domain.Contest) (*application.ContestLifecycleService, *FakeLifecycleContestDatabasePort, *FakeLifecycleTeamDatabasePort, *FakeLifecycleGameDatabasePort) {
+	contestRepo := &FakeLifecycleContestDatabasePort{contests: make(map[int64]*domain.Contest)}
+	for _, contest := range contests {
+		contestRepo.contests[contest.ContestID] = contest
+		if contest.AutoStart && contest.ContestStatus == domain.ContestStatusPending {
+			contestRepo.due = append(contestRepo.due, contest)
+		}
+	}
+
+	mockRedis := new(MockContestApplicationRedisPort)
+	mockRedis.On("GetAcceptedApplications", mock.Anything, mock.Anything).Return([]int64{}, nil)
+	mockRedis.On("ClearApplications", mock.Anything, mock.Anything).Return(nil)
+
+	teamRepo := &FakeLifecycleTeamDatabasePort{}
+	gameRepo := &FakeLifecycleGameDatabasePort{games: make(map[int64][]*gameDomain.Game)}
+
+	contestService := application.NewContestServiceFull(contestRepo, nil, nil, mockRedis, nil, nil, nil, nil, teamRepo, nil)
+	service := application.NewContestLifecycleService(contestService, contestRepo, gameRepo, nil)
+
+	return service, contestRepo, teamRepo, gameRepo
+}
+
+func autoStartContest(contestID int64, contestType domain.ContestType, startedAt time.Time) *domain.Contest {
+	return &domain.Contest{
+		ContestID:     contestID,
+		ContestStatus: domain.ContestStatusPending,
+		ContestType:   contestType,
+		MaxTeamCount:  4,
+		StartedAt:     startedAt,
+		AutoStart:     true,
+	}
+}
+
+// ==================== Auto-start Tests ====================
+
+func TestContestLifecycleService_TransitionDueContests_StartsDueContest(t *testing.T) {
+	contest := autoStartContest(1, domain.ContestTypeCasual, time.Now().Add(-time.Minute))
+	service, _, _, _ := setupLifecycleService(contest)
+
+	service.TransitionDueContests(context.Background(), time.Now())
+
+	assert.Equal(t, domain.ContestStatusActive, contest.ContestStatus)
+	assert.True(t, contest.AutoStart)
+}
+
+func TestContestLifecycleService_TransitionDueContests_NotEnoughTeamsDisablesAutoStart(t *testing.T) {
+	contest := autoStartContest(1, domain.ContestTypeTournament, time.Now().Add(-time.Minute))
+	service, contestRepo, teamRepo, _ := setupLifecycleService(contest)
+	teamRepo.registered = 3
+
+	service.TransitionDueContests(context.Background(), time.Now())
+
+	assert.Equal(t, domain.ContestStatusPending, contest.ContestStatus)
+	assert.False(t, contest.AutoStart)
+	assert.Equal(t, 1, contestRepo.updates)
+}
+
+func TestContestLifecycleService_TransitionDueContests_CannotStartDisablesAutoStart(t *testing.T) {
+	contest := autoStartContest(1, domain.ContestTypeCasual, time.Now().Add(time.Hour))
+	service, _, _, _ := setupLifecycleService(contest)
+
+	service.TransitionDueContests(context.Background(), time.Now())
+
+	assert.Equal(t, domain.ContestStatusPending, contest.ContestStatus)
+	assert.False(t, contest.AutoStart)
+}
+
+func TestContestLifecycleService_TransitionDueContests_OtherFailuresKeepAutoStart(t *testing.T) {
+	for name, countErr := range map[string]error{
+		"db error":       errors.New("connection lost"),
+		"business error": exception.ErrTeamNotFound,
+	} {
+		contest := autoStartContest(1, domain.ContestTypeTournament, time.Now().Add(-time.Minute))
+		service, contestRepo, teamRepo, _ := setupLifecycleService(contest)
+		teamRepo.countErr = countErr
+
+		service.TransitionDueContests(context.Background(), time.Now())
+
+		assert.Equal(t, domain.ContestStatusPending, contest.ContestStatus, name)
+		assert.True(t, contest.AutoStart, name)
+		assert.Zero(t, contestRepo.updates, name)
+	}
+}
+
+// ==================== Auto-finish Tests ====================
+
+func TestContestLifecycleService_TransitionDueContests_FinishesWhenGamesAreDone(t *testing.T) {
+	contest := &domain.Contest{ContestID: 1, ContestStatus: domain.ContestStatusActive, EndedAt: time.Now().Add(time.Hour)}
+	service, _, _, gameRepo := setupLifecycleService(contest)
+	gameRepo.games[1] = []*gameDomain.Game{
+		{GameID: 1, GameStatus: gameDomain.GameStatusFinished},
+		{GameID: 2, GameStatus: gameDomain.GameStatusCancelled},
+	}
+
+	now := time.Now()
+	service.TransitionDueContests(context.Background(), now)
+
+	assert.Equal(t, domain.ContestStatusFinished, contest.ContestStatus)
+	assert.False(t, contest.EndedAt.Before(now))
+}
+
+func TestContestLifecycleService_TransitionDueContests_KeepsContestWithOpenGames(t *testing.T) {
+	contest := &domain.Contest{ContestID: 1, ContestStatus: domain.ContestStatusActive, EndedAt: time.Now().Add(time.Hour)}
+	service, contestRepo, _, gameRepo := setupLifecycleService(contest)
+	gameRepo.games[1] = []*gameDomain.Game{
+		{GameID: 1, GameStatus: gameDomain.GameStatusFinished},
+		{GameID: 2, GameStatus: gameDomain.GameStatusActive},
+	}
+
+	service.TransitionDueContests(context.Background(), time.Now())
+
+	assert.Equal(t, domain.ContestStatusActive, contest.ContestStatus)
+	assert.Zero(t, contestRepo.updates)
+}
+
+func TestContestLifecycleService_TransitionDueContests_FinishesPastEndTime(t *testing.T) {
+	endedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	contest := &domain.Contest{ContestID: 1, ContestStatus: domain.ContestStatusActive, EndedAt: endedAt}
+	service, _, _, _ := setupLifecycleService(contest)
+
+	service.TransitionDueContests(context.Background(), time.Now())
+
+	assert.Equal(t, domain.ContestStatusFinished, contest.ContestStatus)
+	assert.Equal(t, endedAt, contest.EndedAt)
+}
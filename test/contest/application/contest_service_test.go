@@ -12,6 +12,7 @@ import (
 	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
 	oauth2Domain "github.com/FOR-GAMERS/GAMERS-BE/internal/oauth2/domain"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -34,6 +35,14 @@ func (m *MockContestDatabasePort) Save(contest *domain.Contest) (*domain.Contest
 	return args.Get(0).(*domain.Contest), args.Error(1)
 }
 
+func (m *MockContestDatabasePort) SaveWithContext(ctx context.Context, contest *domain.Contest) (*domain.Contest, error) {
+	args := m.Called(ctx, contest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePort) GetContestById(contestId int64) (*domain.Contest, error) {
 	args := m.Called(contestId)
 	if args.Get(0) == nil {
@@ -42,11 +51,27 @@ func (m *MockContestDatabasePort) GetContestById(contestId int64) (*domain.Conte
 	return args.Get(0).(*domain.Contest), args.Error(1)
 }
 
+func (m *MockContestDatabasePort) GetContestByIdForUpdate(ctx context.Context, contestId int64) (*domain.Contest, error) {
+	args := m.Called(ctx, contestId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePort) GetContests(offset, limit int, sortReq *commonDto.SortRequest, title *string) ([]domain.Contest, int64, error) {
 	args := m.Called(offset, limit, sortReq, title)
 	return args.Get(0).([]domain.Contest), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockContestDatabasePort) GetContestsByCursor(cursor *commonDto.CursorRequest, title *string) ([]domain.Contest, error) {
+	args := m.Called(cursor, title)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePort) DeleteContestById(contestId int64) error {
 	args := m.Called(contestId)
 	return args.Error(0)
@@ -57,6 +82,35 @@ func (m *MockContestDatabasePort) UpdateContest(contest *domain.Contest) error {
 	return args.Error(0)
 }
 
+func (m *MockContestDatabasePort) UpdateContestWithContext(ctx context.Context, contest *domain.Contest) error {
+	args := m.Called(ctx, contest)
+	return args.Error(0)
+}
+
+func (m *MockContestDatabasePort) GetContestsDueForRosterLock(now time.Time) ([]*domain.Contest, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
+func (m *MockContestDatabasePort) GetContestsDueForAutoStart(now time.Time) ([]*domain.Contest, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
+func (m *MockContestDatabasePort) GetContestsByStatuses(statuses []domain.ContestStatus) ([]*domain.Contest, error) {
+	args := m.Called(statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
 // MockContestMemberDatabasePort mocks the ContestMemberDatabasePort interface
 type MockContestMemberDatabasePort struct {
 	mock.Mock
@@ -72,6 +126,16 @@ func (m *MockContestMemberDatabasePort) DeleteById(contestId, userId int64) erro
 	return args.Error(0)
 }
 
+func (m *MockContestMemberDatabasePort) SaveWithContext(ctx context.Context, member *domain.ContestMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockContestMemberDatabasePort) DeleteByIdWithContext(ctx context.Context, contestId, userId int64) error {
+	args := m.Called(ctx, contestId, userId)
+	return args.Error(0)
+}
+
 func (m *MockContestMemberDatabasePort) GetByContestAndUser(contestId, userId int64) (*domain.ContestMember, error) {
 	args := m.Called(contestId, userId)
 	if args.Get(0) == nil {
@@ -101,6 +165,14 @@ func (m *MockContestMemberDatabasePort) GetMembersWithUserByContest(contestId in
 	return args.Get(0).([]*port.ContestMemberWithUser), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockContestMemberDatabasePort) GetMembersWithUserByContestCursor(contestId int64, cursor *commonDto.CursorRequest) ([]*port.ContestMemberWithUser, error) {
+	args := m.Called(contestId, cursor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ContestMemberWithUser), args.Error(1)
+}
+
 func (m *MockContestMemberDatabasePort) GetContestsByUserId(userId int64, pagination *commonDto.PaginationRequest, sort *commonDto.SortRequest, status *domain.ContestStatus) ([]*port.ContestWithMembership, int64, error) {
 	args := m.Called(userId, pagination, sort, status)
 	if args.Get(0) == nil {
@@ -317,6 +389,7 @@ func TestContestService_SaveContest_Success(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -331,10 +404,10 @@ func TestContestService_SaveContest_Success(t *testing.T) {
 	mockOAuth2DB.On("FindDiscordAccountByUserId", userID).Return(discordAccount, nil)
 
 	// Mock contest save
-	mockContestDB.On("Save", mock.AnythingOfType("*domain.Contest")).Return(savedContest, nil)
+	mockContestDB.On("SaveWithContext", mock.Anything, mock.AnythingOfType("*domain.Contest")).Return(savedContest, nil)
 
 	// Mock member save (leader)
-	mockMemberDB.On("Save", mock.AnythingOfType("*domain.ContestMember")).Return(nil)
+	mockMemberDB.On("SaveWithContext", mock.Anything, mock.AnythingOfType("*domain.ContestMember")).Return(nil)
 
 	// When
 	result, linkRequired, err := service.SaveContest(req, userID)
@@ -351,6 +424,45 @@ func TestContestService_SaveContest_Success(t *testing.T) {
 	mockMemberDB.AssertExpectations(t)
 }
 
+func TestContestService_SaveContest_FailWhenEventPublishFails(t *testing.T) {
+	// Given
+	mockContestDB := new(MockContestDatabasePort)
+	mockMemberDB := new(MockContestMemberDatabasePort)
+	mockRedis := new(MockContestApplicationRedisPort)
+	mockOAuth2DB := new(MockOAuth2DatabasePort)
+	mockEventPub := new(MockEventPublisherPort)
+
+	service := application.NewContestService(
+		mockContestDB,
+		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
+		mockRedis,
+		mockOAuth2DB,
+		mockEventPub,
+	)
+
+	userID := int64(1)
+	req := createValidContestRequest()
+	guildID, channelID := "guild-1", "channel-1"
+	savedContest := createSavedContest(req, 1)
+	savedContest.DiscordGuildId = &guildID
+	savedContest.DiscordTextChannelId = &channelID
+
+	mockOAuth2DB.On("FindDiscordAccountByUserId", userID).Return(createDiscordAccount(userID), nil)
+	mockContestDB.On("SaveWithContext", mock.Anything, mock.AnythingOfType("*domain.Contest")).Return(savedContest, nil)
+	mockMemberDB.On("SaveWithContext", mock.Anything, mock.AnythingOfType("*domain.ContestMember")).Return(nil)
+	mockEventPub.On("PublishContestCreatedEvent", mock.Anything, mock.AnythingOfType("*port.ContestCreatedEvent")).
+		Return(errors.New("failed to write outbox event"))
+
+	// When
+	result, _, err := service.SaveContest(req, userID)
+
+	// Then - the outbox write shares the contest's transaction, so its failure fails the creation
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockEventPub.AssertExpectations(t)
+}
+
 func TestContestService_SaveContest_WithTournamentBracketGeneration(t *testing.T) {
 	// Given
 	mockContestDB := new(MockContestDatabasePort)
@@ -363,6 +475,7 @@ func TestContestService_SaveContest_WithTournamentBracketGeneration(t *testing.T
 	service := application.NewContestServiceFull(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -381,8 +494,8 @@ func TestContestService_SaveContest_WithTournamentBracketGeneration(t *testing.T
 	savedContest := createSavedContest(req, 1)
 
 	mockOAuth2DB.On("FindDiscordAccountByUserId", userID).Return(discordAccount, nil)
-	mockContestDB.On("Save", mock.AnythingOfType("*domain.Contest")).Return(savedContest, nil)
-	mockMemberDB.On("Save", mock.AnythingOfType("*domain.ContestMember")).Return(nil)
+	mockContestDB.On("SaveWithContext", mock.Anything, mock.AnythingOfType("*domain.Contest")).Return(savedContest, nil)
+	mockMemberDB.On("SaveWithContext", mock.Anything, mock.AnythingOfType("*domain.ContestMember")).Return(nil)
 
 	// Mock tournament bracket generation
 	games := []*gameDomain.Game{
@@ -414,6 +527,7 @@ func TestContestService_SaveContest_FailWithoutDiscordLink(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -449,6 +563,7 @@ func TestContestService_SaveContest_FailWithInvalidTitle(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -481,6 +596,7 @@ func TestContestService_SaveContest_FailWithInvalidDates(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -514,6 +630,7 @@ func TestContestService_StartContest_Success(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -571,6 +688,7 @@ func TestContestService_StartContest_FailNotLeader(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -618,6 +736,7 @@ func TestContestService_StartContest_FailNotPending(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -666,6 +785,7 @@ func TestContestService_StartContest_FailBeforeStartTime(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -714,6 +834,7 @@ func TestContestService_StopContest_Success(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -765,6 +886,7 @@ func TestContestService_StopContest_FailNotActive(t *testing.T) {
 	service := application.NewContestService(
 		mockContestDB,
 		mockMemberDB,
+		application.NewContestPermissionChecker(mockMemberDB),
 		mockRedis,
 		mockOAuth2DB,
 		mockEventPub,
@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestApplicationForm() *domain.ContestApplicationForm {
+	return domain.NewContestApplicationForm(1, domain.FormQuestions{
+		{QuestionID: "role", Label: "Preferred role", Type: domain.FormQuestionTypeChoice, Required: true, Options: []string{"Duelist", "Controller"}},
+		{QuestionID: "rank", Label: "In-game rank", Type: domain.FormQuestionTypeNumber},
+		{QuestionID: "availability", Label: "Availability", Type: domain.FormQuestionTypeText},
+	})
+}
+
+func TestContestApplicationForm_Validate(t *testing.T) {
+	t.Run("accepts valid form", func(t *testing.T) {
+		assert.NoError(t, newTestApplicationForm().Validate())
+	})
+
+	t.Run("fails without questions", func(t *testing.T) {
+		form := domain.NewContestApplicationForm(1, domain.FormQuestions{})
+
+		assert.Equal(t, exception.ErrInvalidApplicationForm, form.Validate())
+	})
+
+	t.Run("fails for choice question without options", func(t *testing.T) {
+		form := domain.NewContestApplicationForm(1, domain.FormQuestions{
+			{QuestionID: "role", Label: "Role", Type: domain.FormQuestionTypeChoice, Options: []string{"Duelist"}},
+		})
+
+		assert.Equal(t, exception.ErrInvalidFormQuestion, form.Validate())
+	})
+
+	t.Run("fails for duplicate question ids", func(t *testing.T) {
+		form := domain.NewContestApplicationForm(1, domain.FormQuestions{
+			{QuestionID: "rank", Label: "Rank", Type: domain.FormQuestionTypeNumber},
+			{QuestionID: "rank", Label: "Peak rank", Type: domain.FormQuestionTypeNumber},
+		})
+
+		assert.Equal(t, exception.ErrDuplicateFormQuestion, form.Validate())
+	})
+}
+
+func TestContestApplicationForm_ValidateAnswers(t *testing.T) {
+	form := newTestApplicationForm()
+
+	t.Run("accepts valid answers", func(t *testing.T) {
+		answers := domain.ApplicationAnswers{
+			{QuestionID: "role", Value: "Duelist"},
+			{QuestionID: "rank", Value: "21"},
+		}
+
+		assert.NoError(t, form.ValidateAnswers(answers))
+	})
+
+	t.Run("fails when required question is missing", func(t *testing.T) {
+		answers := domain.ApplicationAnswers{{QuestionID: "rank", Value: "21"}}
+
+		assert.Equal(t, exception.ErrRequiredAnswerMissing, form.ValidateAnswers(answers))
+		assert.Equal(t, exception.ErrRequiredAnswerMissing, form.ValidateAnswers(nil))
+	})
+
+	t.Run("fails for unknown question", func(t *testing.T) {
+		answers := domain.ApplicationAnswers{
+			{QuestionID: "role", Value: "Duelist"},
+			{QuestionID: "agent", Value: "Jett"},
+		}
+
+		assert.Equal(t, exception.ErrUnknownFormQuestion, form.ValidateAnswers(answers))
+	})
+
+	t.Run("fails for answers not matching the question type", func(t *testing.T) {
+		badChoice := domain.ApplicationAnswers{{QuestionID: "role", Value: "Sentinel"}}
+		badNumber := domain.ApplicationAnswers{
+			{QuestionID: "role", Value: "Duelist"},
+			{QuestionID: "rank", Value: "Diamond"},
+		}
+
+		assert.Equal(t, exception.ErrInvalidFormAnswer, form.ValidateAnswers(badChoice))
+		assert.Equal(t, exception.ErrInvalidFormAnswer, form.ValidateAnswers(badNumber))
+	})
+}
@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func newTestAutoAcceptRule() *domain.ContestAutoAcceptRule {
+	return &domain.ContestAutoAcceptRule{
+		ContestID:          1,
+		Enabled:            true,
+		MinTier:            intPtr(9),
+		MaxTier:            intPtr(18),
+		RequireRiotAccount: true,
+		OnMismatch:         domain.AutoAcceptMismatchLeavePending,
+	}
+}
+
+func TestContestAutoAcceptRule_Validate(t *testing.T) {
+	t.Run("accepts valid rule", func(t *testing.T) {
+		assert.NoError(t, newTestAutoAcceptRule().Validate())
+	})
+
+	t.Run("fails for tier out of range", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.MaxTier = intPtr(domain.MaxValorantTier + 1)
+
+		assert.Equal(t, exception.ErrInvalidAutoAcceptTier, rule.Validate())
+	})
+
+	t.Run("fails when min tier is above max tier", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.MinTier = intPtr(20)
+
+		assert.Equal(t, exception.ErrInvalidAutoAcceptTier, rule.Validate())
+	})
+
+	t.Run("fails for non positive cap", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.AcceptCap = intPtr(0)
+
+		assert.Equal(t, exception.ErrInvalidAutoAcceptCap, rule.Validate())
+	})
+
+	t.Run("fails for unknown mismatch action", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.OnMismatch = "IGNORE"
+
+		assert.Equal(t, exception.ErrInvalidAutoAcceptMismatchAction, rule.Validate())
+	})
+}
+
+func TestContestAutoAcceptRule_Evaluate(t *testing.T) {
+	t.Run("accepts matching applicant", func(t *testing.T) {
+		decision := newTestAutoAcceptRule().Evaluate(domain.ApplicantProfile{Tier: intPtr(12), HasRiotAccount: true})
+
+		assert.Equal(t, domain.AutoAcceptOutcomeAccepted, decision.Outcome)
+	})
+
+	t.Run("leaves applicant without riot account pending", func(t *testing.T) {
+		decision := newTestAutoAcceptRule().Evaluate(domain.ApplicantProfile{})
+
+		assert.Equal(t, domain.AutoAcceptOutcomePending, decision.Outcome)
+		assert.Equal(t, "riot account is not linked", decision.Reason)
+	})
+
+	t.Run("rejects applicant below min tier when configured to reject", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.OnMismatch = domain.AutoAcceptMismatchReject
+
+		decision := rule.Evaluate(domain.ApplicantProfile{Tier: intPtr(3), HasRiotAccount: true})
+
+		assert.Equal(t, domain.AutoAcceptOutcomeRejected, decision.Outcome)
+		assert.Contains(t, decision.Reason, "below the minimum tier")
+	})
+
+	t.Run("requires discord guild membership", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.RequireGuildMember = true
+
+		decision := rule.Evaluate(domain.ApplicantProfile{Tier: intPtr(12), HasRiotAccount: true})
+
+		assert.Equal(t, domain.AutoAcceptOutcomePending, decision.Outcome)
+	})
+
+	t.Run("stops accepting once the cap is reached", func(t *testing.T) {
+		rule := newTestAutoAcceptRule()
+		rule.AcceptCap = intPtr(2)
+
+		assert.Equal(t, domain.AutoAcceptOutcomeAccepted,
+			rule.Evaluate(domain.ApplicantProfile{Tier: intPtr(12), HasRiotAccount: true, AcceptedCount: 1}).Outcome)
+		assert.Equal(t, domain.AutoAcceptOutcomePending,
+			rule.Evaluate(domain.ApplicantProfile{Tier: intPtr(12), HasRiotAccount: true, AcceptedCount: 2}).Outcome)
+	})
+}
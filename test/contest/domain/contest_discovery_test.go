@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	gameDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContestDiscoveryFilter_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	zero := 0
+
+	tests := []struct {
+		name    string
+		filter  domain.ContestDiscoveryFilter
+		wantErr bool
+	}{
+		{"Empty filter", domain.ContestDiscoveryFilter{}, false},
+		{
+			"Known values",
+			domain.ContestDiscoveryFilter{
+				GameTypes:    []gameDomain.GameType{gameDomain.GameTypeValorant},
+				ContestTypes: []domain.ContestType{domain.ContestTypeTournament},
+				Statuses:     []domain.ContestStatus{domain.ContestStatusPending},
+				StartsFrom:   &earlier,
+				StartsTo:     &now,
+			},
+			false,
+		},
+		{"Unknown game type", domain.ContestDiscoveryFilter{GameTypes: []gameDomain.GameType{"CHESS"}}, true},
+		{"Unknown contest type", domain.ContestDiscoveryFilter{ContestTypes: []domain.ContestType{"LADDER"}}, true},
+		{"Unknown status", domain.ContestDiscoveryFilter{Statuses: []domain.ContestStatus{"PAUSED"}}, true},
+		{"Reversed date range", domain.ContestDiscoveryFilter{StartsFrom: &now, StartsTo: &earlier}, true},
+		{"Team size below one", domain.ContestDiscoveryFilter{TeamSize: &zero}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.Equal(t, exception.ErrInvalidDiscoveryFilter, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContestDiscoveryFilter_FullTextQuery(t *testing.T) {
+	short := domain.ContestDiscoveryFilter{Query: " ab "}
+	assert.False(t, short.UsesFullText())
+
+	filter := domain.ContestDiscoveryFilter{Query: `valorant -cup "open" (kr)`}
+	assert.True(t, filter.UsesFullText())
+	assert.Equal(t, "+valorant* +cup* +open* +kr*", filter.FullTextQuery())
+}
+
+func TestContestDiscoverySort_IsValid(t *testing.T) {
+	assert.True(t, domain.ContestDiscoverySortRecommended.IsValid())
+	assert.True(t, domain.ContestDiscoverySortStartedAt.IsValid())
+	assert.False(t, domain.ContestDiscoverySort("title; DROP TABLE contests").IsValid())
+}
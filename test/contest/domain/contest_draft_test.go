@@ -0,0 +1,124 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// draftPlayers builds players from user ID / rating pairs
+func draftPlayers(pairs ...int64) []*domain.DraftPlayer {
+	players := make([]*domain.DraftPlayer, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		players = append(players, &domain.DraftPlayer{UserID: pairs[i], Rating: int(pairs[i+1])})
+	}
+	return players
+}
+
+func newTestDraft(t *testing.T) *domain.ContestDraft {
+	captains := draftPlayers(1, 0, 2, 0)
+	pool := draftPlayers(1, 0, 2, 0, 3, 1200, 4, 1500, 5, 900, 6, 1800)
+
+	draft, err := domain.NewContestDraft(100, 3, time.Minute, captains, pool, 99)
+	require.NoError(t, err)
+	return draft
+}
+
+func TestNewContestDraft(t *testing.T) {
+	t.Run("excludes captains from the pool", func(t *testing.T) {
+		draft := newTestDraft(t)
+
+		assert.Equal(t, domain.DraftStatusInProgress, draft.Status)
+		assert.Len(t, draft.Pool, 4)
+		assert.Equal(t, 4, draft.TotalPicks)
+		assert.NotNil(t, draft.PickDeadline)
+	})
+
+	t.Run("requires at least two captains", func(t *testing.T) {
+		_, err := domain.NewContestDraft(100, 3, time.Minute, draftPlayers(1, 0), nil, 99)
+		assert.ErrorIs(t, err, exception.ErrDraftNotEnoughCaptains)
+	})
+
+	t.Run("requires enough players to fill every team", func(t *testing.T) {
+		captains := draftPlayers(1, 0, 2, 0)
+		pool := draftPlayers(3, 0, 4, 0, 5, 0)
+
+		_, err := domain.NewContestDraft(100, 3, time.Minute, captains, pool, 99)
+		assert.ErrorIs(t, err, exception.ErrDraftNotEnoughPlayers)
+	})
+
+	t.Run("rejects pick timeout out of range", func(t *testing.T) {
+		captains := draftPlayers(1, 0, 2, 0)
+		pool := draftPlayers(3, 0, 4, 0)
+
+		_, err := domain.NewContestDraft(100, 2, time.Second, captains, pool, 99)
+		assert.ErrorIs(t, err, exception.ErrDraftInvalidPickTimeout)
+	})
+}
+
+func TestContestDraft_SnakeOrder(t *testing.T) {
+	draft := newTestDraft(t)
+	now := time.Now()
+
+	// Round 1: captain 1 → captain 2, Round 2: captain 2 → captain 1
+	assert.Equal(t, int64(1), draft.CurrentCaptainUserID())
+	require.NoError(t, draft.Pick(1, 6, false, now))
+
+	assert.Equal(t, int64(2), draft.CurrentCaptainUserID())
+	require.NoError(t, draft.Pick(2, 4, false, now))
+
+	assert.Equal(t, int64(2), draft.CurrentCaptainUserID())
+	require.NoError(t, draft.Pick(2, 3, false, now))
+
+	assert.Equal(t, int64(1), draft.CurrentCaptainUserID())
+	require.NoError(t, draft.Pick(1, 5, false, now))
+
+	assert.True(t, draft.IsCompleted())
+	assert.Nil(t, draft.PickDeadline)
+	assert.Equal(t, []int64{6, 5}, draft.RosterOf(1))
+	assert.Equal(t, []int64{4, 3}, draft.RosterOf(2))
+}
+
+func TestContestDraft_Pick(t *testing.T) {
+	t.Run("rejects captain out of turn", func(t *testing.T) {
+		draft := newTestDraft(t)
+
+		err := draft.Pick(2, 3, false, time.Now())
+		assert.ErrorIs(t, err, exception.ErrDraftNotYourTurn)
+	})
+
+	t.Run("rejects player not in pool", func(t *testing.T) {
+		draft := newTestDraft(t)
+		require.NoError(t, draft.Pick(1, 3, false, time.Now()))
+
+		err := draft.Pick(2, 3, false, time.Now())
+		assert.ErrorIs(t, err, exception.ErrDraftPlayerNotAvailable)
+	})
+
+	t.Run("rejects picks after cancel", func(t *testing.T) {
+		draft := newTestDraft(t)
+		require.NoError(t, draft.Cancel())
+
+		err := draft.Pick(1, 3, false, time.Now())
+		assert.ErrorIs(t, err, exception.ErrDraftNotInProgress)
+	})
+}
+
+func TestContestDraft_AutoPick(t *testing.T) {
+	draft := newTestDraft(t)
+	now := time.Now()
+
+	assert.False(t, draft.IsPickExpired(now))
+	assert.True(t, draft.IsPickExpired(now.Add(2*time.Minute)))
+
+	require.NoError(t, draft.AutoPick(now))
+
+	last := draft.Picks[len(draft.Picks)-1]
+	assert.Equal(t, int64(1), last.CaptainUserID)
+	assert.Equal(t, int64(6), last.PlayerUserID)
+	assert.True(t, last.AutoPicked)
+}
@@ -0,0 +1,115 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func strPtr(v string) *string {
+	return &v
+}
+
+func newTestEligibility() *domain.ContestEligibility {
+	return &domain.ContestEligibility{
+		AllowedRegions:    domain.ContestRegions{"kr", "ap"},
+		MinTier:           intPtr(9),
+		MaxTier:           intPtr(18),
+		MinAccountAgeDays: intPtr(30),
+	}
+}
+
+func newEligibleProfile(now time.Time) domain.EligibilityProfile {
+	return domain.EligibilityProfile{
+		Region:           strPtr("KR"),
+		CurrentTier:      intPtr(12),
+		PeakTier:         intPtr(21),
+		AccountCreatedAt: now.AddDate(0, -2, 0),
+	}
+}
+
+func TestContestEligibility_Validate(t *testing.T) {
+	t.Run("accepts valid constraints", func(t *testing.T) {
+		assert.NoError(t, newTestEligibility().Validate())
+	})
+
+	t.Run("fails for unknown region", func(t *testing.T) {
+		eligibility := newTestEligibility()
+		eligibility.AllowedRegions = domain.ContestRegions{"mars"}
+
+		assert.Equal(t, exception.ErrInvalidEligibility, eligibility.Validate())
+	})
+
+	t.Run("fails when min tier is above max tier", func(t *testing.T) {
+		eligibility := newTestEligibility()
+		eligibility.MinTier = intPtr(20)
+
+		assert.Equal(t, exception.ErrInvalidEligibility, eligibility.Validate())
+	})
+
+	t.Run("fails for unknown tier basis", func(t *testing.T) {
+		eligibility := newTestEligibility()
+		eligibility.TierBasis = "AVERAGE"
+
+		assert.Equal(t, exception.ErrInvalidEligibility, eligibility.Validate())
+	})
+
+	t.Run("requires discord integration for guild membership", func(t *testing.T) {
+		contest := &domain.Contest{Eligibility: domain.ContestEligibility{RequireGuildMember: true}}
+
+		assert.Equal(t, exception.ErrEligibilityGuildRequired, contest.ValidateEligibility())
+	})
+}
+
+func TestContestEligibility_Check(t *testing.T) {
+	now := time.Now()
+
+	t.Run("accepts eligible user", func(t *testing.T) {
+		assert.NoError(t, newTestEligibility().Check(newEligibleProfile(now), now))
+	})
+
+	t.Run("unrestricted contest accepts anyone", func(t *testing.T) {
+		eligibility := &domain.ContestEligibility{}
+
+		assert.False(t, eligibility.IsRestricted())
+		assert.NoError(t, eligibility.Check(domain.EligibilityProfile{}, now))
+	})
+
+	t.Run("rejects disallowed region", func(t *testing.T) {
+		profile := newEligibleProfile(now)
+		profile.Region = strPtr("na")
+
+		assert.Equal(t, exception.ErrIneligibleRegion, newTestEligibility().Check(profile, now))
+	})
+
+	t.Run("rejects user without linked tier", func(t *testing.T) {
+		profile := newEligibleProfile(now)
+		profile.CurrentTier = nil
+
+		assert.Equal(t, exception.ErrIneligibleTier, newTestEligibility().Check(profile, now))
+	})
+
+	t.Run("compares peak tier when configured", func(t *testing.T) {
+		eligibility := newTestEligibility()
+		eligibility.TierBasis = domain.EligibilityTierBasisPeak
+
+		assert.Equal(t, exception.ErrIneligibleTier, eligibility.Check(newEligibleProfile(now), now))
+	})
+
+	t.Run("rejects new account", func(t *testing.T) {
+		profile := newEligibleProfile(now)
+		profile.AccountCreatedAt = now.AddDate(0, 0, -3)
+
+		assert.Equal(t, exception.ErrIneligibleAccountAge, newTestEligibility().Check(profile, now))
+	})
+
+	t.Run("rejects user outside the discord server", func(t *testing.T) {
+		eligibility := newTestEligibility()
+		eligibility.RequireGuildMember = true
+
+		assert.Equal(t, exception.ErrIneligibleGuildMembership, eligibility.Check(newEligibleProfile(now), now))
+	})
+}
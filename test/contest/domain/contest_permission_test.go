@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rolePtr(role domain.ContestRole) *domain.ContestRole {
+	return &role
+}
+
+func TestContestMember_Role(t *testing.T) {
+	tests := []struct {
+		name     string
+		member   *domain.ContestMember
+		expected domain.ContestRole
+	}{
+		{"Leader is owner", domain.NewContestMemberAsLeader(1, 1), domain.ContestRoleOwner},
+		{"Legacy staff acts as admin", domain.NewContestMember(2, 1, domain.MemberTypeStaff, domain.LeaderTypeMember), domain.ContestRoleAdmin},
+		{"Staff with role", domain.NewContestOrganizer(3, 1, domain.ContestRoleReferee), domain.ContestRoleReferee},
+		{"Normal member has no role", domain.NewContestMember(4, 1, domain.MemberTypeNormal, domain.LeaderTypeMember), domain.ContestRoleNone},
+		{"Team captain has no role", domain.NewContestMember(6, 1, domain.MemberTypeNormal, domain.LeaderTypeLeader), domain.ContestRoleNone},
+		{
+			"Demoted member keeps no role",
+			&domain.ContestMember{UserID: 5, ContestID: 1, MemberType: domain.MemberTypeNormal, LeaderType: domain.LeaderTypeMember, StaffRole: rolePtr(domain.ContestRoleAdmin)},
+			domain.ContestRoleNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.member.Role())
+		})
+	}
+}
+
+func TestContestMember_Can(t *testing.T) {
+	owner := domain.NewContestMemberAsLeader(1, 1)
+	admin := domain.NewContestOrganizer(2, 1, domain.ContestRoleAdmin)
+	referee := domain.NewContestOrganizer(3, 1, domain.ContestRoleReferee)
+	moderator := domain.NewContestOrganizer(4, 1, domain.ContestRoleModerator)
+	normal := domain.NewContestMember(5, 1, domain.MemberTypeNormal, domain.LeaderTypeMember)
+
+	assert.True(t, owner.Can(domain.ContestActionManageOrganizers))
+	assert.False(t, admin.Can(domain.ContestActionManageOrganizers))
+
+	assert.True(t, admin.Can(domain.ContestActionManageApplications))
+	assert.True(t, admin.Can(domain.ContestActionSubmitResults))
+
+	assert.True(t, referee.Can(domain.ContestActionManageGames))
+	assert.True(t, referee.Can(domain.ContestActionSubmitResults))
+	assert.False(t, referee.Can(domain.ContestActionManageApplications))
+
+	assert.True(t, moderator.Can(domain.ContestActionModerateComments))
+	assert.True(t, moderator.Can(domain.ContestActionManageApplications))
+	assert.False(t, moderator.Can(domain.ContestActionSubmitResults))
+
+	for _, action := range domain.ContestRoleOwner.Actions() {
+		assert.False(t, normal.Can(action))
+	}
+}
+
+func TestContestMember_Can_TeamCaptainHasNoStaffAction(t *testing.T) {
+	captain := domain.NewContestMember(1, 1, domain.MemberTypeNormal, domain.LeaderTypeLeader)
+
+	for _, action := range domain.ContestRoleOwner.Actions() {
+		assert.False(t, captain.Can(action), "team captain must not %s", action)
+	}
+}
+
+func TestContestRole_IsAssignable(t *testing.T) {
+	assert.True(t, domain.ContestRoleAdmin.IsAssignable())
+	assert.True(t, domain.ContestRoleReferee.IsAssignable())
+	assert.True(t, domain.ContestRoleModerator.IsAssignable())
+	assert.False(t, domain.ContestRoleOwner.IsAssignable())
+	assert.False(t, domain.ContestRole("SUPERUSER").IsAssignable())
+}
+
+func TestContestOrganizerInvite_Validate(t *testing.T) {
+	assert.NoError(t, domain.NewContestOrganizerInvite(1, 2, 3, domain.ContestRoleReferee).Validate())
+	assert.Equal(t, exception.ErrInvalidContestRole, domain.NewContestOrganizerInvite(1, 2, 3, domain.ContestRoleOwner).Validate())
+	assert.Equal(t, exception.ErrInvalidUserID, domain.NewContestOrganizerInvite(1, 0, 3, domain.ContestRoleAdmin).Validate())
+}
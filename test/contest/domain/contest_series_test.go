@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSeries(t *testing.T) *domain.ContestSeries {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	require.NoError(t, err)
+
+	return &domain.ContestSeries{
+		OwnerUserID:     1,
+		TemplateID:      1,
+		Name:            "Friday Cup Season 1",
+		Weekday:         time.Friday,
+		StartTime:       "20:00",
+		Timezone:        "Asia/Seoul",
+		IntervalWeeks:   1,
+		DurationMinutes: 180,
+		CreateAheadDays: 7,
+		// Monday
+		StartsOn: time.Date(2026, 3, 2, 9, 0, 0, 0, seoul),
+		Active:   true,
+		PointsTable: domain.SeriesPointsTable{
+			{Placement: 1, Points: 10},
+			{Placement: 2, Points: 6},
+			{Placement: 3, Points: 3},
+		},
+	}
+}
+
+func TestContestSeries_Validate(t *testing.T) {
+	t.Run("accepts valid series", func(t *testing.T) {
+		assert.NoError(t, newTestSeries(t).Validate())
+	})
+
+	t.Run("fails for invalid start time", func(t *testing.T) {
+		series := newTestSeries(t)
+		series.StartTime = "25:00"
+
+		assert.Equal(t, exception.ErrInvalidSeriesRecurrence, series.Validate())
+	})
+
+	t.Run("fails for unknown timezone", func(t *testing.T) {
+		series := newTestSeries(t)
+		series.Timezone = "Mars/Olympus"
+
+		assert.Equal(t, exception.ErrInvalidSeriesRecurrence, series.Validate())
+	})
+
+	t.Run("fails for duplicate placements", func(t *testing.T) {
+		series := newTestSeries(t)
+		series.PointsTable = append(series.PointsTable, &domain.SeriesPlacementPoints{Placement: 1, Points: 1})
+
+		assert.Equal(t, exception.ErrInvalidSeriesPointsTable, series.Validate())
+	})
+}
+
+func TestContestSeries_NextOccurrence(t *testing.T) {
+	series := newTestSeries(t)
+
+	first, err := series.NextOccurrence()
+	require.NoError(t, err)
+	assert.Equal(t, time.Friday, first.Weekday())
+	assert.Equal(t, 20, first.Hour())
+	assert.Equal(t, 6, first.Day())
+
+	series.MarkScheduled(first)
+	second, err := series.NextOccurrence()
+	require.NoError(t, err)
+	assert.Equal(t, 13, second.Day())
+	assert.Equal(t, 20, second.Hour())
+	assert.Equal(t, 1, series.InstanceCount)
+	assert.Equal(t, "Friday Cup #2", series.InstanceTitle("Friday Cup"))
+}
+
+func TestContestSeries_IsDue(t *testing.T) {
+	series := newTestSeries(t)
+	occurrence, err := series.NextOccurrence()
+	require.NoError(t, err)
+
+	assert.True(t, series.IsDue(occurrence, occurrence.AddDate(0, 0, -3)))
+	assert.False(t, series.IsDue(occurrence, occurrence.AddDate(0, 0, -10)))
+
+	endsOn := occurrence.Add(-time.Hour)
+	series.EndsOn = &endsOn
+	assert.True(t, series.HasEnded(occurrence))
+	assert.False(t, series.IsDue(occurrence, occurrence))
+}
+
+func TestSeriesStandings_Ranked(t *testing.T) {
+	standings := domain.NewSeriesStandings(newTestSeries(t).PointsTable)
+
+	// Contest 1: user 1 wins, user 2 runner-up, user 3 third
+	standings.AddPlacement(1, 1)
+	standings.AddPlacement(2, 2)
+	standings.AddPlacement(3, 3)
+	// Contest 2: user 2 wins, user 1 third, user 4 fifth (no points)
+	standings.AddPlacement(2, 1)
+	standings.AddPlacement(1, 3)
+	standings.AddPlacement(4, 5)
+
+	ranked := standings.Ranked()
+
+	require.Len(t, ranked, 4)
+	assert.Equal(t, int64(2), ranked[0].UserID)
+	assert.Equal(t, 16, ranked[0].Points)
+	assert.Equal(t, int64(1), ranked[1].UserID)
+	assert.Equal(t, 13, ranked[1].Points)
+	assert.Equal(t, 2, ranked[1].ContestsPlayed)
+	assert.Equal(t, 1, ranked[1].BestPlacement)
+	assert.Equal(t, int64(4), ranked[3].UserID)
+	assert.Equal(t, 0, ranked[3].Points)
+	assert.Equal(t, 4, ranked[3].Rank)
+}
@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTemplateSourceContest() *domain.Contest {
+	contest := domain.NewContestInstance(
+		"Weekly Cup", "Every sunday", 16, 100,
+		domain.ContestTypeTournament,
+		time.Now().Add(24*time.Hour), time.Now().Add(48*time.Hour),
+		true, nil, nil, 5,
+		strPtr("guild-1"), strPtr("channel-1"), strPtr("thumbnail.png"),
+	)
+	contest.ContestID = 7
+	contest.ContestStatus = domain.ContestStatusFinished
+	contest.Eligibility.AllowedRegions = domain.ContestRegions{"kr"}
+	return contest
+}
+
+func TestNewContestTemplateFromContest(t *testing.T) {
+	contest := newTemplateSourceContest()
+
+	template := domain.NewContestTemplateFromContest(3, "  Weekly  ", contest)
+
+	assert.Equal(t, int64(3), template.OwnerUserID)
+	assert.Equal(t, "Weekly", template.Name)
+	assert.Equal(t, contest.Title, template.Title)
+	assert.Equal(t, contest.ContestType, template.ContestType)
+	assert.Equal(t, contest.DiscordTextChannelId, template.DiscordTextChannelId)
+	assert.Equal(t, contest.Thumbnail, template.Thumbnail)
+	assert.Equal(t, contest.Eligibility, template.Eligibility)
+	assert.True(t, template.IsOwnedBy(3))
+	assert.False(t, template.IsOwnedBy(4))
+}
+
+func TestContestTemplate_Validate(t *testing.T) {
+	contest := newTemplateSourceContest()
+
+	assert.NoError(t, domain.NewContestTemplateFromContest(1, "Weekly", contest).Validate())
+	assert.Equal(t, exception.ErrInvalidContestTemplateName, domain.NewContestTemplateFromContest(1, " ", contest).Validate())
+	assert.Equal(t, exception.ErrInvalidContestTemplateName,
+		domain.NewContestTemplateFromContest(1, strings.Repeat("a", domain.MaxContestTemplateName+1), contest).Validate())
+}
+
+func TestContestScheduleRequest_ToCreateContestRequest(t *testing.T) {
+	template := domain.NewContestTemplateFromContest(1, "Weekly", newTemplateSourceContest())
+	startedAt := time.Now().Add(7 * 24 * time.Hour)
+	endedAt := startedAt.Add(6 * time.Hour)
+
+	t.Run("copies settings and applies the schedule", func(t *testing.T) {
+		req := (&dto.ContestScheduleRequest{StartedAt: startedAt, EndedAt: endedAt}).ToCreateContestRequest(template)
+
+		assert.Equal(t, "Weekly Cup", req.Title)
+		assert.Equal(t, startedAt, req.StartedAt)
+		assert.Equal(t, endedAt, req.EndedAt)
+		assert.Equal(t, template.DiscordGuildId, req.DiscordGuildId)
+		assert.Equal(t, template.MaxTeamCount, req.MaxTeamCount)
+		assert.Equal(t, template.Eligibility, *req.Eligibility)
+	})
+
+	t.Run("overrides title", func(t *testing.T) {
+		req := (&dto.ContestScheduleRequest{Title: strPtr("Weekly Cup #2")}).ToCreateContestRequest(template)
+
+		assert.Equal(t, "Weekly Cup #2", req.Title)
+	})
+}
@@ -498,3 +498,95 @@ func TestContest_Validate(t *testing.T) {
 		assert.Equal(t, exception.ErrInvalidMaxTeamCount, err)
 	})
 }
+
+func TestContest_RegistrationDeadline(t *testing.T) {
+	t.Run("fails when registration closes after start", func(t *testing.T) {
+		startedAt := time.Now().Add(24 * time.Hour)
+		closesAt := startedAt.Add(time.Hour)
+		contest := &domain.Contest{
+			StartedAt:            startedAt,
+			EndedAt:              startedAt.Add(48 * time.Hour),
+			RegistrationClosesAt: &closesAt,
+		}
+		err := contest.ValidateDates()
+		assert.Equal(t, exception.ErrInvalidRegistrationDeadline, err)
+	})
+
+	t.Run("reports registration closed once deadline passes", func(t *testing.T) {
+		closesAt := time.Now().Add(time.Hour)
+		contest := &domain.Contest{RegistrationClosesAt: &closesAt}
+
+		assert.False(t, contest.IsRegistrationClosed(time.Now()))
+		assert.True(t, contest.IsRegistrationClosed(closesAt))
+		assert.False(t, (&domain.Contest{}).IsRegistrationClosed(time.Now()))
+	})
+
+	t.Run("locks roster only once", func(t *testing.T) {
+		contest := &domain.Contest{}
+
+		assert.NoError(t, contest.LockRoster(time.Now()))
+		assert.True(t, contest.IsRosterLocked())
+		assert.Equal(t, exception.ErrRosterAlreadyLocked, contest.LockRoster(time.Now()))
+	})
+}
+
+func TestContest_Lifecycle(t *testing.T) {
+	t.Run("auto-start is due only for pending auto-start contests past start time", func(t *testing.T) {
+		now := time.Now()
+		contest := &domain.Contest{
+			ContestStatus: domain.ContestStatusPending,
+			AutoStart:     true,
+			StartedAt:     now.Add(-time.Minute),
+		}
+		assert.True(t, contest.IsDueForAutoStart(now))
+
+		contest.AutoStart = false
+		assert.False(t, contest.IsDueForAutoStart(now))
+
+		contest.AutoStart = true
+		contest.ContestStatus = domain.ContestStatusActive
+		assert.False(t, contest.IsDueForAutoStart(now))
+
+		contest.ContestStatus = domain.ContestStatusPending
+		contest.StartedAt = now.Add(time.Minute)
+		assert.False(t, contest.IsDueForAutoStart(now))
+	})
+
+	t.Run("reports past end time", func(t *testing.T) {
+		now := time.Now()
+
+		assert.True(t, (&domain.Contest{EndedAt: now.Add(-time.Minute)}).IsPastEndTime(now))
+		assert.False(t, (&domain.Contest{EndedAt: now.Add(time.Minute)}).IsPastEndTime(now))
+		assert.False(t, (&domain.Contest{}).IsPastEndTime(now))
+	})
+}
+
+func TestContest_Cancel(t *testing.T) {
+	t.Run("cancels pending contest and records reason", func(t *testing.T) {
+		now := time.Now()
+		contest := &domain.Contest{ContestStatus: domain.ContestStatusPending}
+
+		err := contest.Cancel("venue unavailable", now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ContestStatusCancelled, contest.ContestStatus)
+		assert.Equal(t, now, *contest.CancelledAt)
+		assert.Equal(t, "venue unavailable", *contest.CancelReason)
+	})
+
+	t.Run("cancels active contest", func(t *testing.T) {
+		contest := &domain.Contest{ContestStatus: domain.ContestStatusActive}
+
+		assert.NoError(t, contest.Cancel("reason", time.Now()))
+		assert.Equal(t, domain.ContestStatusCancelled, contest.ContestStatus)
+	})
+
+	t.Run("fails for finished or cancelled contest", func(t *testing.T) {
+		finished := &domain.Contest{ContestStatus: domain.ContestStatusFinished}
+		cancelled := &domain.Contest{ContestStatus: domain.ContestStatusCancelled}
+
+		assert.Equal(t, exception.ErrContestAlreadyClosed, finished.Cancel("reason", time.Now()))
+		assert.Equal(t, exception.ErrContestAlreadyClosed, cancelled.Cancel("reason", time.Now()))
+		assert.Nil(t, finished.CancelReason)
+	})
+}
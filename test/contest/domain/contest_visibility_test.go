@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContest_Visibility(t *testing.T) {
+	tests := []struct {
+		name        string
+		visibility  domain.ContestVisibility
+		wantPrivate bool
+		wantListed  bool
+	}{
+		{"Legacy contest is public", "", false, true},
+		{"Public", domain.ContestVisibilityPublic, false, true},
+		{"Unlisted", domain.ContestVisibilityUnlisted, false, false},
+		{"Private", domain.ContestVisibilityPrivate, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contest := &domain.Contest{Visibility: tt.visibility}
+			assert.Equal(t, tt.wantPrivate, contest.IsPrivate())
+			assert.Equal(t, tt.wantListed, contest.IsListed())
+		})
+	}
+}
+
+func TestContest_ValidateVisibility(t *testing.T) {
+	assert.NoError(t, (&domain.Contest{Visibility: domain.ContestVisibilityUnlisted}).ValidateVisibility())
+	assert.Equal(t, exception.ErrInvalidContestVisibility, (&domain.Contest{Visibility: "SECRET"}).ValidateVisibility())
+}
+
+func TestContest_EnsureJoinCode(t *testing.T) {
+	public := &domain.Contest{Visibility: domain.ContestVisibilityPublic}
+	assert.NoError(t, public.EnsureJoinCode())
+	assert.Nil(t, public.JoinCode)
+
+	private := &domain.Contest{Visibility: domain.ContestVisibilityPrivate}
+	assert.NoError(t, private.EnsureJoinCode())
+	assert.NotNil(t, private.JoinCode)
+	assert.Len(t, *private.JoinCode, domain.ContestJoinCodeLength)
+
+	code := *private.JoinCode
+	assert.NoError(t, private.EnsureJoinCode())
+	assert.Equal(t, code, *private.JoinCode)
+}
+
+func TestContest_RegenerateJoinCode(t *testing.T) {
+	assert.Equal(t, exception.ErrContestNotPrivate, (&domain.Contest{Visibility: domain.ContestVisibilityUnlisted}).RegenerateJoinCode())
+
+	contest := &domain.Contest{Visibility: domain.ContestVisibilityPrivate}
+	assert.NoError(t, contest.RegenerateJoinCode())
+	assert.Equal(t, strings.ToUpper(*contest.JoinCode), *contest.JoinCode)
+	assert.Equal(t, *contest.JoinCode, domain.NormalizeJoinCode(" "+strings.ToLower(*contest.JoinCode)+" "))
+}
@@ -201,6 +201,7 @@ func (s *ContestIntegrationTestSuite) SetupTest() {
 	s.contestService = application.NewContestService(
 		s.contestAdapter,
 		s.memberAdapter,
+		application.NewContestPermissionChecker(s.memberAdapter),
 		s.mockRedis,
 		s.mockOAuth2,
 		s.mockEventPub,
@@ -251,6 +251,10 @@ func (a *InMemoryGameAdapter) Update(game *gameDomain.Game) error {
 	return nil
 }
 
+func (a *InMemoryGameAdapter) UpdateWithContext(ctx context.Context, game *gameDomain.Game) error {
+	return a.Update(game)
+}
+
 func (a *InMemoryGameAdapter) Delete(gameID int64) error {
 	delete(a.games, gameID)
 	return nil
@@ -309,6 +313,10 @@ func (a *InMemoryTeamAdapter) Save(team *gameDomain.Team) (*gameDomain.Team, err
 	return team, nil
 }
 
+func (a *InMemoryTeamAdapter) SaveWithContext(ctx context.Context, team *gameDomain.Team) (*gameDomain.Team, error) {
+	return a.Save(team)
+}
+
 func (a *InMemoryTeamAdapter) GetByID(teamID int64) (*gameDomain.Team, error) {
 	if team, ok := a.teams[teamID]; ok {
 		return team, nil
@@ -345,16 +353,52 @@ func (a *InMemoryTeamAdapter) CountByContestID(contestID int64) (int, error) {
 	return count, nil
 }
 
+func (a *InMemoryTeamAdapter) CountRegisteredByContestID(contestID int64) (int, error) {
+	count := 0
+	for _, team := range a.teams {
+		if team.ContestID == contestID && !team.IsWaitlisted() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (a *InMemoryTeamAdapter) GetWaitlistedByContestID(contestID int64) ([]*gameDomain.Team, error) {
+	var result []*gameDomain.Team
+	for _, team := range a.teams {
+		if team.ContestID == contestID && team.IsWaitlisted() {
+			result = append(result, team)
+		}
+	}
+	return result, nil
+}
+
+func (a *InMemoryTeamAdapter) CountRegisteredByContestIDWithContext(ctx context.Context, contestID int64) (int, error) {
+	return a.CountRegisteredByContestID(contestID)
+}
+
+func (a *InMemoryTeamAdapter) GetWaitlistedByContestIDWithContext(ctx context.Context, contestID int64) ([]*gameDomain.Team, error) {
+	return a.GetWaitlistedByContestID(contestID)
+}
+
 func (a *InMemoryTeamAdapter) Update(team *gameDomain.Team) error {
 	a.teams[team.TeamID] = team
 	return nil
 }
 
+func (a *InMemoryTeamAdapter) UpdateWithContext(ctx context.Context, team *gameDomain.Team) error {
+	return a.Update(team)
+}
+
 func (a *InMemoryTeamAdapter) Delete(teamID int64) error {
 	delete(a.teams, teamID)
 	return nil
 }
 
+func (a *InMemoryTeamAdapter) DeleteWithContext(ctx context.Context, teamID int64) error {
+	return a.Delete(teamID)
+}
+
 func (a *InMemoryTeamAdapter) DeleteByContestID(contestID int64) error {
 	for id, team := range a.teams {
 		if team.ContestID == contestID {
@@ -372,6 +416,10 @@ func (a *InMemoryTeamAdapter) SaveMember(member *gameDomain.TeamMember) (*gameDo
 	return member, nil
 }
 
+func (a *InMemoryTeamAdapter) SaveMemberWithContext(ctx context.Context, member *gameDomain.TeamMember) (*gameDomain.TeamMember, error) {
+	return a.SaveMember(member)
+}
+
 func (a *InMemoryTeamAdapter) SaveMemberBatch(members []*gameDomain.TeamMember) error {
 	for _, m := range members {
 		a.SaveMember(m)
@@ -379,6 +427,25 @@ func (a *InMemoryTeamAdapter) SaveMemberBatch(members []*gameDomain.TeamMember)
 	return nil
 }
 
+func (a *InMemoryTeamAdapter) SaveMemberBatchWithContext(ctx context.Context, members []*gameDomain.TeamMember) error {
+	return a.SaveMemberBatch(members)
+}
+
+func (a *InMemoryTeamAdapter) SaveTeamsWithMembers(teams []*gamePort.TeamWithMembers) error {
+	for _, t := range teams {
+		a.Save(t.Team)
+		for _, m := range t.Members {
+			m.TeamID = t.Team.TeamID
+		}
+		a.SaveMemberBatch(t.Members)
+	}
+	return nil
+}
+
+func (a *InMemoryTeamAdapter) SaveTeamsWithMembersWithContext(ctx context.Context, teams []*gamePort.TeamWithMembers) error {
+	return a.SaveTeamsWithMembers(teams)
+}
+
 func (a *InMemoryTeamAdapter) GetMemberByID(id int64) (*gameDomain.TeamMember, error) {
 	if m, ok := a.members[id]; ok {
 		return m, nil
@@ -429,6 +496,10 @@ func (a *InMemoryTeamAdapter) UpdateMember(member *gameDomain.TeamMember) error
 	return nil
 }
 
+func (a *InMemoryTeamAdapter) UpdateMemberWithContext(ctx context.Context, member *gameDomain.TeamMember) error {
+	return a.UpdateMember(member)
+}
+
 func (a *InMemoryTeamAdapter) DeleteMember(id int64) error {
 	delete(a.members, id)
 	return nil
@@ -444,6 +515,10 @@ func (a *InMemoryTeamAdapter) DeleteMemberByTeamAndUser(teamID, userID int64) er
 	return nil
 }
 
+func (a *InMemoryTeamAdapter) DeleteMemberByTeamAndUserWithContext(ctx context.Context, teamID, userID int64) error {
+	return a.DeleteMemberByTeamAndUser(teamID, userID)
+}
+
 func (a *InMemoryTeamAdapter) DeleteAllMembersByTeamID(teamID int64) error {
 	for id, m := range a.members {
 		if m.TeamID == teamID {
@@ -453,6 +528,10 @@ func (a *InMemoryTeamAdapter) DeleteAllMembersByTeamID(teamID int64) error {
 	return nil
 }
 
+func (a *InMemoryTeamAdapter) DeleteAllMembersByTeamIDWithContext(ctx context.Context, teamID int64) error {
+	return a.DeleteAllMembersByTeamID(teamID)
+}
+
 func (a *InMemoryTeamAdapter) GetTeamsByContestWithMembers(contestID int64) ([]*gamePort.TeamWithMembers, error) {
 	return nil, nil
 }
@@ -620,6 +699,7 @@ func (s *TournamentFlowTestSuite) SetupTest() {
 	s.contestService = application.NewContestServiceFull(
 		s.contestAdapter,
 		s.memberAdapter,
+		application.NewContestPermissionChecker(s.memberAdapter),
 		s.mockRedis,
 		s.mockOAuth2,
 		s.mockEventPub,
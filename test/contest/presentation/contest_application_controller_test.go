@@ -36,6 +36,14 @@ func (m *MockContestDatabasePortForApp) Save(contest *domain.Contest) (*domain.C
 	return args.Get(0).(*domain.Contest), args.Error(1)
 }
 
+func (m *MockContestDatabasePortForApp) SaveWithContext(ctx context.Context, contest *domain.Contest) (*domain.Contest, error) {
+	args := m.Called(ctx, contest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePortForApp) GetContestById(contestId int64) (*domain.Contest, error) {
 	args := m.Called(contestId)
 	if args.Get(0) == nil {
@@ -44,6 +52,14 @@ func (m *MockContestDatabasePortForApp) GetContestById(contestId int64) (*domain
 	return args.Get(0).(*domain.Contest), args.Error(1)
 }
 
+func (m *MockContestDatabasePortForApp) GetContestByIdForUpdate(ctx context.Context, contestId int64) (*domain.Contest, error) {
+	args := m.Called(ctx, contestId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePortForApp) GetContests(offset, limit int, sortReq *commonDto.SortRequest, title *string) ([]domain.Contest, int64, error) {
 	args := m.Called(offset, limit, sortReq, title)
 	if args.Get(0) == nil {
@@ -52,6 +68,14 @@ func (m *MockContestDatabasePortForApp) GetContests(offset, limit int, sortReq *
 	return args.Get(0).([]domain.Contest), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockContestDatabasePortForApp) GetContestsByCursor(cursor *commonDto.CursorRequest, title *string) ([]domain.Contest, error) {
+	args := m.Called(cursor, title)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePortForApp) DeleteContestById(contestId int64) error {
 	args := m.Called(contestId)
 	return args.Error(0)
@@ -62,6 +86,35 @@ func (m *MockContestDatabasePortForApp) UpdateContest(contest *domain.Contest) e
 	return args.Error(0)
 }
 
+func (m *MockContestDatabasePortForApp) UpdateContestWithContext(ctx context.Context, contest *domain.Contest) error {
+	args := m.Called(ctx, contest)
+	return args.Error(0)
+}
+
+func (m *MockContestDatabasePortForApp) GetContestsDueForRosterLock(now time.Time) ([]*domain.Contest, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
+func (m *MockContestDatabasePortForApp) GetContestsDueForAutoStart(now time.Time) ([]*domain.Contest, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
+func (m *MockContestDatabasePortForApp) GetContestsByStatuses(statuses []domain.ContestStatus) ([]*domain.Contest, error) {
+	args := m.Called(statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
 type MockContestMemberDatabasePortForApp struct {
 	mock.Mock
 }
@@ -76,6 +129,16 @@ func (m *MockContestMemberDatabasePortForApp) DeleteById(contestId, userId int64
 	return args.Error(0)
 }
 
+func (m *MockContestMemberDatabasePortForApp) SaveWithContext(ctx context.Context, member *domain.ContestMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockContestMemberDatabasePortForApp) DeleteByIdWithContext(ctx context.Context, contestId, userId int64) error {
+	args := m.Called(ctx, contestId, userId)
+	return args.Error(0)
+}
+
 func (m *MockContestMemberDatabasePortForApp) GetByContestAndUser(contestId, userId int64) (*domain.ContestMember, error) {
 	args := m.Called(contestId, userId)
 	if args.Get(0) == nil {
@@ -105,6 +168,14 @@ func (m *MockContestMemberDatabasePortForApp) GetMembersWithUserByContest(contes
 	return args.Get(0).([]*port.ContestMemberWithUser), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockContestMemberDatabasePortForApp) GetMembersWithUserByContestCursor(contestId int64, cursor *commonDto.CursorRequest) ([]*port.ContestMemberWithUser, error) {
+	args := m.Called(contestId, cursor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ContestMemberWithUser), args.Error(1)
+}
+
 func (m *MockContestMemberDatabasePortForApp) GetContestsByUserId(userId int64, pagination *commonDto.PaginationRequest, sort *commonDto.SortRequest, status *domain.ContestStatus) ([]*port.ContestWithMembership, int64, error) {
 	args := m.Called(userId, pagination, sort, status)
 	if args.Get(0) == nil {
@@ -298,6 +369,7 @@ func setupAppMockService() (*application.ContestApplicationService, *MockContest
 		mockRedis,      // applicationRepo
 		mockContestDB,  // contestRepo
 		mockMemberDB,   // memberRepo
+		application.NewContestPermissionChecker(mockMemberDB), // permissionChecker
 		mockEventPub,   // eventPublisher
 		mockOAuth2,     // oauth2Repository
 		mockUserQuery,  // userQueryRepo
@@ -36,6 +36,14 @@ func (m *MockContestDatabasePort) Save(contest *domain.Contest) (*domain.Contest
 	return args.Get(0).(*domain.Contest), args.Error(1)
 }
 
+func (m *MockContestDatabasePort) SaveWithContext(ctx context.Context, contest *domain.Contest) (*domain.Contest, error) {
+	args := m.Called(ctx, contest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePort) GetContestById(contestId int64) (*domain.Contest, error) {
 	args := m.Called(contestId)
 	if args.Get(0) == nil {
@@ -44,6 +52,14 @@ func (m *MockContestDatabasePort) GetContestById(contestId int64) (*domain.Conte
 	return args.Get(0).(*domain.Contest), args.Error(1)
 }
 
+func (m *MockContestDatabasePort) GetContestByIdForUpdate(ctx context.Context, contestId int64) (*domain.Contest, error) {
+	args := m.Called(ctx, contestId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePort) GetContests(offset, limit int, sortReq *commonDto.SortRequest, title *string) ([]domain.Contest, int64, error) {
 	args := m.Called(offset, limit, sortReq, title)
 	if args.Get(0) == nil {
@@ -52,6 +68,14 @@ func (m *MockContestDatabasePort) GetContests(offset, limit int, sortReq *common
 	return args.Get(0).([]domain.Contest), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockContestDatabasePort) GetContestsByCursor(cursor *commonDto.CursorRequest, title *string) ([]domain.Contest, error) {
+	args := m.Called(cursor, title)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Contest), args.Error(1)
+}
+
 func (m *MockContestDatabasePort) DeleteContestById(contestId int64) error {
 	args := m.Called(contestId)
 	return args.Error(0)
@@ -62,6 +86,35 @@ func (m *MockContestDatabasePort) UpdateContest(contest *domain.Contest) error {
 	return args.Error(0)
 }
 
+func (m *MockContestDatabasePort) UpdateContestWithContext(ctx context.Context, contest *domain.Contest) error {
+	args := m.Called(ctx, contest)
+	return args.Error(0)
+}
+
+func (m *MockContestDatabasePort) GetContestsDueForRosterLock(now time.Time) ([]*domain.Contest, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
+func (m *MockContestDatabasePort) GetContestsDueForAutoStart(now time.Time) ([]*domain.Contest, error) {
+	args := m.Called(now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
+func (m *MockContestDatabasePort) GetContestsByStatuses(statuses []domain.ContestStatus) ([]*domain.Contest, error) {
+	args := m.Called(statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Contest), args.Error(1)
+}
+
 type MockContestMemberDatabasePort struct {
 	mock.Mock
 }
@@ -76,6 +129,16 @@ func (m *MockContestMemberDatabasePort) DeleteById(contestId, userId int64) erro
 	return args.Error(0)
 }
 
+func (m *MockContestMemberDatabasePort) SaveWithContext(ctx context.Context, member *domain.ContestMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockContestMemberDatabasePort) DeleteByIdWithContext(ctx context.Context, contestId, userId int64) error {
+	args := m.Called(ctx, contestId, userId)
+	return args.Error(0)
+}
+
 func (m *MockContestMemberDatabasePort) GetByContestAndUser(contestId, userId int64) (*domain.ContestMember, error) {
 	args := m.Called(contestId, userId)
 	if args.Get(0) == nil {
@@ -105,6 +168,14 @@ func (m *MockContestMemberDatabasePort) GetMembersWithUserByContest(contestId in
 	return args.Get(0).([]*port.ContestMemberWithUser), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockContestMemberDatabasePort) GetMembersWithUserByContestCursor(contestId int64, cursor *commonDto.CursorRequest) ([]*port.ContestMemberWithUser, error) {
+	args := m.Called(contestId, cursor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ContestMemberWithUser), args.Error(1)
+}
+
 func (m *MockContestMemberDatabasePort) GetContestsByUserId(userId int64, pagination *commonDto.PaginationRequest, sort *commonDto.SortRequest, status *domain.ContestStatus) ([]*port.ContestWithMembership, int64, error) {
 	args := m.Called(userId, pagination, sort, status)
 	if args.Get(0) == nil {
@@ -257,7 +328,7 @@ func setupMockService() (*application.ContestService, *MockContestDatabasePort,
 	mockOAuth2 := new(MockOAuth2DatabasePort)
 	mockEventPub := new(MockEventPublisherPort)
 
-	service := application.NewContestService(mockContestDB, mockMemberDB, mockRedis, mockOAuth2, mockEventPub)
+	service := application.NewContestService(mockContestDB, mockMemberDB, application.NewContestPermissionChecker(mockMemberDB), mockRedis, mockOAuth2, mockEventPub)
 	return service, mockContestDB, mockMemberDB
 }
 
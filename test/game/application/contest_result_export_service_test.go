@@ -0,0 +1,226 @@
+package application_test
+
+import (
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// MockGameDatabasePort mocks the GameDatabasePort methods used by the game services under test
+type MockGameDatabasePort struct {
+	mock.Mock
+	port.GameDatabasePort
+}
+
+func (m *MockGameDatabasePort) GetByID(gameID int64) (*domain.Game, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Game), args.Error(1)
+}
+
+func (m *MockGameDatabasePort) GetByContestID(contestID int64) ([]*domain.Game, error) {
+	args := m.Called(contestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Game), args.Error(1)
+}
+
+// MockContestDatabasePort mocks the ContestDatabasePort methods used by the game services under test
+type MockContestDatabasePort struct {
+	mock.Mock
+	contestPort.ContestDatabasePort
+}
+
+func (m *MockContestDatabasePort) GetContestById(contestId int64) (*contestDomain.Contest, error) {
+	args := m.Called(contestId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*contestDomain.Contest), args.Error(1)
+}
+
+// MockContestResultExportDatabasePort mocks the ContestResultExportDatabasePort interface
+type MockContestResultExportDatabasePort struct {
+	mock.Mock
+}
+
+func (m *MockContestResultExportDatabasePort) GetRosterMembers(contestID int64) ([]*port.ResultExportMember, error) {
+	args := m.Called(contestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ResultExportMember), args.Error(1)
+}
+
+func (m *MockContestResultExportDatabasePort) GetGamesWithResults(contestID int64) ([]*port.ResultExportGame, error) {
+	args := m.Called(contestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ResultExportGame), args.Error(1)
+}
+
+func (m *MockContestResultExportDatabasePort) GetGameTeams(contestID int64) ([]*port.ResultExportGameTeam, error) {
+	args := m.Called(contestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ResultExportGameTeam), args.Error(1)
+}
+
+func (m *MockContestResultExportDatabasePort) GetPlayerStatTotals(contestID int64) ([]*port.ResultExportPlayerTotals, error) {
+	args := m.Called(contestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.ResultExportPlayerTotals), args.Error(1)
+}
+
+// MockContestPermissionPort mocks the ContestPermissionPort interface
+type MockContestPermissionPort struct {
+	mock.Mock
+}
+
+func (m *MockContestPermissionPort) CheckPermission(contestId, userId int64, action contestDomain.ContestAction) error {
+	args := m.Called(contestId, userId, action)
+	return args.Error(0)
+}
+
+// ==================== Helper Functions ====================
+
+// setupResultExportService wires an export of contest 1 without games, holding the given teams with one member each
+func setupResultExportService(teams []*domain.Team, members []*port.ResultExportMember) (*application.ContestResultExportService, *MockContestPermissionPort, *MockContestResultExportDatabasePort) {
+	mockGameDB := new(MockGameDatabasePort)
+	mockTeamDB := new(MockTeamDatabasePort)
+	mockContestDB := new(MockContestDatabasePort)
+	mockExportDB := new(MockContestResultExportDatabasePort)
+	mockPermission := new(MockContestPermissionPort)
+
+	mockContestDB.On("GetContestById", int64(1)).Return(&contestDomain.Contest{ContestID: 1, Title: "Spring Cup"}, nil)
+	mockGameDB.On("GetByContestID", int64(1)).Return([]*domain.Game{}, nil)
+	mockTeamDB.On("GetByContestID", int64(1)).Return(teams, nil)
+	mockExportDB.On("GetRosterMembers", int64(1)).Return(members, nil)
+	mockExportDB.On("GetGamesWithResults", int64(1)).Return([]*port.ResultExportGame{}, nil)
+	mockExportDB.On("GetGameTeams", int64(1)).Return([]*port.ResultExportGameTeam{}, nil)
+	mockExportDB.On("GetPlayerStatTotals", int64(1)).Return([]*port.ResultExportPlayerTotals{}, nil)
+
+	resultService := application.NewTournamentResultService(mockGameDB, nil, mockTeamDB, nil, mockContestDB)
+	service := application.NewContestResultExportService(resultService, mockExportDB, mockTeamDB)
+	service.SetPermissionChecker(mockPermission)
+
+	return service, mockPermission, mockExportDB
+}
+
+// readExportCSV parses an exported CSV, checking the UTF-8 BOM spreadsheet apps rely on
+func readExportCSV(t *testing.T, body []byte) [][]string {
+	bom := []byte{0xEF, 0xBB, 0xBF}
+	assert.True(t, bytes.HasPrefix(body, bom))
+
+	rows, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(body, bom))).ReadAll()
+	assert.NoError(t, err)
+	return rows
+}
+
+// ==================== Export Tests ====================
+
+func TestContestResultExportService_Export_EscapesSpreadsheetFormulas(t *testing.T) {
+	tests := []struct {
+		name     string
+		teamName string
+		expected string
+	}{
+		{"Equals", `=HYPERLINK("http://evil.example","Prize")`, `'=HYPERLINK("http://evil.example","Prize")`},
+		{"Plus", "+SUM(A1:A9)", "'+SUM(A1:A9)"},
+		{"Minus", "-2+3+cmd|' /C calc'!A0", "'-2+3+cmd|' /C calc'!A0"},
+		{"At", "@SUM(A1)", "'@SUM(A1)"},
+		{"Tab", "\t=1+1", "'\t=1+1"},
+		{"Negative number is data", "-5", "-5"},
+		{"Plain name", "Team Alpha", "Team Alpha"},
+		{"Korean name", "팀 알파", "팀 알파"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams := []*domain.Team{{TeamID: 7, ContestID: 1, TeamName: tt.teamName, Status: domain.TeamStatusRegistered}}
+			members := []*port.ResultExportMember{{TeamID: 7, UserID: 10, MemberType: domain.TeamMemberTypeLeader, Username: "alpha", Tag: "KR1"}}
+			service, mockPermission, _ := setupResultExportService(teams, members)
+			mockPermission.On("CheckPermission", int64(1), int64(99), contestDomain.ContestActionExportResults).Return(nil)
+
+			body, contentType, err := service.Export(1, 99, &dto.ContestResultExportRequest{
+				Format:  dto.ResultExportFormatCSV,
+				Section: dto.ResultExportSectionRosters,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "text/csv; charset=utf-8", contentType)
+
+			rows := readExportCSV(t, body)
+			assert.Len(t, rows, 2)
+			assert.Equal(t, tt.expected, rows[1][1])
+		})
+	}
+}
+
+func TestContestResultExportService_Export_EscapesPlayerNames(t *testing.T) {
+	riotName, riotTag := "=cmd", "KR1"
+	discordID := "@everyone"
+	teams := []*domain.Team{{TeamID: 7, ContestID: 1, TeamName: "Team Alpha", Status: domain.TeamStatusRegistered}}
+	members := []*port.ResultExportMember{{
+		TeamID:     7,
+		UserID:     10,
+		MemberType: domain.TeamMemberTypeLeader,
+		Username:   "+alpha",
+		Tag:        "KR1",
+		RiotName:   &riotName,
+		RiotTag:    &riotTag,
+		DiscordID:  &discordID,
+	}}
+	service, mockPermission, _ := setupResultExportService(teams, members)
+	mockPermission.On("CheckPermission", int64(1), int64(99), contestDomain.ContestActionExportResults).Return(nil)
+
+	body, _, err := service.Export(1, 99, &dto.ContestResultExportRequest{
+		Format:  dto.ResultExportFormatCSV,
+		Section: dto.ResultExportSectionRosters,
+	})
+
+	assert.NoError(t, err)
+	rows := readExportCSV(t, body)
+	assert.Equal(t, "'+alpha#KR1", rows[1][5])
+	assert.Equal(t, "'=cmd#KR1", rows[1][7])
+	assert.Equal(t, "'@everyone", rows[1][8])
+}
+
+func TestContestResultExportService_Export_PermissionDenied(t *testing.T) {
+	service, mockPermission, mockExportDB := setupResultExportService([]*domain.Team{}, []*port.ResultExportMember{})
+	mockPermission.On("CheckPermission", int64(1), int64(99), contestDomain.ContestActionExportResults).Return(exception.ErrPermissionDenied)
+
+	body, _, err := service.Export(1, 99, &dto.ContestResultExportRequest{Format: dto.ResultExportFormatCSV})
+
+	assert.ErrorIs(t, err, exception.ErrPermissionDenied)
+	assert.Nil(t, body)
+	mockExportDB.AssertNotCalled(t, "GetRosterMembers", mock.Anything)
+}
+
+func TestContestResultExportService_Export_InvalidFormat(t *testing.T) {
+	service, mockPermission, _ := setupResultExportService([]*domain.Team{}, []*port.ResultExportMember{})
+
+	_, _, err := service.Export(1, 99, &dto.ContestResultExportRequest{Format: "xlsx"})
+
+	assert.ErrorIs(t, err, exception.ErrInvalidExportFormat)
+	mockPermission.AssertNotCalled(t, "CheckPermission", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,325 @@
+package application_test
+
+import (
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/utils"
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// MockGameTeamDatabasePort mocks the GameTeamDatabasePort methods used by the game services under test
+type MockGameTeamDatabasePort struct {
+	mock.Mock
+	port.GameTeamDatabasePort
+}
+
+func (m *MockGameTeamDatabasePort) GetByGameID(gameID int64) ([]*domain.GameTeam, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GameTeam), args.Error(1)
+}
+
+// MockLeaderboardDatabasePort mocks the LeaderboardDatabasePort methods used by leaderboard updates
+type MockLeaderboardDatabasePort struct {
+	mock.Mock
+	port.LeaderboardDatabasePort
+}
+
+func (m *MockLeaderboardDatabasePort) GetGameTeamMembers(gameID int64) ([]*port.LeaderboardTeamMember, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*port.LeaderboardTeamMember), args.Error(1)
+}
+
+// MockMatchResultDatabasePort mocks the MatchResultDatabasePort methods used by leaderboard updates
+type MockMatchResultDatabasePort struct {
+	mock.Mock
+	port.MatchResultDatabasePort
+}
+
+func (m *MockMatchResultDatabasePort) GetByGameID(gameID int64) (*domain.MatchResult, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MatchResult), args.Error(1)
+}
+
+func (m *MockMatchResultDatabasePort) GetPlayerStatsByMatchResult(matchResultID int64) ([]*domain.MatchPlayerStat, error) {
+	args := m.Called(matchResultID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MatchPlayerStat), args.Error(1)
+}
+
+// FakeLeaderboardCache keeps leaderboards in memory and applies a game the way the Redis script does: all of it or none.
+// Setting failures makes the next ApplyGame calls fail without applying anything.
+type FakeLeaderboardCache struct {
+	port.LeaderboardCachePort
+
+	processed map[int64]bool
+	scores    map[string]map[string]float64
+	totals    map[string]map[string]int64
+	failures  int
+}
+
+func NewFakeLeaderboardCache() *FakeLeaderboardCache {
+	return &FakeLeaderboardCache{
+		processed: make(map[int64]bool),
+		scores:    make(map[string]map[string]float64),
+		totals:    make(map[string]map[string]int64),
+	}
+}
+
+func (f *FakeLeaderboardCache) ApplyGame(ctx context.Context, gameID int64, update *port.LeaderboardGameUpdate) (bool, error) {
+	if f.failures > 0 {
+		f.failures--
+		return false, errors.New("connection reset")
+	}
+	if f.processed[gameID] {
+		return false, nil
+	}
+	f.processed[gameID] = true
+
+	for key, deltas := range update.Increments {
+		for member, delta := range deltas {
+			f.board(key)[member] += delta
+		}
+	}
+	for key, increments := range update.Totals {
+		if f.totals[key] == nil {
+			f.totals[key] = make(map[string]int64)
+		}
+		for field, increment := range increments {
+			f.totals[key][field] += increment
+		}
+	}
+	for _, average := range update.Averages {
+		for _, member := range average.Members {
+			rounds := f.totals[average.TotalsKey][member+":rounds"]
+			if rounds >= average.MinRounds {
+				score := f.totals[average.TotalsKey][member+":score"]
+				f.board(average.Key)[member] = math.Round(float64(score)/float64(rounds)*100) / 100
+			}
+		}
+	}
+	return true, nil
+}
+
+func (f *FakeLeaderboardCache) board(key string) map[string]float64 {
+	if f.scores[key] == nil {
+		f.scores[key] = make(map[string]float64)
+	}
+	return f.scores[key]
+}
+
+// score returns a member's score on a leaderboard and whether the member is ranked
+func (f *FakeLeaderboardCache) score(scope, subject string, metric domain.LeaderboardMetric, member string) (float64, bool) {
+	score, ok := f.scores[utils.GetLeaderboardKey(scope, subject, string(metric))][member]
+	return score, ok
+}
+
+// ==================== Helper Functions ====================
+
+const (
+	alphaTeamID = int64(100)
+	bravoTeamID = int64(200)
+)
+
+type leaderboardFixture struct {
+	service       *application.LeaderboardService
+	cache         *FakeLeaderboardCache
+	mockGameDB    *MockGameDatabasePort
+	mockContestDB *MockContestDatabasePort
+}
+
+// setupLeaderboardService seats team Alpha (users 1, 2) and team Bravo (users 3, 4) in games 1 and 2 of contest 1.
+// Alpha wins both 13-7 and every player scores 250 combat score per round.
+func setupLeaderboardService() *leaderboardFixture {
+	cache := NewFakeLeaderboardCache()
+	mockLeaderboardDB := new(MockLeaderboardDatabasePort)
+	mockGameDB := new(MockGameDatabasePort)
+	mockGameTeamDB := new(MockGameTeamDatabasePort)
+	mockMatchResultDB := new(MockMatchResultDatabasePort)
+	mockContestDB := new(MockContestDatabasePort)
+
+	first, second := 1, 2
+	for _, gameID := range []int64{1, 2} {
+		mockGameDB.On("GetByID", gameID).Return(&domain.Game{GameID: gameID, ContestID: 1, GameStatus: domain.GameStatusFinished}, nil).Maybe()
+		mockGameTeamDB.On("GetByGameID", gameID).Return([]*domain.GameTeam{
+			{GameID: gameID, TeamID: alphaTeamID, Grade: &first},
+			{GameID: gameID, TeamID: bravoTeamID, Grade: &second},
+		}, nil)
+		mockLeaderboardDB.On("GetGameTeamMembers", gameID).Return([]*port.LeaderboardTeamMember{
+			{TeamID: alphaTeamID, TeamName: "Alpha", UserID: 1},
+			{TeamID: alphaTeamID, TeamName: "Alpha", UserID: 2},
+			{TeamID: bravoTeamID, TeamName: "Bravo", UserID: 3},
+			{TeamID: bravoTeamID, TeamName: "Bravo", UserID: 4},
+		}, nil)
+		mockMatchResultDB.On("GetByGameID", gameID).Return(&domain.MatchResult{MatchResultID: gameID * 10, GameID: gameID, RoundsPlayed: 25}, nil)
+		mockMatchResultDB.On("GetPlayerStatsByMatchResult", gameID*10).Return([]*domain.MatchPlayerStat{
+			{UserID: 1, TeamID: alphaTeamID, Kills: 20, Score: 6250},
+			{UserID: 2, TeamID: alphaTeamID, Kills: 15, Score: 6250},
+			{UserID: 3, TeamID: bravoTeamID, Kills: 10, Score: 6250},
+			{UserID: 4, TeamID: bravoTeamID, Kills: 5, Score: 6250},
+		}, nil)
+	}
+	mockContestDB.On("GetContestById", int64(1)).Return(&contestDomain.Contest{ContestID: 1}, nil).Maybe()
+
+	service := application.NewLeaderboardService(cache, mockLeaderboardDB, nil, mockGameDB, mockGameTeamDB, mockMatchResultDB)
+	service.SetContestRepository(mockContestDB)
+
+	return &leaderboardFixture{service: service, cache: cache, mockGameDB: mockGameDB, mockContestDB: mockContestDB}
+}
+
+func finishedEvent(gameID int64) *port.GameEvent {
+	return &port.GameEvent{EventType: port.GameEventFinished, GameID: gameID}
+}
+
+// ==================== Scoring Tests ====================
+
+func TestLeaderboardService_HandleGameEvent_PlacementAndKills(t *testing.T) {
+	f := setupLeaderboardService()
+
+	err := f.service.HandleGameEvent(context.Background(), finishedEvent(1))
+
+	assert.NoError(t, err)
+	tests := []struct {
+		name     string
+		subject  string
+		metric   domain.LeaderboardMetric
+		member   string
+		expected float64
+	}{
+		{"Winner placement", "players", domain.LeaderboardMetricPlacement, "1", 3},
+		{"Loser placement", "players", domain.LeaderboardMetricPlacement, "3", 1},
+		{"Winning roster placement", "teams", domain.LeaderboardMetricPlacement, "1-2", 3},
+		{"Player kills", "players", domain.LeaderboardMetricKills, "2", 15},
+		{"Roster kills", "teams", domain.LeaderboardMetricKills, "3-4", 15},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := f.cache.score("global", tt.subject, tt.metric, tt.member)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, score)
+		})
+	}
+}
+
+func TestLeaderboardService_HandleGameEvent_WinsOnlyForTournamentFinal(t *testing.T) {
+	f := setupLeaderboardService()
+	round, match := 2, 1
+	f.mockGameDB.ExpectedCalls = nil
+	f.mockGameDB.On("GetByID", int64(1)).Return(&domain.Game{GameID: 1, ContestID: 1, GameStatus: domain.GameStatusFinished, Round: &round, MatchNumber: &match}, nil)
+	nextGameID := int64(9)
+	f.mockGameDB.On("GetByID", int64(2)).Return(&domain.Game{GameID: 2, ContestID: 1, GameStatus: domain.GameStatusFinished, Round: &round, MatchNumber: &match, NextGameID: &nextGameID}, nil)
+
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(2)))
+
+	wins, _ := f.cache.score("global", "players", domain.LeaderboardMetricWins, "1")
+	assert.Equal(t, float64(1), wins)
+	teamWins, _ := f.cache.score("global", "teams", domain.LeaderboardMetricWins, "1-2")
+	assert.Equal(t, float64(1), teamWins)
+	_, loserRanked := f.cache.score("global", "players", domain.LeaderboardMetricWins, "3")
+	assert.False(t, loserRanked)
+}
+
+func TestLeaderboardService_HandleGameEvent_SeasonScope(t *testing.T) {
+	f := setupLeaderboardService()
+	seriesID := int64(7)
+	f.mockContestDB.ExpectedCalls = nil
+	f.mockContestDB.On("GetContestById", int64(1)).Return(&contestDomain.Contest{ContestID: 1, SeriesID: &seriesID}, nil)
+
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+
+	global, _ := f.cache.score("global", "players", domain.LeaderboardMetricPlacement, "1")
+	season, _ := f.cache.score("season:7", "players", domain.LeaderboardMetricPlacement, "1")
+	assert.Equal(t, float64(3), global)
+	assert.Equal(t, float64(3), season)
+}
+
+// ==================== ACS Tests ====================
+
+func TestLeaderboardService_HandleGameEvent_ACSAfterMinRounds(t *testing.T) {
+	f := setupLeaderboardService()
+
+	// 25 rounds is below MinACSRounds
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+	_, ranked := f.cache.score("global", "players", domain.LeaderboardMetricACS, "1")
+	assert.False(t, ranked)
+
+	// 50 rounds reaches it
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(2)))
+	acs, ranked := f.cache.score("global", "players", domain.LeaderboardMetricACS, "1")
+	assert.True(t, ranked)
+	assert.Equal(t, float64(250), acs)
+
+	for key := range f.cache.scores {
+		assert.False(t, strings.Contains(key, ":teams:acs"), "teams have no ACS leaderboard")
+	}
+}
+
+// ==================== Redelivery Tests ====================
+
+func TestLeaderboardService_HandleGameEvent_RedeliveredEventCountsOnce(t *testing.T) {
+	f := setupLeaderboardService()
+
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+
+	kills, _ := f.cache.score("global", "players", domain.LeaderboardMetricKills, "1")
+	assert.Equal(t, float64(20), kills)
+	placement, _ := f.cache.score("global", "teams", domain.LeaderboardMetricPlacement, "1-2")
+	assert.Equal(t, float64(3), placement)
+}
+
+func TestLeaderboardService_HandleGameEvent_RetryAfterFailureCountsOnce(t *testing.T) {
+	f := setupLeaderboardService()
+	seriesID := int64(7)
+	f.mockContestDB.ExpectedCalls = nil
+	f.mockContestDB.On("GetContestById", int64(1)).Return(&contestDomain.Contest{ContestID: 1, SeriesID: &seriesID}, nil)
+	f.cache.failures = 1
+
+	err := f.service.HandleGameEvent(context.Background(), finishedEvent(1))
+	assert.Error(t, err)
+	assert.False(t, f.cache.processed[1])
+	assert.Empty(t, f.cache.scores)
+
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+
+	for _, scope := range []string{"global", "season:7"} {
+		kills, _ := f.cache.score(scope, "players", domain.LeaderboardMetricKills, "1")
+		assert.Equal(t, float64(20), kills, scope)
+		placement, _ := f.cache.score(scope, "players", domain.LeaderboardMetricPlacement, "1")
+		assert.Equal(t, float64(3), placement, scope)
+	}
+	assert.Equal(t, int64(25), f.cache.totals[utils.GetLeaderboardTotalsKey("global", "players")]["1:rounds"])
+}
+
+func TestLeaderboardService_HandleGameEvent_IgnoresOtherEvents(t *testing.T) {
+	f := setupLeaderboardService()
+
+	err := f.service.HandleGameEvent(context.Background(), &port.GameEvent{EventType: port.GameEventActivated, GameID: 1})
+
+	assert.NoError(t, err)
+	assert.Empty(t, f.cache.processed)
+	f.mockGameDB.AssertNotCalled(t, "GetByID", mock.Anything)
+}
@@ -0,0 +1,113 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Mock Definitions ====================
+
+// FakeMatchAwardDatabasePort keeps votes in memory and rejects a second vote of the same voter in a match,
+// as the unique index on mvp_votes(match_result_id, voter_id) does
+type FakeMatchAwardDatabasePort struct {
+	port.MatchAwardDatabasePort
+
+	voting *domain.MvpVoting
+	votes  []*domain.MvpVote
+}
+
+func (f *FakeMatchAwardDatabasePort) GetVoting(matchResultID int64) (*domain.MvpVoting, error) {
+	if f.voting == nil || f.voting.MatchResultID != matchResultID {
+		return nil, exception.ErrMvpVotingNotFound
+	}
+	return f.voting, nil
+}
+
+func (f *FakeMatchAwardDatabasePort) SaveVote(vote *domain.MvpVote) error {
+	for _, saved := range f.votes {
+		if saved.MatchResultID == vote.MatchResultID && saved.VoterID == vote.VoterID {
+			return exception.ErrMvpAlreadyVoted
+		}
+	}
+	vote.CreatedAt = time.Now()
+	f.votes = append(f.votes, vote)
+	return nil
+}
+
+func (f *FakeMatchAwardDatabasePort) GetVote(matchResultID, voterID int64) (*domain.MvpVote, error) {
+	for _, saved := range f.votes {
+		if saved.MatchResultID == matchResultID && saved.VoterID == voterID {
+			return saved, nil
+		}
+	}
+	return nil, nil
+}
+
+// ==================== Helper Functions ====================
+
+// setupMatchAwardService opens the MVP vote of game 1, played by users 1 to 4
+func setupMatchAwardService() (*application.MatchAwardService, *FakeMatchAwardDatabasePort) {
+	result := &domain.MatchResult{MatchResultID: 10, GameID: 1, CreatedAt: time.Now().Add(-time.Hour)}
+	awardRepo := &FakeMatchAwardDatabasePort{voting: domain.NewMvpVoting(result)}
+	mockMatchResultDB := new(MockMatchResultDatabasePort)
+
+	mockMatchResultDB.On("GetByGameID", int64(1)).Return(result, nil)
+	mockMatchResultDB.On("GetPlayerStatsByMatchResult", int64(10)).Return([]*domain.MatchPlayerStat{
+		{UserID: 1, TeamID: alphaTeamID},
+		{UserID: 2, TeamID: alphaTeamID},
+		{UserID: 3, TeamID: bravoTeamID},
+		{UserID: 4, TeamID: bravoTeamID},
+	}, nil)
+
+	return application.NewMatchAwardService(awardRepo, mockMatchResultDB, nil), awardRepo
+}
+
+// ==================== Vote Tests ====================
+
+func TestMatchAwardService_Vote_RejectsDuplicateVote(t *testing.T) {
+	tests := []struct {
+		name        string
+		candidateID int64
+	}{
+		{"Same candidate again", 2},
+		{"Different candidate", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, awardRepo := setupMatchAwardService()
+
+			first, err := service.Vote(1, 1, &dto.MvpVoteRequest{CandidateUserID: 2})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), *first.CandidateUserID)
+
+			second, err := service.Vote(1, 1, &dto.MvpVoteRequest{CandidateUserID: tt.candidateID})
+
+			assert.ErrorIs(t, err, exception.ErrMvpAlreadyVoted)
+			assert.Nil(t, second)
+			assert.Len(t, awardRepo.votes, 1)
+
+			mine, err := service.GetMyVote(1, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), *mine.CandidateUserID)
+		})
+	}
+}
+
+func TestMatchAwardService_Vote_OtherVotersStillVote(t *testing.T) {
+	service, awardRepo := setupMatchAwardService()
+
+	_, err := service.Vote(1, 1, &dto.MvpVoteRequest{CandidateUserID: 2})
+	assert.NoError(t, err)
+	_, err = service.Vote(1, 3, &dto.MvpVoteRequest{CandidateUserID: 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, awardRepo.votes, 2)
+}
@@ -0,0 +1,251 @@
+package application_test
+
+import (
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// FakePickemDatabasePort keeps predictions in memory.
+// Like the database adapter, it re-checks the game's lock on save and scores only predictions not yet scored against the winner.
+type FakePickemDatabasePort struct {
+	port.PickemDatabasePort
+
+	game        *domain.Game
+	predictions []*domain.PickemPrediction
+	// dropOnSave loses saved predictions, as if the game was deleted right after the save
+	dropOnSave bool
+}
+
+func (f *FakePickemDatabasePort) SavePrediction(prediction *domain.PickemPrediction, now time.Time) error {
+	if err := f.game.CanPredict(now); err != nil {
+		return err
+	}
+	if f.dropOnSave {
+		return nil
+	}
+
+	for _, saved := range f.predictions {
+		if saved.GameID == prediction.GameID && saved.UserID == prediction.UserID {
+			saved.PredictedTeamID = prediction.PredictedTeamID
+			saved.ModifiedAt = now
+			return nil
+		}
+	}
+	prediction.CreatedAt, prediction.ModifiedAt = now, now
+	f.predictions = append(f.predictions, prediction)
+	return nil
+}
+
+func (f *FakePickemDatabasePort) GetPrediction(gameID, userID int64) (*domain.PickemPrediction, error) {
+	for _, saved := range f.predictions {
+		if saved.GameID == gameID && saved.UserID == userID {
+			return saved, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *FakePickemDatabasePort) ScoreGame(gameID, winnerTeamID int64, points int, scoredAt time.Time) (int64, error) {
+	var scored int64
+	for _, saved := range f.predictions {
+		if saved.GameID != gameID || (saved.ScoredWinnerTeamID != nil && *saved.ScoredWinnerTeamID == winnerTeamID) {
+			continue
+		}
+		correct := saved.PredictedTeamID == winnerTeamID
+		saved.Correct = &correct
+		saved.Points = 0
+		if correct {
+			saved.Points = points
+		}
+		saved.ScoredAt = &scoredAt
+		saved.ScoredWinnerTeamID = &winnerTeamID
+		scored++
+	}
+	return scored, nil
+}
+
+// ==================== Helper Functions ====================
+
+type pickemFixture struct {
+	service        *application.PickemService
+	pickemRepo     *FakePickemDatabasePort
+	game           *domain.Game
+	mockGameTeamDB *MockGameTeamDatabasePort
+	mockMatchDB    *MockMatchResultDatabasePort
+}
+
+// setupPickemService opens pick'em on game 1, a second round bracket game of tournament 1 between Alpha and Bravo
+func setupPickemService() *pickemFixture {
+	round, match := 2, 1
+	scheduled := time.Now().Add(time.Hour)
+	game := &domain.Game{
+		GameID:             1,
+		ContestID:          1,
+		GameStatus:         domain.GameStatusPending,
+		DetectionStatus:    domain.DetectionStatusNone,
+		Round:              &round,
+		MatchNumber:        &match,
+		ScheduledStartTime: &scheduled,
+	}
+
+	pickemRepo := &FakePickemDatabasePort{game: game}
+	mockGameDB := new(MockGameDatabasePort)
+	mockGameTeamDB := new(MockGameTeamDatabasePort)
+	mockMatchResultDB := new(MockMatchResultDatabasePort)
+	mockContestDB := new(MockContestDatabasePort)
+
+	mockContestDB.On("GetContestById", int64(1)).Return(&contestDomain.Contest{ContestID: 1, ContestType: contestDomain.ContestTypeTournament}, nil)
+	mockGameDB.On("GetByID", int64(1)).Return(game, nil)
+
+	service := application.NewPickemService(pickemRepo, mockGameDB, mockGameTeamDB, mockMatchResultDB, nil)
+	service.SetContestRepository(mockContestDB)
+
+	return &pickemFixture{
+		service:        service,
+		pickemRepo:     pickemRepo,
+		game:           game,
+		mockGameTeamDB: mockGameTeamDB,
+		mockMatchDB:    mockMatchResultDB,
+	}
+}
+
+func gameTeamsOf(gameID int64) []*domain.GameTeam {
+	return []*domain.GameTeam{
+		{GameID: gameID, TeamID: alphaTeamID},
+		{GameID: gameID, TeamID: bravoTeamID},
+	}
+}
+
+// finish finishes the game with Alpha winning
+func (f *pickemFixture) finish() {
+	f.game.GameStatus = domain.GameStatusFinished
+	f.game.DetectionStatus = domain.DetectionStatusDetected
+	f.mockMatchDB.On("GetByGameID", int64(1)).Return(&domain.MatchResult{MatchResultID: 10, GameID: 1, WinnerTeamID: alphaTeamID, LoserTeamID: bravoTeamID}, nil)
+}
+
+// ==================== Scoring Tests ====================
+
+func TestPickemService_HandleGameEvent_RedeliveredEventScoresOnce(t *testing.T) {
+	f := setupPickemService()
+	f.mockGameTeamDB.On("GetByGameID", int64(1)).Return(gameTeamsOf(1), nil)
+
+	_, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: alphaTeamID})
+	assert.NoError(t, err)
+	_, err = f.service.Predict(1, 1, 20, &dto.PickemPredictionRequest{TeamID: bravoTeamID})
+	assert.NoError(t, err)
+
+	f.finish()
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+	first, _ := f.pickemRepo.GetPrediction(1, 10)
+	scoredAt := *first.ScoredAt
+
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+
+	correct, _ := f.pickemRepo.GetPrediction(1, 10)
+	assert.Equal(t, domain.PickemPoints(2), correct.Points)
+	assert.True(t, *correct.Correct)
+	assert.Equal(t, scoredAt, *correct.ScoredAt)
+
+	wrong, _ := f.pickemRepo.GetPrediction(1, 20)
+	assert.Equal(t, 0, wrong.Points)
+	assert.False(t, *wrong.Correct)
+}
+
+func TestPickemService_HandleGameEvent_CorrectedResultRescores(t *testing.T) {
+	f := setupPickemService()
+	f.mockGameTeamDB.On("GetByGameID", int64(1)).Return(gameTeamsOf(1), nil)
+
+	_, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: alphaTeamID})
+	assert.NoError(t, err)
+	_, err = f.service.Predict(1, 1, 20, &dto.PickemPredictionRequest{TeamID: bravoTeamID})
+	assert.NoError(t, err)
+
+	f.finish()
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+
+	// A manual result reverses the detected winner and finishes the game again
+	f.mockMatchDB.ExpectedCalls = nil
+	f.mockMatchDB.On("GetByGameID", int64(1)).Return(&domain.MatchResult{MatchResultID: 11, GameID: 1, WinnerTeamID: bravoTeamID, LoserTeamID: alphaTeamID}, nil)
+	assert.NoError(t, f.service.HandleGameEvent(context.Background(), finishedEvent(1)))
+
+	wrong, _ := f.pickemRepo.GetPrediction(1, 10)
+	assert.Equal(t, 0, wrong.Points)
+	assert.False(t, *wrong.Correct)
+
+	correct, _ := f.pickemRepo.GetPrediction(1, 20)
+	assert.Equal(t, domain.PickemPoints(2), correct.Points)
+	assert.True(t, *correct.Correct)
+	assert.Equal(t, bravoTeamID, *correct.ScoredWinnerTeamID)
+}
+
+func TestPickemService_HandleGameEvent_IgnoresGamesOutsideBrackets(t *testing.T) {
+	f := setupPickemService()
+	f.game.Round, f.game.MatchNumber = nil, nil
+
+	err := f.service.HandleGameEvent(context.Background(), finishedEvent(1))
+
+	assert.NoError(t, err)
+	f.mockMatchDB.AssertNotCalled(t, "GetByGameID", mock.Anything)
+}
+
+// ==================== Predict Tests ====================
+
+func TestPickemService_Predict_ReplacesEarlierPick(t *testing.T) {
+	f := setupPickemService()
+	f.mockGameTeamDB.On("GetByGameID", int64(1)).Return(gameTeamsOf(1), nil)
+
+	_, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: alphaTeamID})
+	assert.NoError(t, err)
+	resp, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: bravoTeamID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, bravoTeamID, resp.TeamID)
+	assert.Len(t, f.pickemRepo.predictions, 1)
+}
+
+func TestPickemService_Predict_GameLocksBeforeSave(t *testing.T) {
+	f := setupPickemService()
+	// The game activates while the teams are read, after the first lock check passed
+	f.mockGameTeamDB.On("GetByGameID", int64(1)).Return(gameTeamsOf(1), nil).Run(func(args mock.Arguments) {
+		f.game.GameStatus = domain.GameStatusActive
+	})
+
+	resp, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: alphaTeamID})
+
+	assert.ErrorIs(t, err, exception.ErrPickemLocked)
+	assert.Nil(t, resp)
+	assert.Empty(t, f.pickemRepo.predictions)
+}
+
+func TestPickemService_Predict_MissingPredictionAfterSave(t *testing.T) {
+	f := setupPickemService()
+	f.pickemRepo.dropOnSave = true
+	f.mockGameTeamDB.On("GetByGameID", int64(1)).Return(gameTeamsOf(1), nil)
+
+	resp, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: alphaTeamID})
+
+	assert.ErrorIs(t, err, exception.ErrPickemPredictionNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestPickemService_Predict_TeamNotInGame(t *testing.T) {
+	f := setupPickemService()
+	f.mockGameTeamDB.On("GetByGameID", int64(1)).Return(gameTeamsOf(1), nil)
+
+	_, err := f.service.Predict(1, 1, 10, &dto.PickemPredictionRequest{TeamID: 999})
+
+	assert.ErrorIs(t, err, exception.ErrPickemTeamNotInGame)
+	assert.Empty(t, f.pickemRepo.predictions)
+}
@@ -0,0 +1,175 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	userQueryPort "github.com/FOR-GAMERS/GAMERS-BE/internal/user/application/port/port"
+	userDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/user/domain"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// MockPlayerStatsDatabasePort mocks the PlayerStatsDatabasePort interface
+type MockPlayerStatsDatabasePort struct {
+	mock.Mock
+}
+
+func (m *MockPlayerStatsDatabasePort) GetPlayerGameStats(userID int64) ([]*domain.PlayerGameStat, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.PlayerGameStat), args.Error(1)
+}
+
+// MockPlayerStatsCachePort mocks the PlayerStatsCachePort interface
+type MockPlayerStatsCachePort struct {
+	mock.Mock
+}
+
+func (m *MockPlayerStatsCachePort) Get(ctx context.Context, userID int64) (*domain.PlayerCareerStats, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PlayerCareerStats), args.Error(1)
+}
+
+func (m *MockPlayerStatsCachePort) Set(ctx context.Context, stats *domain.PlayerCareerStats, ttl time.Duration) error {
+	args := m.Called(ctx, stats, ttl)
+	return args.Error(0)
+}
+
+func (m *MockPlayerStatsCachePort) Invalidate(ctx context.Context, userIDs ...int64) error {
+	args := m.Called(ctx, userIDs)
+	return args.Error(0)
+}
+
+// MockUserQueryPort mocks the UserQueryPort methods used by the game services under test
+type MockUserQueryPort struct {
+	mock.Mock
+	userQueryPort.UserQueryPort
+}
+
+func (m *MockUserQueryPort) FindById(id int64) (*userDomain.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*userDomain.User), args.Error(1)
+}
+
+// MockContestAccessPort mocks the ContestAccessPort interface
+type MockContestAccessPort struct {
+	mock.Mock
+}
+
+func (m *MockContestAccessPort) CheckViewAccess(contestId, userId int64) error {
+	args := m.Called(contestId, userId)
+	return args.Error(0)
+}
+
+// ==================== Helper Functions ====================
+
+const (
+	publicContestID  = int64(1)
+	privateContestID = int64(2)
+)
+
+// createPlayerGameStats returns two games of a public contest and one of a private contest
+func createPlayerGameStats() []*domain.PlayerGameStat {
+	playedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	return []*domain.PlayerGameStat{
+		{GameID: 1, ContestID: publicContestID, ContestTitle: "Spring Cup", AgentName: "Jett", MapName: "Ascent", Won: true,
+			RoundsPlayed: 20, Kills: 20, Deaths: 10, Assists: 5, Score: 4000, PlayedAt: playedAt},
+		{GameID: 2, ContestID: publicContestID, ContestTitle: "Spring Cup", AgentName: "Jett", MapName: "Bind", Won: false,
+			RoundsPlayed: 20, Kills: 10, Deaths: 10, Assists: 5, Score: 3000, PlayedAt: playedAt.Add(time.Hour)},
+		{GameID: 3, ContestID: privateContestID, ContestTitle: "Scrim League", AgentName: "Omen", MapName: "Lotus", Won: true,
+			RoundsPlayed: 13, Kills: 30, Deaths: 2, Assists: 1, Score: 5000, PlayedAt: playedAt.Add(2 * time.Hour)},
+	}
+}
+
+// setupPlayerStatsService serves user 10's stats from a warm cache holding every game
+func setupPlayerStatsService() (*application.PlayerStatsService, *MockPlayerStatsDatabasePort, *MockContestAccessPort) {
+	mockStatsDB := new(MockPlayerStatsDatabasePort)
+	mockStatsCache := new(MockPlayerStatsCachePort)
+	mockUserQuery := new(MockUserQueryPort)
+	mockAccess := new(MockContestAccessPort)
+
+	games := createPlayerGameStats()
+	mockUserQuery.On("FindById", int64(10)).Return(&userDomain.User{Id: 10}, nil)
+	mockStatsCache.On("Get", mock.Anything, int64(10)).Return(domain.BuildPlayerCareerStats(10, games, time.Now()), nil)
+	mockStatsDB.On("GetPlayerGameStats", int64(10)).Return(games, nil)
+	mockAccess.On("CheckViewAccess", publicContestID, mock.Anything).Return(nil)
+
+	service := application.NewPlayerStatsService(mockStatsDB, mockStatsCache, mockUserQuery)
+	service.SetAccessChecker(mockAccess)
+
+	return service, mockStatsDB, mockAccess
+}
+
+// ==================== GetPlayerStats Tests ====================
+
+func TestPlayerStatsService_GetPlayerStats_HidesPrivateContestFromLifetime(t *testing.T) {
+	tests := []struct {
+		name     string
+		viewerID int64
+	}{
+		{"Anonymous", 0},
+		{"Other user", 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, mockAccess := setupPlayerStatsService()
+			mockAccess.On("CheckViewAccess", privateContestID, tt.viewerID).Return(exception.ErrContestNotFound)
+
+			stats, err := service.GetPlayerStats(10, tt.viewerID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, stats.Lifetime.GamesPlayed)
+			assert.Equal(t, 30, stats.Lifetime.Kills)
+			assert.Equal(t, 1, stats.Lifetime.Wins)
+			assert.Len(t, stats.Contests, 1)
+			assert.Equal(t, publicContestID, stats.Contests[0].ContestID)
+			assert.Len(t, stats.Agents, 1)
+			assert.Equal(t, "Jett", stats.Agents[0].AgentName)
+			for _, m := range stats.Maps {
+				assert.NotEqual(t, "Lotus", m.MapName)
+			}
+			for _, g := range stats.RecentForm {
+				assert.NotEqual(t, privateContestID, g.ContestID)
+			}
+		})
+	}
+}
+
+func TestPlayerStatsService_GetPlayerStats_ViewerWithAccessSeesEverything(t *testing.T) {
+	service, mockStatsDB, mockAccess := setupPlayerStatsService()
+	mockAccess.On("CheckViewAccess", privateContestID, int64(20)).Return(nil)
+
+	stats, err := service.GetPlayerStats(10, 20)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, stats.Lifetime.GamesPlayed)
+	assert.Equal(t, 60, stats.Lifetime.Kills)
+	assert.Len(t, stats.Contests, 2)
+	mockStatsDB.AssertNotCalled(t, "GetPlayerGameStats", mock.Anything)
+}
+
+func TestPlayerStatsService_GetPlayerStats_OwnProfileSkipsAccessChecks(t *testing.T) {
+	service, _, mockAccess := setupPlayerStatsService()
+
+	stats, err := service.GetPlayerStats(10, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, stats.Lifetime.GamesPlayed)
+	mockAccess.AssertNotCalled(t, "CheckViewAccess", mock.Anything, mock.Anything)
+}
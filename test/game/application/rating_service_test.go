@@ -0,0 +1,159 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Mock Definitions ====================
+
+// FakeRatingDatabasePort stores ratings in memory and is its own transactor: rows are only read for update and
+// saved inside a transaction, and transactions run one at a time as they would when waiting on the row locks
+type FakeRatingDatabasePort struct {
+	port.RatingDatabasePort
+
+	txMu        sync.Mutex
+	inTx        bool
+	lockedOutTx bool
+	players     map[int64]domain.PlayerRating
+	teams       map[string]domain.TeamRating
+	history     []*domain.RatingHistory
+}
+
+func (f *FakeRatingDatabasePort) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	f.txMu.Lock()
+	defer f.txMu.Unlock()
+
+	f.inTx = true
+	defer func() { f.inTx = false }()
+	return fn(ctx)
+}
+
+func (f *FakeRatingDatabasePort) GetPlayerRatingsForUpdate(ctx context.Context, userIDs []int64) ([]*domain.PlayerRating, error) {
+	f.lockedOutTx = f.lockedOutTx || !f.inTx
+
+	ratings := make([]*domain.PlayerRating, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := f.players[userID]; !ok {
+			f.players[userID] = *domain.NewPlayerRating(userID)
+		}
+		rating := f.players[userID]
+		ratings = append(ratings, &rating)
+	}
+	return ratings, nil
+}
+
+func (f *FakeRatingDatabasePort) GetTeamRatingsForUpdate(ctx context.Context, rosterKeys []string) ([]*domain.TeamRating, error) {
+	f.lockedOutTx = f.lockedOutTx || !f.inTx
+
+	var ratings []*domain.TeamRating
+	for _, rosterKey := range rosterKeys {
+		if rating, ok := f.teams[rosterKey]; ok {
+			ratings = append(ratings, &rating)
+		}
+	}
+	return ratings, nil
+}
+
+func (f *FakeRatingDatabasePort) HasGameHistoryWithContext(ctx context.Context, gameID int64) (bool, error) {
+	for _, h := range f.history {
+		if h.GameID == gameID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *FakeRatingDatabasePort) SaveRatingUpdateWithContext(ctx context.Context, update *port.RatingUpdate) error {
+	for _, player := range update.Players {
+		f.players[player.UserID] = *player
+	}
+	for _, team := range update.Teams {
+		f.teams[team.RosterKey] = *team
+	}
+	f.history = append(f.history, update.History...)
+	return nil
+}
+
+// ==================== Helper Functions ====================
+
+// setupRatingService serves finished games 1 (users 1,2 beat 3,4) and 2 (users 1,5 beat 6,7)
+func setupRatingService() (*application.RatingService, *FakeRatingDatabasePort) {
+	ratingRepo := &FakeRatingDatabasePort{
+		players: make(map[int64]domain.PlayerRating),
+		teams:   make(map[string]domain.TeamRating),
+	}
+
+	endedAt := time.Now().Add(-time.Hour)
+	mockGameDB := new(MockGameDatabasePort)
+	mockMatchResultDB := new(MockMatchResultDatabasePort)
+	mockLeaderboardDB := new(MockLeaderboardDatabasePort)
+	for gameID, userIDs := range map[int64][4]int64{1: {1, 2, 3, 4}, 2: {1, 5, 6, 7}} {
+		winnerTeamID, loserTeamID := gameID*10+1, gameID*10+2
+		mockGameDB.On("GetByID", gameID).Return(&domain.Game{GameID: gameID, GameStatus: domain.GameStatusFinished, EndedAt: &endedAt}, nil)
+		mockMatchResultDB.On("GetByGameID", gameID).Return(&domain.MatchResult{GameID: gameID, WinnerTeamID: winnerTeamID, LoserTeamID: loserTeamID}, nil)
+		mockLeaderboardDB.On("GetGameTeamMembers", gameID).Return([]*port.LeaderboardTeamMember{
+			{TeamID: winnerTeamID, TeamName: "Winners", UserID: userIDs[0]},
+			{TeamID: winnerTeamID, TeamName: "Winners", UserID: userIDs[1]},
+			{TeamID: loserTeamID, TeamName: "Losers", UserID: userIDs[2]},
+			{TeamID: loserTeamID, TeamName: "Losers", UserID: userIDs[3]},
+		}, nil)
+	}
+
+	service := application.NewRatingService(ratingRepo, mockLeaderboardDB, mockGameDB, mockMatchResultDB, nil, nil)
+	service.SetTransactionManager(ratingRepo)
+
+	return service, ratingRepo
+}
+
+// ==================== HandleGameEvent Tests ====================
+
+func TestRatingService_HandleGameEvent_RatesGameUnderRowLocks(t *testing.T) {
+	service, ratingRepo := setupRatingService()
+
+	err := service.HandleGameEvent(context.Background(), finishedEvent(1))
+
+	assert.NoError(t, err)
+	assert.False(t, ratingRepo.lockedOutTx)
+	assert.Len(t, ratingRepo.history, 6)
+	assert.Len(t, ratingRepo.teams, 2)
+	assert.Equal(t, 1, ratingRepo.players[1].Wins)
+	assert.Greater(t, ratingRepo.players[1].Rating, ratingRepo.players[3].Rating)
+}
+
+func TestRatingService_HandleGameEvent_RedeliveredEventSkipsRatedGame(t *testing.T) {
+	service, ratingRepo := setupRatingService()
+	assert.NoError(t, service.HandleGameEvent(context.Background(), finishedEvent(1)))
+	rated := ratingRepo.players[1]
+
+	err := service.HandleGameEvent(context.Background(), finishedEvent(1))
+
+	assert.NoError(t, err)
+	assert.Len(t, ratingRepo.history, 6)
+	assert.Equal(t, rated, ratingRepo.players[1])
+}
+
+func TestRatingService_HandleGameEvent_ConcurrentGamesSharingPlayersKeepBothResults(t *testing.T) {
+	service, ratingRepo := setupRatingService()
+
+	var wg sync.WaitGroup
+	for _, gameID := range []int64{1, 2} {
+		wg.Add(1)
+		go func(gameID int64) {
+			defer wg.Done()
+			assert.NoError(t, service.HandleGameEvent(context.Background(), finishedEvent(gameID)))
+		}(gameID)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, ratingRepo.players[1].GamesPlayed)
+	assert.Equal(t, 2, ratingRepo.players[1].Wins)
+	assert.Len(t, ratingRepo.history, 12)
+}
@@ -0,0 +1,238 @@
+package application_test
+
+import (
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+func (m *MockContestDatabasePort) UpdateContestWithContext(ctx context.Context, contest *contestDomain.Contest) error {
+	args := m.Called(ctx, contest)
+	return args.Error(0)
+}
+
+// FakeRosterTeamRedisPort holds the team still being built in the cache
+type FakeRosterTeamRedisPort struct {
+	port.TeamRedisPort
+
+	team           *port.CachedTeam
+	members        []*port.CachedTeamMember
+	finalized      bool
+	finalizedCount int64
+}
+
+func (f *FakeRosterTeamRedisPort) GetTeam(ctx context.Context, contestID int64) (*port.CachedTeam, error) {
+	if f.team == nil {
+		return nil, exception.ErrTeamMemberNotFound
+	}
+	return f.team, nil
+}
+
+func (f *FakeRosterTeamRedisPort) GetAllMembers(ctx context.Context, contestID int64) ([]*port.CachedTeamMember, error) {
+	return f.members, nil
+}
+
+func (f *FakeRosterTeamRedisPort) IsMember(ctx context.Context, contestID, userID int64) (bool, error) {
+	for _, member := range f.members {
+		if member.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *FakeRosterTeamRedisPort) GetLeader(ctx context.Context, contestID int64) (*port.CachedTeamMember, error) {
+	for _, member := range f.members {
+		if member.MemberType == port.TeamMemberTypeLeader {
+			return member, nil
+		}
+	}
+	return nil, exception.ErrTeamMemberNotFound
+}
+
+func (f *FakeRosterTeamRedisPort) IsFinalized(ctx context.Context, contestID int64) (bool, error) {
+	return f.finalized, nil
+}
+
+func (f *FakeRosterTeamRedisPort) MarkAsFinalized(ctx context.Context, contestID int64) error {
+	f.finalized = true
+	return nil
+}
+
+func (f *FakeRosterTeamRedisPort) IncrementFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error) {
+	f.finalizedCount++
+	return f.finalizedCount, nil
+}
+
+func (f *FakeRosterTeamRedisPort) ClearTeam(ctx context.Context, contestID int64) error {
+	f.team = nil
+	f.members = nil
+	return nil
+}
+
+// FakeRosterDatabasePort stores the lock report, or fails to when failSave is set
+type FakeRosterDatabasePort struct {
+	port.RosterDatabasePort
+
+	report   *domain.RosterLockReport
+	failSave bool
+}
+
+func (f *FakeRosterDatabasePort) SaveLockReportWithContext(ctx context.Context, report *domain.RosterLockReport) (*domain.RosterLockReport, error) {
+	if f.failSave {
+		return nil, errors.New("connection lost")
+	}
+	f.report = report
+	return report, nil
+}
+
+// ==================== Helper Functions ====================
+
+// setupRosterService runs contest 1 with teams of three. DB team 1 is complete and DB team 2 has two members;
+// the cached team of cachedMembers players (leader 31) has not been finalized yet.
+func setupRosterService(maxTeamCount, cachedMembers int) (*application.RosterService, *FakeTeamMembersDatabasePort, *FakeRosterTeamRedisPort, *FakeRosterDatabasePort, *contestDomain.Contest) {
+	contest := &contestDomain.Contest{ContestID: 1, ContestStatus: contestDomain.ContestStatusPending, TotalTeamMember: 3, MaxTeamCount: maxTeamCount}
+
+	teamRepo := &FakeTeamMembersDatabasePort{
+		FakeWaitlistTeamDatabasePort: &FakeWaitlistTeamDatabasePort{teams: []*domain.Team{
+			{TeamID: 1, ContestID: 1, TeamName: "Complete", Status: domain.TeamStatusRegistered},
+			{TeamID: 2, ContestID: 1, TeamName: "Incomplete", Status: domain.TeamStatusRegistered},
+		}},
+		members: map[int64][]*domain.TeamMember{
+			1: {
+				domain.NewTeamMemberAsLeader(1, 11),
+				domain.NewTeamMemberAsMember(1, 12),
+				domain.NewTeamMemberAsMember(1, 13),
+			},
+			2: {
+				domain.NewTeamMemberAsLeader(2, 21),
+				domain.NewTeamMemberAsMember(2, 22),
+			},
+		},
+	}
+
+	teamName := "Cached"
+	cache := &FakeRosterTeamRedisPort{team: &port.CachedTeam{
+		ContestID:    1,
+		TeamID:       1,
+		TeamName:     &teamName,
+		MaxMembers:   3,
+		CurrentCount: cachedMembers,
+		LeaderUserID: 31,
+	}}
+	for i := 0; i < cachedMembers; i++ {
+		memberType := port.TeamMemberTypeMember
+		if i == 0 {
+			memberType = port.TeamMemberTypeLeader
+		}
+		cache.members = append(cache.members, &port.CachedTeamMember{UserID: int64(31 + i), ContestID: 1, MemberType: memberType})
+	}
+
+	mockContestDB := new(MockContestDatabasePort)
+	mockContestDB.On("GetContestById", int64(1)).Return(contest, nil)
+	mockContestDB.On("GetContestByIdForUpdate", mock.Anything, int64(1)).Return(contest, nil)
+	mockContestDB.On("UpdateContestWithContext", mock.Anything, contest).Return(nil)
+
+	rosterRepo := &FakeRosterDatabasePort{}
+
+	teamService := application.NewTeamService(teamRepo, cache, mockContestDB, nil, nil, nil, nil)
+	teamService.SetTransactionManager(&FakeContestRowLock{})
+	service := application.NewRosterService(teamService, teamRepo, cache, rosterRepo, mockContestDB)
+	service.SetTransactionManager(&FakeContestRowLock{})
+
+	return service, teamRepo, cache, rosterRepo, contest
+}
+
+// ==================== LockContestRoster Tests ====================
+
+func TestRosterService_LockContestRoster_DropsIncompleteTeams(t *testing.T) {
+	service, teamRepo, cache, rosterRepo, contest := setupRosterService(0, 2)
+
+	report, err := service.LockContestRoster(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.True(t, contest.IsRosterLocked())
+	assert.Equal(t, 1, report.FinalizedTeamCount)
+	assert.Equal(t, 2, report.DroppedTeamCount)
+	assert.Same(t, report, rosterRepo.report)
+
+	dropped := make(map[string][]int64)
+	for _, team := range report.DroppedTeams {
+		dropped[team.TeamName] = team.MemberUserIDs
+	}
+	assert.Equal(t, map[string][]int64{"Incomplete": {21, 22}, "Cached": {31, 32}}, dropped)
+
+	assert.ElementsMatch(t, []int64{1}, teamRepo.registered())
+	assert.Nil(t, cache.team)
+}
+
+func TestRosterService_LockContestRoster_FinalizesCompleteCachedTeam(t *testing.T) {
+	service, _, cache, _, contest := setupRosterService(0, 3)
+
+	report, err := service.LockContestRoster(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.FinalizedTeamCount)
+	assert.Equal(t, 1, report.DroppedTeamCount)
+	assert.True(t, cache.finalized)
+	assert.Equal(t, int64(1), cache.finalizedCount)
+}
+
+func TestRosterService_LockContestRoster_FailedCommitLeavesCacheUntouched(t *testing.T) {
+	for _, cachedMembers := range []int{2, 3} {
+		service, _, cache, rosterRepo, contest := setupRosterService(0, cachedMembers)
+		rosterRepo.failSave = true
+
+		report, err := service.LockContestRoster(context.Background(), contest)
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+		assert.NotNil(t, cache.team, "cached team of %d kept", cachedMembers)
+		assert.Len(t, cache.members, cachedMembers)
+		assert.False(t, cache.finalized)
+		assert.Zero(t, cache.finalizedCount)
+	}
+}
+
+func TestRosterService_LockContestRoster_PromotesIntoDroppedSlots(t *testing.T) {
+	service, teamRepo, _, _, contest := setupRosterService(2, 2)
+	waitlisted := &domain.Team{TeamID: 3, ContestID: 1, TeamName: "Waiting"}
+	waitlisted.Waitlist(time.Now().Add(-time.Hour))
+	teamRepo.teams = append(teamRepo.teams, waitlisted)
+	teamRepo.members[3] = []*domain.TeamMember{
+		domain.NewTeamMemberAsLeader(3, 41),
+		domain.NewTeamMemberAsMember(3, 42),
+		domain.NewTeamMemberAsMember(3, 43),
+	}
+
+	report, err := service.LockContestRoster(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.FinalizedTeamCount)
+	assert.ElementsMatch(t, []int64{1, 3}, teamRepo.registered())
+}
+
+func TestRosterService_LockContestRoster_AlreadyLocked(t *testing.T) {
+	service, teamRepo, cache, rosterRepo, contest := setupRosterService(0, 2)
+	lockedAt := time.Now().Add(-time.Hour)
+	contest.RosterLockedAt = &lockedAt
+
+	report, err := service.LockContestRoster(context.Background(), contest)
+
+	assert.ErrorIs(t, err, exception.ErrRosterAlreadyLocked)
+	assert.Nil(t, report)
+	assert.Nil(t, rosterRepo.report)
+	assert.ElementsMatch(t, []int64{1, 2}, teamRepo.registered())
+	assert.NotNil(t, cache.team)
+}
@@ -0,0 +1,168 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// MockTeamPersistencePublisherPort mocks the TeamPersistencePublisherPort interface
+type MockTeamPersistencePublisherPort struct {
+	mock.Mock
+}
+
+func (m *MockTeamPersistencePublisherPort) PublishTeamCreated(ctx context.Context, event *port.TeamPersistenceEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockTeamPersistencePublisherPort) PublishMemberAdded(ctx context.Context, event *port.TeamPersistenceEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockTeamPersistencePublisherPort) PublishMemberRemoved(ctx context.Context, event *port.TeamPersistenceEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockTeamPersistencePublisherPort) PublishTeamFinalized(ctx context.Context, event *port.TeamPersistenceEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockTeamPersistencePublisherPort) PublishTeamDeleted(ctx context.Context, event *port.TeamPersistenceEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockTeamPersistencePublisherPort) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockTeamPersistencePublisherPort) HealthCheck(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// ==================== Helper Functions ====================
+
+// exhaustRetries fails the event until it is dead-lettered, as the consumer does
+func exhaustRetries(event *port.TeamPersistenceEvent, err error) {
+	for !event.RecordFailure(err) {
+	}
+}
+
+// ==================== RecordDeadLetter Tests ====================
+
+func TestTeamDeadLetterService_RecordDeadLetter(t *testing.T) {
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	mockPublisher := new(MockTeamPersistencePublisherPort)
+	service := application.NewTeamDeadLetterService(mockDeadLetterDB, mockPublisher)
+
+	event := createTeamCreatedEvent("team-created:1")
+	event.TeamID = 3
+	exhaustRetries(event, errors.New("duplicate entry"))
+	body, _ := json.Marshal(event)
+
+	mockDeadLetterDB.On("SaveDeadLetter", mock.MatchedBy(func(d *domain.TeamDeadLetter) bool {
+		return d.EventID == "event-1" &&
+			d.IdempotencyKey == "team-created:1" &&
+			d.EventType == string(port.TeamPersistenceCreated) &&
+			d.ContestID == 1 &&
+			d.TeamID == 3 &&
+			d.RetryCount == port.MaxRetryCount+1 &&
+			d.LastError == "duplicate entry" &&
+			d.Payload == string(body) &&
+			d.IsPending()
+	})).Return(&domain.TeamDeadLetter{}, nil)
+
+	err := service.RecordDeadLetter(context.Background(), body)
+
+	assert.NoError(t, err)
+	mockDeadLetterDB.AssertExpectations(t)
+}
+
+func TestTeamDeadLetterService_RecordDeadLetter_MalformedBody(t *testing.T) {
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	mockPublisher := new(MockTeamPersistencePublisherPort)
+	service := application.NewTeamDeadLetterService(mockDeadLetterDB, mockPublisher)
+
+	mockDeadLetterDB.On("SaveDeadLetter", mock.MatchedBy(func(d *domain.TeamDeadLetter) bool {
+		return d.EventType == "unparsable" && d.Payload == "not json" && d.LastError != ""
+	})).Return(&domain.TeamDeadLetter{}, nil)
+
+	err := service.RecordDeadLetter(context.Background(), []byte("not json"))
+
+	assert.NoError(t, err)
+	mockDeadLetterDB.AssertExpectations(t)
+}
+
+func TestTeamDeadLetterService_RecordDeadLetter_SaveFails(t *testing.T) {
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	mockPublisher := new(MockTeamPersistencePublisherPort)
+	service := application.NewTeamDeadLetterService(mockDeadLetterDB, mockPublisher)
+
+	body, _ := json.Marshal(createTeamCreatedEvent("team-created:1"))
+	mockDeadLetterDB.On("SaveDeadLetter", mock.Anything).Return(nil, errors.New("connection refused"))
+
+	// The error is returned so the consumer leaves the message on the DLQ
+	err := service.RecordDeadLetter(context.Background(), body)
+
+	assert.Error(t, err)
+}
+
+// ==================== ReplayDeadLetter Tests ====================
+
+func TestTeamDeadLetterService_ReplayDeadLetter_ResetsRetries(t *testing.T) {
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	mockPublisher := new(MockTeamPersistencePublisherPort)
+	service := application.NewTeamDeadLetterService(mockDeadLetterDB, mockPublisher)
+
+	event := createTeamCreatedEvent("team-created:1")
+	exhaustRetries(event, errors.New("duplicate entry"))
+	body, _ := json.Marshal(event)
+
+	deadLetter := domain.NewTeamDeadLetter(string(event.EventType), string(body), event.LastError)
+	deadLetter.DeadLetterID = 5
+
+	mockDeadLetterDB.On("GetDeadLetterByID", int64(5)).Return(deadLetter, nil)
+	mockPublisher.On("PublishTeamCreated", mock.Anything, mock.MatchedBy(func(e *port.TeamPersistenceEvent) bool {
+		return e.RetryCount == 0 && e.LastError == "" && e.IdempotencyKey == "team-created:1"
+	})).Return(nil)
+	mockDeadLetterDB.On("UpdateDeadLetter", deadLetter).Return(nil)
+
+	resp, err := service.ReplayDeadLetter(context.Background(), 5, 99)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, domain.DeadLetterStatusReplayed, deadLetter.Status)
+	mockPublisher.AssertExpectations(t)
+	mockDeadLetterDB.AssertExpectations(t)
+}
+
+func TestTeamDeadLetterService_ReplayDeadLetter_AlreadyResolved(t *testing.T) {
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	mockPublisher := new(MockTeamPersistencePublisherPort)
+	service := application.NewTeamDeadLetterService(mockDeadLetterDB, mockPublisher)
+
+	deadLetter := domain.NewTeamDeadLetter(string(port.TeamPersistenceCreated), "{}", "duplicate entry")
+	_ = deadLetter.MarkDiscarded(99)
+	mockDeadLetterDB.On("GetDeadLetterByID", int64(5)).Return(deadLetter, nil)
+
+	_, err := service.ReplayDeadLetter(context.Background(), 5, 99)
+
+	assert.ErrorIs(t, err, exception.ErrDeadLetterNotPending)
+	mockPublisher.AssertNotCalled(t, "PublishTeamCreated", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,250 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/config"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// MockTeamDatabasePort mocks the TeamDatabasePort methods used by the game services under test;
+// calling any other method panics on the nil embedded interface
+type MockTeamDatabasePort struct {
+	mock.Mock
+	port.TeamDatabasePort
+}
+
+func (m *MockTeamDatabasePort) Save(team *domain.Team) (*domain.Team, error) {
+	args := m.Called(team)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Team), args.Error(1)
+}
+
+func (m *MockTeamDatabasePort) GetByContestAndName(contestID int64, teamName string) (*domain.Team, error) {
+	args := m.Called(contestID, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Team), args.Error(1)
+}
+
+func (m *MockTeamDatabasePort) GetByContestID(contestID int64) ([]*domain.Team, error) {
+	args := m.Called(contestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Team), args.Error(1)
+}
+
+func (m *MockTeamDatabasePort) SaveMember(member *domain.TeamMember) (*domain.TeamMember, error) {
+	args := m.Called(member)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TeamMember), args.Error(1)
+}
+
+func (m *MockTeamDatabasePort) GetMemberByTeamAndUser(teamID, userID int64) (*domain.TeamMember, error) {
+	args := m.Called(teamID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TeamMember), args.Error(1)
+}
+
+// MockTeamDeadLetterDatabasePort mocks the TeamDeadLetterDatabasePort interface
+type MockTeamDeadLetterDatabasePort struct {
+	mock.Mock
+}
+
+func (m *MockTeamDeadLetterDatabasePort) SaveDeadLetter(deadLetter *domain.TeamDeadLetter) (*domain.TeamDeadLetter, error) {
+	args := m.Called(deadLetter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TeamDeadLetter), args.Error(1)
+}
+
+func (m *MockTeamDeadLetterDatabasePort) GetDeadLetterByID(deadLetterID int64) (*domain.TeamDeadLetter, error) {
+	args := m.Called(deadLetterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TeamDeadLetter), args.Error(1)
+}
+
+func (m *MockTeamDeadLetterDatabasePort) GetDeadLetters(offset, limit int, status *domain.DeadLetterStatus) ([]*domain.TeamDeadLetter, int64, error) {
+	args := m.Called(offset, limit, status)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.TeamDeadLetter), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTeamDeadLetterDatabasePort) UpdateDeadLetter(deadLetter *domain.TeamDeadLetter) error {
+	args := m.Called(deadLetter)
+	return args.Error(0)
+}
+
+func (m *MockTeamDeadLetterDatabasePort) HasProcessedEvent(idempotencyKey string) (bool, error) {
+	args := m.Called(idempotencyKey)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTeamDeadLetterDatabasePort) SaveProcessedEvent(event *domain.ProcessedTeamEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+// ==================== Helper Functions ====================
+
+func createTeamCreatedEvent(idempotencyKey string) *port.TeamPersistenceEvent {
+	teamName := "Team Alpha"
+	return &port.TeamPersistenceEvent{
+		EventID:        "event-1",
+		EventType:      port.TeamPersistenceCreated,
+		IdempotencyKey: idempotencyKey,
+		Timestamp:      time.Now(),
+		ContestID:      1,
+		TeamName:       &teamName,
+		Members: []*port.TeamMemberPersistence{
+			{UserID: 10, MemberType: port.TeamMemberTypeLeader, JoinedAt: time.Now()},
+		},
+	}
+}
+
+// ==================== HandleTeamPersistence Tests ====================
+
+func TestTeamPersistenceHandler_SkipsDuplicateEvent(t *testing.T) {
+	mockTeamDB := new(MockTeamDatabasePort)
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	handler := application.NewTeamPersistenceHandler(mockTeamDB, mockDeadLetterDB)
+
+	mockDeadLetterDB.On("HasProcessedEvent", "team-created:1").Return(true, nil)
+
+	err := handler.HandleTeamPersistence(context.Background(), createTeamCreatedEvent("team-created:1"))
+
+	assert.NoError(t, err)
+	mockTeamDB.AssertNotCalled(t, "Save", mock.Anything)
+	mockTeamDB.AssertNotCalled(t, "SaveMember", mock.Anything)
+	mockDeadLetterDB.AssertNotCalled(t, "SaveProcessedEvent", mock.Anything)
+}
+
+func TestTeamPersistenceHandler_RecordsIdempotencyKeyAfterApplying(t *testing.T) {
+	mockTeamDB := new(MockTeamDatabasePort)
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	handler := application.NewTeamPersistenceHandler(mockTeamDB, mockDeadLetterDB)
+
+	savedTeam := &domain.Team{TeamID: 7, ContestID: 1, TeamName: "Team Alpha"}
+	mockDeadLetterDB.On("HasProcessedEvent", "team-created:1").Return(false, nil)
+	mockTeamDB.On("GetByContestAndName", int64(1), "Team Alpha").Return(nil, exception.ErrTeamNotFound)
+	mockTeamDB.On("Save", mock.AnythingOfType("*domain.Team")).Return(savedTeam, nil)
+	mockTeamDB.On("GetMemberByTeamAndUser", int64(7), int64(10)).Return(nil, exception.ErrTeamMemberNotFound)
+	mockTeamDB.On("SaveMember", mock.AnythingOfType("*domain.TeamMember")).Return(&domain.TeamMember{}, nil)
+	mockDeadLetterDB.On("SaveProcessedEvent", mock.MatchedBy(func(e *domain.ProcessedTeamEvent) bool {
+		return e.IdempotencyKey == "team-created:1" && e.EventType == string(port.TeamPersistenceCreated)
+	})).Return(nil)
+
+	err := handler.HandleTeamPersistence(context.Background(), createTeamCreatedEvent("team-created:1"))
+
+	assert.NoError(t, err)
+	mockTeamDB.AssertExpectations(t)
+	mockDeadLetterDB.AssertExpectations(t)
+}
+
+func TestTeamPersistenceHandler_FailureDoesNotRecordIdempotencyKey(t *testing.T) {
+	mockTeamDB := new(MockTeamDatabasePort)
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	handler := application.NewTeamPersistenceHandler(mockTeamDB, mockDeadLetterDB)
+
+	mockDeadLetterDB.On("HasProcessedEvent", "team-created:1").Return(false, nil)
+	mockTeamDB.On("GetByContestAndName", int64(1), "Team Alpha").Return(nil, exception.ErrTeamNotFound)
+	mockTeamDB.On("Save", mock.AnythingOfType("*domain.Team")).Return(nil, errors.New("connection refused"))
+
+	err := handler.HandleTeamPersistence(context.Background(), createTeamCreatedEvent("team-created:1"))
+
+	assert.Error(t, err)
+	mockDeadLetterDB.AssertNotCalled(t, "SaveProcessedEvent", mock.Anything)
+}
+
+// ==================== Retry Tests ====================
+
+func TestTeamPersistenceEvent_RecordFailure_DeadLettersAfterMaxRetries(t *testing.T) {
+	event := createTeamCreatedEvent("team-created:1")
+
+	for attempt := 1; attempt <= port.MaxRetryCount; attempt++ {
+		deadLetter := event.RecordFailure(errors.New("deadlock found"))
+
+		assert.False(t, deadLetter, "attempt %d should be retried", attempt)
+		assert.Equal(t, attempt, event.RetryCount)
+		assert.Equal(t, "deadlock found", event.LastError)
+	}
+
+	assert.True(t, event.RecordFailure(errors.New("deadlock found")))
+	assert.Equal(t, port.MaxRetryCount+1, event.RetryCount)
+}
+
+func TestTeamPersistenceEvent_EveryRetryHasDelayQueue(t *testing.T) {
+	assert.GreaterOrEqual(t, len(config.TeamPersistenceRetryDelays), port.MaxRetryCount)
+
+	for i := 1; i < len(config.TeamPersistenceRetryDelays); i++ {
+		assert.Greater(t, config.TeamPersistenceRetryDelays[i], config.TeamPersistenceRetryDelays[i-1])
+	}
+}
+
+func TestTeamPersistenceEvent_ResetForReplay_KeepsIdempotencyKey(t *testing.T) {
+	event := createTeamCreatedEvent("team-created:1")
+	for i := 0; i <= port.MaxRetryCount; i++ {
+		event.RecordFailure(errors.New("deadlock found"))
+	}
+
+	event.ResetForReplay()
+
+	assert.Equal(t, 0, event.RetryCount)
+	assert.Empty(t, event.LastError)
+	assert.Equal(t, "team-created:1", event.IdempotencyKey)
+	assert.False(t, event.RecordFailure(errors.New("deadlock found")))
+}
+
+func TestTeamPersistenceHandler_ReplayedFinalizeKeepsWaitlistPlace(t *testing.T) {
+	mockTeamDB := new(MockTeamDatabasePort)
+	mockDeadLetterDB := new(MockTeamDeadLetterDatabasePort)
+	handler := application.NewTeamPersistenceHandler(mockTeamDB, mockDeadLetterDB)
+
+	finalizedAt := time.Now().Add(-time.Hour)
+	event := createTeamCreatedEvent("team-finalized:1")
+	event.EventType = port.TeamPersistenceFinalized
+	event.Timestamp = finalizedAt
+	event.Waitlisted = true
+	event.WaitlistedAt = &finalizedAt
+	for i := 0; i <= port.MaxRetryCount; i++ {
+		event.RecordFailure(errors.New("deadlock found"))
+	}
+	event.ResetForReplay()
+
+	mockDeadLetterDB.On("HasProcessedEvent", "team-finalized:1").Return(false, nil)
+	mockTeamDB.On("GetByContestAndName", int64(1), "Team Alpha").Return(nil, exception.ErrTeamNotFound)
+	mockTeamDB.On("Save", mock.MatchedBy(func(team *domain.Team) bool {
+		return team.IsWaitlisted() && team.WaitlistedAt != nil && team.WaitlistedAt.Equal(finalizedAt)
+	})).Return(&domain.Team{TeamID: 7, ContestID: 1, TeamName: "Team Alpha"}, nil)
+	mockTeamDB.On("SaveMember", mock.AnythingOfType("*domain.TeamMember")).Return(&domain.TeamMember{}, nil)
+	mockDeadLetterDB.On("SaveProcessedEvent", mock.Anything).Return(nil)
+
+	err := handler.HandleTeamPersistence(context.Background(), event)
+
+	assert.NoError(t, err)
+	assert.True(t, event.Timestamp.After(finalizedAt))
+	mockTeamDB.AssertExpectations(t)
+}
@@ -0,0 +1,382 @@
+package application_test
+
+import (
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+func (m *MockContestDatabasePort) GetContestsByStatuses(statuses []contestDomain.ContestStatus) ([]*contestDomain.Contest, error) {
+	args := m.Called(statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*contestDomain.Contest), args.Error(1)
+}
+
+// FakeReconcileTeamDatabasePort keeps the persisted teams of a contest in memory.
+// failDelete makes member deletes fail, to test that a repair rolls back.
+type FakeReconcileTeamDatabasePort struct {
+	port.TeamDatabasePort
+
+	teams      []*port.TeamWithMembers
+	nextTeamID int64
+	failDelete bool
+}
+
+func (f *FakeReconcileTeamDatabasePort) GetTeamsByContestWithMembers(contestID int64) ([]*port.TeamWithMembers, error) {
+	return f.teams, nil
+}
+
+func (f *FakeReconcileTeamDatabasePort) SaveWithContext(ctx context.Context, team *domain.Team) (*domain.Team, error) {
+	f.nextTeamID++
+	team.TeamID = f.nextTeamID
+	f.teams = append(f.teams, &port.TeamWithMembers{Team: team})
+	return team, nil
+}
+
+func (f *FakeReconcileTeamDatabasePort) SaveMemberBatchWithContext(ctx context.Context, members []*domain.TeamMember) error {
+	for _, member := range members {
+		if _, err := f.SaveMemberWithContext(ctx, member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FakeReconcileTeamDatabasePort) UpdateWithContext(ctx context.Context, team *domain.Team) error {
+	return nil
+}
+
+func (f *FakeReconcileTeamDatabasePort) SaveMemberWithContext(ctx context.Context, member *domain.TeamMember) (*domain.TeamMember, error) {
+	team := f.team(member.TeamID)
+	team.Members = append(team.Members, member)
+	return member, nil
+}
+
+func (f *FakeReconcileTeamDatabasePort) UpdateMemberWithContext(ctx context.Context, member *domain.TeamMember) error {
+	return nil
+}
+
+func (f *FakeReconcileTeamDatabasePort) DeleteMemberByTeamAndUserWithContext(ctx context.Context, teamID, userID int64) error {
+	if f.failDelete {
+		return errors.New("connection lost")
+	}
+
+	team := f.team(teamID)
+	for i, member := range team.Members {
+		if member.UserID == userID {
+			team.Members = append(team.Members[:i], team.Members[i+1:]...)
+			return nil
+		}
+	}
+	return exception.ErrTeamMemberNotFound
+}
+
+func (f *FakeReconcileTeamDatabasePort) team(teamID int64) *port.TeamWithMembers {
+	for _, team := range f.teams {
+		if team.Team.TeamID == teamID {
+			return team
+		}
+	}
+	return nil
+}
+
+// snapshot deep-copies the persisted teams
+func (f *FakeReconcileTeamDatabasePort) snapshot() []*port.TeamWithMembers {
+	teams := make([]*port.TeamWithMembers, len(f.teams))
+	for i, t := range f.teams {
+		team := *t.Team
+		members := make([]*domain.TeamMember, len(t.Members))
+		for j, m := range t.Members {
+			member := *m
+			members[j] = &member
+		}
+		teams[i] = &port.TeamWithMembers{Team: &team, Members: members}
+	}
+	return teams
+}
+
+// FakeRollbackTransactor restores the fake database when the transaction fails, as MySQL would
+type FakeRollbackTransactor struct {
+	db *FakeReconcileTeamDatabasePort
+}
+
+func (t *FakeRollbackTransactor) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	before := t.db.snapshot()
+	if err := fn(ctx); err != nil {
+		t.db.teams = before
+		return err
+	}
+	return nil
+}
+
+// FakeReconcileTeamRedisPort holds the cached team of a contest and its reconciliation lock
+type FakeReconcileTeamRedisPort struct {
+	port.TeamRedisPort
+
+	team           *port.CachedTeam
+	members        []*port.CachedTeamMember
+	finalizedCount int64
+	locked         bool
+}
+
+func (f *FakeReconcileTeamRedisPort) GetTeam(ctx context.Context, contestID int64) (*port.CachedTeam, error) {
+	if f.team == nil {
+		return nil, exception.ErrTeamMemberNotFound
+	}
+	return f.team, nil
+}
+
+func (f *FakeReconcileTeamRedisPort) GetAllMembers(ctx context.Context, contestID int64) ([]*port.CachedTeamMember, error) {
+	return f.members, nil
+}
+
+func (f *FakeReconcileTeamRedisPort) GetFinalizedTeamCount(ctx context.Context, contestID int64) (int64, error) {
+	return f.finalizedCount, nil
+}
+
+func (f *FakeReconcileTeamRedisPort) SetFinalizedTeamCount(ctx context.Context, contestID int64, count int64) error {
+	f.finalizedCount = count
+	return nil
+}
+
+func (f *FakeReconcileTeamRedisPort) AcquireReconcileLock(ctx context.Context, contestID int64, ttl time.Duration) (bool, error) {
+	if f.locked {
+		return false, nil
+	}
+	f.locked = true
+	return true, nil
+}
+
+func (f *FakeReconcileTeamRedisPort) ReleaseReconcileLock(ctx context.Context, contestID int64) error {
+	f.locked = false
+	return nil
+}
+
+// ==================== Helper Functions ====================
+
+// setupReconciliation caches team "Alpha" (leader 1, members 2 and 4) of contest 1 with teams of three.
+// MySQL holds it as "Alpha Old" with 2 as a second leader and 3 instead of 4.
+func setupReconciliation(modifiedAgo time.Duration) (*application.TeamReconciliationService, *FakeReconcileTeamDatabasePort, *FakeReconcileTeamRedisPort, *MockContestDatabasePort) {
+	contest := &contestDomain.Contest{ContestID: 1, ContestStatus: contestDomain.ContestStatusPending, TotalTeamMember: 3}
+
+	teamName := "Alpha"
+	cache := &FakeReconcileTeamRedisPort{
+		team: &port.CachedTeam{
+			ContestID:    1,
+			TeamID:       1,
+			TeamName:     &teamName,
+			MaxMembers:   3,
+			CurrentCount: 3,
+			LeaderUserID: 1,
+			CreatedAt:    time.Now().Add(-time.Hour),
+			ModifiedAt:   time.Now().Add(-modifiedAgo),
+		},
+		members: []*port.CachedTeamMember{
+			{UserID: 1, ContestID: 1, MemberType: port.TeamMemberTypeLeader},
+			{UserID: 2, ContestID: 1, MemberType: port.TeamMemberTypeMember},
+			{UserID: 4, ContestID: 1, MemberType: port.TeamMemberTypeMember},
+		},
+		finalizedCount: 1,
+	}
+
+	db := &FakeReconcileTeamDatabasePort{nextTeamID: 10}
+	team, _ := db.SaveWithContext(context.Background(), domain.NewTeam(1, "Alpha Old"))
+	_ = db.SaveMemberBatchWithContext(context.Background(), []*domain.TeamMember{
+		domain.NewTeamMember(team.TeamID, 1, domain.TeamMemberTypeLeader),
+		domain.NewTeamMember(team.TeamID, 2, domain.TeamMemberTypeLeader),
+		domain.NewTeamMember(team.TeamID, 3, domain.TeamMemberTypeMember),
+	})
+
+	mockContestDB := new(MockContestDatabasePort)
+	mockContestDB.On("GetContestById", int64(1)).Return(contest, nil)
+	mockContestDB.On("GetContestsByStatuses", mock.Anything).Return([]*contestDomain.Contest{contest}, nil)
+
+	service := application.NewTeamReconciliationService(db, cache, mockContestDB, nil, nil)
+	service.SetTransactionManager(&FakeRollbackTransactor{db: db})
+
+	return service, db, cache, mockContestDB
+}
+
+func driftTypes(report *domain.TeamReconciliationReport) []domain.TeamDriftType {
+	var types []domain.TeamDriftType
+	for _, drift := range report.Drifts {
+		types = append(types, drift.Type)
+	}
+	return types
+}
+
+func memberTypes(team *port.TeamWithMembers) map[int64]domain.TeamMemberType {
+	types := make(map[int64]domain.TeamMemberType, len(team.Members))
+	for _, member := range team.Members {
+		types[member.UserID] = member.MemberType
+	}
+	return types
+}
+
+// ==================== CheckContest Tests ====================
+
+func TestTeamReconciliationService_CheckContest_ReportsDrift(t *testing.T) {
+	service, db, _, _ := setupReconciliation(time.Hour)
+
+	report, err := service.CheckContest(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.True(t, report.CacheTeamFound)
+	assert.ElementsMatch(t, []domain.TeamDriftType{
+		domain.TeamDriftNameMismatch,
+		domain.TeamDriftMemberTypeMismatch,
+		domain.TeamDriftMemberMissingInDB,
+		domain.TeamDriftMemberMissingInCache,
+	}, driftTypes(report))
+	assert.False(t, report.Repaired)
+
+	// Checking changes nothing
+	assert.Equal(t, "Alpha Old", db.teams[0].Team.TeamName)
+	assert.Len(t, db.teams[0].Members, 3)
+}
+
+func TestTeamReconciliationService_CheckContest_FinalizedCountDrift(t *testing.T) {
+	service, _, cache, _ := setupReconciliation(time.Hour)
+	cache.finalizedCount = 2
+
+	report, err := service.CheckContest(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Contains(t, driftTypes(report), domain.TeamDriftFinalizedCount)
+}
+
+// ==================== ReconcileContest Tests ====================
+
+func TestTeamReconciliationService_ReconcileContest_RepairsDBFromCache(t *testing.T) {
+	service, db, cache, _ := setupReconciliation(time.Hour)
+
+	report, err := service.ReconcileContest(context.Background(), 1, domain.ReconcileCacheToDB)
+
+	assert.NoError(t, err)
+	assert.True(t, report.Repaired)
+	assert.Equal(t, domain.ReconcileCacheToDB, *report.Direction)
+
+	team := db.teams[0]
+	assert.Equal(t, "Alpha", team.Team.TeamName)
+	assert.Equal(t, map[int64]domain.TeamMemberType{
+		1: domain.TeamMemberTypeLeader,
+		2: domain.TeamMemberTypeMember,
+		4: domain.TeamMemberTypeMember,
+	}, memberTypes(team))
+	assert.Equal(t, int64(1), cache.finalizedCount)
+	assert.False(t, cache.locked)
+
+	// Nothing is left to repair
+	report, err = service.CheckContest(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.False(t, report.HasDrift())
+}
+
+func TestTeamReconciliationService_ReconcileContest_CreatesTeamMissingInDB(t *testing.T) {
+	service, db, cache, _ := setupReconciliation(time.Hour)
+	db.teams = nil
+	cache.finalizedCount = 0
+
+	report, err := service.ReconcileContest(context.Background(), 1, domain.ReconcileCacheToDB)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.TeamDriftType{domain.TeamDriftMissingInDB}, driftTypes(report))
+	assert.Len(t, db.teams, 1)
+	assert.Equal(t, "Alpha", db.teams[0].Team.TeamName)
+	assert.Equal(t, map[int64]domain.TeamMemberType{
+		1: domain.TeamMemberTypeLeader,
+		2: domain.TeamMemberTypeMember,
+		4: domain.TeamMemberTypeMember,
+	}, memberTypes(db.teams[0]))
+	assert.Equal(t, int64(1), cache.finalizedCount)
+}
+
+func TestTeamReconciliationService_ReconcileContest_FailedRepairRollsBack(t *testing.T) {
+	service, db, cache, _ := setupReconciliation(time.Hour)
+	db.failDelete = true
+
+	report, err := service.ReconcileContest(context.Background(), 1, domain.ReconcileCacheToDB)
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+
+	// The writes before the failed delete are rolled back with it
+	team := db.teams[0]
+	assert.Equal(t, "Alpha Old", team.Team.TeamName)
+	assert.Equal(t, map[int64]domain.TeamMemberType{
+		1: domain.TeamMemberTypeLeader,
+		2: domain.TeamMemberTypeLeader,
+		3: domain.TeamMemberTypeMember,
+	}, memberTypes(team))
+	assert.False(t, cache.locked)
+}
+
+func TestTeamReconciliationService_ReconcileContest_Busy(t *testing.T) {
+	service, db, cache, _ := setupReconciliation(time.Hour)
+	cache.locked = true
+
+	report, err := service.ReconcileContest(context.Background(), 1, domain.ReconcileCacheToDB)
+
+	assert.ErrorIs(t, err, exception.ErrReconciliationBusy)
+	assert.Nil(t, report)
+	assert.Equal(t, "Alpha Old", db.teams[0].Team.TeamName)
+	assert.True(t, cache.locked)
+}
+
+func TestTeamReconciliationService_ReconcileContest_InvalidDirection(t *testing.T) {
+	service, _, _, mockContestDB := setupReconciliation(time.Hour)
+
+	_, err := service.ReconcileContest(context.Background(), 1, domain.ReconcileDirection("SIDEWAYS"))
+
+	assert.ErrorIs(t, err, exception.ErrInvalidReconcileDirection)
+	mockContestDB.AssertNotCalled(t, "GetContestById", mock.Anything)
+}
+
+// ==================== RunScheduledReconciliation Tests ====================
+
+func TestTeamReconciliationService_RunScheduledReconciliation_RepairsSettledTeam(t *testing.T) {
+	service, db, _, _ := setupReconciliation(10 * time.Minute)
+
+	service.RunScheduledReconciliation(context.Background())
+
+	assert.Equal(t, "Alpha", db.teams[0].Team.TeamName)
+	assert.Len(t, db.teams[0].Members, 3)
+}
+
+func TestTeamReconciliationService_RunScheduledReconciliation_SkipsRecentlyModifiedTeam(t *testing.T) {
+	// Created an hour ago, but a member joined a minute ago and its event may still be in flight
+	service, db, _, _ := setupReconciliation(time.Minute)
+
+	service.RunScheduledReconciliation(context.Background())
+
+	assert.Equal(t, "Alpha Old", db.teams[0].Team.TeamName)
+	assert.Equal(t, map[int64]domain.TeamMemberType{
+		1: domain.TeamMemberTypeLeader,
+		2: domain.TeamMemberTypeLeader,
+		3: domain.TeamMemberTypeMember,
+	}, memberTypes(db.teams[0]))
+}
+
+func TestTeamReconciliationService_RunScheduledReconciliation_ReportsActiveContestOnly(t *testing.T) {
+	service, db, _, mockContestDB := setupReconciliation(time.Hour)
+	active := &contestDomain.Contest{ContestID: 1, ContestStatus: contestDomain.ContestStatusActive, TotalTeamMember: 3}
+	mockContestDB.ExpectedCalls = nil
+	mockContestDB.On("GetContestsByStatuses", mock.Anything).Return([]*contestDomain.Contest{active}, nil)
+
+	service.RunScheduledReconciliation(context.Background())
+
+	assert.Equal(t, "Alpha Old", db.teams[0].Team.TeamName)
+}
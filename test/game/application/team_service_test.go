@@ -0,0 +1,356 @@
+package application_test
+
+import (
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+func (m *MockContestDatabasePort) GetContestByIdForUpdate(ctx context.Context, contestId int64) (*contestDomain.Contest, error) {
+	args := m.Called(ctx, contestId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*contestDomain.Contest), args.Error(1)
+}
+
+// FakeWaitlistTeamDatabasePort keeps the teams of a contest in memory and returns the waitlist
+// in waitlist order, as the database adapter does
+type FakeWaitlistTeamDatabasePort struct {
+	port.TeamDatabasePort
+
+	mu    sync.Mutex
+	teams []*domain.Team
+}
+
+func (f *FakeWaitlistTeamDatabasePort) CountRegisteredByContestIDWithContext(ctx context.Context, contestID int64) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	count := 0
+	for _, team := range f.teams {
+		if team.ContestID == contestID && !team.IsWaitlisted() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (f *FakeWaitlistTeamDatabasePort) GetWaitlistedByContestIDWithContext(ctx context.Context, contestID int64) ([]*domain.Team, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var waitlist []*domain.Team
+	for _, team := range f.teams {
+		if team.ContestID == contestID && team.IsWaitlisted() {
+			copied := *team
+			waitlist = append(waitlist, &copied)
+		}
+	}
+	sort.Slice(waitlist, func(i, j int) bool {
+		return waitlist[i].WaitlistedAt.Before(*waitlist[j].WaitlistedAt)
+	})
+	return waitlist, nil
+}
+
+func (f *FakeWaitlistTeamDatabasePort) UpdateWithContext(ctx context.Context, team *domain.Team) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, saved := range f.teams {
+		if saved.TeamID == team.TeamID {
+			f.teams[i] = team
+		}
+	}
+	return nil
+}
+
+func (f *FakeWaitlistTeamDatabasePort) registered() []int64 {
+	var teamIDs []int64
+	for _, team := range f.teams {
+		if !team.IsWaitlisted() {
+			teamIDs = append(teamIDs, team.TeamID)
+		}
+	}
+	return teamIDs
+}
+
+// FakeTeamMembersDatabasePort adds the members of each team to the waitlist fake
+type FakeTeamMembersDatabasePort struct {
+	*FakeWaitlistTeamDatabasePort
+
+	members map[int64][]*domain.TeamMember
+}
+
+func (f *FakeTeamMembersDatabasePort) GetTeamsByContestWithMembers(contestID int64) ([]*port.TeamWithMembers, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var teams []*port.TeamWithMembers
+	for _, team := range f.teams {
+		if team.ContestID == contestID {
+			teams = append(teams, &port.TeamWithMembers{Team: team, Members: f.members[team.TeamID]})
+		}
+	}
+	return teams, nil
+}
+
+func (f *FakeTeamMembersDatabasePort) DeleteAllMembersByTeamIDWithContext(ctx context.Context, teamID int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.members, teamID)
+	return nil
+}
+
+func (f *FakeTeamMembersDatabasePort) DeleteWithContext(ctx context.Context, teamID int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, team := range f.teams {
+		if team.TeamID == teamID {
+			f.teams = append(f.teams[:i], f.teams[i+1:]...)
+			return nil
+		}
+	}
+	return exception.ErrTeamNotFound
+}
+
+func (f *FakeTeamMembersDatabasePort) GetUserTeamInContest(contestID, userID int64) (*domain.Team, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, team := range f.teams {
+		for _, member := range f.members[team.TeamID] {
+			if team.ContestID == contestID && member.UserID == userID {
+				return team, nil
+			}
+		}
+	}
+	return nil, exception.ErrTeamNotFound
+}
+
+func (f *FakeTeamMembersDatabasePort) GetMembersByTeamID(teamID int64) ([]*domain.TeamMember, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.members[teamID], nil
+}
+
+func (f *FakeTeamMembersDatabasePort) SaveTeamsWithMembersWithContext(ctx context.Context, teams []*port.TeamWithMembers) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, team := range teams {
+		team.Team.TeamID = int64(len(f.teams) + 1)
+		for _, member := range team.Members {
+			member.TeamID = team.Team.TeamID
+		}
+		f.teams = append(f.teams, team.Team)
+		f.members[team.Team.TeamID] = team.Members
+	}
+	return nil
+}
+
+// FakeContestRowLock serializes transactions, as the contest row lock taken inside them does
+type FakeContestRowLock struct {
+	mu sync.Mutex
+}
+
+func (l *FakeContestRowLock) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return fn(ctx)
+}
+
+// ==================== Helper Functions ====================
+
+// setupWaitlistTeamService runs contest 1 with room for maxTeamCount teams; registered teams get IDs from 1,
+// waitlisted teams get IDs from 101 and are waitlisted in the order given by waitlistOffsets
+func setupWaitlistTeamService(maxTeamCount, registered int, waitlistOffsets ...time.Duration) (*application.TeamService, *FakeWaitlistTeamDatabasePort, *contestDomain.Contest) {
+	contest := &contestDomain.Contest{ContestID: 1, ContestStatus: contestDomain.ContestStatusPending, MaxTeamCount: maxTeamCount}
+
+	teamRepo := &FakeWaitlistTeamDatabasePort{}
+	for i := 0; i < registered; i++ {
+		teamRepo.teams = append(teamRepo.teams, &domain.Team{TeamID: int64(i + 1), ContestID: 1, Status: domain.TeamStatusRegistered})
+	}
+	base := time.Now().Add(-time.Hour)
+	for i, offset := range waitlistOffsets {
+		team := &domain.Team{TeamID: int64(101 + i), ContestID: 1}
+		team.Waitlist(base.Add(offset))
+		teamRepo.teams = append(teamRepo.teams, team)
+	}
+
+	mockContestDB := new(MockContestDatabasePort)
+	mockContestDB.On("GetContestByIdForUpdate", mock.Anything, int64(1)).Return(contest, nil)
+
+	service := application.NewTeamService(teamRepo, nil, mockContestDB, nil, nil, nil, nil)
+	service.SetTransactionManager(&FakeContestRowLock{})
+
+	return service, teamRepo, contest
+}
+
+// ==================== PromoteFromWaitlist Tests ====================
+
+func TestTeamService_PromoteFromWaitlist_InWaitlistOrder(t *testing.T) {
+	// Team 102 was waitlisted first, then 103, then 101
+	service, teamRepo, contest := setupWaitlistTeamService(4, 3, 2*time.Minute, 0, 3*time.Minute)
+
+	promoted, err := service.PromoteFromWaitlist(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.Len(t, promoted, 1)
+	assert.Equal(t, int64(102), promoted[0].TeamID)
+	assert.ElementsMatch(t, []int64{1, 2, 3, 102}, teamRepo.registered())
+}
+
+func TestTeamService_PromoteFromWaitlist_FillsEveryFreeSlot(t *testing.T) {
+	service, teamRepo, contest := setupWaitlistTeamService(4, 2, 0, time.Minute, 2*time.Minute)
+
+	promoted, err := service.PromoteFromWaitlist(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.Len(t, promoted, 2)
+	assert.Equal(t, int64(101), promoted[0].TeamID)
+	assert.Equal(t, int64(102), promoted[1].TeamID)
+	assert.ElementsMatch(t, []int64{1, 2, 101, 102}, teamRepo.registered())
+}
+
+func TestTeamService_PromoteFromWaitlist_ContestFull(t *testing.T) {
+	service, teamRepo, contest := setupWaitlistTeamService(3, 3, 0)
+
+	promoted, err := service.PromoteFromWaitlist(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.Empty(t, promoted)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, teamRepo.registered())
+}
+
+func TestTeamService_PromoteFromWaitlist_ConcurrentWithdrawalsStayUnderCap(t *testing.T) {
+	// One slot is free; every withdrawal of the same moment tries to fill it
+	service, teamRepo, contest := setupWaitlistTeamService(4, 3, 0, time.Minute, 2*time.Minute, 3*time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.PromoteFromWaitlist(context.Background(), contest)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []int64{1, 2, 3, 101}, teamRepo.registered())
+}
+
+func TestTeamService_PromoteFromWaitlist_UncappedContest(t *testing.T) {
+	service, teamRepo, contest := setupWaitlistTeamService(0, 3, 0)
+
+	promoted, err := service.PromoteFromWaitlist(context.Background(), contest)
+
+	assert.NoError(t, err)
+	assert.Empty(t, promoted)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, teamRepo.registered())
+}
+
+// ==================== CreateDraftedTeams Tests ====================
+
+// setupDraftedTeamService runs contest 1 with teams of three and DB team 1 (players 11-13) already registered
+func setupDraftedTeamService(maxTeamCount int) (*application.TeamService, *FakeTeamMembersDatabasePort, *FakeRosterTeamRedisPort, *contestDomain.Contest) {
+	contest := &contestDomain.Contest{ContestID: 1, ContestStatus: contestDomain.ContestStatusPending, TotalTeamMember: 3, MaxTeamCount: maxTeamCount}
+
+	teamRepo := &FakeTeamMembersDatabasePort{
+		FakeWaitlistTeamDatabasePort: &FakeWaitlistTeamDatabasePort{teams: []*domain.Team{
+			{TeamID: 1, ContestID: 1, TeamName: "Registered", Status: domain.TeamStatusRegistered},
+		}},
+		members: map[int64][]*domain.TeamMember{
+			1: {
+				domain.NewTeamMemberAsLeader(1, 11),
+				domain.NewTeamMemberAsMember(1, 12),
+				domain.NewTeamMemberAsMember(1, 13),
+			},
+		},
+	}
+	cache := &FakeRosterTeamRedisPort{}
+
+	mockContestDB := new(MockContestDatabasePort)
+	mockContestDB.On("GetContestById", int64(1)).Return(contest, nil)
+	mockContestDB.On("GetContestByIdForUpdate", mock.Anything, int64(1)).Return(contest, nil)
+
+	service := application.NewTeamService(teamRepo, cache, mockContestDB, nil, nil, nil, nil)
+	service.SetTransactionManager(&FakeContestRowLock{})
+
+	return service, teamRepo, cache, contest
+}
+
+func draftedTeams() []*domain.DraftedTeam {
+	return []*domain.DraftedTeam{
+		{CaptainUserID: 21, MemberUserIDs: []int64{22, 23}, TeamName: "Draft A"},
+		{CaptainUserID: 31, MemberUserIDs: []int64{32, 33}, TeamName: "Draft B"},
+	}
+}
+
+func TestTeamService_CreateDraftedTeams_WaitlistsBeyondMaxTeamCount(t *testing.T) {
+	service, teamRepo, cache, _ := setupDraftedTeamService(2)
+
+	teams, err := service.CreateDraftedTeams(context.Background(), 1, draftedTeams())
+
+	assert.NoError(t, err)
+	assert.Len(t, teams, 2)
+	assert.False(t, teams[0].IsWaitlisted())
+	assert.True(t, teams[1].IsWaitlisted())
+	assert.ElementsMatch(t, []int64{1, teams[0].TeamID}, teamRepo.registered())
+	assert.Equal(t, int64(2), cache.finalizedCount)
+}
+
+func TestTeamService_CreateDraftedTeams_RetryReturnsExistingTeams(t *testing.T) {
+	service, teamRepo, cache, _ := setupDraftedTeamService(0)
+
+	created, err := service.CreateDraftedTeams(context.Background(), 1, draftedTeams())
+	assert.NoError(t, err)
+
+	retried, err := service.CreateDraftedTeams(context.Background(), 1, draftedTeams())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{created[0].TeamID, created[1].TeamID}, []int64{retried[0].TeamID, retried[1].TeamID})
+	assert.Len(t, teamRepo.teams, 3)
+	assert.Equal(t, int64(2), cache.finalizedCount)
+}
+
+func TestTeamService_CreateDraftedTeams_PlayerAlreadyInTeam(t *testing.T) {
+	service, teamRepo, cache, _ := setupDraftedTeamService(0)
+	drafted := draftedTeams()
+	drafted[1].MemberUserIDs = []int64{32, 12}
+
+	teams, err := service.CreateDraftedTeams(context.Background(), 1, drafted)
+
+	assert.ErrorIs(t, err, exception.ErrPlayerAlreadyInTeam)
+	assert.Nil(t, teams)
+	assert.Len(t, teamRepo.teams, 1)
+	assert.Zero(t, cache.finalizedCount)
+}
+
+func TestTeamService_CreateDraftedTeams_RosterLocked(t *testing.T) {
+	service, teamRepo, _, contest := setupDraftedTeamService(0)
+	lockedAt := time.Now().Add(-time.Hour)
+	contest.RosterLockedAt = &lockedAt
+
+	teams, err := service.CreateDraftedTeams(context.Background(), 1, draftedTeams())
+
+	assert.ErrorIs(t, err, exception.ErrRosterLocked)
+	assert.Nil(t, teams)
+	assert.Len(t, teamRepo.teams, 1)
+}
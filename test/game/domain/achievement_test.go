@@ -0,0 +1,108 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== EvaluateAchievements Tests ====================
+
+func TestEvaluateAchievements(t *testing.T) {
+	tests := []struct {
+		name     string
+		trigger  domain.AchievementTrigger
+		facts    domain.AchievementFacts
+		expected []domain.BadgeCode
+	}{
+		// Flawless
+		{"13-0 win", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{WonMatch: true, WinnerScore: 13, LoserScore: 0},
+			[]domain.BadgeCode{domain.BadgePerfect13To0}},
+		{"13-0 loss", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{WonMatch: false, WinnerScore: 13, LoserScore: 0},
+			nil},
+		{"13-1 win", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{WonMatch: true, WinnerScore: 13, LoserScore: 1},
+			nil},
+		{"14-0 is not a regulation win", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{WonMatch: true, WinnerScore: 14, LoserScore: 0},
+			nil},
+
+		// Thirty Bomb
+		{"30 kills", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{Kills: 30},
+			[]domain.BadgeCode{domain.BadgeKills30}},
+		{"29 kills", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{Kills: 29},
+			nil},
+		{"30 kills in a loss", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{Kills: 34, WonMatch: false, WinnerScore: 13, LoserScore: 11},
+			[]domain.BadgeCode{domain.BadgeKills30}},
+
+		// Champion
+		{"Tournament final winner", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{WonMatch: true, WonTournamentFinal: true, WinnerScore: 13, LoserScore: 9},
+			[]domain.BadgeCode{domain.BadgeFirstTournamentWin}},
+		{"Match winner before the final", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{WonMatch: true, WinnerScore: 13, LoserScore: 9},
+			nil},
+
+		// Squad Up
+		{"Team of 1", domain.AchievementTriggerTeamFinalized,
+			domain.AchievementFacts{TeamSize: 1},
+			nil},
+		{"Team of 2", domain.AchievementTriggerTeamFinalized,
+			domain.AchievementFacts{TeamSize: 2},
+			[]domain.BadgeCode{domain.BadgeSquadUp}},
+
+		// First Contest
+		{"Contest joined", domain.AchievementTriggerContestJoined,
+			domain.AchievementFacts{},
+			[]domain.BadgeCode{domain.BadgeFirstContest}},
+
+		// Regular
+		{"10 contests played", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{ContestsPlayed: 10},
+			[]domain.BadgeCode{domain.BadgeContestsPlayed10}},
+		{"9 contests played", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{ContestsPlayed: 9},
+			nil},
+
+		// Rules only fire on their own trigger
+		{"Game facts on a team trigger", domain.AchievementTriggerTeamFinalized,
+			domain.AchievementFacts{Kills: 30, WonTournamentFinal: true},
+			nil},
+		{"Team facts on a game trigger", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{TeamSize: 5},
+			nil},
+
+		// Several badges come back in catalog order
+		{"13-0 final with 30 kills", domain.AchievementTriggerGameFinished,
+			domain.AchievementFacts{Kills: 30, WonMatch: true, WinnerScore: 13, LoserScore: 0, WonTournamentFinal: true},
+			[]domain.BadgeCode{domain.BadgeKills30, domain.BadgePerfect13To0, domain.BadgeFirstTournamentWin}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			facts := tt.facts
+			facts.UserID = 10
+
+			codes := domain.EvaluateAchievements(tt.trigger, &facts)
+
+			assert.Equal(t, tt.expected, codes)
+		})
+	}
+}
+
+// ==================== FindBadge Tests ====================
+
+func TestFindBadge(t *testing.T) {
+	badge, ok := domain.FindBadge(domain.BadgePerfect13To0)
+	assert.True(t, ok)
+	assert.Equal(t, "Flawless", badge.Name)
+
+	_, ok = domain.FindBadge("RETIRED_BADGE")
+	assert.False(t, ok)
+}
@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Helper Functions ====================
+
+func renderCalendarEvent(summary, description string) string {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := domain.CalendarEvent{
+		UID:          "game-1@gamers",
+		Summary:      summary,
+		Description:  description,
+		Start:        start,
+		End:          start.Add(time.Hour),
+		LastModified: start,
+	}
+	return string(domain.RenderCalendar("GAMERS", []domain.CalendarEvent{event}, start))
+}
+
+// calendarLine returns the unfolded content line starting with prefix
+func calendarLine(t *testing.T, calendar, prefix string) string {
+	unfolded := strings.ReplaceAll(calendar, "\r\n ", "")
+	for _, line := range strings.Split(unfolded, "\r\n") {
+		if strings.HasPrefix(line, prefix) {
+			return line
+		}
+	}
+	t.Fatalf("no %q line in calendar", prefix)
+	return ""
+}
+
+// ==================== Line Folding Tests ====================
+
+func TestRenderCalendar_FoldsLinesAt75Octets(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+	}{
+		{"ASCII", strings.Repeat("Grand final between Team Alpha and Team Bravo. ", 5)},
+		{"Korean", strings.Repeat("결승전 팀 알파 대 팀 브라보 경기입니다 ", 10)},
+		{"Mixed", strings.Repeat("Round 1 라운드 ", 20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := renderCalendarEvent("Grand Final", tt.description)
+
+			for _, line := range strings.Split(strings.TrimSuffix(calendar, "\r\n"), "\r\n") {
+				assert.LessOrEqual(t, len(line), 75, "line %q exceeds 75 octets", line)
+				assert.True(t, utf8.ValidString(line), "line %q splits a multi-byte character", line)
+			}
+			assert.Equal(t, "DESCRIPTION:"+tt.description, calendarLine(t, calendar, "DESCRIPTION:"))
+		})
+	}
+}
+
+func TestRenderCalendar_ContinuationLinesStartWithSpace(t *testing.T) {
+	calendar := renderCalendarEvent("Grand Final", strings.Repeat("가", 60))
+
+	lines := strings.Split(strings.TrimSuffix(calendar, "\r\n"), "\r\n")
+	folded := 0
+	for i, line := range lines {
+		if strings.HasPrefix(line, " ") {
+			folded++
+			assert.True(t, strings.HasPrefix(lines[i-1], "DESCRIPTION:") || strings.HasPrefix(lines[i-1], " "))
+		}
+	}
+	assert.Greater(t, folded, 0)
+}
+
+func TestRenderCalendar_ShortLinesAreNotFolded(t *testing.T) {
+	calendar := renderCalendarEvent("Grand Final", "Best of three")
+
+	assert.NotContains(t, calendar, "\r\n ")
+	assert.Contains(t, calendar, "\r\nDESCRIPTION:Best of three\r\n")
+}
+
+// ==================== TEXT Escaping Tests ====================
+
+func TestRenderCalendar_EscapesText(t *testing.T) {
+	tests := []struct {
+		name     string
+		summary  string
+		expected string
+	}{
+		{"Comma", "Alpha, Bravo", `SUMMARY:Alpha\, Bravo`},
+		{"Semicolon", "Alpha; Bravo", `SUMMARY:Alpha\; Bravo`},
+		{"Backslash", `Alpha\Bravo`, `SUMMARY:Alpha\\Bravo`},
+		{"LF", "Alpha\nBravo", `SUMMARY:Alpha\nBravo`},
+		{"CRLF", "Alpha\r\nBravo", `SUMMARY:Alpha\nBravo`},
+		{"Escaped backslash before comma", `Alpha\,Bravo`, `SUMMARY:Alpha\\\,Bravo`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := renderCalendarEvent(tt.summary, "")
+
+			assert.Equal(t, tt.expected, calendarLine(t, calendar, "SUMMARY:"))
+		})
+	}
+}
+
+func TestRenderCalendar_EscapesCalendarName(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	calendar := string(domain.RenderCalendar("GAMERS; Season 1, Korea", nil, start))
+
+	assert.Equal(t, `X-WR-CALNAME:GAMERS\; Season 1\, Korea`, calendarLine(t, calendar, "X-WR-CALNAME:"))
+}
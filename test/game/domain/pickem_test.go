@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== CanPredict Tests ====================
+
+func TestGame_CanPredict(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) *time.Time {
+		scheduled := now.Add(offset)
+		return &scheduled
+	}
+	round, match := 1, 1
+
+	tests := []struct {
+		name      string
+		status    domain.GameStatus
+		detection domain.DetectionStatus
+		scheduled *time.Time
+		bracket   bool
+		expected  error
+	}{
+		{"Pending without a schedule", domain.GameStatusPending, domain.DetectionStatusNone, nil, true, nil},
+		{"Scheduled later", domain.GameStatusPending, domain.DetectionStatusNone, at(time.Minute), true, nil},
+		{"Scheduled start reached", domain.GameStatusPending, domain.DetectionStatusNone, at(0), true, exception.ErrPickemLocked},
+		{"Scheduled start passed", domain.GameStatusPending, domain.DetectionStatusNone, at(-time.Minute), true, exception.ErrPickemLocked},
+		{"Activated before its schedule", domain.GameStatusActive, domain.DetectionStatusNone, at(time.Hour), true, exception.ErrPickemLocked},
+		{"Detection started", domain.GameStatusPending, domain.DetectionStatusDetecting, at(time.Hour), true, exception.ErrPickemLocked},
+		{"Manual result", domain.GameStatusPending, domain.DetectionStatusManual, nil, true, exception.ErrPickemLocked},
+		{"Finished", domain.GameStatusFinished, domain.DetectionStatusDetected, nil, true, exception.ErrPickemLocked},
+		{"Cancelled", domain.GameStatusCancelled, domain.DetectionStatusNone, nil, true, exception.ErrPickemLocked},
+		{"Not a bracket game", domain.GameStatusPending, domain.DetectionStatusNone, nil, false, exception.ErrPickemNotAvailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := &domain.Game{
+				GameID:             1,
+				ContestID:          1,
+				GameStatus:         tt.status,
+				DetectionStatus:    tt.detection,
+				ScheduledStartTime: tt.scheduled,
+			}
+			if tt.bracket {
+				game.Round, game.MatchNumber = &round, &match
+			}
+
+			err := game.CanPredict(now)
+
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
+
+// ==================== PickemPoints Tests ====================
+
+func TestPickemPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		round    int
+		expected int
+	}{
+		{"Unset round counts as the first", 0, 10},
+		{"First round", 1, 10},
+		{"Semifinal of an 8 team bracket", 2, 20},
+		{"Final of an 8 team bracket", 3, 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, domain.PickemPoints(tt.round))
+		})
+	}
+}
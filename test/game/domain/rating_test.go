@@ -0,0 +1,148 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Update Tests ====================
+
+// Glickman, "Example of the Glicko-2 system": a 1500/200/0.06 player beats a 1400/30 player
+// and loses to a 1550/100 and a 1700/300 player in one rating period, with tau 0.5
+func TestGlicko2_UpdatePeriod_GlickmanExample(t *testing.T) {
+	player := domain.Glicko2{Rating: 1500, Deviation: 200, Volatility: 0.06}
+
+	after := player.UpdatePeriod([]domain.GlickoResult{
+		{Opponent: domain.Glicko2{Rating: 1400, Deviation: 30, Volatility: 0.06}, Score: 1},
+		{Opponent: domain.Glicko2{Rating: 1550, Deviation: 100, Volatility: 0.06}, Score: 0},
+		{Opponent: domain.Glicko2{Rating: 1700, Deviation: 300, Volatility: 0.06}, Score: 0},
+	})
+
+	assert.InDelta(t, 1464.06, after.Rating, 0.01)
+	assert.InDelta(t, 151.52, after.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, after.Volatility, 0.00001)
+}
+
+func TestGlicko2_Update_SingleGame(t *testing.T) {
+	even := domain.Glicko2{Rating: 1500, Deviation: 200, Volatility: 0.06}
+
+	tests := []struct {
+		name     string
+		opponent domain.Glicko2
+		score    float64
+		gains    bool
+	}{
+		{"Win against an equal opponent", even, 1, true},
+		{"Loss against an equal opponent", even, 0, false},
+		{"Upset win against a stronger opponent", domain.Glicko2{Rating: 1900, Deviation: 50, Volatility: 0.06}, 1, true},
+		{"Loss against a weaker opponent", domain.Glicko2{Rating: 1100, Deviation: 50, Volatility: 0.06}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := even.Update(tt.opponent, tt.score)
+
+			assert.Equal(t, tt.gains, after.Rating > even.Rating)
+			assert.Less(t, after.Deviation, even.Deviation)
+			assert.Greater(t, after.Volatility, 0.0)
+			assert.Equal(t, even.UpdatePeriod([]domain.GlickoResult{{Opponent: tt.opponent, Score: tt.score}}), after)
+		})
+	}
+}
+
+func TestGlicko2_Update_EqualOpponentsMoveSymmetrically(t *testing.T) {
+	rating := domain.NewGlicko2()
+
+	won := rating.Update(rating, 1)
+	lost := rating.Update(rating, 0)
+
+	assert.InDelta(t, won.Rating-domain.InitialRating, domain.InitialRating-lost.Rating, 0.000001)
+	assert.InDelta(t, won.Deviation, lost.Deviation, 0.000001)
+}
+
+func TestGlicko2_Update_UpsetRaisesVolatility(t *testing.T) {
+	// A settled rating losing far below expectations takes the branch where delta^2 exceeds phi^2 + v
+	settled := domain.Glicko2{Rating: 2200, Deviation: 30, Volatility: 0.06}
+
+	after := settled.Update(domain.Glicko2{Rating: 1000, Deviation: 30, Volatility: 0.06}, 0)
+
+	assert.Greater(t, after.Volatility, settled.Volatility)
+	assert.False(t, math.IsNaN(after.Rating))
+	assert.Less(t, after.Rating, settled.Rating)
+}
+
+func TestGlicko2_UpdatePeriod_WithoutGamesGrowsDeviation(t *testing.T) {
+	player := domain.Glicko2{Rating: 1500, Deviation: 200, Volatility: 0.06}
+
+	after := player.UpdatePeriod(nil)
+
+	phi := 200 / 173.7178
+	assert.Equal(t, player.Rating, after.Rating)
+	assert.InDelta(t, math.Sqrt(phi*phi+0.06*0.06)*173.7178, after.Deviation, 0.000001)
+	assert.Equal(t, player.Volatility, after.Volatility)
+}
+
+// ==================== Decay Tests ====================
+
+func TestGlicko2_Decay(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(ago time.Duration) *time.Time {
+		playedAt := now.Add(-ago)
+		return &playedAt
+	}
+	decayed := func(deviation, periods float64) float64 {
+		phi := deviation / 173.7178
+		return math.Sqrt(phi*phi+periods*0.06*0.06) * 173.7178
+	}
+
+	tests := []struct {
+		name         string
+		deviation    float64
+		lastPlayedAt *time.Time
+		expected     float64
+	}{
+		{"Never played", 50, nil, 50},
+		{"Played in the future", 50, at(-time.Hour), 50},
+		{"Within the first period", 50, at(6 * 24 * time.Hour), 50},
+		{"One period", 50, at(domain.RatingPeriod), decayed(50, 1)},
+		{"Partial periods round down", 50, at(2*domain.RatingPeriod + 3*24*time.Hour), decayed(50, 2)},
+		{"Capped at the initial deviation", 300, at(10000 * domain.RatingPeriod), domain.InitialDeviation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rating := domain.Glicko2{Rating: 1800, Deviation: tt.deviation, Volatility: 0.06}
+
+			after := rating.Decay(tt.lastPlayedAt, now)
+
+			assert.InDelta(t, tt.expected, after.Deviation, 0.000001)
+			assert.Equal(t, rating.Rating, after.Rating)
+			assert.Equal(t, rating.Volatility, after.Volatility)
+		})
+	}
+}
+
+func TestPlayerRating_Current_LowersConservativeRating(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rating := domain.NewPlayerRating(10)
+	rating.RecordGame(domain.Glicko2{Rating: 1800, Deviation: 60, Volatility: 0.06}, true, now.Add(-10*domain.RatingPeriod))
+
+	current := rating.Current(now)
+
+	assert.Less(t, current.ConservativeRating(), rating.Glicko().ConservativeRating())
+	assert.Equal(t, 1800.0-2*current.Deviation, current.ConservativeRating())
+}
+
+// ==================== Conservative Rating Tests ====================
+
+func TestGlicko2_ConservativeRating(t *testing.T) {
+	newcomer := domain.NewGlicko2()
+
+	assert.Equal(t, 800.0, newcomer.ConservativeRating())
+	assert.True(t, newcomer.IsProvisional())
+	assert.False(t, domain.Glicko2{Rating: 1500, Deviation: 100, Volatility: 0.06}.IsProvisional())
+}
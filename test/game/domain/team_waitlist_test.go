@@ -0,0 +1,47 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Waitlist Tests ====================
+
+func TestTeam_Waitlist(t *testing.T) {
+	t.Run("new team holds a contest slot", func(t *testing.T) {
+		team := domain.NewTeam(1, "Team A")
+
+		assert.Equal(t, domain.TeamStatusRegistered, team.Status)
+		assert.False(t, team.IsWaitlisted())
+		assert.Nil(t, team.WaitlistedAt)
+	})
+
+	t.Run("waitlists team with its waitlist time", func(t *testing.T) {
+		now := time.Now()
+		team := domain.NewTeam(1, "Team A")
+
+		team.Waitlist(now)
+
+		assert.True(t, team.IsWaitlisted())
+		assert.Equal(t, now, *team.WaitlistedAt)
+	})
+
+	t.Run("promotes waitlisted team", func(t *testing.T) {
+		team := domain.NewTeam(1, "Team A")
+		team.Waitlist(time.Now())
+
+		assert.NoError(t, team.PromoteFromWaitlist())
+		assert.Equal(t, domain.TeamStatusRegistered, team.Status)
+		assert.Nil(t, team.WaitlistedAt)
+	})
+
+	t.Run("fails to promote registered team", func(t *testing.T) {
+		team := domain.NewTeam(1, "Team A")
+
+		assert.Equal(t, exception.ErrTeamNotWaitlisted, team.PromoteFromWaitlist())
+	})
+}
@@ -0,0 +1,98 @@
+package persistence_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/infra/persistence/adapter"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"github.com/FOR-GAMERS/GAMERS-BE/test/global/support"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type MatchAwardDatabaseAdapterTestSuite struct {
+	suite.Suite
+	container    *support.MySQLContainer
+	db           *gorm.DB
+	awardAdapter *adapter.MatchAwardDatabaseAdapter
+}
+
+func (s *MatchAwardDatabaseAdapterTestSuite) SetupSuite() {
+	ctx := context.Background()
+	var err error
+
+	s.container, err = support.SetupMySQLContainer(ctx)
+	s.Require().NoError(err, "Failed to setup MySQL container")
+
+	s.db = s.container.GetDB()
+
+	// Auto-migrate schemas
+	err = s.db.AutoMigrate(&domain.MvpVoting{}, &domain.MvpVote{}, &domain.MatchAward{})
+	s.Require().NoError(err, "Failed to migrate schemas")
+}
+
+func (s *MatchAwardDatabaseAdapterTestSuite) TearDownSuite() {
+	ctx := context.Background()
+	if s.container != nil {
+		s.container.Teardown(ctx)
+	}
+}
+
+func (s *MatchAwardDatabaseAdapterTestSuite) SetupTest() {
+	s.db.Exec("DELETE FROM mvp_votes")
+	s.db.Exec("DELETE FROM mvp_votings")
+
+	s.awardAdapter = adapter.NewMatchAwardDatabaseAdapter(s.db)
+}
+
+func (s *MatchAwardDatabaseAdapterTestSuite) openVoting(matchResultID int64) {
+	result := &domain.MatchResult{MatchResultID: matchResultID, GameID: matchResultID, CreatedAt: time.Now()}
+	opened, err := s.awardAdapter.OpenVoting(domain.NewMvpVoting(result), nil)
+	s.Require().NoError(err)
+	s.Require().True(opened)
+}
+
+// ==================== SaveVote Tests ====================
+
+func (s *MatchAwardDatabaseAdapterTestSuite) TestSaveVote_DuplicateVoteRejected() {
+	// Given
+	s.openVoting(10)
+	s.Require().NoError(s.awardAdapter.SaveVote(domain.NewMvpVote(10, 1, 2)))
+
+	// When: the same voter votes again, for another candidate
+	err := s.awardAdapter.SaveVote(domain.NewMvpVote(10, 1, 3))
+
+	// Then
+	s.ErrorIs(err, exception.ErrMvpAlreadyVoted)
+
+	vote, err := s.awardAdapter.GetVote(10, 1)
+	s.NoError(err)
+	s.Equal(int64(2), vote.CandidateID)
+
+	counts, err := s.awardAdapter.CountVotes(10)
+	s.NoError(err)
+	s.Len(counts, 1)
+	s.Equal(1, counts[0].Votes)
+}
+
+func (s *MatchAwardDatabaseAdapterTestSuite) TestSaveVote_SameVoterInAnotherMatch() {
+	// Given
+	s.openVoting(10)
+	s.openVoting(11)
+	s.Require().NoError(s.awardAdapter.SaveVote(domain.NewMvpVote(10, 1, 2)))
+
+	// When
+	err := s.awardAdapter.SaveVote(domain.NewMvpVote(11, 1, 2))
+
+	// Then
+	s.NoError(err)
+}
+
+// ==================== Run the test suite ====================
+
+func TestMatchAwardDatabaseAdapterSuite(t *testing.T) {
+	suite.Run(t, new(MatchAwardDatabaseAdapterTestSuite))
+}
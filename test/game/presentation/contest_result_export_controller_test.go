@@ -0,0 +1,98 @@
+package presentation_test
+
+import (
+	contestApplication "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application"
+	contestPort "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/application/port"
+	contestDomain "github.com/FOR-GAMERS/GAMERS-BE/internal/contest/domain"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/game/presentation"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/handler"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ==================== Mock Definitions ====================
+
+// MockContestMemberDatabasePort mocks the ContestMemberDatabasePort methods used by the permission checker
+type MockContestMemberDatabasePort struct {
+	mock.Mock
+	contestPort.ContestMemberDatabasePort
+}
+
+func (m *MockContestMemberDatabasePort) GetByContestAndUser(contestId, userId int64) (*contestDomain.ContestMember, error) {
+	args := m.Called(contestId, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*contestDomain.ContestMember), args.Error(1)
+}
+
+// MockContestResultExportDatabasePort fails the test if the export is built; a rejected caller must not read results
+type MockContestResultExportDatabasePort struct {
+	mock.Mock
+	port.ContestResultExportDatabasePort
+}
+
+// ==================== Helper Functions ====================
+
+func setupExportRouter(mockMemberDB *MockContestMemberDatabasePort) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	service := application.NewContestResultExportService(nil, new(MockContestResultExportDatabasePort), nil)
+	service.SetPermissionChecker(contestApplication.NewContestPermissionChecker(mockMemberDB))
+	controller := presentation.NewContestResultExportController(nil, service, handler.NewControllerHelper())
+
+	router := gin.New()
+	router.GET("/api/contests/:id/result/export", func(c *gin.Context) {
+		c.Set("userId", int64(10))
+		controller.ExportContestResult(c)
+	})
+	return router
+}
+
+// ==================== Controller Tests ====================
+
+func TestContestResultExportController_Export_ForbiddenForNonStaff(t *testing.T) {
+	tests := []struct {
+		name   string
+		member *contestDomain.ContestMember
+	}{
+		{"Participant", contestDomain.NewContestMember(10, 1, contestDomain.MemberTypeNormal, contestDomain.LeaderTypeMember)},
+		{"Team captain", contestDomain.NewContestMember(10, 1, contestDomain.MemberTypeNormal, contestDomain.LeaderTypeLeader)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMemberDB := new(MockContestMemberDatabasePort)
+			mockMemberDB.On("GetByContestAndUser", int64(1), int64(10)).Return(tt.member, nil)
+			router := setupExportRouter(mockMemberDB)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/contests/1/result/export?format=csv&section=rosters", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.NotContains(t, w.Header().Get("Content-Disposition"), "attachment")
+		})
+	}
+}
+
+func TestContestResultExportController_Export_NonMember(t *testing.T) {
+	mockMemberDB := new(MockContestMemberDatabasePort)
+	mockMemberDB.On("GetByContestAndUser", int64(1), int64(10)).Return(nil, exception.ErrContestMemberNotFound)
+	router := setupExportRouter(mockMemberDB)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/contests/1/result/export", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, exception.ErrInvalidAccess.Status, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+}
@@ -0,0 +1,101 @@
+package dto_test
+
+import (
+	commonDto "github.com/FOR-GAMERS/GAMERS-BE/internal/global/common/dto"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/global/exception"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCursorRequest_FirstPage(t *testing.T) {
+	sort := commonDto.NewSortRequest("created_at", "desc", []string{"created_at"})
+
+	req, err := commonDto.NewCursorRequest("", 0, sort)
+
+	assert.NoError(t, err)
+	assert.True(t, req.IsFirstPage())
+	assert.Equal(t, 10, req.Limit)
+	assert.Equal(t, 11, req.FetchLimit())
+
+	condition, args := req.KeysetCondition("created_at", "contest_id")
+	assert.Empty(t, condition)
+	assert.Nil(t, args)
+}
+
+func TestNewCursorRequest_ClampsLimit(t *testing.T) {
+	sort := commonDto.NewSortRequest("created_at", "desc", nil)
+
+	req, err := commonDto.NewCursorRequest("", 500, sort)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, req.Limit)
+}
+
+func TestCursor_RoundTripTime(t *testing.T) {
+	sort := commonDto.NewSortRequest("created_at", "desc", nil)
+	createdAt := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	encoded := commonDto.EncodeCursor(sort, createdAt, 42)
+	req, err := commonDto.NewCursorRequest(encoded, 20, sort)
+
+	assert.NoError(t, err)
+	assert.False(t, req.IsFirstPage())
+
+	condition, args := req.KeysetCondition("created_at", "contest_id")
+	assert.Equal(t, "(created_at < ? OR (created_at = ? AND contest_id < ?))", condition)
+	assert.Equal(t, []interface{}{createdAt, createdAt, int64(42)}, args)
+	assert.Equal(t, "created_at DESC, contest_id DESC", req.OrderClause("created_at", "contest_id"))
+}
+
+func TestCursor_RoundTripAscendingInt(t *testing.T) {
+	sort := commonDto.NewSortRequest("point", "asc", []string{"point", "username"})
+
+	req, err := commonDto.NewCursorRequest(commonDto.EncodeCursor(sort, 15, 7), 10, sort)
+
+	assert.NoError(t, err)
+	condition, args := req.KeysetCondition("cm.point", "cm.user_id")
+	assert.Equal(t, "(cm.point > ? OR (cm.point = ? AND cm.user_id > ?))", condition)
+	assert.Equal(t, []interface{}{int64(15), int64(15), int64(7)}, args)
+}
+
+func TestNewCursorRequest_RejectsInvalidCursor(t *testing.T) {
+	sort := commonDto.NewSortRequest("created_at", "desc", nil)
+
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"Not base64", "%%%"},
+		{"Not JSON", "bm90LWpzb24"},
+		{"Different sort field", commonDto.EncodeCursor(commonDto.NewSortRequest("started_at", "desc", nil), time.Now(), 1)},
+		{"Different order", commonDto.EncodeCursor(commonDto.NewSortRequest("created_at", "asc", nil), time.Now(), 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := commonDto.NewCursorRequest(tt.encoded, 10, sort)
+			assert.ErrorIs(t, err, exception.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestTrimCursorPage(t *testing.T) {
+	sort := commonDto.NewSortRequest("point", "desc", nil)
+	req, _ := commonDto.NewCursorRequest("", 2, sort)
+	key := func(point int) (interface{}, int64) { return point, int64(point) }
+
+	rows, next := commonDto.TrimCursorPage([]int{30, 20, 10}, req, key)
+	assert.Equal(t, []int{30, 20}, rows)
+	if assert.NotNil(t, next) {
+		nextReq, err := commonDto.NewCursorRequest(*next, 2, sort)
+		assert.NoError(t, err)
+		_, args := nextReq.KeysetCondition("point", "id")
+		assert.Equal(t, []interface{}{int64(20), int64(20), int64(20)}, args)
+	}
+
+	rows, next = commonDto.TrimCursorPage([]int{30, 20}, req, key)
+	assert.Equal(t, []int{30, 20}, rows)
+	assert.Nil(t, next)
+}
@@ -0,0 +1,172 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Mock Definitions ====================
+
+// FakeOutboxDatabasePort keeps outbox rows in memory and selects publishable rows like the database adapter:
+// due PENDING and FAILED rows, oldest first, without an older unpublished row of the same aggregate
+type FakeOutboxDatabasePort struct {
+	port.OutboxDatabasePort
+
+	events []*domain.OutboxEvent
+}
+
+func (f *FakeOutboxDatabasePort) Save(ctx context.Context, event *domain.OutboxEvent) error {
+	event.OutboxID = int64(len(f.events) + 1)
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *FakeOutboxDatabasePort) GetPublishable(now time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	var publishable []*domain.OutboxEvent
+	heldBack := make(map[string]bool)
+
+	for _, event := range f.events {
+		if event.Status == domain.OutboxStatusPublished {
+			continue
+		}
+
+		key := event.AggregateKey()
+		if !heldBack[key] && !event.NextAttemptAt.After(now) && len(publishable) < limit {
+			publishable = append(publishable, event)
+		}
+		heldBack[key] = true
+	}
+
+	return publishable, nil
+}
+
+func (f *FakeOutboxDatabasePort) Update(event *domain.OutboxEvent) error {
+	return nil
+}
+
+// FakeOutboxBrokerPort records published rows and fails the rows listed in failing
+type FakeOutboxBrokerPort struct {
+	published []int64
+	failing   map[int64]bool
+}
+
+func (f *FakeOutboxBrokerPort) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	if f.failing[event.OutboxID] {
+		return errors.New("broker nacked")
+	}
+	f.published = append(f.published, event.OutboxID)
+	return nil
+}
+
+// ==================== Helper Functions ====================
+
+// setupOutboxRelay stores contest 1 created, contest 1 started and game 1 finished, in that order
+func setupOutboxRelay() (*application.OutboxRelayService, *FakeOutboxDatabasePort, *FakeOutboxBrokerPort) {
+	repo := &FakeOutboxDatabasePort{}
+	broker := &FakeOutboxBrokerPort{failing: make(map[int64]bool)}
+
+	enqueue := application.NewOutboxService(repo)
+	messages := []*port.OutboxMessage{
+		{AggregateType: port.AggregateContest, AggregateID: 1, Exchange: "gamers.events", RoutingKey: "contest.created", Body: []byte(`{}`)},
+		{AggregateType: port.AggregateContest, AggregateID: 1, Exchange: "gamers.events", RoutingKey: "contest.started", Body: []byte(`{}`)},
+		{AggregateType: port.AggregateGame, AggregateID: 1, Exchange: "gamers.events", RoutingKey: "game.finished", Body: []byte(`{}`)},
+	}
+	for _, message := range messages {
+		if err := enqueue.Enqueue(context.Background(), message); err != nil {
+			panic(err)
+		}
+	}
+
+	return application.NewOutboxRelayService(repo, broker, nil), repo, broker
+}
+
+// makeDue lets the row's retry come due
+func makeDue(event *domain.OutboxEvent) {
+	event.NextAttemptAt = time.Now().Add(-time.Second)
+}
+
+// ==================== RelayPending Tests ====================
+
+func TestOutboxRelayService_RelayPending_PublishesInOrder(t *testing.T) {
+	relay, repo, broker := setupOutboxRelay()
+
+	relay.RelayPending(context.Background())
+
+	assert.Equal(t, []int64{1, 3}, broker.published)
+	for _, id := range []int64{1, 3} {
+		event := repo.events[id-1]
+		assert.Equal(t, domain.OutboxStatusPublished, event.Status)
+		assert.Equal(t, 1, event.Attempts)
+		assert.NotNil(t, event.PublishedAt)
+	}
+	assert.Equal(t, domain.OutboxStatusPending, repo.events[1].Status)
+
+	// The next row of the contest goes once the first is published
+	relay.RelayPending(context.Background())
+
+	assert.Equal(t, []int64{1, 3, 2}, broker.published)
+	assert.Equal(t, domain.OutboxStatusPublished, repo.events[1].Status)
+}
+
+func TestOutboxRelayService_RelayPending_FailureHoldsBackAggregate(t *testing.T) {
+	relay, repo, broker := setupOutboxRelay()
+	broker.failing[1] = true
+
+	before := time.Now()
+	relay.RelayPending(context.Background())
+
+	// The game is not held back by the contest
+	assert.Equal(t, []int64{3}, broker.published)
+
+	failed := repo.events[0]
+	assert.Equal(t, domain.OutboxStatusPending, failed.Status)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, "broker nacked", failed.LastError)
+	assert.False(t, failed.NextAttemptAt.Before(before.Add(time.Second)))
+
+	// Not retried before its backoff, and the later contest row waits behind it
+	broker.failing[1] = false
+	relay.RelayPending(context.Background())
+	assert.Equal(t, []int64{3}, broker.published)
+
+	makeDue(failed)
+	relay.RelayPending(context.Background())
+	assert.Equal(t, []int64{3, 1}, broker.published)
+	assert.Equal(t, domain.OutboxStatusPublished, failed.Status)
+	assert.Empty(t, failed.LastError)
+}
+
+func TestOutboxRelayService_RelayPending_FailedRowKeepsRetrying(t *testing.T) {
+	relay, repo, broker := setupOutboxRelay()
+	broker.failing[1] = true
+	failed := repo.events[0]
+	failed.Attempts = domain.MaxOutboxAttempts - 1
+
+	before := time.Now()
+	relay.RelayPending(context.Background())
+
+	assert.True(t, failed.IsFailed())
+	assert.False(t, failed.NextAttemptAt.Before(before.Add(5*time.Minute)))
+
+	// A FAILED row still holds back the later rows of its aggregate
+	makeDue(failed)
+	relay.RelayPending(context.Background())
+	assert.Equal(t, []int64{3}, broker.published)
+	assert.True(t, failed.IsFailed())
+
+	// and is published once the broker accepts it
+	broker.failing[1] = false
+	makeDue(failed)
+	relay.RelayPending(context.Background())
+	relay.RelayPending(context.Background())
+
+	assert.Equal(t, []int64{3, 1, 2}, broker.published)
+	assert.Equal(t, domain.OutboxStatusPublished, failed.Status)
+}
@@ -0,0 +1,59 @@
+package application_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/application/port"
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== Enqueue Tests ====================
+
+func TestOutboxService_Enqueue_FillsDefaults(t *testing.T) {
+	repo := &FakeOutboxDatabasePort{}
+	service := application.NewOutboxService(repo)
+
+	before := time.Now()
+	err := service.Enqueue(context.Background(), &port.OutboxMessage{
+		AggregateType: port.AggregateGame,
+		AggregateID:   7,
+		Exchange:      "gamers.events",
+		RoutingKey:    "game.finished",
+		Body:          []byte(`{"game_id":7}`),
+		Headers:       map[string]interface{}{"event_type": "game.finished"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, repo.events, 1)
+
+	event := repo.events[0]
+	assert.NotEmpty(t, event.MessageID)
+	assert.False(t, event.OccurredAt.Before(before))
+	assert.Equal(t, domain.OutboxStatusPending, event.Status)
+	assert.Equal(t, 0, event.Attempts)
+	assert.Equal(t, "game:7", event.AggregateKey())
+	assert.Equal(t, `{"game_id":7}`, event.Payload)
+	assert.Equal(t, "game.finished", event.Headers["event_type"])
+}
+
+func TestOutboxService_Enqueue_KeepsMessageID(t *testing.T) {
+	repo := &FakeOutboxDatabasePort{}
+	service := application.NewOutboxService(repo)
+	occurredAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	err := service.Enqueue(context.Background(), &port.OutboxMessage{
+		AggregateType: port.AggregateContest,
+		AggregateID:   1,
+		RoutingKey:    "contest.created",
+		MessageID:     "contest-1-created",
+		OccurredAt:    occurredAt,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "contest-1-created", repo.events[0].MessageID)
+	assert.Equal(t, occurredAt, repo.events[0].OccurredAt)
+}
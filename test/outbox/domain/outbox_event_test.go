@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"github.com/FOR-GAMERS/GAMERS-BE/internal/outbox/domain"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newEvent() *domain.OutboxEvent {
+	return domain.NewOutboxEvent("contest", 1, "gamers.events", "contest.created", "message-1", []byte(`{}`), nil, time.Now())
+}
+
+// ==================== MarkAttemptFailed Tests ====================
+
+func TestOutboxEvent_MarkAttemptFailed_Backoff(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expected := []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		16 * time.Second,
+		32 * time.Second,
+		64 * time.Second,
+		128 * time.Second,
+		256 * time.Second,
+	}
+
+	event := newEvent()
+	for i, backoff := range expected {
+		event.MarkAttemptFailed(errors.New("broker unavailable"), now)
+
+		assert.Equal(t, i+1, event.Attempts)
+		assert.Equal(t, domain.OutboxStatusPending, event.Status)
+		assert.Equal(t, now.Add(backoff), event.NextAttemptAt, "attempt %d", i+1)
+	}
+}
+
+func TestOutboxEvent_MarkAttemptFailed_KeepsRetryingAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := newEvent()
+	event.Attempts = domain.MaxOutboxAttempts - 1
+
+	event.MarkAttemptFailed(errors.New("broker unavailable"), now)
+
+	assert.True(t, event.IsFailed())
+	assert.Equal(t, domain.MaxOutboxAttempts, event.Attempts)
+	assert.Equal(t, now.Add(5*time.Minute), event.NextAttemptAt)
+
+	// Still scheduled at the capped backoff on every later attempt
+	event.MarkAttemptFailed(errors.New("broker unavailable"), now.Add(time.Hour))
+
+	assert.True(t, event.IsFailed())
+	assert.Equal(t, now.Add(time.Hour+5*time.Minute), event.NextAttemptAt)
+}
+
+func TestOutboxEvent_MarkAttemptFailed_TruncatesError(t *testing.T) {
+	event := newEvent()
+
+	event.MarkAttemptFailed(errors.New(strings.Repeat("x", 1500)), time.Now())
+
+	assert.Len(t, event.LastError, 1000)
+}
+
+// ==================== MarkPublished Tests ====================
+
+func TestOutboxEvent_MarkPublished_AfterFailure(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := newEvent()
+	event.Attempts = domain.MaxOutboxAttempts - 1
+	event.MarkAttemptFailed(errors.New("broker unavailable"), now)
+
+	event.MarkPublished(now.Add(5 * time.Minute))
+
+	assert.Equal(t, domain.OutboxStatusPublished, event.Status)
+	assert.Equal(t, domain.MaxOutboxAttempts+1, event.Attempts)
+	assert.Empty(t, event.LastError)
+	assert.Equal(t, now.Add(5*time.Minute), *event.PublishedAt)
+}